@@ -0,0 +1,220 @@
+// Package alerts defines a beacon node service checking for critical conditions, such as
+// finality not advancing or the node losing its peers, and notifying a webhook when they
+// start and stop.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "alerts")
+
+// Config options for the alerts service.
+type Config struct {
+	// WebhookURL is the URL the alerts are posted to.
+	WebhookURL string
+	// FinalityEpochs is the number of epochs without finality after which an alert fires.
+	FinalityEpochs uint64
+	// MinPeers is the number of connected peers below which an alert fires.
+	MinPeers int
+	// TrackedIndices are the indices of the validators to alert about when they are slashed.
+	TrackedIndices   []uint64
+	ChainInfoFetcher blockchain.ChainInfoFetcher
+	TimeFetcher      blockchain.TimeFetcher
+	POWChainInfo     powchain.ChainInfoFetcher
+	PeersProvider    p2p.PeersProvider
+	SyncChecker      prysmsync.Checker
+	CheckInterval    time.Duration
+}
+
+// Service periodically checks for critical conditions, notifying the webhook
+// when a condition starts and when it is resolved.
+type Service struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cfg      *Config
+	notifier notifier
+	// active holds the conditions which are currently alerting.
+	active map[string]bool
+	lock   sync.Mutex
+}
+
+// The keys of the conditions which are not checked while the node is syncing.
+const (
+	finalityStalledKey        = "finality-stalled"
+	validatorSlashedKeyPrefix = "validator-slashed-"
+)
+
+// condition is a critical condition to alert about, identified by its key.
+type condition struct {
+	key     string
+	message string
+}
+
+// NewService creates an alerts service posting to the configured webhook.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:      ctx,
+		cancel:   cancel,
+		cfg:      cfg,
+		notifier: newWebhookNotifier(cfg.WebhookURL),
+		active:   make(map[string]bool),
+	}
+}
+
+// Start checking for critical conditions.
+func (s *Service) Start() {
+	log.WithField("webhook", s.cfg.WebhookURL).Info("Starting alerts service")
+	runutil.RunEvery(s.ctx, s.cfg.CheckInterval, s.check)
+}
+
+// Stop checking for critical conditions.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the alerts service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// check notifies the conditions which started or were resolved since the last check.
+func (s *Service) check() {
+	syncing := s.cfg.SyncChecker != nil && s.cfg.SyncChecker.Syncing()
+	conditions := s.conditions(s.ctx, syncing)
+
+	var alerts []*alert
+	s.lock.Lock()
+	current := make(map[string]bool, len(conditions))
+	for _, c := range conditions {
+		current[c.key] = true
+		if s.active[c.key] {
+			continue
+		}
+		alerts = append(alerts, &alert{Condition: c.key, Text: c.message})
+	}
+	for key := range s.active {
+		if current[key] {
+			continue
+		}
+		// The conditions not checked while syncing keep their state until they are checked again.
+		if syncing && checkedWhenSynced(key) {
+			current[key] = true
+			continue
+		}
+		alerts = append(alerts, &alert{Condition: key, Text: fmt.Sprintf("Resolved: %s", key), Resolved: true})
+	}
+	s.active = current
+	s.lock.Unlock()
+
+	// The webhook is posted to without holding the lock, as it may take up to its timeout to answer.
+	for _, a := range alerts {
+		s.notify(s.ctx, a)
+	}
+}
+
+func (s *Service) notify(ctx context.Context, a *alert) {
+	log.WithFields(logrus.Fields{
+		"condition": a.Condition,
+		"resolved":  a.Resolved,
+	}).Warn(a.Text)
+	if err := s.notifier.notify(ctx, a); err != nil {
+		log.WithError(err).Error("Could not send alert")
+	}
+}
+
+// conditions returns the critical conditions currently occurring. Finality and slashed validators
+// are not checked while the node is syncing, as its head lags behind until it is synced, while
+// the connections to eth1 and to peers are always checked.
+func (s *Service) conditions(ctx context.Context, syncing bool) []*condition {
+	var conditions []*condition
+	if !syncing {
+		if c := s.finalityCondition(); c != nil {
+			conditions = append(conditions, c)
+		}
+	}
+	if s.cfg.POWChainInfo != nil && !s.cfg.POWChainInfo.IsConnectedToETH1() {
+		conditions = append(conditions, &condition{
+			key:     "eth1-disconnected",
+			message: "Beacon node is not connected to its eth1 endpoint",
+		})
+	}
+	if s.cfg.PeersProvider != nil {
+		if peers := len(s.cfg.PeersProvider.Peers().Connected()); peers < s.cfg.MinPeers {
+			conditions = append(conditions, &condition{
+				key:     "low-peer-count",
+				message: fmt.Sprintf("Beacon node has %d connected peers, below the minimum of %d", peers, s.cfg.MinPeers),
+			})
+		}
+	}
+	if syncing {
+		return conditions
+	}
+	return append(conditions, s.slashedConditions(ctx)...)
+}
+
+// checkedWhenSynced returns true for the keys of the conditions which are not checked while the
+// node is syncing.
+func checkedWhenSynced(key string) bool {
+	return key == finalityStalledKey || strings.HasPrefix(key, validatorSlashedKeyPrefix)
+}
+
+// finalityCondition returns a condition if finality did not advance for the configured number of epochs.
+func (s *Service) finalityCondition() *condition {
+	if s.cfg.TimeFetcher.GenesisTime().IsZero() {
+		return nil
+	}
+	finalized := s.cfg.ChainInfoFetcher.FinalizedCheckpt()
+	if finalized == nil {
+		return nil
+	}
+	currentEpoch := helpers.SlotToEpoch(s.cfg.TimeFetcher.CurrentSlot())
+	if currentEpoch <= finalized.Epoch || currentEpoch-finalized.Epoch <= s.cfg.FinalityEpochs {
+		return nil
+	}
+	return &condition{
+		key: finalityStalledKey,
+		message: fmt.Sprintf("Finality did not advance for %d epochs, last finalized epoch is %d",
+			currentEpoch-finalized.Epoch, finalized.Epoch),
+	}
+}
+
+// slashedConditions returns a condition for every tracked validator slashed in the head state.
+func (s *Service) slashedConditions(ctx context.Context) []*condition {
+	if len(s.cfg.TrackedIndices) == 0 {
+		return nil
+	}
+	headState, err := s.cfg.ChainInfoFetcher.HeadState(ctx)
+	if err != nil || headState == nil {
+		log.WithError(err).Debug("Could not retrieve head state")
+		return nil
+	}
+	var conditions []*condition
+	for _, idx := range s.cfg.TrackedIndices {
+		v, err := headState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			continue
+		}
+		if v.Slashed() {
+			conditions = append(conditions, &condition{
+				key:     fmt.Sprintf("%s%d", validatorSlashedKeyPrefix, idx),
+				message: fmt.Sprintf("Tracked validator %d was slashed", idx),
+			})
+		}
+	}
+	return conditions
+}
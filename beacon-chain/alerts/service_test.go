@@ -0,0 +1,153 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_Check(t *testing.T) {
+	var lock sync.Mutex
+	var received []*alert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := &alert{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(a))
+		lock.Lock()
+		received = append(received, a)
+		lock.Unlock()
+	}))
+	defer srv.Close()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	v, err := beaconState.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	v.Slashed = true
+	require.NoError(t, beaconState.UpdateValidatorAtIndex(3, v))
+
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	chain := &mock.ChainService{
+		State:               beaconState,
+		Genesis:             time.Now().Add(-10 * epochDuration),
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1},
+	}
+	s := NewService(context.Background(), &Config{
+		WebhookURL:       srv.URL,
+		FinalityEpochs:   4,
+		MinPeers:         3,
+		TrackedIndices:   []uint64{2, 3},
+		ChainInfoFetcher: chain,
+		TimeFetcher:      chain,
+		PeersProvider:    &p2ptest.MockPeersProvider{},
+	})
+
+	s.check()
+	lock.Lock()
+	conditions := make(map[string]bool)
+	for _, a := range received {
+		conditions[a.Condition] = true
+		assert.Equal(t, false, a.Resolved)
+	}
+	received = nil
+	lock.Unlock()
+	assert.DeepEqual(t, map[string]bool{
+		"finality-stalled":    true,
+		"low-peer-count":      true,
+		"validator-slashed-3": true,
+	}, conditions)
+
+	// Conditions which are still occurring are not notified again.
+	s.check()
+	lock.Lock()
+	assert.Equal(t, 0, len(received))
+	lock.Unlock()
+
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(chain.CurrentSlot())}
+	s.check()
+	lock.Lock()
+	defer lock.Unlock()
+	require.Equal(t, 1, len(received))
+	assert.Equal(t, "finality-stalled", received[0].Condition)
+	assert.Equal(t, true, received[0].Resolved)
+}
+
+func TestService_Check_Syncing(t *testing.T) {
+	var lock sync.Mutex
+	var received []*alert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := &alert{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(a))
+		lock.Lock()
+		received = append(received, a)
+		lock.Unlock()
+	}))
+	defer srv.Close()
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	v, err := beaconState.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	v.Slashed = true
+	require.NoError(t, beaconState.UpdateValidatorAtIndex(3, v))
+
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	chain := &mock.ChainService{
+		State:               beaconState,
+		Genesis:             time.Now().Add(-10 * epochDuration),
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1},
+	}
+	syncChecker := &mockSync.Sync{IsSyncing: true}
+	s := NewService(context.Background(), &Config{
+		WebhookURL:       srv.URL,
+		FinalityEpochs:   4,
+		MinPeers:         3,
+		TrackedIndices:   []uint64{3},
+		ChainInfoFetcher: chain,
+		TimeFetcher:      chain,
+		PeersProvider:    &p2ptest.MockPeersProvider{},
+		SyncChecker:      syncChecker,
+	})
+
+	// Only the peer and eth1 connections are checked while syncing.
+	s.check()
+	lock.Lock()
+	require.Equal(t, 1, len(received))
+	assert.Equal(t, "low-peer-count", received[0].Condition)
+	received = nil
+	lock.Unlock()
+
+	syncChecker.IsSyncing = false
+	s.check()
+	lock.Lock()
+	conditions := make(map[string]bool)
+	for _, a := range received {
+		conditions[a.Condition] = true
+	}
+	received = nil
+	lock.Unlock()
+	assert.DeepEqual(t, map[string]bool{
+		"finality-stalled":    true,
+		"validator-slashed-3": true,
+	}, conditions)
+
+	// The conditions not checked while syncing are neither resolved nor notified again.
+	syncChecker.IsSyncing = true
+	s.check()
+	syncChecker.IsSyncing = false
+	s.check()
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, 0, len(received))
+}
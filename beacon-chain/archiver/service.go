@@ -0,0 +1,173 @@
+// Package archiver defines the service of archival nodes indexing the history of the chain. Once
+// an epoch is finalized, the validator balances and beacon committees at its start and the
+// attestations included in its canonical blocks are saved, so they can be served without
+// regenerating states.
+package archiver
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "archiver")
+
+// StateFetcher retrieves the canonical state at a slot.
+type StateFetcher interface {
+	StateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error)
+}
+
+// Config options for the archiver service.
+type Config struct {
+	BeaconDB            db.NoHeadAccessDatabase
+	FinalizationFetcher blockchain.FinalizationFetcher
+	StateFetcher        StateFetcher
+}
+
+// Service archives every finalized epoch, in order.
+type Service struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	beaconDB            db.NoHeadAccessDatabase
+	finalizationFetcher blockchain.FinalizationFetcher
+	stateFetcher        StateFetcher
+}
+
+// NewService creates an archiver service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:                 ctx,
+		cancel:              cancel,
+		beaconDB:            cfg.BeaconDB,
+		finalizationFetcher: cfg.FinalizationFetcher,
+		stateFetcher:        cfg.StateFetcher,
+	}
+}
+
+// Start archiving the finalized epochs, checking for new ones every slot.
+func (s *Service) Start() {
+	log.Info("Starting archiver")
+	period := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	runutil.RunEvery(s.ctx, period, func() {
+		if err := s.archiveFinalizedEpochs(s.ctx); err != nil {
+			log.WithError(err).Error("Could not archive finalized epochs")
+		}
+	})
+}
+
+// Stop the archiver.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the archiver.
+func (s *Service) Status() error {
+	return nil
+}
+
+// archiveFinalizedEpochs archives the epochs before the finalized epoch which aren't archived
+// yet. The blocks of these epochs are all canonical, unlike those of the finalized epoch.
+func (s *Service) archiveFinalizedEpochs(ctx context.Context) error {
+	finalized := s.finalizationFetcher.FinalizedCheckpt()
+	if finalized == nil {
+		return nil
+	}
+	archived, err := s.beaconDB.ArchivedEpochs(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve archived epochs")
+	}
+	for epoch := archived; epoch < finalized.Epoch; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.archiveEpoch(ctx, epoch); err != nil {
+			return errors.Wrapf(err, "could not archive epoch %d", epoch)
+		}
+	}
+	if finalized.Epoch > archived {
+		log.WithField("epoch", finalized.Epoch-1).Debug("Archived finalized epochs")
+	}
+	return nil
+}
+
+func (s *Service) archiveEpoch(ctx context.Context, epoch uint64) error {
+	st, err := s.stateFetcher.StateBySlot(ctx, helpers.StartSlot(epoch))
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve state at epoch start")
+	}
+	blocks, err := s.beaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(epoch).SetEndEpoch(epoch))
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve blocks")
+	}
+	var atts []*ethpb.Attestation
+	var attesters [][]uint64
+	for _, blk := range blocks {
+		root, err := stateutil.BlockRoot(blk.Block)
+		if err != nil {
+			return err
+		}
+		if !s.beaconDB.IsFinalizedBlock(ctx, root) {
+			continue
+		}
+		for _, att := range blk.Block.Body.Attestations {
+			// The committees of the attestations of the epoch, from the current or previous
+			// epoch, are both known to the state at the epoch start.
+			committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+			if err != nil {
+				return errors.Wrap(err, "could not retrieve attestation committee")
+			}
+			atts = append(atts, att)
+			attesters = append(attesters, attestationutil.AttestingIndices(att.AggregationBits, committee))
+		}
+	}
+	// The committees are saved first, an epoch marked as archived has all of its indices.
+	if err := s.archiveCommittees(ctx, epoch, st); err != nil {
+		return errors.Wrap(err, "could not archive committees")
+	}
+	return s.beaconDB.SaveArchivedEpoch(ctx, epoch, st.Balances(), atts, attesters)
+}
+
+// archiveCommittees saves the beacon committees of the epoch, computed from the state at its start.
+func (s *Service) archiveCommittees(ctx context.Context, epoch uint64, st *state.BeaconState) error {
+	activeIndices, err := helpers.ActiveValidatorIndices(st, epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve active validator indices")
+	}
+	seed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve attester seed")
+	}
+	countAtSlot := helpers.SlotCommitteeCount(uint64(len(activeIndices)))
+	startSlot := helpers.StartSlot(epoch)
+	committees := make(map[uint64]*ethpb.BeaconCommittees_CommitteesList, params.BeaconConfig().SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		items := make([]*ethpb.BeaconCommittees_CommitteeItem, countAtSlot)
+		for i := range items {
+			committee, err := helpers.BeaconCommittee(activeIndices, seed, slot, uint64(i))
+			if err != nil {
+				return errors.Wrapf(err, "could not compute committee %d of slot %d", i, slot)
+			}
+			items[i] = &ethpb.BeaconCommittees_CommitteeItem{ValidatorIndices: committee}
+		}
+		committees[slot] = &ethpb.BeaconCommittees_CommitteesList{Committees: items}
+	}
+	return s.beaconDB.SaveArchivedCommittees(ctx, &ethpb.BeaconCommittees{
+		Epoch:                epoch,
+		Committees:           committees,
+		ActiveValidatorCount: uint64(len(activeIndices)),
+	})
+}
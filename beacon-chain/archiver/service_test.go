@@ -0,0 +1,165 @@
+package archiver
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockStateFetcher struct {
+	states map[uint64]*state.BeaconState
+}
+
+func (m *mockStateFetcher) StateBySlot(_ context.Context, slot uint64) (*state.BeaconState, error) {
+	return m.states[slot], nil
+}
+
+func TestService_ArchiveFinalizedEpochs(t *testing.T) {
+	db, _ := testDB.SetupDB(t)
+	ctx := context.Background()
+
+	// Canonical chain of a block per epoch, with a fork block in epoch 1.
+	saveBlock := func(slot uint64, parent [32]byte, att *ethpb.Attestation) [32]byte {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = parent[:]
+		blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := stateutil.BlockRoot(blk.Block)
+		require.NoError(t, err)
+		return root
+	}
+	newAtt := func(slot uint64) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0b1101},
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	genesisRoot := saveBlock(0, [32]byte{}, newAtt(0))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	root := saveBlock(slotsPerEpoch, genesisRoot, newAtt(1))
+	saveBlock(slotsPerEpoch+1, genesisRoot, newAtt(2))
+	root = saveBlock(2*slotsPerEpoch, root, newAtt(3))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: root[:]}))
+
+	states := make(map[uint64]*state.BeaconState)
+	for epoch := uint64(0); epoch < 3; epoch++ {
+		st := testutil.NewBeaconState()
+		require.NoError(t, st.SetBalances([]uint64{epoch, epoch + 1}))
+		states[helpers.StartSlot(epoch)] = st
+	}
+	s := NewService(ctx, &Config{
+		BeaconDB:            db,
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 2}},
+		StateFetcher:        &mockStateFetcher{states: states},
+	})
+	require.NoError(t, s.archiveFinalizedEpochs(ctx))
+
+	archived, err := db.ArchivedEpochs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), archived, "Expected the epochs before the finalized epoch to be archived")
+	balances, err := db.ArchivedBalances(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2}, balances)
+	atts, err := db.ArchivedAttestations(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts), "Expected only the attestations of the canonical block")
+	assert.Equal(t, uint64(1), atts[0].Data.Slot)
+	committees, err := db.ArchivedCommittees(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, committees)
+	assert.Equal(t, uint64(1), committees.Epoch)
+	assert.Equal(t, int(slotsPerEpoch), len(committees.Committees), "Expected the committees of every slot of the epoch")
+	balances, err = db.ArchivedBalances(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(balances), "Expected the finalized epoch not to be archived")
+}
+
+func TestService_ArchiveEpoch_Attesters(t *testing.T) {
+	db, _ := testDB.SetupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 64)
+	epochState := genesisState.Copy()
+	require.NoError(t, epochState.SetSlot(slotsPerEpoch))
+	committee, err := helpers.BeaconCommitteeFromState(epochState, slotsPerEpoch, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1, "Expected a committee of several validators")
+
+	// Only the first member of the committee attests.
+	aggBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggBits.SetBitAt(0, true)
+	att := &ethpb.Attestation{
+		AggregationBits: aggBits,
+		Data: &ethpb.AttestationData{
+			Slot:            slotsPerEpoch,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+	saveBlock := func(slot uint64, parent [32]byte, atts []*ethpb.Attestation) [32]byte {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = parent[:]
+		blk.Block.Body.Attestations = atts
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := stateutil.BlockRoot(blk.Block)
+		require.NoError(t, err)
+		return root
+	}
+	genesisRoot := saveBlock(0, [32]byte{}, nil)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	root := saveBlock(slotsPerEpoch, genesisRoot, []*ethpb.Attestation{att})
+	root = saveBlock(2*slotsPerEpoch, root, nil)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: root[:]}))
+
+	s := NewService(ctx, &Config{
+		BeaconDB:            db,
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 2}},
+		StateFetcher: &mockStateFetcher{states: map[uint64]*state.BeaconState{
+			0:             genesisState,
+			slotsPerEpoch: epochState,
+		}},
+	})
+	require.NoError(t, s.archiveFinalizedEpochs(ctx))
+
+	balances, err := db.ArchivedBalances(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, epochState.Balances(), balances)
+	atts, err := db.ArchivedAttestations(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, att, atts[0])
+
+	// The attestation is indexed by its attester only, not by the rest of the committee.
+	atts, err = db.ArchivedAttestationsByValidator(ctx, 1, committee[0])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts), "Expected the attestation of the attester")
+	assert.DeepEqual(t, att, atts[0])
+	for _, idx := range committee[1:] {
+		atts, err = db.ArchivedAttestationsByValidator(ctx, 1, idx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(atts), "Expected no attestation of validator %d which did not attest", idx)
+	}
+}
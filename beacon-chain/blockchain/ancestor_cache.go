@@ -0,0 +1,71 @@
+package blockchain
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// This defines the max number of ancestor roots this cache can store.
+	// Attestation validation mostly looks up the target epoch start slot of a few
+	// recent heads, so a small cache covers the lookups of several epochs.
+	maxAncestorSize = 1024
+
+	// This tracks the number of ancestor root requests that aren't present in the cache.
+	ancestorMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ancestor_root_cache_miss",
+		Help: "The number of ancestor root requests that aren't present in the cache.",
+	})
+	// This tracks the number of ancestor root requests that are in the cache.
+	ancestorHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "ancestor_root_cache_hit",
+		Help: "The number of ancestor root requests that are present in the cache.",
+	})
+)
+
+// ancestorKey identifies an ancestor lookup by the block root it starts from and the queried slot.
+// The ancestor of a block at a given slot never changes, so cached entries never need to be invalidated.
+type ancestorKey struct {
+	root [32]byte
+	slot uint64
+}
+
+// ancestorCache is a struct with 1 LRU cache for looking up the ancestor root of a block at a slot.
+type ancestorCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+// newAncestorCache creates a new ancestor cache for storing/accessing ancestor roots.
+func newAncestorCache() *ancestorCache {
+	cache, err := lru.New(maxAncestorSize)
+	if err != nil {
+		panic(err)
+	}
+	return &ancestorCache{
+		cache: cache,
+	}
+}
+
+// get fetches the ancestor root of the block root at the slot. Returns nil if it doesn't exist.
+func (c *ancestorCache) get(root [32]byte, slot uint64) []byte {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(ancestorKey{root: root, slot: slot})
+	if exists && item != nil {
+		ancestorHit.Inc()
+		return item.([]byte)
+	}
+	ancestorMiss.Inc()
+	return nil
+}
+
+// put adds the ancestor root of the block root at the slot to the cache.
+func (c *ancestorCache) put(root [32]byte, slot uint64, ancestor []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(ancestorKey{root: root, slot: slot}, ancestor)
+}
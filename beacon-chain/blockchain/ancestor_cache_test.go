@@ -0,0 +1,28 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAncestorCache_RoundTrip(t *testing.T) {
+	c := newAncestorCache()
+	root := bytesutil.ToBytes32([]byte{'a'})
+	require.DeepEqual(t, []byte(nil), c.get(root, 10))
+
+	ancestor := bytesutil.PadTo([]byte{'b'}, 32)
+	c.put(root, 10, ancestor)
+	require.DeepEqual(t, ancestor, c.get(root, 10))
+	require.DeepEqual(t, []byte(nil), c.get(root, 11))
+}
+
+func TestAncestorCache_CanPrune(t *testing.T) {
+	c := newAncestorCache()
+	for i := 0; i < maxAncestorSize+1; i++ {
+		c.put([32]byte{}, uint64(i), []byte{'a'})
+	}
+	require.Equal(t, maxAncestorSize, len(c.cache.Keys()))
+	require.DeepEqual(t, []byte(nil), c.get([32]byte{}, 0))
+}
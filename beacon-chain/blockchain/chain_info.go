@@ -42,6 +42,7 @@ type HeadFetcher interface {
 	HeadRoot(ctx context.Context) ([]byte, error)
 	HeadBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error)
 	HeadState(ctx context.Context) (*state.BeaconState, error)
+	HeadStateReadOnly(ctx context.Context) (*state.BeaconState, error)
 	HeadValidatorsIndices(ctx context.Context, epoch uint64) ([]uint64, error)
 	HeadSeed(ctx context.Context, epoch uint64) ([32]byte, error)
 	HeadGenesisValidatorRoot() [32]byte
@@ -155,6 +156,23 @@ func (s *Service) HeadState(ctx context.Context) (*state.BeaconState, error) {
 	return s.beaconDB.HeadState(ctx)
 }
 
+// HeadStateReadOnly returns the head state of the chain without copying it, for callers
+// on hot paths which only read from it. The returned state must not be mutated.
+// If the head is nil from service struct,
+// it will attempt to get the head state from DB.
+func (s *Service) HeadStateReadOnly(ctx context.Context) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.HeadStateReadOnly")
+	defer span.End()
+
+	if s.hasHeadState() {
+		s.headLock.RLock()
+		defer s.headLock.RUnlock()
+		return s.head.state, nil
+	}
+
+	return s.beaconDB.HeadState(ctx)
+}
+
 // HeadValidatorsIndices returns a list of active validator indices from the head view of a given epoch.
 func (s *Service) HeadValidatorsIndices(ctx context.Context, epoch uint64) ([]uint64, error) {
 	if !s.hasHeadState() {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -19,6 +20,15 @@ import (
 	"go.opencensus.io/trace"
 )
 
+const (
+	// headUpdateInterval is the minimum interval between two head updates triggered by
+	// newly received attestations.
+	headUpdateInterval = 500 * time.Millisecond
+	// reorgNotificationInterval is the minimum interval between two reorg events sent
+	// to the rest of the services.
+	reorgNotificationInterval = time.Second
+)
+
 // This defines the current chain service's view of head.
 type head struct {
 	slot  uint64                   // current head slot.
@@ -102,19 +112,25 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 
 	// A chain re-org occurred, so we fire an event notifying the rest of the services.
 	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != s.headRoot() {
+		oldHeadRoot := s.headRoot()
+		depth := s.reorgDepth(oldHeadRoot, headRoot)
 		log.WithFields(logrus.Fields{
 			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
 			"oldSlot": fmt.Sprintf("%d", s.headSlot()),
-		}).Debug("Chain reorg occurred")
-		s.stateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.Reorg,
-			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: s.headSlot(),
-			},
+			"newRoot": fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+			"oldRoot": fmt.Sprintf("%#x", bytesutil.Trunc(oldHeadRoot[:])),
+			"depth":   depth,
+		}).Info("Chain reorg occurred")
+		s.notifyReorg(&statefeed.ReorgData{
+			NewSlot:     newHeadBlock.Block.Slot,
+			OldSlot:     s.headSlot(),
+			NewHeadRoot: headRoot,
+			OldHeadRoot: oldHeadRoot,
+			Depth:       depth,
 		})
 
 		reorgCount.Inc()
+		reorgDepth.Observe(float64(depth))
 	}
 
 	// Cache the new head info.
@@ -128,6 +144,108 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	return nil
 }
 
+// This updates the head for newly received attestations, at most once per head update interval.
+// Attestations received before the interval has elapsed schedule a single update at its end,
+// so a burst of attestations does not recompute the head for every one of them.
+func (s *Service) updateHeadThrottled(ctx context.Context) error {
+	s.headUpdateLock.Lock()
+	if s.headUpdateScheduled {
+		s.headUpdateLock.Unlock()
+		return nil
+	}
+	if wait := headUpdateInterval - time.Since(s.lastHeadUpdate); wait > 0 {
+		s.headUpdateScheduled = true
+		s.headUpdateLock.Unlock()
+		time.AfterFunc(wait, s.scheduledHeadUpdate)
+		return nil
+	}
+	s.lastHeadUpdate = time.Now()
+	s.headUpdateLock.Unlock()
+	return s.updateHead(ctx, s.getJustifiedBalances())
+}
+
+func (s *Service) scheduledHeadUpdate() {
+	s.headUpdateLock.Lock()
+	s.headUpdateScheduled = false
+	s.lastHeadUpdate = time.Now()
+	s.headUpdateLock.Unlock()
+	if s.ctx.Err() != nil {
+		return
+	}
+	if err := s.updateHead(s.ctx, s.getJustifiedBalances()); err != nil {
+		log.Warnf("Resolving fork due to new attestations: %v", err)
+	}
+}
+
+// This sends a reorg event to the rest of the services, at most once per reorg notification
+// interval. Reorgs happening before the interval has elapsed are coalesced into a single event,
+// sent at its end, going from the head before the first of them to the head after the last one
+// with the depth of the deepest of them.
+func (s *Service) notifyReorg(data *statefeed.ReorgData) {
+	s.reorgNotificationLock.Lock()
+	if s.pendingReorg != nil {
+		s.pendingReorg.NewSlot = data.NewSlot
+		s.pendingReorg.NewHeadRoot = data.NewHeadRoot
+		if data.Depth > s.pendingReorg.Depth {
+			s.pendingReorg.Depth = data.Depth
+		}
+		s.reorgNotificationLock.Unlock()
+		return
+	}
+	if wait := reorgNotificationInterval - time.Since(s.lastReorgNotification); wait > 0 {
+		s.pendingReorg = data
+		s.reorgNotificationLock.Unlock()
+		time.AfterFunc(wait, s.sendPendingReorg)
+		return
+	}
+	s.lastReorgNotification = time.Now()
+	s.reorgNotificationLock.Unlock()
+	s.sendReorg(data)
+}
+
+func (s *Service) sendPendingReorg() {
+	s.reorgNotificationLock.Lock()
+	data := s.pendingReorg
+	s.pendingReorg = nil
+	s.lastReorgNotification = time.Now()
+	s.reorgNotificationLock.Unlock()
+	if data == nil || s.ctx.Err() != nil {
+		return
+	}
+	s.sendReorg(data)
+}
+
+func (s *Service) sendReorg(data *statefeed.ReorgData) {
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: data,
+	})
+}
+
+// This returns the number of slots between the old head and the latest block it has in common
+// with the new head, using the fork choice store. It returns 0 if either head is not in the store.
+func (s *Service) reorgDepth(oldHeadRoot [32]byte, newHeadRoot [32]byte) uint64 {
+	oldNode := s.forkChoiceStore.Node(oldHeadRoot)
+	newNode := s.forkChoiceStore.Node(newHeadRoot)
+	if oldNode == nil || newNode == nil {
+		return 0
+	}
+	nodes := s.forkChoiceStore.Nodes()
+	ancestor, other := oldNode, newNode
+	// Blocks only have ancestors at lower slots, so stepping back from the higher
+	// of the two nodes until both meet finds their latest common ancestor.
+	for ancestor.Root() != other.Root() {
+		if ancestor.Slot() < other.Slot() {
+			ancestor, other = other, ancestor
+		}
+		if ancestor.Parent() == protoarray.NonExistentNode || ancestor.Parent() >= uint64(len(nodes)) {
+			return 0
+		}
+		ancestor = nodes[ancestor.Parent()]
+	}
+	return oldNode.Slot() - ancestor.Slot()
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -264,7 +382,9 @@ func (s *Service) updateRecentCanonicalBlocks(ctx context.Context, headRoot [32]
 	return nil
 }
 
-// This caches justified state balances to be used for fork choice.
+// This caches justified state balances to be used for fork choice. The balances are only
+// recomputed when the justified root differs from the one they were cached for, so the
+// justified state is not regenerated when the same checkpoint is cached again.
 func (s *Service) cacheJustifiedStateBalances(ctx context.Context, justifiedRoot [32]byte) error {
 	if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
 		return err
@@ -272,6 +392,13 @@ func (s *Service) cacheJustifiedStateBalances(ctx context.Context, justifiedRoot
 
 	s.clearInitSyncBlocks()
 
+	s.justifiedBalancesLock.RLock()
+	cached := len(s.justifiedBalances) != 0 && s.justifiedBalancesRoot == justifiedRoot
+	s.justifiedBalancesLock.RUnlock()
+	if cached {
+		return nil
+	}
+
 	var justifiedState *stateTrie.BeaconState
 	var err error
 	if justifiedRoot == s.genesisRoot {
@@ -306,6 +433,7 @@ func (s *Service) cacheJustifiedStateBalances(ctx context.Context, justifiedRoot
 	s.justifiedBalancesLock.Lock()
 	defer s.justifiedBalancesLock.Unlock()
 	s.justifiedBalances = justifiedBalances
+	s.justifiedBalancesRoot = justifiedRoot
 	return nil
 }
 
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -139,3 +142,87 @@ func TestCacheJustifiedStateBalances_CanCache(t *testing.T) {
 	require.NoError(t, service.cacheJustifiedStateBalances(context.Background(), r))
 	require.DeepEqual(t, service.getJustifiedBalances(), state.Balances(), "Incorrect justified balances")
 }
+
+func TestCacheJustifiedStateBalances_SkipsCachedRoot(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	state, _ := testutil.DeterministicGenesisState(t, 100)
+	r1 := [32]byte{'a'}
+	r2 := [32]byte{'b'}
+	for _, r := range [][32]byte{r1, r2} {
+		require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Root: r[:]}))
+		require.NoError(t, service.beaconDB.SaveState(ctx, state, r))
+	}
+	require.NoError(t, service.cacheJustifiedStateBalances(ctx, r1))
+
+	// Overwrite the cached balances, they should be kept when caching the same root again.
+	service.justifiedBalances = []uint64{1, 2, 3}
+	require.NoError(t, service.cacheJustifiedStateBalances(ctx, r1))
+	assert.DeepEqual(t, []uint64{1, 2, 3}, service.getJustifiedBalances(), "Expected cached balances to be reused")
+
+	require.NoError(t, service.cacheJustifiedStateBalances(ctx, r2))
+	assert.DeepEqual(t, state.Balances(), service.getJustifiedBalances(), "Expected balances to be recomputed for a new root")
+}
+
+func TestReorgDepth(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	// Build the following tree, where B is the old head and C the new one:
+	//   0 <- A(1) <- B(2)
+	//    \
+	//     C(3)
+	root0, a, b, c := [32]byte{'0'}, [32]byte{'A'}, [32]byte{'B'}, [32]byte{'C'}
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 0, root0, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 1, a, root0, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 2, b, a, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 3, c, root0, [32]byte{}, 0, 0))
+
+	assert.Equal(t, uint64(2), service.reorgDepth(b, c))
+	assert.Equal(t, uint64(0), service.reorgDepth(a, b), "Expected no depth when the old head is an ancestor of the new one")
+	assert.Equal(t, uint64(0), service.reorgDepth([32]byte{'D'}, c), "Expected no depth for an unknown head")
+}
+
+func TestUpdateHeadThrottled_SchedulesSingleUpdate(t *testing.T) {
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+	defer service.cancel()
+
+	service.lastHeadUpdate = time.Now()
+	require.NoError(t, service.updateHeadThrottled(context.Background()))
+	service.headUpdateLock.Lock()
+	require.Equal(t, true, service.headUpdateScheduled, "Expected a head update to be scheduled")
+	service.headUpdateLock.Unlock()
+	require.NoError(t, service.updateHeadThrottled(context.Background()))
+
+	time.Sleep(2 * headUpdateInterval)
+	service.headUpdateLock.Lock()
+	defer service.headUpdateLock.Unlock()
+	assert.Equal(t, false, service.headUpdateScheduled, "Expected the scheduled head update to have run")
+}
+
+func TestNotifyReorg_CoalescesReorgs(t *testing.T) {
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	events := make(chan *feed.Event, 3)
+	sub := service.stateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	service.notifyReorg(&statefeed.ReorgData{OldSlot: 1, NewSlot: 2, OldHeadRoot: [32]byte{'a'}, NewHeadRoot: [32]byte{'b'}, Depth: 1})
+	service.notifyReorg(&statefeed.ReorgData{OldSlot: 2, NewSlot: 3, OldHeadRoot: [32]byte{'b'}, NewHeadRoot: [32]byte{'c'}, Depth: 3})
+	service.notifyReorg(&statefeed.ReorgData{OldSlot: 3, NewSlot: 4, OldHeadRoot: [32]byte{'c'}, NewHeadRoot: [32]byte{'d'}, Depth: 2})
+
+	ev := <-events
+	assert.DeepEqual(t, &statefeed.ReorgData{OldSlot: 1, NewSlot: 2, OldHeadRoot: [32]byte{'a'}, NewHeadRoot: [32]byte{'b'}, Depth: 1}, ev.Data)
+	select {
+	case ev = <-events:
+	case <-time.After(2 * reorgNotificationInterval):
+		t.Fatal("Did not receive the coalesced reorg event")
+	}
+	assert.DeepEqual(t, &statefeed.ReorgData{OldSlot: 2, NewSlot: 4, OldHeadRoot: [32]byte{'b'}, NewHeadRoot: [32]byte{'d'}, Depth: 3}, ev.Data)
+	assert.Equal(t, 0, len(events), "Expected a single coalesced reorg event")
+}
@@ -1,6 +1,8 @@
 package blockchain
 
 import (
+	"bytes"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -84,10 +86,19 @@ var (
 		Name: "total_voted_target_balances",
 		Help: "The total amount of ether, in gwei, that has been used in voting attestation target of previous epoch",
 	})
+	totalVotedHeadBalances = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "total_voted_head_balances",
+		Help: "The total amount of ether, in gwei, that has been used in voting attestation head of previous epoch",
+	})
 	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "beacon_reorg_total",
 		Help: "Count the number of times beacon chain has a reorg",
 	})
+	reorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "beacon_reorg_depth_slots",
+		Help:    "The number of slots between the old head and the common ancestor of the old and new heads on reorgs",
+		Buckets: []float64{1, 2, 4, 8, 16, 32, 64},
+	})
 	sentBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_sent_latency_milliseconds",
@@ -102,8 +113,48 @@ var (
 			Buckets: []float64{1, 2, 3, 4, 6, 32, 64},
 		},
 	)
+	includedAttestationVotes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "included_attestation_votes_total",
+			Help: "The number of target and head votes of the attestations included in processed blocks, " +
+				"by whether they match the canonical chain of the block",
+		},
+		[]string{"vote", "correct"},
+	)
+	includedAttestationInclusionDelay = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "included_attestation_inclusion_delay_slots",
+			Help:    "The number of slots between att.Slot and block.Slot, by whether the attestation head vote is correct",
+			Buckets: []float64{1, 2, 3, 4, 6, 32, 64},
+		},
+		[]string{"correct_head"},
+	)
+	blockProcessingTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "block_processing_seconds",
+			Help:    "Captures the time spent in each phase of processing a block, by the source of the block",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+		},
+		[]string{"phase", "source"},
+	)
 )
 
+// Phases of the block processing reported in the block processing time histogram. The signatures
+// of batch synced blocks are verified after their state transition, as their own phase. The state
+// transition of gossip blocks also reports the phases of state.ExecuteStateTransition.
+const (
+	stateTransitionPhase = "state_transition"
+	signaturesPhase      = "signature_verification"
+	dbWritePhase         = "db_write"
+	gossipBlockSource    = "gossip"
+	syncBlockSource      = "sync"
+)
+
+// reportBlockProcessingTime reports the time since start spent in the phase of processing a block.
+func reportBlockProcessingTime(phase string, source string, start time.Time) {
+	blockProcessingTime.WithLabelValues(phase, source).Observe(time.Since(start).Seconds())
+}
+
 // reportSlotMetrics reports slot related metrics.
 func reportSlotMetrics(stateSlot uint64, headSlot uint64, clockSlot uint64, finalizedCheckpoint *ethpb.Checkpoint) {
 	clockTimeSlot.Set(float64(clockSlot))
@@ -201,6 +252,7 @@ func reportEpochMetrics(state *stateTrie.BeaconState) {
 	if precompute.Balances != nil {
 		totalEligibleBalances.Set(float64(precompute.Balances.ActivePrevEpoch))
 		totalVotedTargetBalances.Set(float64(precompute.Balances.PrevEpochTargetAttested))
+		totalVotedHeadBalances.Set(float64(precompute.Balances.PrevEpochHeadAttested))
 	}
 }
 
@@ -216,8 +268,28 @@ func captureSentTimeMetric(genesisTime uint64, currentSlot uint64) error {
 	return nil
 }
 
-func reportAttestationInclusion(blk *ethpb.BeaconBlock) {
+// reportAttestationInclusion reports the inclusion delay and the vote correctness of the
+// attestations of the block, comparing their votes with the block roots of its post state.
+func reportAttestationInclusion(postState *stateTrie.BeaconState, blk *ethpb.BeaconBlock) {
 	for _, att := range blk.Body.Attestations {
-		attestationInclusionDelay.Observe(float64(blk.Slot - att.Data.Slot))
+		delay := float64(blk.Slot - att.Data.Slot)
+		attestationInclusionDelay.Observe(delay)
+
+		targetRoot, err := helpers.BlockRoot(postState, att.Data.Target.Epoch)
+		if err != nil {
+			log.WithError(err).Debug("Could not get attestation target block root")
+			continue
+		}
+		correctTarget := bytes.Equal(att.Data.Target.Root, targetRoot)
+		includedAttestationVotes.WithLabelValues("target", strconv.FormatBool(correctTarget)).Inc()
+
+		headRoot, err := helpers.BlockRootAtSlot(postState, att.Data.Slot)
+		if err != nil {
+			log.WithError(err).Debug("Could not get attestation head block root")
+			continue
+		}
+		correctHead := strconv.FormatBool(bytes.Equal(att.Data.BeaconBlockRoot, headRoot))
+		includedAttestationVotes.WithLabelValues("head", correctHead).Inc()
+		includedAttestationInclusionDelay.WithLabelValues(correctHead).Observe(delay)
 	}
 }
@@ -45,6 +45,23 @@ func (s *Service) onAttestation(ctx context.Context, a *ethpb.Attestation) ([]ui
 	ctx, span := trace.StartSpan(ctx, "blockChain.onAttestation")
 	defer span.End()
 
+	indexedAtt, err := s.verifyForkchoiceAttestation(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update forkchoice store with the new attestation for updating weight.
+	s.forkChoiceStore.ProcessAttestation(ctx, indexedAtt.AttestingIndices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
+
+	return indexedAtt.AttestingIndices, nil
+}
+
+// verifyForkchoiceAttestation runs every check of on_attestation on the attestation and returns
+// it in indexed form, without updating the validators' latest votes in the fork choice store.
+func (s *Service) verifyForkchoiceAttestation(ctx context.Context, a *ethpb.Attestation) (*ethpb.IndexedAttestation, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.verifyForkchoiceAttestation")
+	defer span.End()
+
 	if a == nil {
 		return nil, errors.New("nil attestation")
 	}
@@ -105,8 +122,5 @@ func (s *Service) onAttestation(ctx context.Context, a *ethpb.Attestation) ([]ui
 		return nil, errors.New("nil attesting indices")
 	}
 
-	// Update forkchoice store with the new attestation for updating weight.
-	s.forkChoiceStore.ProcessAttestation(ctx, indexedAtt.AttestingIndices, bytesutil.ToBytes32(a.Data.BeaconBlockRoot), a.Data.Target.Epoch)
-
-	return indexedAtt.AttestingIndices, nil
+	return indexedAtt, nil
 }
@@ -3,6 +3,7 @@ package blockchain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -84,10 +85,22 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		return err
 	}
 
-	postState, err := state.ExecuteStateTransition(ctx, preState, signed)
+	// The state transition reports the time of its slot processing, operations and state hashing.
+	transitionCtx := state.WithPhaseTimer(ctx, func(phase string, start time.Time) {
+		reportBlockProcessingTime(phase, gossipBlockSource, start)
+	})
+	start := time.Now()
+	postState, err := state.ExecuteStateTransition(transitionCtx, preState, signed)
 	if err != nil {
 		return errors.Wrap(err, "could not execute state transition")
 	}
+	reportBlockProcessingTime(stateTransitionPhase, gossipBlockSource, start)
+
+	// A block finalizing a chain which conflicts with the weak subjectivity checkpoint is rejected
+	// before it is imported.
+	if err := s.verifyWeakSubjectivityCheckpoint(ctx, postState.FinalizedCheckpoint(), nil); err != nil {
+		return err
+	}
 
 	if err := s.savePostStateInfo(ctx, blockRoot, signed, postState, false /* reg sync */); err != nil {
 		return err
@@ -124,7 +137,7 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		s.depositCache.InsertFinalizedDeposits(ctx, int64(postState.Eth1DepositIndex()))
 	}
 
-	defer reportAttestationInclusion(b)
+	defer reportAttestationInclusion(postState, b)
 
 	return s.handleEpochBoundary(postState)
 }
@@ -161,6 +174,7 @@ func (s *Service) onBlockInitialSyncStateTransition(ctx context.Context, signed
 		return nil
 	}
 
+	start := time.Now()
 	var postState *stateTrie.BeaconState
 	if featureconfig.Get().InitSyncNoVerify {
 		postState, err = state.ExecuteStateTransitionNoVerifyAttSigs(ctx, preState, signed)
@@ -170,6 +184,11 @@ func (s *Service) onBlockInitialSyncStateTransition(ctx context.Context, signed
 	if err != nil {
 		return errors.Wrap(err, "could not execute state transition")
 	}
+	reportBlockProcessingTime(stateTransitionPhase, syncBlockSource, start)
+
+	if err := s.verifyWeakSubjectivityCheckpoint(ctx, postState.FinalizedCheckpoint(), nil); err != nil {
+		return err
+	}
 
 	if err := s.savePostStateInfo(ctx, blockRoot, signed, postState, true /* init sync */); err != nil {
 		return err
@@ -198,6 +217,10 @@ func (s *Service) onBlockInitialSyncStateTransition(ctx context.Context, signed
 		if err := s.updateFinalized(ctx, postState.FinalizedCheckpoint()); err != nil {
 			return err
 		}
+		fRoot := bytesutil.ToBytes32(postState.FinalizedCheckpoint().Root)
+		if err := s.forkChoiceStore.Prune(ctx, fRoot); err != nil {
+			return errors.Wrap(err, "could not prune proto array fork choice nodes")
+		}
 	}
 
 	return s.handleEpochBoundary(postState)
@@ -238,10 +261,12 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 	set := new(bls.SignatureSet)
 	boundaries := make(map[[32]byte]*stateTrie.BeaconState)
 	for i, b := range blks {
+		start := time.Now()
 		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
 			return nil, nil, err
 		}
+		reportBlockProcessingTime(stateTransitionPhase, syncBlockSource, start)
 		// Save potential boundary states.
 		if helpers.IsEpochStart(preState.Slot()) {
 			boundaries[blockRoots[i]] = preState.Copy()
@@ -253,6 +278,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 		fCheckpoints[i] = preState.FinalizedCheckpoint()
 		sigSet.Join(set)
 	}
+	start := time.Now()
 	verify, err := bls.VerifyMultipleSignatures(sigSet.Signatures, sigSet.Messages, sigSet.PublicKeys)
 	if err != nil {
 		return nil, nil, err
@@ -260,6 +286,18 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 	if !verify {
 		return nil, nil, errors.New("batch block signature verification failed")
 	}
+	// The signatures of the batch are verified at once, so the average time per block is reported.
+	blockProcessingTime.WithLabelValues(signaturesPhase, syncBlockSource).Observe(
+		time.Since(start).Seconds() / float64(len(blks)))
+	// Finalized checkpoints only move forward along the batch, so verifying the last one against the
+	// weak subjectivity checkpoint covers the whole batch before any of it is imported.
+	pending := make(map[[32]byte]*ethpb.BeaconBlock, len(blks))
+	for i, b := range blks {
+		pending[blockRoots[i]] = b.Block
+	}
+	if err := s.verifyWeakSubjectivityCheckpoint(ctx, fCheckpoints[len(fCheckpoints)-1], pending); err != nil {
+		return nil, nil, err
+	}
 	for r, st := range boundaries {
 		if err := s.stateGen.SaveState(ctx, r, st); err != nil {
 			return nil, nil, err
@@ -308,6 +346,9 @@ func (s *Service) handleBlockAfterBatchVerify(ctx context.Context, signed *ethpb
 		if err := s.updateFinalized(ctx, fCheckpoint); err != nil {
 			return err
 		}
+		if err := s.forkChoiceStore.Prune(ctx, bytesutil.ToBytes32(fCheckpoint.Root)); err != nil {
+			return errors.Wrap(err, "could not prune proto array fork choice nodes")
+		}
 	}
 	return nil
 }
@@ -352,6 +393,8 @@ func (s *Service) insertBlockAndAttestationsToForkChoiceStore(ctx context.Contex
 
 func (s *Service) insertBlockToForkChoiceStore(ctx context.Context, blk *ethpb.BeaconBlock,
 	root [32]byte, fCheckpoint *ethpb.Checkpoint, jCheckpoint *ethpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.insertBlockToForkChoiceStore")
+	defer span.End()
 	if err := s.fillInForkChoiceMissingBlocks(ctx, blk, fCheckpoint, jCheckpoint); err != nil {
 		return err
 	}
@@ -370,7 +413,10 @@ func (s *Service) insertBlockToForkChoiceStore(ctx context.Context, blk *ethpb.B
 func (s *Service) savePostStateInfo(ctx context.Context, r [32]byte, b *ethpb.SignedBeaconBlock, state *stateTrie.BeaconState, initSync bool) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.savePostStateInfo")
 	defer span.End()
+	start := time.Now()
+	source := gossipBlockSource
 	if initSync {
+		source = syncBlockSource
 		s.saveInitSyncBlock(r, b)
 	} else if err := s.beaconDB.SaveBlock(ctx, b); err != nil {
 		return errors.Wrapf(err, "could not save block from slot %d", b.Block.Slot)
@@ -378,6 +424,7 @@ func (s *Service) savePostStateInfo(ctx context.Context, r [32]byte, b *ethpb.Si
 	if err := s.stateGen.SaveState(ctx, r, state); err != nil {
 		return errors.Wrap(err, "could not save state")
 	}
+	reportBlockProcessingTime(dbWritePhase, source, start)
 	if err := s.insertBlockAndAttestationsToForkChoiceStore(ctx, b.Block, r, state); err != nil {
 		return errors.Wrapf(err, "could not insert block %d to fork choice store", b.Block.Slot)
 	}
@@ -258,6 +258,23 @@ func (s *Service) ancestor(ctx context.Context, root []byte, slot uint64) ([]byt
 	ctx, span := trace.StartSpan(ctx, "forkChoice.ancestor")
 	defer span.End()
 
+	// Attestation validation repeatedly looks up the same target epoch start slot from the
+	// same few head roots, so the results are cached instead of walking the parents every time.
+	r := bytesutil.ToBytes32(root)
+	if cached := s.ancestorCache.get(r, slot); cached != nil {
+		return cached, nil
+	}
+	a, err := s.ancestorByParents(ctx, root, slot)
+	if err != nil {
+		return nil, err
+	}
+	s.ancestorCache.put(r, slot, a)
+	return a, nil
+}
+
+// ancestorByParents looks up the ancestor root of the block root at the slot, using the fork
+// choice store when it has the block or recursively walking the parent blocks otherwise.
+func (s *Service) ancestorByParents(ctx context.Context, root []byte, slot uint64) ([]byte, error) {
 	// Stop recursive ancestry lookup if context is cancelled.
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
@@ -287,7 +304,7 @@ func (s *Service) ancestor(ctx context.Context, root []byte, slot uint64) ([]byt
 		return root, nil
 	}
 
-	return s.ancestor(ctx, b.ParentRoot, slot)
+	return s.ancestorByParents(ctx, b.ParentRoot, slot)
 }
 
 // This updates justified check point in store, if the new justified is later than stored justified or
@@ -376,23 +393,6 @@ func (s *Service) fillInForkChoiceMissingBlocks(ctx context.Context, blk *ethpb.
 	return nil
 }
 
-// The deletes input attestations from the attestation pool, so proposers don't include them in a block for the future.
-func (s *Service) deletePoolAtts(atts []*ethpb.Attestation) error {
-	for _, att := range atts {
-		if helpers.IsAggregated(att) {
-			if err := s.attPool.DeleteAggregatedAttestation(att); err != nil {
-				return err
-			}
-		} else {
-			if err := s.attPool.DeleteUnaggregatedAttestation(att); err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
-
 // This ensures that the input root defaults to using genesis root instead of zero hashes. This is needed for handling
 // fork choice justification routine.
 func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
@@ -493,6 +493,36 @@ func TestAncestor_HandleSkipSlot(t *testing.T) {
 	}
 }
 
+func TestAncestor_UsesCache(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+
+	cfg := &Config{BeaconDB: db, ForkChoiceStore: protoarray.New(0, 0, [32]byte{})}
+	service, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 10
+	require.NoError(t, db.SaveBlock(ctx, b))
+	r, err := stateutil.BlockRoot(b.Block)
+	require.NoError(t, err)
+
+	a, err := service.ancestor(ctx, r[:], 5)
+	require.NoError(t, err)
+	assert.DeepEqual(t, r[:], a)
+
+	assert.DeepEqual(t, r[:], service.ancestorCache.get(r, 5), "Ancestor root was not cached")
+
+	// The root is not in the DB, so the lookup can only be served by the cache.
+	unknown := [32]byte{'a'}
+	_, err = service.ancestor(ctx, unknown[:], 5)
+	assert.ErrorContains(t, "nil block", err)
+	service.ancestorCache.put(unknown, 5, r[:])
+	a, err = service.ancestor(ctx, unknown[:], 5)
+	require.NoError(t, err)
+	assert.DeepEqual(t, r[:], a)
+}
+
 func TestEnsureRootNotZeroHashes(t *testing.T) {
 	ctx := context.Background()
 	cfg := &Config{}
@@ -30,7 +30,7 @@ type AttestationReceiver interface {
 // attestation that is received from regular sync. The operations consist of:
 //  1. Validate attestation, update validator's latest vote
 //  2. Apply fork choice to the processed attestation
-//  3. Save latest head info
+//  3. Save latest head info, at most once per head update interval
 func (s *Service) ReceiveAttestationNoPubsub(ctx context.Context, att *ethpb.Attestation) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveAttestationNoPubsub")
 	defer span.End()
@@ -44,7 +44,7 @@ func (s *Service) ReceiveAttestationNoPubsub(ctx context.Context, att *ethpb.Att
 		// This updates fork choice head, if a new head could not be updated due to
 		// long range or intermediate forking. It simply logs a warning and returns nil
 		// as that's more appropriate than returning errors.
-		if err := s.updateHead(ctx, s.getJustifiedBalances()); err != nil {
+		if err := s.updateHeadThrottled(ctx); err != nil {
 			log.Warnf("Resolving fork due to new attestation: %v", err)
 			return nil
 		}
@@ -89,43 +89,94 @@ func (s *Service) processAttestation(subscribedToStateEvents chan struct{}) {
 		case <-s.ctx.Done():
 			return
 		case <-st.C():
-			ctx := context.Background()
-			atts := s.attPool.ForkchoiceAttestations()
-			for _, a := range atts {
-				// Based on the spec, don't process the attestation until the subsequent slot.
-				// This delays consideration in the fork choice until their slot is in the past.
-				// https://github.com/ethereum/eth2.0-specs/blob/dev/specs/phase0/fork-choice.md#validate_on_attestation
-				nextSlot := a.Data.Slot + 1
-				if err := helpers.VerifySlotTime(uint64(s.genesisTime.Unix()), nextSlot, params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
-					continue
-				}
-
-				hasState := s.stateGen.StateSummaryExists(ctx, bytesutil.ToBytes32(a.Data.BeaconBlockRoot))
-				hasBlock := s.hasBlock(ctx, bytesutil.ToBytes32(a.Data.BeaconBlockRoot))
-				if !(hasState && hasBlock) {
-					continue
-				}
-
-				if err := s.attPool.DeleteForkchoiceAttestation(a); err != nil {
-					log.WithError(err).Error("Could not delete fork choice attestation in pool")
-				}
-
-				if !s.verifyCheckpointEpoch(a.Data.Target) {
-					continue
-				}
-
-				if err := s.ReceiveAttestationNoPubsub(ctx, a); err != nil {
-					log.WithFields(logrus.Fields{
-						"slot":             a.Data.Slot,
-						"committeeIndex":   a.Data.CommitteeIndex,
-						"beaconBlockRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(a.Data.BeaconBlockRoot)),
-						"targetRoot":       fmt.Sprintf("%#x", bytesutil.Trunc(a.Data.Target.Root)),
-						"aggregationCount": a.AggregationBits.Count(),
-					}).WithError(err).Warn("Could not receive attestation in chain service")
-				}
+			s.applyForkchoiceAttestations(context.Background())
+		}
+	}
+}
+
+// forkchoiceVote is a validator's latest vote taken from a batch of fork choice attestations.
+type forkchoiceVote struct {
+	root        [32]byte
+	targetEpoch uint64
+}
+
+// applyForkchoiceAttestations verifies the fork choice attestations of the pool and applies
+// them to the fork choice store as a single batch. Only the latest vote of each validator in
+// the batch is kept, votes shared by several validators are applied at once, and the head is
+// updated a single time once the whole batch has been applied, throttled like the other head
+// updates for attestations.
+func (s *Service) applyForkchoiceAttestations(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.applyForkchoiceAttestations")
+	defer span.End()
+
+	// Only the votes for a target of the current or previous epoch are applied, the others are
+	// left in the pool until their target epoch or their expiry.
+	currentEpoch := helpers.SlotToEpoch(s.CurrentSlot())
+	atts := s.attPool.ForkchoiceAttestationsByTargetEpoch(currentEpoch)
+	if currentEpoch > 0 {
+		atts = append(atts, s.attPool.ForkchoiceAttestationsByTargetEpoch(currentEpoch-1)...)
+	}
+	votes := make(map[uint64]forkchoiceVote)
+	for _, a := range atts {
+		// Based on the spec, don't process the attestation until the subsequent slot.
+		// This delays consideration in the fork choice until their slot is in the past.
+		// https://github.com/ethereum/eth2.0-specs/blob/dev/specs/phase0/fork-choice.md#validate_on_attestation
+		nextSlot := a.Data.Slot + 1
+		if err := helpers.VerifySlotTime(uint64(s.genesisTime.Unix()), nextSlot, params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+			continue
+		}
+
+		hasState := s.stateGen.StateSummaryExists(ctx, bytesutil.ToBytes32(a.Data.BeaconBlockRoot))
+		hasBlock := s.hasBlock(ctx, bytesutil.ToBytes32(a.Data.BeaconBlockRoot))
+		if !(hasState && hasBlock) {
+			continue
+		}
+
+		if err := s.attPool.DeleteForkchoiceAttestation(a); err != nil {
+			log.WithError(err).Error("Could not delete fork choice attestation in pool")
+		}
+
+		if !s.verifyCheckpointEpoch(a.Data.Target) {
+			continue
+		}
+
+		indexedAtt, err := s.verifyForkchoiceAttestation(ctx, a)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"slot":             a.Data.Slot,
+				"committeeIndex":   a.Data.CommitteeIndex,
+				"beaconBlockRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(a.Data.BeaconBlockRoot)),
+				"targetRoot":       fmt.Sprintf("%#x", bytesutil.Trunc(a.Data.Target.Root)),
+				"aggregationCount": a.AggregationBits.Count(),
+			}).WithError(err).Warn("Could not receive attestation in chain service")
+			continue
+		}
+		vote := forkchoiceVote{root: bytesutil.ToBytes32(a.Data.BeaconBlockRoot), targetEpoch: a.Data.Target.Epoch}
+		for _, idx := range indexedAtt.AttestingIndices {
+			// Like the fork choice store, only a vote with a higher target epoch replaces a previous one.
+			if v, ok := votes[idx]; ok && v.targetEpoch >= vote.targetEpoch {
+				continue
 			}
+			votes[idx] = vote
 		}
 	}
+	if len(votes) == 0 {
+		return
+	}
+
+	indicesByVote := make(map[forkchoiceVote][]uint64)
+	for idx, vote := range votes {
+		indicesByVote[vote] = append(indicesByVote[vote], idx)
+	}
+	for vote, indices := range indicesByVote {
+		s.forkChoiceStore.ProcessAttestation(ctx, indices, vote.root, vote.targetEpoch)
+	}
+
+	// This updates fork choice head, at most once per head update interval, if a new head could
+	// not be updated due to long range or intermediate forking. It simply logs a warning.
+	if err := s.updateHeadThrottled(ctx); err != nil {
+		log.Warnf("Resolving fork due to new attestations: %v", err)
+	}
 }
 
 // This verifies the epoch of input checkpoint is within current epoch and previous epoch
@@ -1,13 +1,16 @@
 package blockchain
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 func TestVerifyCheckpointEpoch_Ok(t *testing.T) {
@@ -20,3 +23,31 @@ func TestVerifyCheckpointEpoch_Ok(t *testing.T) {
 	assert.Equal(t, true, chainService.verifyCheckpointEpoch(&ethpb.Checkpoint{}))
 	assert.Equal(t, false, chainService.verifyCheckpointEpoch(&ethpb.Checkpoint{Epoch: 1}))
 }
+
+func TestApplyForkchoiceAttestations_KeepsPendingAttestations(t *testing.T) {
+	helpers.ClearCache()
+	db, sc := testDB.SetupDB(t)
+
+	chainService := setupBeaconChain(t, db, sc)
+	chainService.genesisTime = time.Now()
+
+	newAtt := func(slot uint64, blockRoot []byte) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: bytesutil.PadTo(blockRoot, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			AggregationBits: []byte{0b11},
+			Signature:       make([]byte, 96),
+		}
+	}
+	// The first attestation's slot is not in the past yet, and
+	// the second one votes for a block the node has not seen.
+	require.NoError(t, chainService.attPool.SaveForkchoiceAttestation(newAtt(10, []byte("future"))))
+	require.NoError(t, chainService.attPool.SaveForkchoiceAttestation(newAtt(0, []byte("unknown block"))))
+
+	chainService.applyForkchoiceAttestations(context.Background())
+	assert.Equal(t, 2, len(chainService.attPool.ForkchoiceAttestations()), "Expected attestations to be kept for a later batch")
+}
@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -13,6 +14,9 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// errServiceStopped is returned for the blocks received once the service is stopped.
+var errServiceStopped = errors.New("blockchain service is stopped")
+
 // BlockReceiver interface defines the methods of chain service receive and processing new blocks.
 type BlockReceiver interface {
 	ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlock, blockRoot [32]byte) error
@@ -29,6 +33,14 @@ type BlockReceiver interface {
 func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlock, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlock")
 	defer span.End()
+	span.AddAttributes(trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blockRoot)))
+	if block != nil && block.Block != nil {
+		span.AddAttributes(trace.Int64Attribute("slot", int64(block.Block.Slot)))
+	}
+	if err := s.startBlockProcessing(); err != nil {
+		return err
+	}
+	defer s.endBlockProcessing()
 	blockCopy := stateTrie.CopySignedBeaconBlock(block)
 
 	// Apply state transition on the new block.
@@ -75,6 +87,10 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 func (s *Service) ReceiveBlockInitialSync(ctx context.Context, block *ethpb.SignedBeaconBlock, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlockNoVerify")
 	defer span.End()
+	if err := s.startBlockProcessing(); err != nil {
+		return err
+	}
+	defer s.endBlockProcessing()
 	blockCopy := stateTrie.CopySignedBeaconBlock(block)
 
 	// Apply state transition on the new block.
@@ -113,6 +129,10 @@ func (s *Service) ReceiveBlockInitialSync(ctx context.Context, block *ethpb.Sign
 func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []*ethpb.SignedBeaconBlock, blkRoots [][32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlockBatch")
 	defer span.End()
+	if err := s.startBlockProcessing(); err != nil {
+		return err
+	}
+	defer s.endBlockProcessing()
 
 	// Apply state transition on the incoming newly received blockCopy without verifying its BLS contents.
 	fCheckpoints, jCheckpoints, err := s.onBlockBatch(ctx, blocks, blkRoots)
@@ -151,11 +171,6 @@ func (s *Service) HasInitSyncBlock(root [32]byte) bool {
 }
 
 func (s *Service) handlePostBlockOperations(b *ethpb.BeaconBlock) error {
-	// Delete the processed block attestations from attestation pool.
-	if err := s.deletePoolAtts(b.Body.Attestations); err != nil {
-		return err
-	}
-
 	// Add block attestations to the fork choice pool to compute head.
 	if err := s.attPool.SaveBlockAttestations(b.Body.Attestations); err != nil {
 		log.Errorf("Could not save block attestations for fork choice: %v", err)
@@ -67,6 +67,7 @@ type Service struct {
 	boundaryRoots             [][32]byte
 	checkpointState           *cache.CheckpointStateCache
 	checkpointStateLock       sync.Mutex
+	ancestorCache             *ancestorCache
 	stateGen                  *stategen.State
 	opsService                *attestations.Service
 	initSyncBlocks            map[[32]byte]*ethpb.SignedBeaconBlock
@@ -74,7 +75,19 @@ type Service struct {
 	recentCanonicalBlocks     map[[32]byte]bool
 	recentCanonicalBlocksLock sync.RWMutex
 	justifiedBalances         []uint64
+	justifiedBalancesRoot     [32]byte
 	justifiedBalancesLock     sync.RWMutex
+	headUpdateLock            sync.Mutex
+	headUpdateScheduled       bool
+	lastHeadUpdate            time.Time
+	reorgNotificationLock     sync.Mutex
+	pendingReorg              *statefeed.ReorgData
+	lastReorgNotification     time.Time
+	wsCheckpt                 *ethpb.Checkpoint
+	wsVerifiedLock            sync.Mutex // Held while verifying the finalized chain against the weak subjectivity checkpoint.
+	wsVerified                bool
+	shutdownLock              sync.RWMutex // Held for reading while processing blocks, for writing on shutdown.
+	stopped                   bool
 }
 
 // Config options for the service.
@@ -92,6 +105,7 @@ type Config struct {
 	ForkChoiceStore   f.ForkChoicer
 	OpsService        *attestations.Service
 	StateGen          *stategen.State
+	WsCheckpt         *ethpb.Checkpoint
 }
 
 // NewService instantiates a new block service instance that will
@@ -114,11 +128,13 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		initSyncState:         make(map[[32]byte]*stateTrie.BeaconState),
 		boundaryRoots:         [][32]byte{},
 		checkpointState:       cache.NewCheckpointStateCache(),
+		ancestorCache:         newAncestorCache(),
 		opsService:            cfg.OpsService,
 		stateGen:              cfg.StateGen,
 		initSyncBlocks:        make(map[[32]byte]*ethpb.SignedBeaconBlock),
 		recentCanonicalBlocks: make(map[[32]byte]bool),
 		justifiedBalances:     make([]uint64, 0),
+		wsCheckpt:             cfg.WsCheckpt,
 	}, nil
 }
 
@@ -171,6 +187,9 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatalf("Could not get finalized checkpoint: %v", err)
 		}
+		if err := s.verifyWeakSubjectivityCheckpoint(s.ctx, finalizedCheckpoint, nil); err != nil {
+			log.Fatalf("Could not verify weak subjectivity checkpoint: %v", err)
+		}
 
 		// Resume fork choice.
 		s.justifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
@@ -294,6 +313,17 @@ func (s *Service) initializeBeaconChain(
 func (s *Service) Stop() error {
 	defer s.cancel()
 
+	// Wait for the blocks in process to be done with, no new ones are processed from then on.
+	s.shutdownLock.Lock()
+	s.stopped = true
+	s.shutdownLock.Unlock()
+
+	if err := s.persistForkChoiceStore(s.ctx); err != nil {
+		log.WithError(err).Error("Could not persist fork choice store")
+	}
+	if err := s.persistHeadState(s.ctx); err != nil {
+		log.WithError(err).Error("Could not persist head state")
+	}
 	if s.stateGen != nil && s.head != nil && s.head.state != nil {
 		return s.stateGen.ForceCheckpoint(s.ctx, s.head.state.FinalizedCheckpoint().Root)
 	}
@@ -443,13 +473,110 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	return nil
 }
 
-// This is called when a client starts from non-genesis slot. This passes last justified and finalized
-// information to fork choice service to initializes fork choice store.
+// This is called when a client starts from non-genesis slot. This restores the fork choice store
+// persisted on shutdown and updates the head from it, or if it can't be restored, passes last
+// justified and finalized information to fork choice service to initializes fork choice store.
+// The persisted store is deleted once read, so a node which crashes later on does not restore it
+// again on its next start, as it is only saved on shutdown.
 func (s *Service) resumeForkChoice(justifiedCheckpoint *ethpb.Checkpoint, finalizedCheckpoint *ethpb.Checkpoint) {
+	restored, err := s.restoreForkChoiceStore(s.ctx, finalizedCheckpoint)
+	if err != nil {
+		log.WithError(err).Warn("Could not restore fork choice store, resuming from finalized checkpoint")
+	}
+	if err := s.beaconDB.DeleteForkChoiceStore(s.ctx); err != nil {
+		log.WithError(err).Error("Could not delete persisted fork choice store")
+	}
+	if restored != nil {
+		s.forkChoiceStore = restored
+		log.WithField("nodes", len(restored.Nodes())).Info("Restored fork choice store")
+		if err := s.updateHead(s.ctx, s.getJustifiedBalances()); err != nil {
+			log.WithError(err).Warn("Could not update head from restored fork choice store")
+		}
+		return
+	}
 	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
 	s.forkChoiceStore = store
 }
 
+// This saves the fork choice store in the DB, so a restarted node resumes with the same nodes,
+// votes and weights. The blocks of the initial sync cache are saved first, so every node of
+// the persisted store has its block in the DB.
+func (s *Service) persistForkChoiceStore(ctx context.Context) error {
+	if s.forkChoiceStore == nil {
+		return nil
+	}
+	if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+		return errors.Wrap(err, "could not save initial sync blocks")
+	}
+	s.clearInitSyncBlocks()
+	return s.beaconDB.SaveForkChoiceStore(ctx, s.forkChoiceStore.ToProto())
+}
+
+// persistHeadState saves the head state on shutdown, so it is loaded instead of regenerated on restart.
+func (s *Service) persistHeadState(ctx context.Context) error {
+	if !s.hasHeadState() {
+		return nil
+	}
+	headRoot := s.headRoot()
+	if s.beaconDB.HasState(ctx, headRoot) {
+		return nil
+	}
+	if err := s.beaconDB.SaveState(ctx, s.headState(ctx), headRoot); err != nil {
+		return errors.Wrap(err, "could not save head state")
+	}
+	return s.beaconDB.SaveHeadBlockRoot(ctx, headRoot)
+}
+
+// startBlockProcessing is called before processing blocks and fails once the service is stopped.
+// The processing must be followed by a call to endBlockProcessing.
+func (s *Service) startBlockProcessing() error {
+	s.shutdownLock.RLock()
+	if s.stopped {
+		s.shutdownLock.RUnlock()
+		return errServiceStopped
+	}
+	return nil
+}
+
+// endBlockProcessing is called after processing blocks, to let a pending shutdown go ahead.
+func (s *Service) endBlockProcessing() {
+	s.shutdownLock.RUnlock()
+}
+
+// This returns the fork choice store persisted on shutdown. It returns nil if there is none, or if
+// it does not contain the finalized checkpoint, in which case fork choice has to start over from it.
+// An error is returned if one of the blocks or state summaries of its nodes is missing in the DB.
+func (s *Service) restoreForkChoiceStore(ctx context.Context, finalizedCheckpoint *ethpb.Checkpoint) (*protoarray.ForkChoice, error) {
+	persisted, err := s.beaconDB.ForkChoiceStore(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork choice store from db")
+	}
+	if persisted == nil {
+		return nil, nil
+	}
+	store, err := protoarray.InitializeFromProto(persisted)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize fork choice store")
+	}
+	finalizedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalizedCheckpoint.Root))
+	if !store.HasNode(finalizedRoot) {
+		log.Debug("Persisted fork choice store does not contain finalized checkpoint")
+		return nil, nil
+	}
+	for _, n := range store.Nodes() {
+		r := n.Root()
+		if !s.beaconDB.HasBlock(ctx, r) || !s.stateGen.StateSummaryExists(ctx, r) {
+			return nil, fmt.Errorf("no block or state summary in db for fork choice node %#x", bytesutil.Trunc(r[:]))
+		}
+	}
+	// Drop the nodes from before the finalized checkpoint, the node may have stopped
+	// before the store got pruned.
+	if err := store.Prune(ctx, finalizedRoot); err != nil {
+		return nil, errors.Wrap(err, "could not prune fork choice store")
+	}
+	return store, nil
+}
+
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
 // 1.) Check fork choice store.
 // 2.) Check DB.
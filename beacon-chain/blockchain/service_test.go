@@ -95,7 +95,7 @@ func setupBeaconChain(t *testing.T, beaconDB db.Database, sc *cache.StateSummary
 	require.NoError(t, err)
 	web3Service, err = powchain.NewService(ctx, &powchain.Web3ServiceConfig{
 		BeaconDB:        beaconDB,
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: common.Address{},
 	})
 	require.NoError(t, err, "Unable to set up web3 service")
@@ -347,3 +347,82 @@ func BenchmarkHasBlockForkChoiceStore(b *testing.B) {
 		require.Equal(b, true, s.forkChoiceStore.HasNode(r), "Block is not in fork choice store")
 	}
 }
+
+func TestRestoreForkChoiceStore(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := stateutil.BlockRoot(genesis.Block)
+	require.NoError(t, err)
+	child := testutil.NewBeaconBlock()
+	child.Block.Slot = 1
+	child.Block.ParentRoot = genesisRoot[:]
+	childRoot, err := stateutil.BlockRoot(child.Block)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{genesis, child}))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Root: genesisRoot[:]}))
+	service.genesisRoot = genesisRoot
+
+	store := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, store.ProcessBlock(ctx, 0, genesisRoot, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, store.ProcessBlock(ctx, 1, childRoot, genesisRoot, [32]byte{}, 0, 0))
+	service.forkChoiceStore = store
+	require.NoError(t, service.persistForkChoiceStore(ctx))
+	finalized := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+
+	// The state summary of the child block is missing.
+	_, err = service.restoreForkChoiceStore(ctx, finalized)
+	assert.ErrorContains(t, "no block or state summary in db for fork choice node", err)
+
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 1, Root: childRoot[:]}))
+	restored, err := service.restoreForkChoiceStore(ctx, finalized)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.DeepEqual(t, store.Nodes(), restored.Nodes(), "Unexpected restored nodes")
+
+	// A store which doesn't contain the finalized checkpoint is outdated.
+	restored, err = service.restoreForkChoiceStore(ctx, &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32)})
+	require.NoError(t, err)
+	assert.Equal(t, (*protoarray.ForkChoice)(nil), restored, "Expected outdated store not to be restored")
+}
+
+func TestResumeForkChoice_DeletesPersistedStore(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	service.forkChoiceStore = protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, service.persistForkChoiceStore(ctx))
+	finalized := &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32)}
+	service.resumeForkChoice(finalized, finalized)
+
+	persisted, err := db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*protodb.ForkChoiceStore)(nil), persisted, "Expected persisted store to be deleted")
+}
+
+func TestChainService_StopPersistsHeadStateAndRejectsBlocks(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	headBlock := testutil.NewBeaconBlock()
+	headBlock.Block.Slot = 1
+	headRoot, err := stateutil.BlockRoot(headBlock.Block)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, headBlock))
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(1))
+	service.head = &head{slot: 1, root: headRoot, block: headBlock, state: headState}
+
+	require.NoError(t, service.Stop())
+	assert.Equal(t, true, db.HasState(ctx, headRoot), "Expected head state to be persisted")
+	savedHead, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, headBlock, savedHead)
+
+	err = service.ReceiveBlock(ctx, testutil.NewBeaconBlock(), [32]byte{'a'})
+	assert.ErrorContains(t, errServiceStopped.Error(), err)
+}
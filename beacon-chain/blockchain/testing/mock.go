@@ -247,6 +247,11 @@ func (ms *ChainService) HeadState(context.Context) (*stateTrie.BeaconState, erro
 	return ms.State, nil
 }
 
+// HeadStateReadOnly mocks HeadStateReadOnly method in chain service.
+func (ms *ChainService) HeadStateReadOnly(context.Context) (*stateTrie.BeaconState, error) {
+	return ms.State, nil
+}
+
 // CurrentFork mocks HeadState method in chain service.
 func (ms *ChainService) CurrentFork() *pb.Fork {
 	return ms.Fork
@@ -0,0 +1,83 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// ParseWeakSubjectivityCheckpoint parses a weak subjectivity checkpoint given as
+// <block root>:<epoch>, where the block root is hex encoded with an optional 0x prefix.
+func ParseWeakSubjectivityCheckpoint(input string) (*ethpb.Checkpoint, error) {
+	parts := strings.Split(input, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("weak subjectivity checkpoint %q is not of the form <block root>:<epoch>", input)
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(parts[0], "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode weak subjectivity checkpoint root")
+	}
+	if len(root) != 32 {
+		return nil, fmt.Errorf("weak subjectivity checkpoint root must be 32 bytes, received %d", len(root))
+	}
+	epoch, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse weak subjectivity checkpoint epoch")
+	}
+	return &ethpb.Checkpoint{Root: root, Epoch: epoch}, nil
+}
+
+// verifyWeakSubjectivityCheckpoint checks that the chain finalized at the given checkpoint
+// includes the weak subjectivity checkpoint the node was started with. Chains finalized before the
+// weak subjectivity epoch cannot be checked yet, the verification happens once finality reaches it.
+// Pending blocks are blocks which are not imported yet, such as the rest of an initial sync batch,
+// and are looked up before the fork choice store and the DB.
+func (s *Service) verifyWeakSubjectivityCheckpoint(ctx context.Context, finalized *ethpb.Checkpoint,
+	pending map[[32]byte]*ethpb.BeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "blockchain.verifyWeakSubjectivityCheckpoint")
+	defer span.End()
+
+	if s.wsCheckpt == nil || finalized.Epoch < s.wsCheckpt.Epoch {
+		return nil
+	}
+	// Blocks are received from both sync and the regular block processing, which may verify concurrently.
+	s.wsVerifiedLock.Lock()
+	defer s.wsVerifiedLock.Unlock()
+	if s.wsVerified {
+		return nil
+	}
+	root := finalized.Root
+	if finalized.Epoch > s.wsCheckpt.Epoch {
+		slot := helpers.StartSlot(s.wsCheckpt.Epoch)
+		r := bytesutil.ToBytes32(finalized.Root)
+		b, ok := pending[r]
+		for ok && b.Slot > slot {
+			r = bytesutil.ToBytes32(b.ParentRoot)
+			b, ok = pending[r]
+		}
+		root = r[:]
+		if !ok {
+			var err error
+			root, err = s.ancestor(ctx, r[:], slot)
+			if err != nil {
+				return errors.Wrap(err, "could not get ancestor of finalized block at weak subjectivity epoch")
+			}
+		}
+	}
+	if !bytes.Equal(root, s.wsCheckpt.Root) {
+		return fmt.Errorf("finalized chain conflicts with weak subjectivity checkpoint: block root at epoch %d is %#x, wanted %#x",
+			s.wsCheckpt.Epoch, root, s.wsCheckpt.Root)
+	}
+	s.wsVerified = true
+	log.WithField("epoch", s.wsCheckpt.Epoch).Info("Verified finalized chain against weak subjectivity checkpoint")
+	return nil
+}
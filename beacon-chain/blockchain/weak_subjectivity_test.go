@@ -0,0 +1,145 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestParseWeakSubjectivityCheckpoint(t *testing.T) {
+	root := bytesutil.PadTo([]byte{'a'}, 32)
+	cp, err := ParseWeakSubjectivityCheckpoint(fmt.Sprintf("%#x:%d", root, 100))
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Root: root, Epoch: 100}, cp)
+
+	_, err = ParseWeakSubjectivityCheckpoint(fmt.Sprintf("%#x", root))
+	assert.ErrorContains(t, "is not of the form", err)
+	_, err = ParseWeakSubjectivityCheckpoint("0x1234:100")
+	assert.ErrorContains(t, "must be 32 bytes", err)
+	_, err = ParseWeakSubjectivityCheckpoint(fmt.Sprintf("%#x:abc", root))
+	assert.ErrorContains(t, "could not parse weak subjectivity checkpoint epoch", err)
+}
+
+func TestVerifyWeakSubjectivityCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+
+	// Blocks at the start slots of epochs 1 and 2, and a fork at epoch 2 skipping the start slot of epoch 1.
+	b1 := testutil.NewBeaconBlock()
+	b1.Block.Slot = helpers.StartSlot(1)
+	require.NoError(t, db.SaveBlock(ctx, b1))
+	r1, err := stateutil.BlockRoot(b1.Block)
+	require.NoError(t, err)
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = helpers.StartSlot(2)
+	b2.Block.ParentRoot = r1[:]
+	require.NoError(t, db.SaveBlock(ctx, b2))
+	r2, err := stateutil.BlockRoot(b2.Block)
+	require.NoError(t, err)
+	forkParent := testutil.NewBeaconBlock()
+	forkParent.Block.Slot = helpers.StartSlot(1) - 1
+	require.NoError(t, db.SaveBlock(ctx, forkParent))
+	forkParentRoot, err := stateutil.BlockRoot(forkParent.Block)
+	require.NoError(t, err)
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = helpers.StartSlot(2)
+	fork.Block.ParentRoot = forkParentRoot[:]
+	require.NoError(t, db.SaveBlock(ctx, fork))
+	forkRoot, err := stateutil.BlockRoot(fork.Block)
+	require.NoError(t, err)
+	// Blocks at the start slot of epoch 3 on both chains which are not saved, as in an initial sync batch.
+	pending := testutil.NewBeaconBlock()
+	pending.Block.Slot = helpers.StartSlot(3)
+	pending.Block.ParentRoot = r2[:]
+	pendingRoot, err := stateutil.BlockRoot(pending.Block)
+	require.NoError(t, err)
+	pendingFork := testutil.NewBeaconBlock()
+	pendingFork.Block.Slot = helpers.StartSlot(3)
+	pendingFork.Block.ParentRoot = forkRoot[:]
+	pendingForkRoot, err := stateutil.BlockRoot(pendingFork.Block)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		wsCheckpt *ethpb.Checkpoint
+		finalized *ethpb.Checkpoint
+		pending   map[[32]byte]*ethpb.BeaconBlock
+		wantErr   bool
+		verified  bool
+	}{
+		{
+			name:      "no checkpoint",
+			finalized: &ethpb.Checkpoint{Root: forkRoot[:], Epoch: 2},
+		},
+		{
+			name:      "finalized before checkpoint",
+			wsCheckpt: &ethpb.Checkpoint{Root: r2[:], Epoch: 2},
+			finalized: &ethpb.Checkpoint{Root: r1[:], Epoch: 1},
+		},
+		{
+			name:      "same epoch",
+			wsCheckpt: &ethpb.Checkpoint{Root: r2[:], Epoch: 2},
+			finalized: &ethpb.Checkpoint{Root: r2[:], Epoch: 2},
+			verified:  true,
+		},
+		{
+			name:      "same epoch conflicting",
+			wsCheckpt: &ethpb.Checkpoint{Root: r2[:], Epoch: 2},
+			finalized: &ethpb.Checkpoint{Root: forkRoot[:], Epoch: 2},
+			wantErr:   true,
+		},
+		{
+			name:      "ancestor",
+			wsCheckpt: &ethpb.Checkpoint{Root: r1[:], Epoch: 1},
+			finalized: &ethpb.Checkpoint{Root: r2[:], Epoch: 2},
+			verified:  true,
+		},
+		{
+			name:      "conflicting ancestor",
+			wsCheckpt: &ethpb.Checkpoint{Root: r1[:], Epoch: 1},
+			finalized: &ethpb.Checkpoint{Root: forkRoot[:], Epoch: 2},
+			wantErr:   true,
+		},
+		{
+			name:      "pending ancestor",
+			wsCheckpt: &ethpb.Checkpoint{Root: r1[:], Epoch: 1},
+			finalized: &ethpb.Checkpoint{Root: pendingRoot[:], Epoch: 3},
+			pending:   map[[32]byte]*ethpb.BeaconBlock{pendingRoot: pending.Block},
+			verified:  true,
+		},
+		{
+			name:      "conflicting pending ancestor",
+			wsCheckpt: &ethpb.Checkpoint{Root: r1[:], Epoch: 1},
+			finalized: &ethpb.Checkpoint{Root: pendingForkRoot[:], Epoch: 3},
+			pending:   map[[32]byte]*ethpb.BeaconBlock{pendingForkRoot: pendingFork.Block},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := NewService(ctx, &Config{
+				BeaconDB:        db,
+				ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+				WsCheckpt:       tt.wsCheckpt,
+			})
+			require.NoError(t, err)
+			err = service.verifyWeakSubjectivityCheckpoint(ctx, tt.finalized, tt.pending)
+			if tt.wantErr {
+				assert.ErrorContains(t, "conflicts with weak subjectivity checkpoint", err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.verified, service.wsVerified)
+		})
+	}
+}
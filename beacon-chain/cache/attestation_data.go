@@ -99,12 +99,14 @@ func (c *AttestationCache) Get(ctx context.Context, req *ethpb.AttestationDataRe
 
 	if exists && item != nil && item.(*attestationReqResWrapper).res != nil {
 		attestationCacheHit.Inc()
+		attestationDataCacheMetrics.Lookup(true)
 		if featureconfig.Get().ReduceAttesterStateCopy {
 			return state.CopyAttestationData(item.(*attestationReqResWrapper).res), nil
 		}
 		return item.(*attestationReqResWrapper).res, nil
 	}
 	attestationCacheMiss.Inc()
+	attestationDataCacheMetrics.Lookup(false)
 	return nil, nil
 }
 
@@ -146,7 +148,7 @@ func (c *AttestationCache) Put(ctx context.Context, req *ethpb.AttestationDataRe
 	if err := c.cache.AddIfNotPresent(data); err != nil {
 		return err
 	}
-	trim(c.cache, maxCacheSize)
+	trim(c.cache, maxCacheSize, attestationDataCacheMetrics)
 
 	attestationCacheSize.Set(float64(len(c.cache.List())))
 	return nil
@@ -41,7 +41,7 @@ type CheckpointStateCache struct {
 
 // NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing processed state.
 func NewCheckpointStateCache() *CheckpointStateCache {
-	cache, err := lru.New(maxCheckpointStateSize)
+	cache, err := lru.NewWithEvict(maxCheckpointStateSize, checkpointStateCacheMetrics.Evicted)
 	if err != nil {
 		panic(err)
 	}
@@ -64,11 +64,13 @@ func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (*stateTr
 
 	if exists && item != nil {
 		checkpointStateHit.Inc()
+		checkpointStateCacheMetrics.Lookup(true)
 		// Copy here is unnecessary since the return will only be used to verify attestation signature.
 		return item.(*stateTrie.BeaconState), nil
 	}
 
 	checkpointStateMiss.Inc()
+	checkpointStateCacheMetrics.Lookup(false)
 	return nil, nil
 }
 
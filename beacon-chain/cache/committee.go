@@ -77,8 +77,10 @@ func (c *CommitteeCache) Committee(slot uint64, seed [32]byte, index uint64) ([]
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		committeeCacheMetrics.Lookup(true)
 	} else {
 		CommitteeCacheMiss.Inc()
+		committeeCacheMetrics.Lookup(false)
 		return nil, nil
 	}
 
@@ -111,7 +113,7 @@ func (c *CommitteeCache) AddCommitteeShuffledList(committees *Committees) error
 	if err := c.CommitteeCache.AddIfNotPresent(committees); err != nil {
 		return err
 	}
-	trim(c.CommitteeCache, maxCommitteesCacheSize)
+	trim(c.CommitteeCache, maxCommitteesCacheSize, committeeCacheMetrics)
 	return nil
 }
 
@@ -140,7 +142,7 @@ func (c *CommitteeCache) AddProposerIndicesList(seed [32]byte, indices []uint64)
 		}
 	}
 
-	trim(c.CommitteeCache, maxCommitteesCacheSize)
+	trim(c.CommitteeCache, maxCommitteesCacheSize, committeeCacheMetrics)
 	return nil
 }
 
@@ -155,8 +157,10 @@ func (c *CommitteeCache) ActiveIndices(seed [32]byte) ([]uint64, error) {
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		committeeCacheMetrics.Lookup(true)
 	} else {
 		CommitteeCacheMiss.Inc()
+		committeeCacheMetrics.Lookup(false)
 		return nil, nil
 	}
 
@@ -179,8 +183,10 @@ func (c *CommitteeCache) ActiveIndicesCount(seed [32]byte) (int, error) {
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		committeeCacheMetrics.Lookup(true)
 	} else {
 		CommitteeCacheMiss.Inc()
+		committeeCacheMetrics.Lookup(false)
 		return 0, nil
 	}
 
@@ -203,8 +209,10 @@ func (c *CommitteeCache) ProposerIndices(seed [32]byte) ([]uint64, error) {
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		committeeCacheMetrics.Lookup(true)
 	} else {
 		CommitteeCacheMiss.Inc()
+		committeeCacheMetrics.Lookup(false)
 		return nil, nil
 	}
 
@@ -11,8 +11,8 @@ var (
 	maxCacheSize = 4 * params.BeaconConfig().SlotsPerEpoch
 )
 
-// trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize uint64) {
+// trim the FIFO queue to the maxSize, reporting the evicted entries to the cache metrics.
+func trim(queue *cache.FIFO, maxSize uint64, metrics *Metrics) {
 	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
 		_, err := queue.Pop(popProcessNoopFunc)
 		if err != nil {
@@ -20,6 +20,7 @@ func trim(queue *cache.FIFO, maxSize uint64) {
 			// happy.
 			return
 		}
+		metrics.Evicted(nil, nil)
 	}
 }
 
@@ -31,7 +31,7 @@ type HotStateCache struct {
 
 // NewHotStateCache initializes the map and underlying cache.
 func NewHotStateCache() *HotStateCache {
-	cache, err := lru.New(hotStateCacheSize)
+	cache, err := lru.NewWithEvict(hotStateCacheSize, hotStateCacheMetrics.Evicted)
 	if err != nil {
 		panic(err)
 	}
@@ -49,9 +49,11 @@ func (c *HotStateCache) Get(root [32]byte) *stateTrie.BeaconState {
 
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		hotStateCacheMetrics.Lookup(true)
 		return item.(*stateTrie.BeaconState).Copy()
 	}
 	hotStateCacheMiss.Inc()
+	hotStateCacheMetrics.Lookup(false)
 	return nil
 }
 
@@ -62,9 +64,11 @@ func (c *HotStateCache) GetWithoutCopy(root [32]byte) *stateTrie.BeaconState {
 	item, exists := c.cache.Get(root)
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		hotStateCacheMetrics.Lookup(true)
 		return item.(*stateTrie.BeaconState)
 	}
 	hotStateCacheMiss.Inc()
+	hotStateCacheMetrics.Lookup(false)
 	return nil
 }
 
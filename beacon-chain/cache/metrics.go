@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// The hits, misses and evictions of every cache are also reported under common
+// metrics labeled by cache name, so caches can be compared and sized from the same data.
+var (
+	cacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_hits_total",
+		Help: "The number of lookups which found their key in the cache, by cache",
+	}, []string{"cache"})
+	cacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_misses_total",
+		Help: "The number of lookups which did not find their key in the cache, by cache",
+	}, []string{"cache"})
+	cacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_evictions_total",
+		Help: "The number of entries evicted or removed from the cache, by cache",
+	}, []string{"cache"})
+)
+
+var (
+	committeeCacheMetrics       = NewMetrics("committee")
+	hotStateCacheMetrics        = NewMetrics("hot_state")
+	skipSlotCacheMetrics        = NewMetrics("skip_slot")
+	attestationDataCacheMetrics = NewMetrics("attestation_data")
+	checkpointStateCacheMetrics = NewMetrics("checkpoint_state")
+)
+
+// Metrics reports the hits, misses and evictions of a cache.
+type Metrics struct {
+	hits      prometheus.Counter
+	misses    prometheus.Counter
+	evictions prometheus.Counter
+}
+
+// NewMetrics returns the metrics of the cache with the given name.
+func NewMetrics(name string) *Metrics {
+	return &Metrics{
+		hits:      cacheHits.WithLabelValues(name),
+		misses:    cacheMisses.WithLabelValues(name),
+		evictions: cacheEvictions.WithLabelValues(name),
+	}
+}
+
+// Lookup reports a hit or a miss of the cache.
+func (m *Metrics) Lookup(hit bool) {
+	if hit {
+		m.hits.Inc()
+		return
+	}
+	m.misses.Inc()
+}
+
+// Evicted reports an entry evicted from the cache. It has the signature of
+// the eviction callback of the LRU caches.
+func (m *Metrics) Evicted(_ interface{}, _ interface{}) {
+	m.evictions.Inc()
+}
@@ -35,7 +35,7 @@ type SkipSlotCache struct {
 
 // NewSkipSlotCache initializes the map and underlying cache.
 func NewSkipSlotCache() *SkipSlotCache {
-	cache, err := lru.New(8)
+	cache, err := lru.NewWithEvict(8, skipSlotCacheMetrics.Evicted)
 	if err != nil {
 		panic(err)
 	}
@@ -63,6 +63,7 @@ func (c *SkipSlotCache) Get(ctx context.Context, slot uint64) (*stateTrie.Beacon
 	if c.disabled {
 		// Return a miss result if cache is not enabled.
 		skipSlotCacheMiss.Inc()
+		skipSlotCacheMetrics.Lookup(false)
 		return nil, nil
 	}
 
@@ -96,10 +97,12 @@ func (c *SkipSlotCache) Get(ctx context.Context, slot uint64) (*stateTrie.Beacon
 
 	if exists && item != nil {
 		skipSlotCacheHit.Inc()
+		skipSlotCacheMetrics.Lookup(true)
 		span.AddAttributes(trace.BoolAttribute("hit", true))
 		return item.(*stateTrie.BeaconState).Copy(), nil
 	}
 	skipSlotCacheMiss.Inc()
+	skipSlotCacheMetrics.Lookup(false)
 	span.AddAttributes(trace.BoolAttribute("hit", false))
 	return nil, nil
 }
@@ -10,7 +10,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/bazelbuild/rules_go/go/tools/bazel"
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -61,7 +60,7 @@ func runBlockProcessingTest(t *testing.T, config string) {
 			}
 
 			// If the post.ssz is not present, it means the test should fail on our end.
-			postSSZFilepath, readError := bazel.Runfile(path.Join(testsFolderPath, folder.Name(), "post.ssz"))
+			postSSZFilepath, readError := testutil.SpecTestFilePath(path.Join(testsFolderPath, folder.Name(), "post.ssz"))
 			postSSZExists := true
 			if readError != nil && strings.Contains(readError.Error(), "could not locate file") {
 				postSSZExists = false
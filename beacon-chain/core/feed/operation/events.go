@@ -18,6 +18,10 @@ const (
 
 	// ExitReceived is sent after an voluntary exit object has been received from the outside world (eg in RPC or sync)
 	ExitReceived
+
+	// SlashableAttReceived is sent after an attestation object received from the outside world was
+	// rejected by the attestation pool for being slashable. (eg. double votes or surround votes)
+	SlashableAttReceived
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -37,3 +41,11 @@ type ExitReceivedData struct {
 	// Exit is the voluntary exit object.
 	Exit *ethpb.SignedVoluntaryExit
 }
+
+// SlashableAttReceivedData is the data sent with SlashableAttReceived events.
+type SlashableAttReceivedData struct {
+	// Attestation is the rejected attestation object.
+	Attestation *ethpb.Attestation
+	// Slashings are the attester slashings the attestation makes with the attestations already seen.
+	Slashings []*ethpb.AttesterSlashing
+}
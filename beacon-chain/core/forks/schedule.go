@@ -0,0 +1,168 @@
+// Package forks defines the schedule of the hard forks of the beacon chain, mapping epochs to
+// the fork versions activated at them and to the upgrades of the state they require. The state
+// transition, p2p and RPC services derive the active fork from it, so a fork is activated by
+// adding its version to the ForkVersionSchedule of the beacon config.
+package forks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// UpgradeFunc upgrades a state reaching the epoch of a fork to the data structures of the fork.
+type UpgradeFunc func(ctx context.Context, state *stateTrie.BeaconState) (*stateTrie.BeaconState, error)
+
+// Fork is a fork version along with the epoch it is activated at.
+type Fork struct {
+	Epoch   uint64
+	Version []byte
+	Upgrade UpgradeFunc
+}
+
+// Schedule is the list of forks of the beacon chain, ordered by epoch. The first fork is
+// the genesis fork, activated at epoch 0.
+type Schedule struct {
+	forks []*Fork
+}
+
+// NewSchedule creates the schedule of the fork versions by epoch following the genesis fork
+// version, along with the upgrades of the state by fork version.
+func NewSchedule(genesisVersion []byte, versions map[uint64][]byte, upgrades map[[4]byte]UpgradeFunc) (*Schedule, error) {
+	if len(genesisVersion) != 4 {
+		return nil, fmt.Errorf("genesis fork version must be 4 bytes, received %d", len(genesisVersion))
+	}
+	forks := []*Fork{{Epoch: 0, Version: genesisVersion}}
+	for epoch, version := range versions {
+		if len(version) != 4 {
+			return nil, fmt.Errorf("fork version at epoch %d must be 4 bytes, received %d", epoch, len(version))
+		}
+		// The genesis fork version may be listed at epoch 0.
+		if epoch == 0 {
+			if !bytes.Equal(version, genesisVersion) {
+				return nil, fmt.Errorf("fork version %#x at epoch 0 differs from genesis fork version %#x", version, genesisVersion)
+			}
+			continue
+		}
+		forks = append(forks, &Fork{Epoch: epoch, Version: version})
+	}
+	sort.Slice(forks, func(i, j int) bool {
+		return forks[i].Epoch < forks[j].Epoch
+	})
+	seen := make(map[[4]byte]bool, len(forks))
+	for _, f := range forks {
+		version := bytesutil.ToBytes4(f.Version)
+		if seen[version] {
+			return nil, fmt.Errorf("fork version %#x is scheduled more than once", f.Version)
+		}
+		seen[version] = true
+		f.Upgrade = upgrades[version]
+	}
+	return &Schedule{forks: forks}, nil
+}
+
+// NewBeaconSchedule creates the schedule of the fork versions of the beacon config, along with
+// the upgrades of the state by fork version. It is built once at startup and handed to the
+// services deriving the active fork from it.
+func NewBeaconSchedule(upgrades map[[4]byte]UpgradeFunc) (*Schedule, error) {
+	cfg := params.BeaconConfig()
+	return NewSchedule(cfg.GenesisForkVersion, cfg.ForkVersionSchedule, upgrades)
+}
+
+// Forks returns the scheduled forks, ordered by epoch.
+func (s *Schedule) Forks() []*Fork {
+	return s.forks
+}
+
+// ForkAtEpoch returns the fork active at the epoch.
+func (s *Schedule) ForkAtEpoch(epoch uint64) *Fork {
+	i := sort.Search(len(s.forks), func(i int) bool {
+		return s.forks[i].Epoch > epoch
+	})
+	return s.forks[i-1]
+}
+
+// NextFork returns the first fork scheduled after the epoch, or nil if there is none.
+func (s *Schedule) NextFork(epoch uint64) *Fork {
+	i := sort.Search(len(s.forks), func(i int) bool {
+		return s.forks[i].Epoch > epoch
+	})
+	if i == len(s.forks) {
+		return nil
+	}
+	return s.forks[i]
+}
+
+// StateFork returns the fork data of a state at the epoch.
+func (s *Schedule) StateFork(epoch uint64) *pb.Fork {
+	i := sort.Search(len(s.forks), func(i int) bool {
+		return s.forks[i].Epoch > epoch
+	})
+	current := s.forks[i-1]
+	previous := current
+	if i > 1 {
+		previous = s.forks[i-2]
+	}
+	return &pb.Fork{
+		PreviousVersion: previous.Version,
+		CurrentVersion:  current.Version,
+		Epoch:           current.Epoch,
+	}
+}
+
+// ForkDigest returns the digest of the fork active at the epoch, used in gossip topics
+// and the status of peers.
+func (s *Schedule) ForkDigest(epoch uint64, genesisValidatorsRoot []byte) ([4]byte, error) {
+	return helpers.ComputeForkDigest(s.ForkAtEpoch(epoch).Version, genesisValidatorsRoot)
+}
+
+// ForkDigests returns the forks by their digest, to find out the fork of the gossip topic
+// of a message.
+func (s *Schedule) ForkDigests(genesisValidatorsRoot []byte) (map[[4]byte]*Fork, error) {
+	digests := make(map[[4]byte]*Fork, len(s.forks))
+	for _, f := range s.forks {
+		digest, err := helpers.ComputeForkDigest(f.Version, genesisValidatorsRoot)
+		if err != nil {
+			return nil, err
+		}
+		digests[digest] = f
+	}
+	return digests, nil
+}
+
+// UpgradeState applies the fork scheduled at the epoch starting at the slot of the state,
+// updating the fork of the state and upgrading it. States not at the start of the epoch of a
+// fork, or already upgraded, are returned unchanged.
+func (s *Schedule) UpgradeState(ctx context.Context, state *stateTrie.BeaconState) (*stateTrie.BeaconState, error) {
+	if state.Slot()%params.BeaconConfig().SlotsPerEpoch != 0 {
+		return state, nil
+	}
+	epoch := helpers.SlotToEpoch(state.Slot())
+	f := s.ForkAtEpoch(epoch)
+	if f.Epoch != epoch || f.Epoch == 0 || bytes.Equal(state.Fork().GetCurrentVersion(), f.Version) {
+		return state, nil
+	}
+	if err := state.SetFork(&pb.Fork{
+		PreviousVersion: state.Fork().GetCurrentVersion(),
+		CurrentVersion:  f.Version,
+		Epoch:           f.Epoch,
+	}); err != nil {
+		return nil, err
+	}
+	if f.Upgrade == nil {
+		return state, nil
+	}
+	upgraded, err := f.Upgrade(ctx, state)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not upgrade state to fork version %#x", f.Version)
+	}
+	return upgraded, nil
+}
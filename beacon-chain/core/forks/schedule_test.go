@@ -0,0 +1,118 @@
+package forks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var (
+	genesisVersion = []byte{0, 0, 0, 0}
+	version1       = []byte{0, 0, 0, 1}
+	version2       = []byte{0, 0, 0, 2}
+)
+
+func TestNewSchedule_Errors(t *testing.T) {
+	_, err := NewSchedule([]byte{0}, nil, nil)
+	assert.ErrorContains(t, "genesis fork version must be 4 bytes", err)
+	_, err = NewSchedule(genesisVersion, map[uint64][]byte{10: {1}}, nil)
+	assert.ErrorContains(t, "fork version at epoch 10 must be 4 bytes", err)
+	_, err = NewSchedule(genesisVersion, map[uint64][]byte{0: version1}, nil)
+	assert.ErrorContains(t, "differs from genesis fork version", err)
+	_, err = NewSchedule(genesisVersion, map[uint64][]byte{10: version1, 20: version1}, nil)
+	assert.ErrorContains(t, "is scheduled more than once", err)
+}
+
+func TestSchedule_ForkAtEpoch(t *testing.T) {
+	s, err := NewSchedule(genesisVersion, map[uint64][]byte{0: genesisVersion, 20: version2, 10: version1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(s.Forks()))
+
+	assert.DeepEqual(t, genesisVersion, s.ForkAtEpoch(9).Version)
+	assert.DeepEqual(t, version1, s.ForkAtEpoch(10).Version)
+	assert.DeepEqual(t, version2, s.ForkAtEpoch(100).Version)
+
+	assert.Equal(t, uint64(10), s.NextFork(0).Epoch)
+	assert.Equal(t, uint64(20), s.NextFork(10).Epoch)
+	assert.Equal(t, (*Fork)(nil), s.NextFork(20))
+
+	assert.DeepEqual(t, &pb.Fork{PreviousVersion: genesisVersion, CurrentVersion: genesisVersion}, s.StateFork(0))
+	assert.DeepEqual(t, &pb.Fork{PreviousVersion: version1, CurrentVersion: version2, Epoch: 20}, s.StateFork(25))
+}
+
+func TestSchedule_ForkDigests(t *testing.T) {
+	s, err := NewSchedule(genesisVersion, map[uint64][]byte{10: version1}, nil)
+	require.NoError(t, err)
+	root := make([]byte, 32)
+	digests, err := s.ForkDigests(root)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(digests))
+
+	digest, err := s.ForkDigest(10, root)
+	require.NoError(t, err)
+	want, err := helpers.ComputeForkDigest(version1, root)
+	require.NoError(t, err)
+	assert.Equal(t, want, digest)
+	assert.DeepEqual(t, version1, digests[digest].Version)
+}
+
+func TestSchedule_UpgradeState(t *testing.T) {
+	var upgraded bool
+	upgrades := map[[4]byte]UpgradeFunc{
+		{0, 0, 0, 1}: func(ctx context.Context, state *stateTrie.BeaconState) (*stateTrie.BeaconState, error) {
+			upgraded = true
+			return state, nil
+		},
+		{0, 0, 0, 2}: func(ctx context.Context, state *stateTrie.BeaconState) (*stateTrie.BeaconState, error) {
+			return nil, errors.New("bad upgrade")
+		},
+	}
+	s, err := NewSchedule(genesisVersion, map[uint64][]byte{10: version1, 20: version2}, upgrades)
+	require.NoError(t, err)
+
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetFork(&pb.Fork{PreviousVersion: genesisVersion, CurrentVersion: genesisVersion}))
+
+	// Not at the start of a fork epoch.
+	require.NoError(t, st.SetSlot(helpers.StartSlot(10)-1))
+	st, err = s.UpgradeState(context.Background(), st)
+	require.NoError(t, err)
+	assert.Equal(t, false, upgraded)
+	assert.DeepEqual(t, genesisVersion, st.Fork().CurrentVersion)
+
+	require.NoError(t, st.SetSlot(helpers.StartSlot(10)))
+	st, err = s.UpgradeState(context.Background(), st)
+	require.NoError(t, err)
+	assert.Equal(t, true, upgraded)
+	assert.DeepEqual(t, &pb.Fork{PreviousVersion: genesisVersion, CurrentVersion: version1, Epoch: 10}, st.Fork())
+
+	// Already upgraded states are unchanged.
+	upgraded = false
+	st, err = s.UpgradeState(context.Background(), st)
+	require.NoError(t, err)
+	assert.Equal(t, false, upgraded)
+
+	require.NoError(t, st.SetSlot(helpers.StartSlot(20)))
+	_, err = s.UpgradeState(context.Background(), st)
+	assert.ErrorContains(t, "bad upgrade", err)
+}
+
+func TestNewBeaconSchedule(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.ForkVersionSchedule = map[uint64][]byte{5: version1}
+	params.OverrideBeaconConfig(c)
+
+	s, err := NewBeaconSchedule(nil)
+	require.NoError(t, err)
+	assert.DeepEqual(t, version1, s.ForkAtEpoch(5).Version)
+	assert.Equal(t, true, s.ForkAtEpoch(5).Upgrade == nil)
+}
@@ -0,0 +1,20 @@
+package state
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
+)
+
+// forkSchedule is the schedule of the forks the states are upgraded to by the state transition.
+// No state is upgraded while it is nil.
+var forkSchedule *forks.Schedule
+
+// UseForkSchedule sets the schedule of the forks the states are upgraded to when they reach the
+// epoch of a fork. It is called once at startup, before any state transition runs, and returns a
+// function restoring the previous schedule, for tests to call on cleanup.
+func UseForkSchedule(s *forks.Schedule) func() {
+	prev := forkSchedule
+	forkSchedule = s
+	return func() {
+		forkSchedule = prev
+	}
+}
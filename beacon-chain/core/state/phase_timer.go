@@ -0,0 +1,32 @@
+package state
+
+import (
+	"context"
+	"time"
+)
+
+// Steps of ExecuteStateTransition reported to a PhaseTimer. The block signatures are verified as
+// the block operations are processed, so their time is part of the operations phase.
+const (
+	SlotProcessingPhase = "slot_processing"
+	OperationsPhase     = "operations"
+	StateHashingPhase   = "state_hashing"
+)
+
+// PhaseTimer is given the start time of each step of a state transition, once the step is done.
+type PhaseTimer func(phase string, start time.Time)
+
+type phaseTimerKey struct{}
+
+// WithPhaseTimer returns a copy of ctx for which ExecuteStateTransition reports the time of its
+// steps to timer.
+func WithPhaseTimer(ctx context.Context, timer PhaseTimer) context.Context {
+	return context.WithValue(ctx, phaseTimerKey{}, timer)
+}
+
+// observePhase reports the time since start spent in the phase to the timer of ctx, if any.
+func observePhase(ctx context.Context, phase string, start time.Time) {
+	if timer, ok := ctx.Value(phaseTimerKey{}).(PhaseTimer); ok {
+		timer(phase, start)
+	}
+}
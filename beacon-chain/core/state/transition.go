@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -59,24 +60,30 @@ func ExecuteStateTransition(
 	defer span.End()
 	var err error
 	// Execute per slots transition.
+	start := time.Now()
 	state, err = ProcessSlots(ctx, state, signed.Block.Slot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process slot")
 	}
+	observePhase(ctx, SlotProcessingPhase, start)
 
 	// Execute per block transition.
+	start = time.Now()
 	state, err = ProcessBlock(ctx, state, signed)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not process block in slot %d", signed.Block.Slot)
 	}
+	observePhase(ctx, OperationsPhase, start)
 
 	interop.WriteBlockToDisk(signed, false)
 	interop.WriteStateToDisk(state)
 
+	start = time.Now()
 	postStateRoot, err := state.HashTreeRoot(ctx)
 	if err != nil {
 		return nil, err
 	}
+	observePhase(ctx, StateHashingPhase, start)
 	if !bytes.Equal(postStateRoot[:], signed.Block.StateRoot) {
 		return state, fmt.Errorf("validate state root failed, wanted: %#x, received: %#x",
 			postStateRoot[:], signed.Block.StateRoot)
@@ -359,7 +366,8 @@ func ProcessSlots(ctx context.Context, state *stateTrie.BeaconState, slot uint64
 			traceutil.AnnotateError(span, err)
 			return nil, errors.Wrap(err, "could not process slot")
 		}
-		if CanProcessEpoch(state) {
+		epochTransition := CanProcessEpoch(state)
+		if epochTransition {
 			state, err = ProcessEpochPrecompute(ctx, state)
 			if err != nil {
 				traceutil.AnnotateError(span, err)
@@ -370,6 +378,14 @@ func ProcessSlots(ctx context.Context, state *stateTrie.BeaconState, slot uint64
 			traceutil.AnnotateError(span, err)
 			return nil, errors.Wrap(err, "failed to increment state slot")
 		}
+		// Forks are only activated at the start of an epoch.
+		if epochTransition && forkSchedule != nil {
+			state, err = forkSchedule.UpgradeState(ctx, state)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return nil, errors.Wrap(err, "could not upgrade state at fork")
+			}
+		}
 	}
 
 	if highestSlot < state.Slot() {
@@ -6,11 +6,13 @@ import (
 	"encoding/binary"
 	"fmt"
 	"testing"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -18,6 +20,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -97,10 +100,16 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	require.NoError(t, err)
 	block.Signature = sig.Marshal()
 
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	var phases []string
+	ctx := state.WithPhaseTimer(context.Background(), func(phase string, _ time.Time) {
+		phases = append(phases, phase)
+	})
+	beaconState, err = state.ExecuteStateTransition(ctx, beaconState, block)
 	require.NoError(t, err)
 
 	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, beaconState.Slot(), "Unexpected Slot number")
+	wanted := []string{state.SlotProcessingPhase, state.OperationsPhase, state.StateHashingPhase}
+	assert.DeepEqual(t, wanted, phases, "Unexpected timed phases")
 
 	mix, err := beaconState.RandaoMixAtIndex(1)
 	require.NoError(t, err)
@@ -913,3 +922,31 @@ func TestProcessSlots_LowerSlotAsParentState(t *testing.T) {
 	_, err = state.ProcessSlots(context.Background(), parentState, slot-1)
 	assert.ErrorContains(t, "expected state.slot 2 < slot 1", err)
 }
+
+func TestProcessSlots_UpgradesStateAtFork(t *testing.T) {
+	version := []byte{0, 0, 0, 1}
+	var upgrades int
+	schedule, err := forks.NewSchedule(params.BeaconConfig().GenesisForkVersion, map[uint64][]byte{1: version}, map[[4]byte]forks.UpgradeFunc{
+		bytesutil.ToBytes4(version): func(ctx context.Context, s *beaconstate.BeaconState) (*beaconstate.BeaconState, error) {
+			upgrades++
+			return s, nil
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(state.UseForkSchedule(schedule))
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	beaconState, err = state.ProcessSlots(context.Background(), beaconState, params.BeaconConfig().SlotsPerEpoch-1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, upgrades)
+	assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, beaconState.Fork().CurrentVersion)
+
+	beaconState, err = state.ProcessSlots(context.Background(), beaconState, params.BeaconConfig().SlotsPerEpoch+1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, upgrades)
+	assert.DeepEqual(t, &pb.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  version,
+		Epoch:           1,
+	}, beaconState.Fork())
+}
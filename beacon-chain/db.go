@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Directory of the beacon node database in the data directory, as used by the beacon node.
+const beaconChainDBName = "beaconchaindata"
+
+var retentionEpochsFlag = &cli.Uint64Flag{
+	Name:  "retention-epochs",
+	Usage: "Number of epochs before the finalized checkpoint to keep the saved states of, older states are deleted",
+	Value: 256,
+}
+
+var dbCommands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for maintaining the beacon node database, the beacon node must be stopped first",
+	Subcommands: []*cli.Command{
+		{
+			Name: "prune",
+			Description: `deletes the blocks of the forks which were not finalized along with their states,
+and the states older than the retention window, then compacts the database file to reclaim the freed space`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				retentionEpochsFlag,
+			},
+			Action: pruneDB,
+		},
+	},
+}
+
+func pruneDB(cliCtx *cli.Context) error {
+	log := logrus.WithField("prefix", "db")
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), beaconChainDBName)
+	retentionSlots := cliCtx.Uint64(retentionEpochsFlag.Name) * params.BeaconConfig().SlotsPerEpoch
+
+	log.WithField("path", dbPath).Info("Pruning database")
+	stats, err := db.PruneDB(context.Background(), dbPath, retentionSlots)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"nonCanonicalBlocks": stats.NonCanonicalBlocks,
+		"states":             stats.States,
+	}).Info("Deleted objects from database")
+
+	log.Info("Compacting database, this may take a while")
+	sizeBefore, sizeAfter, err := db.CompactDB(dbPath)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore":     sizeBefore,
+		"sizeAfter":      sizeAfter,
+		"reclaimedBytes": sizeBefore - sizeAfter,
+	}).Info("Compacted database")
+	return nil
+}
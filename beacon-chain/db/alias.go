@@ -22,3 +22,10 @@ type HeadAccessDatabase = iface.HeadAccessDatabase
 // key-value or relational database in practice. This is the full database interface which should
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.Database
+
+// OperationPools holds the contents of the operation pools persisted in the database.
+type OperationPools = iface.OperationPools
+
+// InspectableDatabase exposes the contents of Prysm's eth2 backend for read access only, including
+// the raw contents of its buckets. It is meant for tools debugging a database, not for the beacon node.
+type InspectableDatabase = iface.InspectableDatabase
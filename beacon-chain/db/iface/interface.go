@@ -14,8 +14,19 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	bolt "go.etcd.io/bbolt"
 )
 
+// OperationPools holds the contents of the operation pools, persisted on shutdown so that
+// they survive a restart.
+type OperationPools struct {
+	Attestations      []*eth.Attestation
+	BlockAttestations []*eth.Attestation
+	ProposerSlashings []*eth.ProposerSlashing
+	AttesterSlashings []*eth.AttesterSlashing
+	VoluntaryExits    []*eth.SignedVoluntaryExit
+}
+
 // ReadOnlyDatabase defines a struct which only has read access to database methods.
 type ReadOnlyDatabase interface {
 	// Block related methods.
@@ -52,6 +63,16 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Fork choice operations.
+	ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error)
+	// Operation pools operations.
+	OperationPools(ctx context.Context) (*OperationPools, error)
+	// Archive operations.
+	ArchivedEpochs(ctx context.Context) (uint64, error)
+	ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error)
+	ArchivedAttestations(ctx context.Context, epoch uint64) ([]*eth.Attestation, error)
+	ArchivedAttestationsByValidator(ctx context.Context, epoch uint64, validatorIndex uint64) ([]*eth.Attestation, error)
+	ArchivedCommittees(ctx context.Context, epoch uint64) (*eth.BeaconCommittees, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -81,6 +102,15 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Fork choice operations.
+	SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error
+	DeleteForkChoiceStore(ctx context.Context) error
+	// Operation pools operations.
+	SaveOperationPools(ctx context.Context, pools *OperationPools) error
+	// Archive operations.
+	EnsureArchiveMode(ctx context.Context, archive bool) error
+	SaveArchivedEpoch(ctx context.Context, epoch uint64, balances []uint64, atts []*eth.Attestation, attesters [][]uint64) error
+	SaveArchivedCommittees(ctx context.Context, committees *eth.BeaconCommittees) error
 
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
@@ -104,6 +134,8 @@ type Database interface {
 
 	DatabasePath() string
 	ClearDB() error
+	// BoltDB returns the underlying bolt database, shared by the services embedded in the node.
+	BoltDB() *bolt.DB
 
 	// Backup and restore methods
 	Backup(ctx context.Context) error
@@ -111,3 +143,25 @@ type Database interface {
 	// HistoricalStatesDeleted verifies historical states exist in DB.
 	HistoricalStatesDeleted(ctx context.Context) error
 }
+
+// InspectableDatabase defines a struct with read access to the database contents, including
+// chain head data and the raw bucket contents, for tools used to debug a database.
+type InspectableDatabase interface {
+	io.Closer
+	ReadOnlyDatabase
+
+	HeadBlock(ctx context.Context) (*eth.SignedBeaconBlock, error)
+	HeadState(ctx context.Context) (*state.BeaconState, error)
+
+	// BucketStats returns the number of keys and data size of each bucket.
+	BucketStats(ctx context.Context) ([]*BucketStats, error)
+	// RawValue returns the bytes stored under a key, as they are persisted.
+	RawValue(ctx context.Context, bucket, key []byte) ([]byte, error)
+}
+
+// BucketStats describes the contents of a database bucket.
+type BucketStats struct {
+	Name string
+	Keys int
+	Size uint64
+}
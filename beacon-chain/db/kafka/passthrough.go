@@ -7,9 +7,11 @@ import (
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	bolt "go.etcd.io/bbolt"
 )
 
 // DatabasePath -- passthrough.
@@ -22,6 +24,11 @@ func (e Exporter) ClearDB() error {
 	return e.db.ClearDB()
 }
 
+// BoltDB -- passthrough.
+func (e Exporter) BoltDB() *bolt.DB {
+	return e.db.BoltDB()
+}
+
 // Backup -- passthrough.
 func (e Exporter) Backup(ctx context.Context) error {
 	return e.db.Backup(ctx)
@@ -217,6 +224,71 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// ForkChoiceStore -- passthrough
+func (e Exporter) ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error) {
+	return e.db.ForkChoiceStore(ctx)
+}
+
+// SaveForkChoiceStore -- passthrough
+func (e Exporter) SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error {
+	return e.db.SaveForkChoiceStore(ctx, store)
+}
+
+// DeleteForkChoiceStore -- passthrough
+func (e Exporter) DeleteForkChoiceStore(ctx context.Context) error {
+	return e.db.DeleteForkChoiceStore(ctx)
+}
+
+// OperationPools -- passthrough
+func (e Exporter) OperationPools(ctx context.Context) (*iface.OperationPools, error) {
+	return e.db.OperationPools(ctx)
+}
+
+// SaveOperationPools -- passthrough
+func (e Exporter) SaveOperationPools(ctx context.Context, pools *iface.OperationPools) error {
+	return e.db.SaveOperationPools(ctx, pools)
+}
+
+// EnsureArchiveMode -- passthrough
+func (e Exporter) EnsureArchiveMode(ctx context.Context, archive bool) error {
+	return e.db.EnsureArchiveMode(ctx, archive)
+}
+
+// SaveArchivedEpoch -- passthrough
+func (e Exporter) SaveArchivedEpoch(ctx context.Context, epoch uint64, balances []uint64, atts []*eth.Attestation, attesters [][]uint64) error {
+	return e.db.SaveArchivedEpoch(ctx, epoch, balances, atts, attesters)
+}
+
+// SaveArchivedCommittees -- passthrough
+func (e Exporter) SaveArchivedCommittees(ctx context.Context, committees *eth.BeaconCommittees) error {
+	return e.db.SaveArchivedCommittees(ctx, committees)
+}
+
+// ArchivedEpochs -- passthrough
+func (e Exporter) ArchivedEpochs(ctx context.Context) (uint64, error) {
+	return e.db.ArchivedEpochs(ctx)
+}
+
+// ArchivedBalances -- passthrough
+func (e Exporter) ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error) {
+	return e.db.ArchivedBalances(ctx, epoch)
+}
+
+// ArchivedAttestations -- passthrough
+func (e Exporter) ArchivedAttestations(ctx context.Context, epoch uint64) ([]*eth.Attestation, error) {
+	return e.db.ArchivedAttestations(ctx, epoch)
+}
+
+// ArchivedAttestationsByValidator -- passthrough
+func (e Exporter) ArchivedAttestationsByValidator(ctx context.Context, epoch uint64, validatorIndex uint64) ([]*eth.Attestation, error) {
+	return e.db.ArchivedAttestationsByValidator(ctx, epoch, validatorIndex)
+}
+
+// ArchivedCommittees -- passthrough
+func (e Exporter) ArchivedCommittees(ctx context.Context, epoch uint64) (*eth.BeaconCommittees, error) {
+	return e.db.ArchivedCommittees(ctx, epoch)
+}
+
 // ArchivedPointRoot -- passthrough
 func (e Exporter) ArchivedPointRoot(ctx context.Context, index uint64) [32]byte {
 	return e.db.ArchivedPointRoot(ctx, index)
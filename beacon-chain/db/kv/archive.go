@@ -0,0 +1,299 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var (
+	errPrunedDatabase  = errors.New("database was synced by a pruned node, an archival node must sync from genesis with an empty database")
+	errArchiveDatabase = errors.New("database was synced by an archival node, run with --archival-node or sync a pruned node with an empty database")
+)
+
+// EnsureArchiveMode records whether the database is used by an archival node on first use, and
+// returns an error if it was already used in the other mode. An archival node keeps the full
+// history, which a pruned node can't be turned into once it skipped it.
+func (kv *Store) EnsureArchiveMode(ctx context.Context, archive bool) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EnsureArchiveMode")
+	defer span.End()
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		if enc := bkt.Get(archiveModeKey); enc != nil {
+			if archived := enc[0] == 1; archived != archive {
+				if archived {
+					return errArchiveDatabase
+				}
+				return errPrunedDatabase
+			}
+			return nil
+		}
+		if archive && tx.Bucket(blocksBucket).Get(genesisBlockRootKey) != nil {
+			return errPrunedDatabase
+		}
+		enc := []byte{0}
+		if archive {
+			enc[0] = 1
+		}
+		return bkt.Put(archiveModeKey, enc)
+	})
+}
+
+// isArchive returns true if the database is used by an archival node.
+func isArchive(tx *bolt.Tx) bool {
+	enc := tx.Bucket(chainMetadataBucket).Get(archiveModeKey)
+	return len(enc) == 1 && enc[0] == 1
+}
+
+// SaveArchivedEpoch indexes the validator balances at the start of the epoch and the attestations
+// included in the canonical blocks of the epoch, and marks the epochs up to it as archived. The
+// attestations are also indexed by the validators in attesters, the attesting indices of each
+// attestation, if given.
+func (kv *Store) SaveArchivedEpoch(ctx context.Context, epoch uint64, balances []uint64, atts []*ethpb.Attestation, attesters [][]uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedEpoch")
+	defer span.End()
+
+	if attesters != nil && len(attesters) != len(atts) {
+		return fmt.Errorf("got attesters of %d attestations, wanted %d", len(attesters), len(atts))
+	}
+	// The positions of the attestations of each validator in the archived attestations of the epoch.
+	positions := make(map[uint64][]uint64)
+	for i, indices := range attesters {
+		for _, idx := range indices {
+			positions[idx] = append(positions[idx], uint64(i))
+		}
+	}
+
+	encAtts := make([][]byte, len(atts))
+	for i, att := range atts {
+		enc, err := encode(ctx, att)
+		if err != nil {
+			return err
+		}
+		encAtts[i] = enc
+	}
+	key := bytesutil.Uint64ToBytesBigEndian(epoch)
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(archivedBalancesBucket).Put(key, encodeBalances(balances)); err != nil {
+			return err
+		}
+		attsBkt := tx.Bucket(archivedAttestationsBucket)
+		if attsBkt.Bucket(key) != nil {
+			if err := attsBkt.DeleteBucket(key); err != nil {
+				return err
+			}
+		}
+		epochBkt, err := attsBkt.CreateBucket(key)
+		if err != nil {
+			return err
+		}
+		for i, enc := range encAtts {
+			if err := epochBkt.Put(bytesutil.Uint64ToBytesBigEndian(uint64(i)), enc); err != nil {
+				return err
+			}
+		}
+		attestersBkt := tx.Bucket(archivedAttesterIndicesBucket)
+		if attestersBkt.Bucket(key) != nil {
+			if err := attestersBkt.DeleteBucket(key); err != nil {
+				return err
+			}
+		}
+		epochAttestersBkt, err := attestersBkt.CreateBucket(key)
+		if err != nil {
+			return err
+		}
+		for idx, pos := range positions {
+			if err := epochAttestersBkt.Put(bytesutil.Uint64ToBytesBigEndian(idx), encodePositions(pos)); err != nil {
+				return err
+			}
+		}
+		bkt := tx.Bucket(chainMetadataBucket)
+		if archived := bkt.Get(archivedEpochsKey); archived != nil && bytesutil.BytesToUint64BigEndian(archived) > epoch {
+			return nil
+		}
+		return bkt.Put(archivedEpochsKey, bytesutil.Uint64ToBytesBigEndian(epoch+1))
+	})
+}
+
+// ArchivedEpochs returns the number of epochs archived since genesis.
+func (kv *Store) ArchivedEpochs(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedEpochs")
+	defer span.End()
+
+	var archived uint64
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		if enc := tx.Bucket(chainMetadataBucket).Get(archivedEpochsKey); enc != nil {
+			archived = bytesutil.BytesToUint64BigEndian(enc)
+		}
+		return nil
+	})
+	return archived, err
+}
+
+// ArchivedBalances retrieves the validator balances at the start of the epoch, it returns nil if
+// the epoch isn't archived.
+func (kv *Store) ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedBalances")
+	defer span.End()
+
+	var balances []uint64
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(archivedBalancesBucket).Get(bytesutil.Uint64ToBytesBigEndian(epoch))
+		if enc == nil {
+			return nil
+		}
+		var err error
+		balances, err = decodeBalances(enc)
+		return err
+	})
+	return balances, err
+}
+
+// ArchivedAttestations retrieves the attestations included in the canonical blocks of the epoch,
+// it returns nil if the epoch isn't archived.
+func (kv *Store) ArchivedAttestations(ctx context.Context, epoch uint64) ([]*ethpb.Attestation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedAttestations")
+	defer span.End()
+
+	var atts []*ethpb.Attestation
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		epochBkt := tx.Bucket(archivedAttestationsBucket).Bucket(bytesutil.Uint64ToBytesBigEndian(epoch))
+		if epochBkt == nil {
+			return nil
+		}
+		atts = make([]*ethpb.Attestation, 0, epochBkt.Stats().KeyN)
+		return epochBkt.ForEach(func(k, v []byte) error {
+			att := &ethpb.Attestation{}
+			if err := decode(ctx, v, att); err != nil {
+				return err
+			}
+			atts = append(atts, att)
+			return nil
+		})
+	})
+	return atts, err
+}
+
+// ArchivedAttestationsByValidator retrieves the attestations of the validator included in the
+// canonical blocks of the epoch, it returns nil if the epoch isn't archived or its attestations
+// weren't indexed by validator.
+func (kv *Store) ArchivedAttestationsByValidator(ctx context.Context, epoch uint64, validatorIndex uint64) ([]*ethpb.Attestation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedAttestationsByValidator")
+	defer span.End()
+
+	var atts []*ethpb.Attestation
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		key := bytesutil.Uint64ToBytesBigEndian(epoch)
+		epochAttestersBkt := tx.Bucket(archivedAttesterIndicesBucket).Bucket(key)
+		epochBkt := tx.Bucket(archivedAttestationsBucket).Bucket(key)
+		if epochAttestersBkt == nil || epochBkt == nil {
+			return nil
+		}
+		atts = make([]*ethpb.Attestation, 0)
+		enc := epochAttestersBkt.Get(bytesutil.Uint64ToBytesBigEndian(validatorIndex))
+		if enc == nil {
+			return nil
+		}
+		positions, err := decodePositions(enc)
+		if err != nil {
+			return err
+		}
+		for _, pos := range positions {
+			enc := epochBkt.Get(bytesutil.Uint64ToBytesBigEndian(pos))
+			if enc == nil {
+				return fmt.Errorf("missing archived attestation %d of epoch %d", pos, epoch)
+			}
+			att := &ethpb.Attestation{}
+			if err := decode(ctx, enc, att); err != nil {
+				return err
+			}
+			atts = append(atts, att)
+		}
+		return nil
+	})
+	return atts, err
+}
+
+// SaveArchivedCommittees saves the beacon committees of their epoch, so the attestations of the
+// epoch can be mapped to validator indices without its states. They must be saved before the
+// epoch is marked as archived by SaveArchivedEpoch.
+func (kv *Store) SaveArchivedCommittees(ctx context.Context, committees *ethpb.BeaconCommittees) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedCommittees")
+	defer span.End()
+
+	enc, err := encode(ctx, committees)
+	if err != nil {
+		return err
+	}
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(archivedCommitteesBucket).Put(bytesutil.Uint64ToBytesBigEndian(committees.Epoch), enc)
+	})
+}
+
+// ArchivedCommittees retrieves the beacon committees of the epoch, it returns nil if they weren't
+// archived.
+func (kv *Store) ArchivedCommittees(ctx context.Context, epoch uint64) (*ethpb.BeaconCommittees, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedCommittees")
+	defer span.End()
+
+	var committees *ethpb.BeaconCommittees
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(archivedCommitteesBucket).Get(bytesutil.Uint64ToBytesBigEndian(epoch))
+		if enc == nil {
+			return nil
+		}
+		committees = &ethpb.BeaconCommittees{}
+		return decode(ctx, enc, committees)
+	})
+	return committees, err
+}
+
+func encodePositions(positions []uint64) []byte {
+	enc := make([]byte, 8*len(positions))
+	for i, pos := range positions {
+		binary.LittleEndian.PutUint64(enc[8*i:], pos)
+	}
+	return enc
+}
+
+func decodePositions(enc []byte) ([]uint64, error) {
+	if len(enc)%8 != 0 {
+		return nil, errors.New("invalid length of archived attestation positions")
+	}
+	positions := make([]uint64, len(enc)/8)
+	for i := range positions {
+		positions[i] = binary.LittleEndian.Uint64(enc[8*i:])
+	}
+	return positions, nil
+}
+
+func encodeBalances(balances []uint64) []byte {
+	enc := make([]byte, 8*len(balances))
+	for i, b := range balances {
+		binary.LittleEndian.PutUint64(enc[8*i:], b)
+	}
+	return snappy.Encode(nil, enc)
+}
+
+func decodeBalances(enc []byte) ([]uint64, error) {
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	if len(dec)%8 != 0 {
+		return nil, errors.New("invalid length of archived balances")
+	}
+	balances := make([]uint64, len(dec)/8)
+	for i := range balances {
+		balances[i] = binary.LittleEndian.Uint64(dec[8*i:])
+	}
+	return balances, nil
+}
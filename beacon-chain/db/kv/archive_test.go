@@ -0,0 +1,122 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_EnsureArchiveMode(t *testing.T) {
+	ctx := context.Background()
+
+	db := setupDB(t)
+	require.NoError(t, db.EnsureArchiveMode(ctx, true))
+	require.NoError(t, db.EnsureArchiveMode(ctx, true))
+	assert.ErrorContains(t, "synced by an archival node", db.EnsureArchiveMode(ctx, false))
+	_, err := db.Prune(ctx, 0)
+	assert.ErrorContains(t, "archival node can't be pruned", err)
+
+	db = setupDB(t)
+	require.NoError(t, db.EnsureArchiveMode(ctx, false))
+	assert.ErrorContains(t, "synced by a pruned node", db.EnsureArchiveMode(ctx, true))
+
+	// Databases synced before the mode was recorded were synced by pruned nodes.
+	db = setupDB(t)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, [32]byte{'a'}))
+	assert.ErrorContains(t, "synced by a pruned node", db.EnsureArchiveMode(ctx, true))
+	require.NoError(t, db.EnsureArchiveMode(ctx, false))
+}
+
+func TestStore_ArchivedEpoch_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	archived, err := db.ArchivedEpochs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), archived)
+	balances, err := db.ArchivedBalances(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(balances), "Expected no balances for an epoch not archived")
+
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: []byte{0b1101}},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: []byte{0b1011}},
+	}
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, []uint64{32, 31}, atts, nil))
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 1, []uint64{33, 30}, nil, nil))
+
+	archived, err = db.ArchivedEpochs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), archived)
+	balances, err = db.ArchivedBalances(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{33, 30}, balances)
+	retrieved, err := db.ArchivedAttestations(ctx, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, atts, retrieved)
+	retrieved, err = db.ArchivedAttestations(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(retrieved))
+
+	// Archiving an earlier epoch again doesn't move the archived epochs back.
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, []uint64{32, 31}, atts, nil))
+	archived, err = db.ArchivedEpochs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), archived)
+}
+
+func TestStore_ArchivedAttestationsByValidator(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: []byte{0b1101}},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: []byte{0b1011}},
+	}
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, nil, atts, [][]uint64{{4, 7}, {7, 9}}))
+
+	retrieved, err := db.ArchivedAttestationsByValidator(ctx, 0, 7)
+	require.NoError(t, err)
+	assert.DeepEqual(t, atts, retrieved)
+	retrieved, err = db.ArchivedAttestationsByValidator(ctx, 0, 9)
+	require.NoError(t, err)
+	assert.DeepEqual(t, atts[1:], retrieved)
+	retrieved, err = db.ArchivedAttestationsByValidator(ctx, 0, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(retrieved))
+	retrieved, err = db.ArchivedAttestationsByValidator(ctx, 1, 7)
+	require.NoError(t, err)
+	assert.Equal(t, true, retrieved == nil, "Expected no attestations for an epoch not archived")
+
+	// Archiving the epoch again replaces its index.
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, nil, atts[:1], [][]uint64{{4}}))
+	retrieved, err = db.ArchivedAttestationsByValidator(ctx, 0, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(retrieved))
+
+	assert.ErrorContains(t, "got attesters of 1 attestations, wanted 2", db.SaveArchivedEpoch(ctx, 0, nil, atts, [][]uint64{{4}}))
+}
+
+func TestStore_ArchivedCommittees_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	committees, err := db.ArchivedCommittees(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, committees == nil, "Expected no committees for an epoch not archived")
+
+	archived := &ethpb.BeaconCommittees{
+		Epoch: 1,
+		Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+			32: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{{ValidatorIndices: []uint64{3, 1}}}},
+		},
+		ActiveValidatorCount: 2,
+	}
+	require.NoError(t, db.SaveArchivedCommittees(ctx, archived))
+	committees, err = db.ArchivedCommittees(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, archived, committees)
+}
@@ -0,0 +1,138 @@
+package kv
+
+import (
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+// compactTxMaxSize is the amount of data copied in a single transaction of the compacted
+// database, to bound the memory used by compacting large databases.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// Compact rewrites the database in the directory path specified to a new file holding only
+// the pages in use, then replaces the original file with it. Space freed by deletions is only
+// returned to the file system this way. The database must not be open, the sizes of the file
+// before and after compaction are returned.
+func Compact(dirPath string) (int64, int64, error) {
+	datafile := path.Join(dirPath, databaseFileName)
+	info, err := os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	sizeBefore := info.Size()
+	src, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout, ReadOnly: true})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return 0, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, 0, err
+	}
+	compactedFile := datafile + ".compact"
+	if err := os.Remove(compactedFile); err != nil && !os.IsNotExist(err) {
+		return 0, 0, err
+	}
+	dst, err := bolt.Open(compactedFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout})
+	if err != nil {
+		return 0, 0, err
+	}
+	copyErr := copyDatabase(dst, src)
+	if err := src.Close(); err != nil && copyErr == nil {
+		copyErr = err
+	}
+	if err := dst.Close(); err != nil && copyErr == nil {
+		copyErr = err
+	}
+	if copyErr != nil {
+		if err := os.Remove(compactedFile); err != nil {
+			return 0, 0, errors.Wrapf(copyErr, "could not remove %s after failure: %v", compactedFile, err)
+		}
+		return 0, 0, errors.Wrap(copyErr, "could not copy database")
+	}
+	if err := os.Rename(compactedFile, datafile); err != nil {
+		return 0, 0, err
+	}
+	info, err = os.Stat(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	return sizeBefore, info.Size(), nil
+}
+
+// copyDatabase copies all the buckets of src to dst, committing the transaction of dst whenever
+// compactTxMaxSize bytes were written to it.
+func copyDatabase(dst, src *bolt.DB) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	var size int
+	var bucketPath [][]byte
+	put := func(k, v []byte, isBucket bool) error {
+		size += len(k) + len(v)
+		if size > compactTxMaxSize {
+			if err := tx.Commit(); err != nil {
+				return err
+			}
+			if tx, err = dst.Begin(true); err != nil {
+				return err
+			}
+			size = len(k) + len(v)
+		}
+		if len(bucketPath) == 0 {
+			_, err := tx.CreateBucketIfNotExists(k)
+			return err
+		}
+		b := tx.Bucket(bucketPath[0])
+		for _, name := range bucketPath[1:] {
+			b = b.Bucket(name)
+		}
+		if isBucket {
+			_, err := b.CreateBucketIfNotExists(k)
+			return err
+		}
+		// Values of src are only valid while its transaction is open, unlike the one of dst.
+		return b.Put(bytesutil.SafeCopyBytes(k), bytesutil.SafeCopyBytes(v))
+	}
+	var copyBucket func(b *bolt.Bucket) error
+	copyBucket = func(b *bolt.Bucket) error {
+		return b.ForEach(func(k, v []byte) error {
+			// Nested buckets have no value.
+			if v != nil {
+				return put(k, v, false)
+			}
+			if err := put(k, nil, true); err != nil {
+				return err
+			}
+			bucketPath = append(bucketPath, k)
+			if err := copyBucket(b.Bucket(k)); err != nil {
+				return err
+			}
+			bucketPath = bucketPath[:len(bucketPath)-1]
+			return nil
+		})
+	}
+	if err := src.View(func(srcTx *bolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if err := put(name, nil, true); err != nil {
+				return err
+			}
+			bucketPath = append(bucketPath, name)
+			if err := copyBucket(b); err != nil {
+				return err
+			}
+			bucketPath = bucketPath[:0]
+			return nil
+		})
+	}); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Wrapf(err, "could not rollback transaction: %v", rollbackErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,51 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCompact(t *testing.T) {
+	p := path.Join(testutil.TempDir(), "compact")
+	require.NoError(t, os.RemoveAll(p))
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(p))
+	})
+	db, err := NewKVStore(p, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	ctx := context.Background()
+	var roots [][32]byte
+	for i := uint64(0); i < 100; i++ {
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: i}}
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := stateutil.BlockRoot(blk.Block)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), root))
+		roots = append(roots, root)
+	}
+	require.NoError(t, db.DeleteStates(ctx, roots[1:]))
+	require.NoError(t, db.Close())
+
+	sizeBefore, sizeAfter, err := Compact(p)
+	require.NoError(t, err)
+	assert.Equal(t, true, sizeAfter < sizeBefore, "Expected compaction to shrink the database")
+
+	db, err = NewKVStoreReadOnly(p, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	assert.Equal(t, true, db.HasState(ctx, roots[0]), "Expected state to be copied")
+	for _, root := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, root), "Expected block to be copied")
+	}
+}
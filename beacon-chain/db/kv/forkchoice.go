@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceStore saves the fork choice store, so it can be restored on restart.
+func (kv *Store) SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceStore")
+	defer span.End()
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc, err := proto.Marshal(store)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(forkChoiceStoreKey, enc)
+	})
+}
+
+// ForkChoiceStore retrieves the saved fork choice store, it returns nil if none was saved.
+func (kv *Store) ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceStore")
+	defer span.End()
+
+	var store *db.ForkChoiceStore
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc := bkt.Get(forkChoiceStoreKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		store = &db.ForkChoiceStore{}
+		return proto.Unmarshal(enc, store)
+	})
+	return store, err
+}
+
+// DeleteForkChoiceStore deletes the saved fork choice store, so it is not restored again once outdated.
+func (kv *Store) DeleteForkChoiceStore(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteForkChoiceStore")
+	defer span.End()
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Delete(forkChoiceStoreKey)
+	})
+}
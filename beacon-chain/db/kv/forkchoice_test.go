@@ -0,0 +1,42 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ForkChoiceStore_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	retrieved, err := db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.ForkChoiceStore)(nil), retrieved, "Expected no saved fork choice store")
+
+	store := &dbpb.ForkChoiceStore{
+		JustifiedEpoch: 2,
+		FinalizedEpoch: 1,
+		FinalizedRoot:  []byte{'a'},
+		PruneThreshold: 256,
+		Nodes: []*dbpb.ForkChoiceNode{
+			{Slot: 32, Root: []byte{'a'}, Parent: ^uint64(0), BestChild: 1, BestDescendant: 1},
+			{Slot: 33, Root: []byte{'b'}, Weight: 10, BestChild: ^uint64(0), BestDescendant: ^uint64(0)},
+		},
+		Votes:    []*dbpb.ForkChoiceVote{{CurrentRoot: []byte{'a'}, NextRoot: []byte{'b'}, NextEpoch: 2}},
+		Balances: []uint64{10},
+	}
+	require.NoError(t, db.SaveForkChoiceStore(ctx, store))
+	retrieved, err = db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(store, retrieved), "Wanted %v, received %v", store, retrieved)
+
+	require.NoError(t, db.DeleteForkChoiceStore(ctx))
+	retrieved, err = db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.ForkChoiceStore)(nil), retrieved, "Expected fork choice store to be deleted")
+}
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// BucketStats returns the number of keys and the size of the data stored
+// in each of the top level buckets of the database.
+func (kv *Store) BucketStats(ctx context.Context) ([]*iface.BucketStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BucketStats")
+	defer span.End()
+	var stats []*iface.BucketStats
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, bkt *bolt.Bucket) error {
+			s := &iface.BucketStats{Name: string(name)}
+			if err := bkt.ForEach(func(k, v []byte) error {
+				s.Keys++
+				s.Size += uint64(len(k) + len(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+			stats = append(stats, s)
+			return nil
+		})
+	})
+	return stats, err
+}
+
+// RawValue returns the bytes stored under the key in the bucket, exactly as they are
+// persisted in the database. The value is nil if the key does not exist.
+func (kv *Store) RawValue(ctx context.Context, bucket, key []byte) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RawValue")
+	defer span.End()
+	var value []byte
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(bucket)
+		if bkt == nil {
+			return errors.Errorf("bucket %q does not exist", bucket)
+		}
+		// Values are only valid for the life of the transaction, so they need to be copied.
+		if v := bkt.Get(key); v != nil {
+			value = make([]byte, len(v))
+			copy(value, v)
+		}
+		return nil
+	})
+	return value, err
+}
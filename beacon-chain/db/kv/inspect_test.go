@@ -0,0 +1,72 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_BucketStats(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5}}
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	stats, err := db.BucketStats(ctx)
+	require.NoError(t, err)
+	found := false
+	for _, s := range stats {
+		if s.Name == string(blocksBucket) {
+			found = true
+			assert.Equal(t, 1, s.Keys)
+			assert.Equal(t, true, s.Size > 0, "Expected non zero bucket size")
+		}
+	}
+	assert.Equal(t, true, found, "Blocks bucket not reported")
+}
+
+func TestStore_RawValue(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5}}
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	root, err := stateutil.BlockRoot(blk.Block)
+	require.NoError(t, err)
+
+	value, err := db.RawValue(ctx, blocksBucket, root[:])
+	require.NoError(t, err)
+	decoded := &ethpb.SignedBeaconBlock{}
+	require.NoError(t, decode(ctx, value, decoded))
+	assert.DeepEqual(t, blk, decoded)
+
+	value, err = db.RawValue(ctx, blocksBucket, []byte("unknown"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(value))
+
+	_, err = db.RawValue(ctx, []byte("unknown"), root[:])
+	assert.ErrorContains(t, "does not exist", err)
+}
+
+func TestNewKVStoreReadOnly(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5}}
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	root, err := stateutil.BlockRoot(blk.Block)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	readOnly, err := NewKVStoreReadOnly(db.databasePath, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, readOnly.Close())
+	}()
+	got, err := readOnly.Block(ctx, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, got)
+	assert.NotNil(t, readOnly.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 6}}))
+}
@@ -18,6 +18,7 @@ import (
 )
 
 var _ = iface.Database(&Store{})
+var _ = iface.InspectableDatabase(&Store{})
 
 const (
 	// VotesCacheSize with 1M validators will be 8MB.
@@ -58,32 +59,11 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 		return nil, err
 	}
 	boltDB.AllocSize = boltAllocSize
-	blockCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1000,           // number of keys to track frequency of (1000).
-		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
+	kv, err := newStore(boltDB, dirPath, stateSummaryCache)
 	if err != nil {
 		return nil, err
 	}
 
-	validatorCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
-		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	kv := &Store{
-		db:                  boltDB,
-		databasePath:        dirPath,
-		blockCache:          blockCache,
-		validatorIndexCache: validatorCache,
-		stateSummaryCache:   stateSummaryCache,
-	}
-
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
 			tx,
@@ -96,6 +76,11 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			chainMetadataBucket,
 			checkpointBucket,
 			powchainBucket,
+			operationPoolsBucket,
+			archivedBalancesBucket,
+			archivedAttestationsBucket,
+			archivedAttesterIndicesBucket,
+			archivedCommitteesBucket,
 			stateSummaryBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
@@ -121,6 +106,52 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 	return kv, err
 }
 
+// NewKVStoreReadOnly opens the boltDB key-value store at the directory path specified
+// in read-only mode. No buckets are created and no migrations are run, so the database
+// can be inspected without modifying it.
+func NewKVStoreReadOnly(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*Store, error) {
+	datafile := path.Join(dirPath, databaseFileName)
+	if _, err := os.Stat(datafile); err != nil {
+		return nil, err
+	}
+	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be opened for writing by another process")
+		}
+		return nil, err
+	}
+	return newStore(boltDB, dirPath, stateSummaryCache)
+}
+
+func newStore(boltDB *bolt.DB, dirPath string, stateSummaryCache *cache.StateSummaryCache) (*Store, error) {
+	blockCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,           // number of keys to track frequency of (1000).
+		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validatorCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
+		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db:                  boltDB,
+		databasePath:        dirPath,
+		blockCache:          blockCache,
+		validatorIndexCache: validatorCache,
+		stateSummaryCache:   stateSummaryCache,
+	}, nil
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (kv *Store) ClearDB() error {
 	if _, err := os.Stat(kv.databasePath); os.IsNotExist(err) {
@@ -144,6 +175,12 @@ func (kv *Store) DatabasePath() string {
 	return kv.databasePath
 }
 
+// BoltDB returns the underlying boltDB database, which services embedded in the beacon node
+// share to keep their own buckets.
+func (kv *Store) BoltDB() *bolt.DB {
+	return kv.db
+}
+
 func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
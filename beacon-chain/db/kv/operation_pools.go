@@ -0,0 +1,143 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var (
+	poolAttestationsKey      = []byte("attestations")
+	poolBlockAttestationsKey = []byte("block-attestations")
+	poolProposerSlashingsKey = []byte("proposer-slashings")
+	poolAttesterSlashingsKey = []byte("attester-slashings")
+	poolVoluntaryExitsKey    = []byte("voluntary-exits")
+)
+
+// SaveOperationPools saves the contents of the operation pools, replacing the previously saved ones.
+func (kv *Store) SaveOperationPools(ctx context.Context, pools *iface.OperationPools) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOperationPools")
+	defer span.End()
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(operationPoolsBucket)
+		save := func(key []byte, msgs []proto.Message) error {
+			if bkt.Bucket(key) != nil {
+				if err := bkt.DeleteBucket(key); err != nil {
+					return err
+				}
+			}
+			sub, err := bkt.CreateBucket(key)
+			if err != nil {
+				return err
+			}
+			for _, msg := range msgs {
+				enc, err := proto.Marshal(msg)
+				if err != nil {
+					return err
+				}
+				seq, err := sub.NextSequence()
+				if err != nil {
+					return err
+				}
+				if err := sub.Put(bytesutil.Uint64ToBytesBigEndian(seq), enc); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		atts := make([]proto.Message, len(pools.Attestations))
+		for i, att := range pools.Attestations {
+			atts[i] = att
+		}
+		if err := save(poolAttestationsKey, atts); err != nil {
+			return err
+		}
+		blockAtts := make([]proto.Message, len(pools.BlockAttestations))
+		for i, att := range pools.BlockAttestations {
+			blockAtts[i] = att
+		}
+		if err := save(poolBlockAttestationsKey, blockAtts); err != nil {
+			return err
+		}
+		proposerSlashings := make([]proto.Message, len(pools.ProposerSlashings))
+		for i, slashing := range pools.ProposerSlashings {
+			proposerSlashings[i] = slashing
+		}
+		if err := save(poolProposerSlashingsKey, proposerSlashings); err != nil {
+			return err
+		}
+		attesterSlashings := make([]proto.Message, len(pools.AttesterSlashings))
+		for i, slashing := range pools.AttesterSlashings {
+			attesterSlashings[i] = slashing
+		}
+		if err := save(poolAttesterSlashingsKey, attesterSlashings); err != nil {
+			return err
+		}
+		exits := make([]proto.Message, len(pools.VoluntaryExits))
+		for i, exit := range pools.VoluntaryExits {
+			exits[i] = exit
+		}
+		return save(poolVoluntaryExitsKey, exits)
+	})
+}
+
+// OperationPools retrieves the saved contents of the operation pools, it returns empty pools
+// if none were saved.
+func (kv *Store) OperationPools(ctx context.Context) (*iface.OperationPools, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OperationPools")
+	defer span.End()
+
+	pools := &iface.OperationPools{}
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(operationPoolsBucket)
+		load := func(key []byte, newMsg func() proto.Message) error {
+			sub := bkt.Bucket(key)
+			if sub == nil {
+				return nil
+			}
+			return sub.ForEach(func(k, v []byte) error {
+				return proto.Unmarshal(v, newMsg())
+			})
+		}
+		if err := load(poolAttestationsKey, func() proto.Message {
+			att := &ethpb.Attestation{}
+			pools.Attestations = append(pools.Attestations, att)
+			return att
+		}); err != nil {
+			return err
+		}
+		if err := load(poolBlockAttestationsKey, func() proto.Message {
+			att := &ethpb.Attestation{}
+			pools.BlockAttestations = append(pools.BlockAttestations, att)
+			return att
+		}); err != nil {
+			return err
+		}
+		if err := load(poolProposerSlashingsKey, func() proto.Message {
+			slashing := &ethpb.ProposerSlashing{}
+			pools.ProposerSlashings = append(pools.ProposerSlashings, slashing)
+			return slashing
+		}); err != nil {
+			return err
+		}
+		if err := load(poolAttesterSlashingsKey, func() proto.Message {
+			slashing := &ethpb.AttesterSlashing{}
+			pools.AttesterSlashings = append(pools.AttesterSlashings, slashing)
+			return slashing
+		}); err != nil {
+			return err
+		}
+		return load(poolVoluntaryExitsKey, func() proto.Message {
+			exit := &ethpb.SignedVoluntaryExit{}
+			pools.VoluntaryExits = append(pools.VoluntaryExits, exit)
+			return exit
+		})
+	})
+	return pools, err
+}
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_OperationPools_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	retrieved, err := db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &iface.OperationPools{}, retrieved, "Expected empty pools")
+
+	pools := &iface.OperationPools{
+		Attestations: []*ethpb.Attestation{
+			{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: []byte{0b1101}},
+			{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: []byte{0b1001}},
+		},
+		BlockAttestations: []*ethpb.Attestation{
+			{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: []byte{0b1111}},
+		},
+		ProposerSlashings: []*ethpb.ProposerSlashing{
+			{Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: 3}}},
+		},
+		AttesterSlashings: []*ethpb.AttesterSlashing{
+			{Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{4}}},
+		},
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{
+			{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 5}},
+		},
+	}
+	require.NoError(t, db.SaveOperationPools(ctx, pools))
+	retrieved, err = db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pools, retrieved)
+
+	// Saving again replaces the previous contents.
+	pools = &iface.OperationPools{
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{
+			{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 6}},
+		},
+	}
+	require.NoError(t, db.SaveOperationPools(ctx, pools))
+	retrieved, err = db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pools, retrieved)
+}
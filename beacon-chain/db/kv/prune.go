@@ -0,0 +1,186 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PruneStats reports the number of objects deleted from the database by Prune.
+type PruneStats struct {
+	NonCanonicalBlocks int
+	States             int
+}
+
+// Prune deletes the blocks up to the finalized block which are not its ancestors, along with
+// their states and state summaries, and the states saved more than retentionSlots slots before the
+// finalized block. The genesis, finalized and head states are always kept. Deleted pages are reused
+// by bolt for new data, the file only shrinks once compacted with Compact. The databases of archival
+// nodes can't be pruned.
+func (kv *Store) Prune(ctx context.Context, retentionSlots uint64) (*PruneStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Prune")
+	defer span.End()
+
+	checkpoint, err := kv.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var genesisRoot, headRoot []byte
+	if err := kv.db.View(func(tx *bolt.Tx) error {
+		if isArchive(tx) {
+			return errors.New("database of an archival node can't be pruned")
+		}
+		bkt := tx.Bucket(blocksBucket)
+		genesisRoot = bytesutil.SafeCopyBytes(bkt.Get(genesisBlockRootKey))
+		headRoot = bytesutil.SafeCopyBytes(bkt.Get(headBlockRootKey))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if genesisRoot == nil {
+		return nil, errors.New("no genesis block in database")
+	}
+	finalizedRoot := checkpoint.Root
+	if bytes.Equal(finalizedRoot, params.BeaconConfig().ZeroHash[:]) {
+		finalizedRoot = genesisRoot
+	}
+	finalizedBlock, err := kv.Block(ctx, bytesutil.ToBytes32(finalizedRoot))
+	if err != nil {
+		return nil, err
+	}
+	if finalizedBlock == nil || finalizedBlock.Block == nil {
+		return nil, fmt.Errorf("missing finalized block in database: block root=%#x", finalizedRoot)
+	}
+	finalizedSlot := finalizedBlock.Block.Slot
+
+	// The canonical chain up to the finalized block is found by walking up its ancestors, rather
+	// than relying on the finalized block roots index which may be incomplete for older databases.
+	canonical := map[[32]byte]bool{bytesutil.ToBytes32(genesisRoot): true}
+	for root := bytesutil.ToBytes32(finalizedRoot); !canonical[root]; {
+		canonical[root] = true
+		blk, err := kv.Block(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil || blk.Block == nil {
+			return nil, fmt.Errorf("missing block in database: block root=%#x", root)
+		}
+		root = bytesutil.ToBytes32(blk.Block.ParentRoot)
+	}
+	var nonCanonicalRoots [][32]byte
+	// An end slot of 0 is not a bound for the filter, there is nothing to prune before genesis anyway.
+	if finalizedSlot > 0 {
+		roots, err := kv.BlockRoots(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(finalizedSlot))
+		if err != nil {
+			return nil, err
+		}
+		for _, root := range roots {
+			if !canonical[root] {
+				nonCanonicalRoots = append(nonCanonicalRoots, root)
+			}
+		}
+	}
+
+	stats := &PruneStats{}
+	err = kv.db.Update(func(tx *bolt.Tx) error {
+		isProtected := func(root []byte) bool {
+			return bytes.Equal(root, genesisRoot) || bytes.Equal(root, finalizedRoot) || bytes.Equal(root, headRoot)
+		}
+		var oldStateRoots [][]byte
+		if finalizedSlot > retentionSlots {
+			cutoff := finalizedSlot - retentionSlots
+			c := tx.Bucket(stateBucket).Cursor()
+			for root, _ := c.First(); root != nil; root, _ = c.Next() {
+				if isProtected(root) {
+					continue
+				}
+				slot, err := slotByBlockRoot(ctx, tx, root)
+				if err != nil {
+					return err
+				}
+				if slot < cutoff {
+					oldStateRoots = append(oldStateRoots, bytesutil.SafeCopyBytes(root))
+				}
+			}
+		}
+
+		// States are deleted first, as their slot is found from their block or state summary.
+		for _, root := range nonCanonicalRoots {
+			deleted, err := deleteStateInTx(ctx, tx, root[:])
+			if err != nil {
+				return err
+			}
+			if deleted {
+				stats.States++
+			}
+		}
+		for _, root := range oldStateRoots {
+			deleted, err := deleteStateInTx(ctx, tx, root)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				stats.States++
+			}
+		}
+		for _, root := range nonCanonicalRoots {
+			if err := tx.Bucket(stateSummaryBucket).Delete(root[:]); err != nil {
+				return err
+			}
+			if err := kv.deleteBlockInTx(ctx, tx, root); err != nil {
+				return err
+			}
+			stats.NonCanonicalBlocks++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// deleteStateInTx deletes the state saved for a block root along with its indices, returning
+// false if there is no such state.
+func deleteStateInTx(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (bool, error) {
+	bkt := tx.Bucket(stateBucket)
+	if bkt.Get(blockRoot) == nil {
+		return false, nil
+	}
+	slot, err := slotByBlockRoot(ctx, tx, blockRoot)
+	if err != nil {
+		return false, err
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot, tx); err != nil {
+		return false, errors.Wrap(err, "could not delete root for DB indices")
+	}
+	return true, bkt.Delete(blockRoot)
+}
+
+// deleteBlockInTx deletes a block along with its indices.
+func (kv *Store) deleteBlockInTx(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) error {
+	bkt := tx.Bucket(blocksBucket)
+	enc := bkt.Get(blockRoot[:])
+	if enc == nil {
+		return nil
+	}
+	block := &ethpb.SignedBeaconBlock{}
+	if err := decode(ctx, enc, block); err != nil {
+		return err
+	}
+	indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+	kv.blockCache.Del(string(blockRoot[:]))
+	return bkt.Delete(blockRoot[:])
+}
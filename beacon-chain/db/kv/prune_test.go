@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Prune(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// Chain of genesis <- canonical1 <- finalized <- head, with a fork at slot 1.
+	saveBlock := func(slot uint64, parent [32]byte) [32]byte {
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parent[:]}}
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := stateutil.BlockRoot(blk.Block)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), root))
+		return root
+	}
+	genesisRoot := saveBlock(0, [32]byte{})
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	canonicalRoot := saveBlock(1, genesisRoot)
+	forkRoot := saveBlock(1, [32]byte{'a'})
+	finalizedRoot := saveBlock(2, canonicalRoot)
+	headRoot := saveBlock(3, finalizedRoot)
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, headRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+
+	stats, err := db.Prune(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.NonCanonicalBlocks)
+	assert.Equal(t, 1, stats.States)
+	assert.Equal(t, false, db.HasBlock(ctx, forkRoot), "Expected fork block to be deleted")
+	assert.Equal(t, false, db.HasState(ctx, forkRoot), "Expected fork state to be deleted")
+	assert.Equal(t, true, db.HasState(ctx, canonicalRoot), "Expected state in the retention window to be kept")
+
+	stats, err = db.Prune(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.NonCanonicalBlocks)
+	assert.Equal(t, 1, stats.States)
+	assert.Equal(t, true, db.HasBlock(ctx, canonicalRoot), "Expected canonical block to be kept")
+	assert.Equal(t, false, db.HasState(ctx, canonicalRoot), "Expected old state to be deleted")
+	for _, root := range [][32]byte{genesisRoot, finalizedRoot, headRoot} {
+		assert.Equal(t, true, db.HasState(ctx, root), "Expected genesis, finalized and head states to be kept")
+	}
+}
@@ -17,6 +17,13 @@ var (
 	chainMetadataBucket     = []byte("chain-metadata")
 	checkpointBucket        = []byte("check-point")
 	powchainBucket          = []byte("powchain")
+	operationPoolsBucket    = []byte("operation-pools")
+
+	// Archive mode buckets, only written by archival nodes.
+	archivedBalancesBucket        = []byte("archived-balances")
+	archivedAttestationsBucket    = []byte("archived-attestations")
+	archivedAttesterIndicesBucket = []byte("archived-attester-indices")
+	archivedCommitteesBucket      = []byte("archived-committees")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -41,6 +48,9 @@ var (
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	forkChoiceStoreKey        = []byte("fork-choice-store")
+	archiveModeKey            = []byte("archive-mode")
+	archivedEpochsKey         = []byte("archived-epochs")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
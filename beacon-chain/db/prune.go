@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/sirupsen/logrus"
+)
+
+// PruneStats reports the number of objects deleted from a DB by PruneDB.
+type PruneStats = kv.PruneStats
+
+// PruneDB opens the existing DB at the directory path specified and deletes its non-canonical
+// blocks and its states older than retentionSlots before the finalized checkpoint. The DB must not
+// be in use by a beacon node.
+func PruneDB(ctx context.Context, dirPath string, retentionSlots uint64) (*PruneStats, error) {
+	store, err := kv.NewKVStore(dirPath, cache.NewStateSummaryCache())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			logrus.WithError(err).Error("Failed to close database")
+		}
+	}()
+	return store.Prune(ctx, retentionSlots)
+}
+
+// CompactDB rewrites the DB at the directory path specified to reclaim the space freed by
+// deletions, returning the size of the DB file before and after. The DB must not be open.
+func CompactDB(dirPath string) (int64, int64, error) {
+	return kv.Compact(dirPath)
+}
@@ -0,0 +1,11 @@
+package db
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
+
+// NewDBReadOnly opens an existing DB in read-only mode, for inspection.
+func NewDBReadOnly(dirPath string) (InspectableDatabase, error) {
+	return kv.NewKVStoreReadOnly(dirPath, cache.NewStateSummaryCache())
+}
@@ -3,14 +3,17 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
 var (
-	// HTTPWeb3ProviderFlag provides an HTTP access endpoint to an ETH 1.0 RPC.
+	// HTTPWeb3ProviderFlag provides the HTTP access endpoints to ETH 1.0 RPCs.
 	HTTPWeb3ProviderFlag = &cli.StringFlag{
-		Name:  "http-web3provider",
-		Usage: "A mainchain web3 provider string http endpoint",
+		Name: "http-web3provider",
+		Usage: "A mainchain web3 provider string http endpoint. A comma-separated list of endpoints " +
+			"may be given, the first one is used while it is healthy and the others are fallbacks",
 		Value: "https://goerli.prylabs.net",
 	}
 	// DepositContractFlag defines a flag for the deposit contract address.
@@ -31,6 +34,11 @@ var (
 		Usage: "RPC port exposed by a beacon node",
 		Value: 4000,
 	}
+	// RPCUnixSocket defines a unix domain socket on which the RPC server should listen.
+	RPCUnixSocket = &cli.StringFlag{
+		Name:  "rpc-unix-socket",
+		Usage: "Path of a unix domain socket on which the RPC server should listen instead of the RPC host and port",
+	}
 	// MonitoringPortFlag defines the http port used to serve prometheus metrics.
 	MonitoringPortFlag = &cli.IntFlag{
 		Name:  "monitoring-port",
@@ -112,11 +120,32 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// ArchiveFlag runs the node in archive mode, keeping the full history of the chain.
+	ArchiveFlag = &cli.BoolFlag{
+		Name: "archival-node",
+		Usage: "Run an archival node, saving the state of every epoch boundary and indexing historical " +
+			"attestations and balances to serve the full history over RPC. Archival nodes must sync from genesis " +
+			"with an empty database, which can't be used by a pruned node afterwards.",
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
 		Usage: "Does not run the discoveryV5 dht.",
 	}
+	// InvalidAttestationsThresholdFlag specifies the number of invalid attestations tolerated from a peer.
+	InvalidAttestationsThresholdFlag = &cli.IntFlag{
+		Name: "invalid-attestations-threshold",
+		Usage: "The number of invalid attestations gossiped by a peer before it is disconnected. The count " +
+			"of every peer is halved every minute.",
+		Value: 32,
+	}
+	// DuplicateAttestationsThresholdFlag specifies the number of duplicate attestations tolerated from a peer.
+	DuplicateAttestationsThresholdFlag = &cli.IntFlag{
+		Name: "duplicate-attestations-threshold",
+		Usage: "The number of duplicate attestations gossiped by a peer before it is disconnected. The count " +
+			"of every peer is halved every minute.",
+		Value: 4096,
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -139,6 +168,148 @@ var (
 		Name:  "historical-slasher-node",
 		Usage: "Enables required flags for serving historical data to a slasher client. Results in additional storage usage",
 	}
+	// SlasherFlag runs a lightweight slasher as a beacon node service.
+	SlasherFlag = &cli.BoolFlag{
+		Name: "slasher",
+		Usage: "Runs a lightweight slasher inside the beacon node, detecting slashable offenses in the " +
+			"blocks and attestations it receives and inserting them into its slashing pool",
+	}
+	// MonitorIndicesFlag defines the validator indices tracked by the validator monitor.
+	MonitorIndicesFlag = &cli.IntSliceFlag{
+		Name: "monitor-indices",
+		Usage: "Validator indices to monitor. The beacon node reports when their attestations are seen on gossip, " +
+			"included in blocks or missing, in its logs and metrics",
+	}
+	// FreeDiskWarningThresholdFlag defines the free disk space below which the beacon node warns about it.
+	FreeDiskWarningThresholdFlag = &cli.Uint64Flag{
+		Name:  "free-disk-warning-threshold-mb",
+		Usage: "Free disk space of the data directory, in megabytes, below which the beacon node logs warnings",
+		Value: 10240,
+	}
+	// AlertWebhookURLFlag defines the webhook the beacon node posts alerts on critical conditions to.
+	AlertWebhookURLFlag = &cli.StringFlag{
+		Name: "alert-webhook-url",
+		Usage: "Webhook URL the beacon node posts JSON alerts to when finality stalls, a validator of --monitor-indices " +
+			"is slashed, the eth1 endpoint is down or the peer count is too low",
+	}
+	// TelemetryEndpointFlag defines the endpoint the beacon node pushes anonymized statistics to.
+	TelemetryEndpointFlag = &cli.StringFlag{
+		Name: "telemetry-endpoint",
+		Usage: "Opt-in URL the beacon node periodically pushes anonymized statistics to, such as its version, " +
+			"head slot, sync distance, peer count and memory usage",
+	}
+	// TelemetrySecretFlag defines the secret the pushed statistics are signed with.
+	TelemetrySecretFlag = &cli.StringFlag{
+		Name:  "telemetry-secret",
+		Usage: "Secret, provided by the telemetry endpoint operator, the pushed statistics are signed with",
+	}
+	// TelemetryIntervalFlag defines how often statistics are pushed to the telemetry endpoint.
+	TelemetryIntervalFlag = &cli.DurationFlag{
+		Name:  "telemetry-interval",
+		Usage: "How often statistics are pushed to the telemetry endpoint",
+		Value: time.Minute,
+	}
+	// AlertFinalityEpochsFlag defines the number of epochs without finality before alerting.
+	AlertFinalityEpochsFlag = &cli.Uint64Flag{
+		Name:  "alert-finality-epochs",
+		Usage: "Number of epochs without finality after which an alert is sent",
+		Value: 4,
+	}
+	// AlertMinPeersFlag defines the peer count below which the beacon node alerts.
+	AlertMinPeersFlag = &cli.IntFlag{
+		Name:  "alert-min-peers",
+		Usage: "Number of connected peers below which an alert is sent",
+		Value: 5,
+	}
+	// EnableClockDriftCheckFlag enables checking the local clock against NTP servers.
+	EnableClockDriftCheckFlag = &cli.BoolFlag{
+		Name: "enable-clock-drift-check",
+		Usage: "Enables periodically checking the drift of the local clock against the --ntp-servers, " +
+			"logging warnings when it exceeds --clock-drift-threshold",
+	}
+	// DisableAttestationPoolPersistenceFlag disables saving the attestation pool on shutdown and restoring it on start.
+	DisableAttestationPoolPersistenceFlag = &cli.BoolFlag{
+		Name:  "disable-attestation-pool-persistence",
+		Usage: "Disables saving the pending attestations on shutdown and restoring the unexpired ones on restart",
+	}
+	// AttestationPoolSizeFlag defines the maximum number of attestations kept in the attestation pool.
+	AttestationPoolSizeFlag = &cli.IntFlag{
+		Name: "attestation-pool-size",
+		Usage: "Maximum number of unaggregated and of aggregated attestations kept in the pool, attestations of the " +
+			"oldest slots are evicted first. 0 means unbounded",
+		Value: 1 << 17,
+	}
+	// AttestationPoolShardsFlag defines the number of shards of the attestation pool.
+	AttestationPoolShardsFlag = &cli.IntFlag{
+		Name: "attestation-pool-shards",
+		Usage: "Number of shards the attestations of the pool are split in by committee index, each locked " +
+			"separately so that the attestations of different committees don't contend. The pool size is " +
+			"split evenly between the shards, so a shard evicts attestations once it holds its share",
+		Value: 1,
+	}
+	// AttestationAggregationModeFlag defines when the attestation pool aggregates unaggregated attestations.
+	AttestationAggregationModeFlag = &cli.StringFlag{
+		Name: "attestation-aggregation-mode",
+		Usage: "When the attestation pool aggregates unaggregated attestations of the same data: lazy, in the " +
+			"background and on aggregation duties, or eager, as soon as they are received",
+		Value: "lazy",
+	}
+	// AttestationRetentionSlotsFlag defines how long attestations are kept in the attestation pool.
+	AttestationRetentionSlotsFlag = &cli.Uint64Flag{
+		Name: "attestation-retention-slots",
+		Usage: "Number of slots attestations are kept in the pool for before they are garbage collected. " +
+			"0 means one epoch, after which attestations can't be included in blocks anymore",
+	}
+	// AttestationAggregationIntervalFlag defines how often unaggregated attestations are aggregated in the background.
+	AttestationAggregationIntervalFlag = &cli.DurationFlag{
+		Name:  "attestation-aggregation-interval",
+		Usage: "How often the unaggregated attestations of the pool are aggregated in the background, a quarter of a slot if unset",
+	}
+	// AttestationAggregationBatchSizeFlag defines the maximum number of attestations aggregated at once in the background.
+	AttestationAggregationBatchSizeFlag = &cli.IntFlag{
+		Name:  "attestation-aggregation-batch-size",
+		Usage: "Maximum number of unaggregated attestations aggregated at once in the background. 0 means unbounded",
+		Value: 4096,
+	}
+	// AttestationAggregationDryRunStrategyFlag defines the aggregation strategy simulated without broadcasting.
+	AttestationAggregationDryRunStrategyFlag = &cli.StringFlag{
+		Name: "attestation-aggregation-dry-run-strategy",
+		Usage: "Aggregation strategy, one of: naive, max_cover, of the aggregates simulated for every slot without " +
+			"being broadcast, to log and record their coverage compared with the attestations on chain. " +
+			"Disabled if unset",
+	}
+	// AttestationQueueSizeFlag defines the number of attestations each tier of the attestation queue holds.
+	AttestationQueueSizeFlag = &cli.IntFlag{
+		Name: "attestation-queue-size",
+		Usage: "Number of unaggregated attestations received on committee subnets queued for validation, for the " +
+			"committees of the node's validators and for the others each. Attestations are dropped once it is full",
+		Value: 4096,
+	}
+	// AttestationQueuePriorityFlag defines how many own committee attestations are validated for each other attestation.
+	AttestationQueuePriorityFlag = &cli.IntFlag{
+		Name: "attestation-queue-priority",
+		Usage: "Number of queued attestations of the committees of the node's validators validated for each " +
+			"attestation of another committee, while both are queued",
+		Value: 4,
+	}
+	// NTPServersFlag defines the NTP servers the local clock is checked against.
+	NTPServersFlag = &cli.StringSliceFlag{
+		Name:  "ntp-servers",
+		Usage: "NTP servers the drift of the local clock is checked against, as host or host:port",
+		Value: cli.NewStringSlice("pool.ntp.org", "time.google.com", "time.cloudflare.com"),
+	}
+	// ClockDriftThresholdFlag defines the clock drift above which the beacon node warns about it.
+	ClockDriftThresholdFlag = &cli.DurationFlag{
+		Name:  "clock-drift-threshold",
+		Usage: "Drift of the local clock from NTP time above which the beacon node logs warnings",
+		Value: 500 * time.Millisecond,
+	}
+	// WeakSubjectivityCheckpt defines the weak subjectivity checkpoint the finalized chain is verified against.
+	WeakSubjectivityCheckpt = &cli.StringFlag{
+		Name: "weak-subjectivity-checkpoint",
+		Usage: "Checkpoint of the form <block root>:<epoch>, with a 0x prefixed block root, the finalized chain must include. " +
+			"The node refuses to follow a chain conflicting with it, protecting nodes offline for long from long range attacks",
+	}
 	// ChainID defines a flag to set the chain id. If none is set, it derives this value from NetworkConfig
 	ChainID = &cli.Uint64Flag{
 		Name:  "chain-id",
@@ -27,4 +27,11 @@ var (
 		Name:  "interop-num-validators",
 		Usage: "Specify number of genesis validators to generate for interop. Must be used with --interop-genesis-time",
 	}
+	// InteropMnemonicFlag specifies the mnemonic the keys of the genesis validators are derived from.
+	InteropMnemonicFlag = &cli.StringFlag{
+		Name: "interop-mnemonic",
+		Usage: "Derive the keys of the interop genesis validators from this mnemonic, along the EIP-2334 paths " +
+			"used by other clients, rather than using the deterministic interop keys. Must be used with " +
+			"--interop-num-validators and --interop-genesis-time",
+	}
 )
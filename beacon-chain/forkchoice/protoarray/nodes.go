@@ -1,7 +1,6 @@
 package protoarray
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -240,8 +239,12 @@ func (s *Store) updateBestChildAndDescendant(parentIndex uint64, childIndex uint
 				newParentChild = noChange
 			} else if child.weight == bestChild.weight {
 				// If both are viable, compare their weights.
-				// Tie-breaker of equal weights by root.
-				if bytes.Compare(child.root[:], bestChild.root[:]) > 0 {
+				// Tie-breaker of equal weights by the store's rule, by highest root if unset.
+				prefersChild, err := s.tieBreaker.prefers(child, childIndex, bestChild, parent.bestChild)
+				if err != nil {
+					return err
+				}
+				if prefersChild {
 					newParentChild = changeToChild
 				} else {
 					newParentChild = noChange
@@ -0,0 +1,117 @@
+package protoarray
+
+import (
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// ToProto returns a copy of the fork choice store along with the validator votes and
+// balances in their protobuf form, so the fork choice can be persisted and restored later.
+func (f *ForkChoice) ToProto() *dbpb.ForkChoiceStore {
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+	f.store.nodeIndicesLock.RLock()
+	defer f.store.nodeIndicesLock.RUnlock()
+
+	nodes := make([]*dbpb.ForkChoiceNode, len(f.store.nodes))
+	for i, n := range f.store.nodes {
+		nodes[i] = &dbpb.ForkChoiceNode{
+			Slot:           n.slot,
+			Root:           bytesutil.SafeCopyBytes(n.root[:]),
+			Parent:         n.parent,
+			JustifiedEpoch: n.justifiedEpoch,
+			FinalizedEpoch: n.finalizedEpoch,
+			Weight:         n.weight,
+			BestChild:      n.bestChild,
+			BestDescendant: n.bestDescendant,
+			Graffiti:       bytesutil.SafeCopyBytes(n.graffiti[:]),
+		}
+	}
+	votes := make([]*dbpb.ForkChoiceVote, len(f.votes))
+	for i, v := range f.votes {
+		votes[i] = &dbpb.ForkChoiceVote{
+			CurrentRoot: bytesutil.SafeCopyBytes(v.currentRoot[:]),
+			NextRoot:    bytesutil.SafeCopyBytes(v.nextRoot[:]),
+			NextEpoch:   v.nextEpoch,
+		}
+	}
+	balances := make([]uint64, len(f.balances))
+	copy(balances, f.balances)
+
+	return &dbpb.ForkChoiceStore{
+		JustifiedEpoch: f.store.justifiedEpoch,
+		FinalizedEpoch: f.store.finalizedEpoch,
+		FinalizedRoot:  bytesutil.SafeCopyBytes(f.store.finalizedRoot[:]),
+		PruneThreshold: f.store.pruneThreshold,
+		Nodes:          nodes,
+		Votes:          votes,
+		Balances:       balances,
+	}
+}
+
+// InitializeFromProto restores a fork choice store from its protobuf form, as returned by ToProto.
+// It returns an error if the nodes of the store do not form a valid proto array.
+func InitializeFromProto(pb *dbpb.ForkChoiceStore) (*ForkChoice, error) {
+	if pb == nil {
+		return nil, errors.New("nil fork choice store")
+	}
+	if len(pb.FinalizedRoot) != 32 {
+		return nil, errors.Errorf("wanted finalized root of length 32, received %d", len(pb.FinalizedRoot))
+	}
+
+	nodes := make([]*Node, len(pb.Nodes))
+	nodesIndices := make(map[[32]byte]uint64, len(pb.Nodes))
+	for i, n := range pb.Nodes {
+		if len(n.Root) != 32 {
+			return nil, errors.Errorf("wanted node root of length 32, received %d", len(n.Root))
+		}
+		// Nodes are always inserted after their parent, and their best child
+		// and best descendant are always inserted after them.
+		if n.Parent != NonExistentNode && n.Parent >= uint64(i) {
+			return nil, errInvalidNodeIndex
+		}
+		if n.BestChild != NonExistentNode && (n.BestChild <= uint64(i) || n.BestChild >= uint64(len(pb.Nodes))) {
+			return nil, errInvalidBestChildIndex
+		}
+		if n.BestDescendant != NonExistentNode && (n.BestDescendant <= uint64(i) || n.BestDescendant >= uint64(len(pb.Nodes))) {
+			return nil, errInvalidBestDescendantIndex
+		}
+		root := bytesutil.ToBytes32(n.Root)
+		nodes[i] = &Node{
+			slot:           n.Slot,
+			root:           root,
+			parent:         n.Parent,
+			justifiedEpoch: n.JustifiedEpoch,
+			finalizedEpoch: n.FinalizedEpoch,
+			weight:         n.Weight,
+			bestChild:      n.BestChild,
+			bestDescendant: n.BestDescendant,
+			graffiti:       bytesutil.ToBytes32(n.Graffiti),
+		}
+		nodesIndices[root] = uint64(i)
+	}
+
+	votes := make([]Vote, len(pb.Votes))
+	for i, v := range pb.Votes {
+		votes[i] = Vote{
+			currentRoot: bytesutil.ToBytes32(v.CurrentRoot),
+			nextRoot:    bytesutil.ToBytes32(v.NextRoot),
+			nextEpoch:   v.NextEpoch,
+		}
+	}
+	balances := make([]uint64, len(pb.Balances))
+	copy(balances, pb.Balances)
+
+	s := &Store{
+		justifiedEpoch: pb.JustifiedEpoch,
+		finalizedEpoch: pb.FinalizedEpoch,
+		finalizedRoot:  bytesutil.ToBytes32(pb.FinalizedRoot),
+		nodes:          nodes,
+		nodesIndices:   nodesIndices,
+		pruneThreshold: pb.PruneThreshold,
+		tieBreaker:     TieBreaker(featureconfig.Get().ForkChoiceTieBreaker),
+	}
+	return &ForkChoice{store: s, votes: votes, balances: balances}, nil
+}
@@ -0,0 +1,82 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestInitializeFromProto_RestoresStore(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'g'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(3), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(2), 2)
+	balances := []uint64{1, 1, 1}
+	head, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), head)
+
+	restored, err := InitializeFromProto(f.ToProto())
+	require.NoError(t, err)
+	assert.DeepEqual(t, f.store.nodes, restored.store.nodes, "Unexpected restored nodes")
+	assert.DeepEqual(t, f.store.nodesIndices, restored.store.nodesIndices, "Unexpected restored node indices")
+	assert.DeepEqual(t, f.votes, restored.votes, "Unexpected restored votes")
+	assert.DeepEqual(t, f.balances, restored.balances, "Unexpected restored balances")
+	assert.Equal(t, f.store.finalizedRoot, restored.store.finalizedRoot)
+	assert.Equal(t, f.store.pruneThreshold, restored.store.pruneThreshold)
+
+	// The restored store keeps the weights, so it does not add the votes again and
+	// moves on to the same head as the original one on new votes.
+	head, err = restored.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), head)
+	for _, fc := range []*ForkChoice{f, restored} {
+		fc.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(2), 3)
+		head, err = fc.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		require.NoError(t, err)
+		assert.Equal(t, indexToHash(2), head)
+	}
+	assert.DeepEqual(t, f.store.nodes, restored.store.nodes, "Restored store diverged from the original one")
+}
+
+func TestInitializeFromProto_InvalidStore(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{}, 1)
+	require.NoError(t, err)
+
+	_, err = InitializeFromProto(nil)
+	assert.ErrorContains(t, "nil fork choice store", err)
+
+	pb := f.ToProto()
+	pb.FinalizedRoot = []byte{'a'}
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, "wanted finalized root of length 32", err)
+
+	pb = f.ToProto()
+	pb.Nodes[1].Root = []byte{'a'}
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, "wanted node root of length 32", err)
+
+	pb = f.ToProto()
+	pb.Nodes[1].Parent = 1
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, errInvalidNodeIndex.Error(), err)
+
+	pb = f.ToProto()
+	pb.Nodes[0].BestChild = 2
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, errInvalidBestChildIndex.Error(), err)
+
+	pb = f.ToProto()
+	pb.Nodes[0].BestDescendant = 0
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, errInvalidBestDescendantIndex.Error(), err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
@@ -24,6 +25,7 @@ func New(justifiedEpoch uint64, finalizedEpoch uint64, finalizedRoot [32]byte) *
 		nodes:          make([]*Node, 0),
 		nodesIndices:   make(map[[32]byte]uint64),
 		pruneThreshold: defaultPruneThreshold,
+		tieBreaker:     TieBreaker(featureconfig.Get().ForkChoiceTieBreaker),
 	}
 
 	b := make([]uint64, 0)
@@ -41,6 +43,11 @@ func (f *ForkChoice) Head(ctx context.Context, justifiedEpoch uint64, justifiedR
 
 	newBalances := justifiedStateBalances
 
+	// Computing the head consumes the pending votes and updates the node weights,
+	// so only one head computation runs at a time and no vote can come in meanwhile.
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
 	// Using the read lock is ok here, rest of the operations below is read only.
 	// The only time it writes to node indices is inserting and pruning blocks from the store.
 	f.store.nodeIndicesLock.RLock()
@@ -65,6 +72,9 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ProcessAttestation")
 	defer span.End()
 
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
 	for _, index := range validatorIndices {
 		// Validator indices will grow the vote cache.
 		for index >= uint64(len(f.votes)) {
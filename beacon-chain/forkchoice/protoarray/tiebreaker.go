@@ -0,0 +1,39 @@
+package protoarray
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// HighestRootTieBreaker chooses the branch with the lexicographically highest root,
+	// which is the rule defined by the spec's get_head.
+	HighestRootTieBreaker TieBreaker = "highest_root"
+
+	// LowestRootTieBreaker chooses the branch with the lexicographically lowest root.
+	LowestRootTieBreaker TieBreaker = "lowest_root"
+
+	// FirstSeenTieBreaker chooses the branch whose block was inserted in the store first.
+	FirstSeenTieBreaker TieBreaker = "first_seen"
+)
+
+// TieBreaker defines the rule used to choose between two viable branches of equal weight.
+// Rules other than HighestRootTieBreaker deviate from the spec and are only meant for devnets.
+type TieBreaker string
+
+// prefers returns true if the child should replace the current best child of its parent, given
+// that both lead to a viable head and have the same weight.
+func (t TieBreaker) prefers(child *Node, childIndex uint64, bestChild *Node, bestChildIndex uint64) (bool, error) {
+	switch t {
+	case "", HighestRootTieBreaker:
+		return bytes.Compare(child.root[:], bestChild.root[:]) > 0, nil
+	case LowestRootTieBreaker:
+		return bytes.Compare(child.root[:], bestChild.root[:]) < 0, nil
+	case FirstSeenTieBreaker:
+		// Nodes are appended to the store as their blocks are inserted.
+		return childIndex < bestChildIndex, nil
+	default:
+		return false, errors.Wrapf(errUnknownTieBreaker, "%q", t)
+	}
+}
@@ -0,0 +1,88 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestTieBreaker_Prefers(t *testing.T) {
+	low := &Node{root: [32]byte{'a'}}
+	high := &Node{root: [32]byte{'b'}}
+	tests := []struct {
+		name       string
+		tieBreaker TieBreaker
+		child      *Node
+		childIndex uint64
+		best       *Node
+		bestIndex  uint64
+		want       bool
+	}{
+		{name: "default, higher root", child: high, childIndex: 1, best: low, bestIndex: 2, want: true},
+		{name: "default, lower root", child: low, childIndex: 1, best: high, bestIndex: 2, want: false},
+		{name: "highest root, higher root", tieBreaker: HighestRootTieBreaker, child: high, childIndex: 2, best: low, bestIndex: 1, want: true},
+		{name: "highest root, same root", tieBreaker: HighestRootTieBreaker, child: low, childIndex: 2, best: low, bestIndex: 1, want: false},
+		{name: "lowest root, lower root", tieBreaker: LowestRootTieBreaker, child: low, childIndex: 2, best: high, bestIndex: 1, want: true},
+		{name: "lowest root, higher root", tieBreaker: LowestRootTieBreaker, child: high, childIndex: 1, best: low, bestIndex: 2, want: false},
+		{name: "first seen, earlier child", tieBreaker: FirstSeenTieBreaker, child: low, childIndex: 1, best: high, bestIndex: 2, want: true},
+		{name: "first seen, later child", tieBreaker: FirstSeenTieBreaker, child: high, childIndex: 2, best: low, bestIndex: 1, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.tieBreaker.prefers(tt.child, tt.childIndex, tt.best, tt.bestIndex)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTieBreaker_UnknownRule(t *testing.T) {
+	_, err := TieBreaker("random").prefers(&Node{}, 1, &Node{}, 2)
+	assert.ErrorContains(t, errUnknownTieBreaker.Error(), err)
+}
+
+func TestTieBreaker_Head(t *testing.T) {
+	tests := []struct {
+		tieBreaker TieBreaker
+		want       [32]byte
+	}{
+		{tieBreaker: "", want: [32]byte{'c'}},
+		{tieBreaker: HighestRootTieBreaker, want: [32]byte{'c'}},
+		{tieBreaker: LowestRootTieBreaker, want: [32]byte{'a'}},
+		{tieBreaker: FirstSeenTieBreaker, want: [32]byte{'b'}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.tieBreaker), func(t *testing.T) {
+			resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{ForkChoiceTieBreaker: string(tt.tieBreaker)})
+			defer resetCfg()
+			ctx := context.Background()
+			f := setup(1, 1)
+			balances := make([]uint64, 4)
+
+			// Insert three competing children of equal weight in an order that matches none of
+			// the root orderings, then verify the head is picked by the configured rule:
+			//          0
+			//        / | \
+			//       b  a  c
+			for _, r := range [][32]byte{{'b'}, {'a'}, {'c'}} {
+				require.NoError(t, f.ProcessBlock(ctx, 1, r, params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+			}
+			r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r, "Incorrect head for equal weights")
+
+			// A heavier branch always wins regardless of the rule.
+			f.ProcessAttestation(ctx, []uint64{0}, [32]byte{'b'}, 2)
+			f.ProcessAttestation(ctx, []uint64{1}, [32]byte{'a'}, 2)
+			f.ProcessAttestation(ctx, []uint64{2, 3}, [32]byte{'c'}, 2)
+			balances = []uint64{1, 1, 1, 1}
+			r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+			require.NoError(t, err)
+			assert.Equal(t, [32]byte{'c'}, r, "Incorrect head for heavier branch")
+		})
+	}
+}
@@ -2,6 +2,7 @@ package protoarray
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -295,3 +296,31 @@ func TestVotes_CanFindHead(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, indexToHash(11), r, "Incorrect head for with justified epoch at 2")
 }
+
+func TestVotes_ConcurrentAttestationsAndHead(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{1, 1, 1, 1}
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+
+	var wg sync.WaitGroup
+	for i := uint64(0); i < uint64(len(balances)); i++ {
+		wg.Add(2)
+		go func(idx uint64) {
+			defer wg.Done()
+			f.ProcessAttestation(ctx, []uint64{idx}, indexToHash(1), 2)
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// Every validator voted for block 1, so it should be the head.
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after concurrent votes")
+}
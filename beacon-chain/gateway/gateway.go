@@ -13,6 +13,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 )
@@ -43,7 +44,11 @@ func (g *Gateway) Start() {
 
 	log.WithField("address", g.gatewayAddr).Info("Starting JSON-HTTP API")
 
-	conn, err := g.dial(ctx, "tcp", g.remoteAddr)
+	network, addr := "tcp", g.remoteAddr
+	if path, ok := grpcutils.UnixSocketPath(g.remoteAddr); ok {
+		network, addr = "unix", path
+	}
+	conn, err := g.dial(ctx, network, addr)
 	if err != nil {
 		log.WithError(err).Error("Failed to connect to gRPC server")
 		g.startFailure = err
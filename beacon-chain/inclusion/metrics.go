@@ -0,0 +1,12 @@
+package inclusion
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var inclusionDistance = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "attestation_inclusion_distance_slots",
+	Help:    "The number of slots between the attestations of the validators and their first inclusion in a head block.",
+	Buckets: []float64{1, 2, 3, 4, 6, 8, 16, 32},
+})
@@ -0,0 +1,222 @@
+// Package inclusion defines a beacon node service tracking the inclusion of attestations in
+// head blocks. It marks the included attestations in the attestation pool, so proposers don't
+// include them again, and records the inclusion distance of the latest attestation of each
+// validator.
+package inclusion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "inclusion")
+
+// Config options for the attestation inclusion tracker.
+type Config struct {
+	BeaconDB      db.ReadOnlyDatabase
+	HeadFetcher   blockchain.HeadFetcher
+	StateNotifier statefeed.Notifier
+	AttPool       attestations.Pool
+}
+
+// Inclusion of the latest attestation of a validator in a head block.
+type Inclusion struct {
+	TargetEpoch     uint64
+	AttestationSlot uint64
+	InclusionSlot   uint64
+}
+
+// Distance is the number of slots between the attestation and the block it was included in.
+func (i *Inclusion) Distance() uint64 {
+	return i.InclusionSlot - i.AttestationSlot
+}
+
+// Service tracks the attestations included in head blocks.
+type Service struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	beaconDB      db.ReadOnlyDatabase
+	headFetcher   blockchain.HeadFetcher
+	stateNotifier statefeed.Notifier
+	attPool       attestations.Pool
+	lock          sync.RWMutex
+	inclusions    map[uint64]*Inclusion // validator index -> inclusion of its latest attestation.
+}
+
+// NewService creates an attestation inclusion tracker.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:           ctx,
+		cancel:        cancel,
+		beaconDB:      cfg.BeaconDB,
+		headFetcher:   cfg.HeadFetcher,
+		stateNotifier: cfg.StateNotifier,
+		attPool:       cfg.AttPool,
+		inclusions:    make(map[uint64]*Inclusion),
+	}
+}
+
+// Start the attestation inclusion tracker, listening for processed blocks.
+func (s *Service) Start() {
+	go s.receiveBlocks(s.ctx)
+}
+
+// Stop the attestation inclusion tracker.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the attestation inclusion tracker.
+func (s *Service) Status() error {
+	return nil
+}
+
+// ValidatorInclusions returns the inclusions of the latest attestations of the validators,
+// leaving out the validators without an included attestation since the node started.
+func (s *Service) ValidatorInclusions(indices []uint64) map[uint64]*Inclusion {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	inclusions := make(map[uint64]*Inclusion, len(indices))
+	for _, idx := range indices {
+		if inclusion, ok := s.inclusions[idx]; ok {
+			copied := *inclusion
+			inclusions[idx] = &copied
+		}
+	}
+	return inclusions
+}
+
+func (s *Service) receiveBlocks(ctx context.Context) {
+	stateChannel := make(chan *feed.Event, 1)
+	sub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				continue
+			}
+			if err := s.processBlockRoot(ctx, data.BlockRoot); err != nil {
+				log.WithError(err).Error("Could not process block attestations")
+			}
+		case <-sub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// processBlockRoot marks the attestations of every processed block as included in the pool, as
+// the block may become the head later on, such as after a reorg. The inclusions of the attesters
+// are only recorded for the head block, the attestations of the blocks which didn't become the
+// head are not included in the chain.
+func (s *Service) processBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	signed, err := s.beaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve block")
+	}
+	if signed == nil || signed.Block == nil {
+		// Blocks processed during initial sync are only saved to the DB in batches.
+		log.WithField("blockRoot", fmt.Sprintf("%#x", blockRoot)).Debug("Processed block not found in the DB")
+		return nil
+	}
+	if err := s.markBlockIncluded(signed.Block); err != nil {
+		return err
+	}
+	headRoot, err := s.headFetcher.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head root")
+	}
+	if blockRoot != bytesutil.ToBytes32(headRoot) {
+		return nil
+	}
+	// The head state is only read to find the committees of the attestations, and a new head
+	// replaces the head state rather than mutating it, so it is not copied. The head may have
+	// moved on since its root was compared, the committees of the attestations are the same in the
+	// state of a later head on the same chain.
+	headState, err := s.headFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state")
+	}
+	s.recordBlockInclusions(signed.Block, headState)
+	return nil
+}
+
+// markBlockIncluded marks the attestations of the block as included in the pool.
+func (s *Service) markBlockIncluded(blk *ethpb.BeaconBlock) error {
+	for _, att := range blk.Body.Attestations {
+		if err := s.markIncluded(att); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordBlockInclusions records the inclusion of the attestations of the head block for their attesters.
+func (s *Service) recordBlockInclusions(blk *ethpb.BeaconBlock, headState *stateTrie.BeaconState) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, att := range blk.Body.Attestations {
+		if att.Data == nil || att.Data.Target == nil {
+			continue
+		}
+		committee, err := helpers.BeaconCommitteeFromState(headState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve attestation committee")
+			continue
+		}
+		for _, idx := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+			s.recordInclusion(idx, &Inclusion{
+				TargetEpoch:     att.Data.Target.Epoch,
+				AttestationSlot: att.Data.Slot,
+				InclusionSlot:   blk.Slot,
+			})
+		}
+	}
+}
+
+// markIncluded deletes the attestation from the pool, marking its bits as seen so that it is
+// not saved in the pool again.
+func (s *Service) markIncluded(att *ethpb.Attestation) error {
+	if helpers.IsAggregated(att) {
+		return s.attPool.DeleteAggregatedAttestation(att)
+	}
+	// Ideally there shouldn't be any unaggregated attestation in the block.
+	return s.attPool.DeleteUnaggregatedAttestation(att)
+}
+
+// recordInclusion keeps the inclusion for the validator if it's for a later target epoch than
+// the recorded one, or for the same target epoch in an earlier block. The caller must hold the lock.
+func (s *Service) recordInclusion(idx uint64, inclusion *Inclusion) {
+	recorded, ok := s.inclusions[idx]
+	if ok && (recorded.TargetEpoch > inclusion.TargetEpoch ||
+		(recorded.TargetEpoch == inclusion.TargetEpoch && recorded.InclusionSlot <= inclusion.InclusionSlot)) {
+		return
+	}
+	if !ok || recorded.TargetEpoch < inclusion.TargetEpoch {
+		inclusionDistance.Observe(float64(inclusion.Distance()))
+	}
+	s.inclusions[idx] = inclusion
+}
@@ -0,0 +1,98 @@
+package inclusion
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ProcessBlockRoot_HeadBlock(t *testing.T) {
+	ctx := context.Background()
+	db, _ := dbTest.SetupDB(t)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 256)
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 1, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(1, true)
+	att := &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            &ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{}},
+	}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestation(att))
+	chain := &mock.ChainService{State: beaconState}
+	s := NewService(ctx, &Config{
+		BeaconDB:    db,
+		HeadFetcher: chain,
+		AttPool:     pool,
+	})
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 3
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	r, err := stateutil.BlockRoot(blk.Block)
+	require.NoError(t, err)
+	chain.Root = r[:]
+	require.NoError(t, s.processBlockRoot(ctx, r))
+	assert.Equal(t, 0, pool.AggregatedAttestationCount(), "Included attestation should be deleted from the pool")
+	require.NoError(t, pool.SaveAggregatedAttestation(att))
+	assert.Equal(t, 0, pool.AggregatedAttestationCount(), "Included attestation should not be saved again")
+
+	want := &Inclusion{TargetEpoch: 0, AttestationSlot: 1, InclusionSlot: 3}
+	inclusions := s.ValidatorInclusions([]uint64{committee[0], committee[1], committee[2]})
+	assert.Equal(t, 2, len(inclusions))
+	assert.DeepEqual(t, want, inclusions[committee[0]])
+	assert.DeepEqual(t, want, inclusions[committee[1]])
+	assert.Equal(t, uint64(2), inclusions[committee[0]].Distance())
+
+	// The first inclusion of an attestation is kept.
+	blk = testutil.NewBeaconBlock()
+	blk.Block.Slot = 4
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	r, err = stateutil.BlockRoot(blk.Block)
+	require.NoError(t, err)
+	chain.Root = r[:]
+	require.NoError(t, s.processBlockRoot(ctx, r))
+	assert.DeepEqual(t, want, s.ValidatorInclusions([]uint64{committee[0]})[committee[0]])
+}
+
+func TestService_ProcessBlockRoot_NonHeadBlock(t *testing.T) {
+	ctx := context.Background()
+	db, _ := dbTest.SetupDB(t)
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1011},
+		Data:            &ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{}},
+	}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestation(att))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 3
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att}
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	r, err := stateutil.BlockRoot(blk.Block)
+	require.NoError(t, err)
+	s := NewService(ctx, &Config{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{Root: []byte{'a'}},
+		AttPool:     pool,
+	})
+
+	// The attestations of a block which isn't the head are marked included, as the block may become the head
+	// after a reorg, but their inclusions aren't recorded. The head state isn't needed for that.
+	require.NoError(t, s.processBlockRoot(ctx, r))
+	assert.Equal(t, 0, pool.AggregatedAttestationCount(), "Included attestation should be deleted from the pool")
+	assert.Equal(t, 0, len(s.inclusions))
+}
@@ -34,6 +34,7 @@ type Service struct {
 	cancel             context.CancelFunc
 	genesisTime        uint64
 	numValidators      uint64
+	mnemonic           string
 	beaconDB           db.HeadAccessDatabase
 	depositCache       *depositcache.DepositCache
 	genesisPath        string
@@ -44,9 +45,12 @@ type Service struct {
 type Config struct {
 	GenesisTime   uint64
 	NumValidators uint64
-	BeaconDB      db.HeadAccessDatabase
-	DepositCache  *depositcache.DepositCache
-	GenesisPath   string
+	// Mnemonic the keys of the validators are derived from, the deterministic interop
+	// keys are used if it is empty.
+	Mnemonic     string
+	BeaconDB     db.HeadAccessDatabase
+	DepositCache *depositcache.DepositCache
+	GenesisPath  string
 }
 
 // NewColdStartService is an interoperability testing service to inject a deterministically generated genesis state
@@ -61,6 +65,7 @@ func NewColdStartService(ctx context.Context, cfg *Config) *Service {
 		cancel:        cancel,
 		genesisTime:   cfg.GenesisTime,
 		numValidators: cfg.NumValidators,
+		mnemonic:      cfg.Mnemonic,
 		beaconDB:      cfg.BeaconDB,
 		depositCache:  cfg.DepositCache,
 		genesisPath:   cfg.GenesisPath,
@@ -86,7 +91,13 @@ func NewColdStartService(ctx context.Context, cfg *Config) *Service {
 	}
 
 	// Save genesis state in db
-	genesisState, _, err := interop.GenerateGenesisState(s.genesisTime, s.numValidators)
+	var genesisState *pb.BeaconState
+	var err error
+	if s.mnemonic != "" {
+		genesisState, _, err = interop.GenerateGenesisStateFromMnemonic(s.genesisTime, s.mnemonic, s.numValidators)
+	} else {
+		genesisState, _, err = interop.GenerateGenesisState(s.genesisTime, s.numValidators)
+	}
 	if err != nil {
 		log.Fatalf("Could not generate interop genesis state: %v", err)
 	}
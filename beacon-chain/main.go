@@ -20,7 +20,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
-	"github.com/urfave/cli/v2/altsrc"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 )
 
@@ -29,6 +28,7 @@ var appFlags = []cli.Flag{
 	flags.HTTPWeb3ProviderFlag,
 	flags.RPCHost,
 	flags.RPCPort,
+	flags.RPCUnixSocket,
 	flags.CertFlag,
 	flags.KeyFlag,
 	flags.DisableGRPCGateway,
@@ -41,15 +41,42 @@ var appFlags = []cli.Flag{
 	flags.SlasherCertFlag,
 	flags.SlasherProviderFlag,
 	flags.DisableDiscv5,
+	flags.InvalidAttestationsThresholdFlag,
+	flags.DuplicateAttestationsThresholdFlag,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
+	flags.InteropMnemonicFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.ArchiveFlag,
+	flags.DisableAttestationPoolPersistenceFlag,
+	flags.AttestationPoolSizeFlag,
+	flags.AttestationPoolShardsFlag,
+	flags.AttestationAggregationModeFlag,
+	flags.AttestationRetentionSlotsFlag,
+	flags.AttestationAggregationIntervalFlag,
+	flags.AttestationAggregationBatchSizeFlag,
+	flags.AttestationAggregationDryRunStrategyFlag,
+	flags.AttestationQueueSizeFlag,
+	flags.AttestationQueuePriorityFlag,
 	flags.EnableDebugRPCEndpoints,
 	flags.HistoricalSlasherNode,
+	flags.SlasherFlag,
+	flags.MonitorIndicesFlag,
+	flags.FreeDiskWarningThresholdFlag,
+	flags.AlertWebhookURLFlag,
+	flags.AlertFinalityEpochsFlag,
+	flags.AlertMinPeersFlag,
+	flags.TelemetryEndpointFlag,
+	flags.TelemetrySecretFlag,
+	flags.TelemetryIntervalFlag,
+	flags.EnableClockDriftCheckFlag,
+	flags.NTPServersFlag,
+	flags.ClockDriftThresholdFlag,
+	flags.WeakSubjectivityCheckpt,
 	flags.ChainID,
 	flags.NetworkID,
 	cmd.MinimalConfigFlag,
@@ -71,12 +98,16 @@ var appFlags = []cli.Flag{
 	cmd.P2PDenyList,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
+	cmd.LogModuleVerbosityFlag,
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
+	cmd.TracingExporterFlag,
 	cmd.TraceSampleFractionFlag,
 	cmd.MonitoringHostFlag,
 	flags.MonitoringPortFlag,
+	cmd.MonitoringDebugEndpointsFlag,
+	cmd.MonitoringDebugAuthTokenFlag,
 	cmd.DisableMonitoringFlag,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
@@ -89,6 +120,10 @@ var appFlags = []cli.Flag{
 	debug.CPUProfileFlag,
 	debug.TraceFlag,
 	cmd.LogFileName,
+	cmd.LogFileMaxSizeFlag,
+	cmd.LogFileRotationIntervalFlag,
+	cmd.LogFileMaxBackupsFlag,
+	cmd.LogFileCompressFlag,
 	cmd.EnableUPnPFlag,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
@@ -96,7 +131,11 @@ var appFlags = []cli.Flag{
 }
 
 func init() {
-	appFlags = cmd.WrapFlags(append(appFlags, featureconfig.BeaconChainFlags...))
+	envFlags, err := cmd.WithEnvVars("PRYSM_BEACON", append(appFlags, featureconfig.BeaconChainFlags...))
+	if err != nil {
+		logrus.WithError(err).Fatal("Could not set the environment variables of the flags")
+	}
+	appFlags = cmd.WrapFlags(envFlags)
 }
 
 func main() {
@@ -108,13 +147,12 @@ func main() {
 	app.Version = version.GetVersion()
 
 	app.Flags = appFlags
+	app.Commands = []*cli.Command{dbCommands, cmd.DumpConfigCommand(appFlags)}
 
 	app.Before = func(ctx *cli.Context) error {
 		// Load any flags from file, if specified.
-		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
-			if err := altsrc.InitInputSourceWithContext(appFlags, altsrc.NewYamlSourceFromFlagFunc(cmd.ConfigFileFlag.Name))(ctx); err != nil {
-				return err
-			}
+		if err := cmd.LoadFlagsFromConfig(ctx, appFlags); err != nil {
+			return err
 		}
 
 		format := ctx.String(cmd.LogFormat.Name)
@@ -141,7 +179,7 @@ func main() {
 
 		logFileName := ctx.String(cmd.LogFileName.Name)
 		if logFileName != "" {
-			if err := logutil.ConfigurePersistentLogging(logFileName); err != nil {
+			if err := logutil.ConfigurePersistentLogging(logFileName, cmd.LogRotationConfig(ctx)); err != nil {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
@@ -173,6 +211,11 @@ func startNode(ctx *cli.Context) error {
 		return err
 	}
 	logrus.SetLevel(level)
+	moduleLevels, err := logutil.ParseModuleLevels(ctx.StringSlice(cmd.LogModuleVerbosityFlag.Name))
+	if err != nil {
+		return err
+	}
+	logutil.ConfigureModuleLevels(level, moduleLevels)
 	if level == logrus.TraceLevel {
 		// libp2p specific logging.
 		golog.SetAllLoggers(golog.LevelDebug)
@@ -0,0 +1,31 @@
+package monitor
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	attestationsSeen = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_attestations_seen_total",
+		Help: "The number of target epochs for which an attestation of the tracked validator was seen on gossip",
+	}, []string{"validator_index"})
+	attestationsIncluded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_attestations_included_total",
+		Help: "The number of target epochs for which an attestation of the tracked validator was included in a block",
+	}, []string{"validator_index"})
+	attestationsMissed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "monitor_attestations_missed_total",
+		Help: "The number of target epochs for which no attestation of the tracked validator was included in a block",
+	}, []string{"validator_index"})
+	attestationInclusionDelay = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "monitor_attestation_inclusion_delay",
+		Help: "The inclusion delay in slots of the latest included attestation of the tracked validator",
+	}, []string{"validator_index"})
+)
+
+func indexLabel(idx uint64) string {
+	return strconv.FormatUint(idx, 10)
+}
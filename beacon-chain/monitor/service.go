@@ -0,0 +1,335 @@
+// Package monitor defines a beacon node service tracking the attestations of a configured
+// list of validators. It reports when their attestations are seen on gossip, included in
+// blocks or missing, so operators don't have to rely on their validator client for it.
+package monitor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "monitor")
+
+// Config options for the validator monitor service.
+type Config struct {
+	// TrackedIndices are the indices of the validators to monitor.
+	TrackedIndices      []uint64
+	BeaconDB            db.ReadOnlyDatabase
+	HeadFetcher         blockchain.HeadFetcher
+	StateNotifier       statefeed.Notifier
+	AttestationNotifier operation.Notifier
+}
+
+// Service monitors the attestations of the tracked validators.
+type Service struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	beaconDB            db.ReadOnlyDatabase
+	headFetcher         blockchain.HeadFetcher
+	stateNotifier       statefeed.Notifier
+	attestationNotifier operation.Notifier
+	tracked             map[uint64]bool
+	lock                sync.Mutex
+	// seen and included keep the tracked validators which attested for each target epoch
+	// whose attestations can still be included in a block.
+	seen             map[uint64]map[uint64]bool
+	included         map[uint64]map[uint64]bool
+	checkedEpochs    bool
+	lastCheckedEpoch uint64
+	// committees keep the committees of the tracked validators for each epoch, so gossip
+	// attestations of the other committees are skipped without reading the head state.
+	committees map[uint64]map[committeeKey][]uint64
+}
+
+// committeeKey identifies the committee of an attestation.
+type committeeKey struct {
+	slot           uint64
+	committeeIndex uint64
+}
+
+// NewService creates a validator monitor service for the tracked indices.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	tracked := make(map[uint64]bool, len(cfg.TrackedIndices))
+	for _, idx := range cfg.TrackedIndices {
+		tracked[idx] = true
+	}
+	return &Service{
+		ctx:                 ctx,
+		cancel:              cancel,
+		beaconDB:            cfg.BeaconDB,
+		headFetcher:         cfg.HeadFetcher,
+		stateNotifier:       cfg.StateNotifier,
+		attestationNotifier: cfg.AttestationNotifier,
+		tracked:             tracked,
+		seen:                make(map[uint64]map[uint64]bool),
+		included:            make(map[uint64]map[uint64]bool),
+		committees:          make(map[uint64]map[committeeKey][]uint64),
+	}
+}
+
+// Start the validator monitor, listening for gossip attestations and processed blocks.
+func (s *Service) Start() {
+	log.WithField("validatorIndices", len(s.tracked)).Info("Starting validator monitor")
+	go s.receiveBlocks(s.ctx)
+	go s.receiveAttestations(s.ctx)
+}
+
+// Stop the validator monitor.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the validator monitor.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) receiveBlocks(ctx context.Context) {
+	stateChannel := make(chan *feed.Event, 1)
+	sub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				continue
+			}
+			signed, err := s.beaconDB.Block(ctx, data.BlockRoot)
+			if err != nil {
+				log.WithError(err).Error("Could not retrieve processed block")
+				continue
+			}
+			if signed == nil || signed.Block == nil {
+				continue
+			}
+			if err := s.processBlock(ctx, signed.Block); err != nil {
+				log.WithError(err).Error("Could not process block attestations")
+			}
+		case <-sub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) receiveAttestations(ctx context.Context) {
+	attsChannel := make(chan *feed.Event, 1)
+	sub := s.attestationNotifier.OperationFeed().Subscribe(attsChannel)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case event := <-attsChannel:
+			var att *ethpb.Attestation
+			switch data := event.Data.(type) {
+			case *operation.UnAggregatedAttReceivedData:
+				att = data.Attestation
+			case *operation.AggregatedAttReceivedData:
+				if data.Attestation != nil {
+					att = data.Attestation.Aggregate
+				}
+			}
+			if att == nil || att.Data == nil {
+				continue
+			}
+			if err := s.processGossipAttestation(ctx, att); err != nil {
+				log.WithError(err).Debug("Could not process gossip attestation")
+			}
+		case <-sub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// processGossipAttestation reports the tracked validators attesting in the attestation
+// the first time one of their attestations for the target epoch is seen on gossip.
+func (s *Service) processGossipAttestation(ctx context.Context, att *ethpb.Attestation) error {
+	if att == nil || att.Data == nil || att.Data.Target == nil {
+		return errors.New("nil attestation")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	epoch := att.Data.Target.Epoch
+	if s.checkedEpochs && epoch <= s.lastCheckedEpoch {
+		return nil
+	}
+	committee, err := s.trackedCommittee(ctx, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return err
+	}
+	for _, idx := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+		if !s.tracked[idx] || markEpoch(s.seen, epoch, idx) {
+			continue
+		}
+		attestationsSeen.WithLabelValues(indexLabel(idx)).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": idx,
+			"slot":           att.Data.Slot,
+			"targetEpoch":    epoch,
+		}).Info("Attestation of tracked validator seen on gossip")
+	}
+	return nil
+}
+
+// processBlock reports the attestations of the tracked validators included in the block, and
+// the tracked validators which missed the epochs whose attestations can no longer be included.
+func (s *Service) processBlock(ctx context.Context, blk *ethpb.BeaconBlock) error {
+	headState, err := s.headFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, att := range blk.Body.Attestations {
+		indices, err := s.trackedAttesters(headState, att)
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve tracked attesting indices")
+			continue
+		}
+		epoch := att.Data.Target.Epoch
+		for _, idx := range indices {
+			if markEpoch(s.included, epoch, idx) {
+				continue
+			}
+			delay := blk.Slot - att.Data.Slot
+			attestationsIncluded.WithLabelValues(indexLabel(idx)).Inc()
+			attestationInclusionDelay.WithLabelValues(indexLabel(idx)).Set(float64(delay))
+			log.WithFields(logrus.Fields{
+				"validatorIndex": idx,
+				"slot":           att.Data.Slot,
+				"targetEpoch":    epoch,
+				"inclusionSlot":  blk.Slot,
+				"inclusionDelay": delay,
+			}).Info("Attestation of tracked validator included in block")
+		}
+	}
+	s.checkMissedEpochs(helpers.SlotToEpoch(blk.Slot))
+	return nil
+}
+
+// checkMissedEpochs reports the tracked validators without an included attestation for the
+// epochs which can no longer be included at the current epoch, which are the ones before
+// the previous epoch.
+func (s *Service) checkMissedEpochs(currentEpoch uint64) {
+	if currentEpoch < 2 {
+		return
+	}
+	lastIncludable := currentEpoch - 2
+	if !s.checkedEpochs {
+		// Don't report the epochs before the service started.
+		s.checkedEpochs = true
+		s.lastCheckedEpoch = lastIncludable
+		return
+	}
+	for epoch := s.lastCheckedEpoch + 1; epoch <= lastIncludable; epoch++ {
+		for idx := range s.tracked {
+			if s.included[epoch][idx] {
+				continue
+			}
+			attestationsMissed.WithLabelValues(indexLabel(idx)).Inc()
+			log.WithFields(logrus.Fields{
+				"validatorIndex": idx,
+				"targetEpoch":    epoch,
+				"seenOnGossip":   s.seen[epoch][idx],
+			}).Warn("Attestation of tracked validator missing")
+		}
+	}
+	if lastIncludable > s.lastCheckedEpoch {
+		s.lastCheckedEpoch = lastIncludable
+	}
+	for epoch := range s.included {
+		if epoch <= s.lastCheckedEpoch {
+			delete(s.included, epoch)
+		}
+	}
+	for epoch := range s.seen {
+		if epoch <= s.lastCheckedEpoch {
+			delete(s.seen, epoch)
+		}
+	}
+	for epoch := range s.committees {
+		if epoch <= s.lastCheckedEpoch {
+			delete(s.committees, epoch)
+		}
+	}
+}
+
+// trackedCommittee returns the committee of the attestation slot and committee index if a
+// tracked validator belongs to it, nil otherwise. The committees of the tracked validators are
+// computed from the head state the first time an attestation of their epoch is received.
+func (s *Service) trackedCommittee(ctx context.Context, slot uint64, committeeIndex uint64) ([]uint64, error) {
+	epoch := helpers.SlotToEpoch(slot)
+	committees, ok := s.committees[epoch]
+	if !ok {
+		headState, err := s.headFetcher.HeadStateReadOnly(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve head state")
+		}
+		assignments, _, err := helpers.CommitteeAssignments(headState, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute committee assignments of epoch %d", epoch)
+		}
+		committees = make(map[committeeKey][]uint64)
+		for idx := range s.tracked {
+			if a, ok := assignments[idx]; ok {
+				committees[committeeKey{slot: a.AttesterSlot, committeeIndex: a.CommitteeIndex}] = a.Committee
+			}
+		}
+		s.committees[epoch] = committees
+	}
+	return committees[committeeKey{slot: slot, committeeIndex: committeeIndex}], nil
+}
+
+// trackedAttesters returns the indices of the tracked validators attesting in the attestation.
+func (s *Service) trackedAttesters(headState *stateTrie.BeaconState, att *ethpb.Attestation) ([]uint64, error) {
+	if att == nil || att.Data == nil || att.Data.Target == nil {
+		return nil, errors.New("nil attestation")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(headState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attestation committee")
+	}
+	var indices []uint64
+	for _, idx := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+		if s.tracked[idx] {
+			indices = append(indices, idx)
+		}
+	}
+	return indices, nil
+}
+
+// markEpoch marks the validator for the epoch, returning whether it was already marked.
+func markEpoch(epochs map[uint64]map[uint64]bool, epoch uint64, idx uint64) bool {
+	if epochs[epoch] == nil {
+		epochs[epoch] = make(map[uint64]bool)
+	}
+	if epochs[epoch][idx] {
+		return true
+	}
+	epochs[epoch][idx] = true
+	return false
+}
@@ -0,0 +1,98 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// committeeAttestation returns an attestation of the whole committee at the slot, and the committee.
+func committeeAttestation(t *testing.T, beaconState *stateTrie.BeaconState, slot uint64) (*ethpb.Attestation, []uint64) {
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, slot, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:   slot,
+			Target: &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(slot)},
+		},
+	}, committee
+}
+
+func TestService_ProcessGossipAttestation(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	att, committee := committeeAttestation(t, beaconState, 1)
+	s := NewService(context.Background(), &Config{
+		TrackedIndices: []uint64{committee[0]},
+		HeadFetcher:    &mock.ChainService{State: beaconState},
+	})
+
+	require.NoError(t, s.processGossipAttestation(context.Background(), att))
+	assert.Equal(t, true, s.seen[0][committee[0]])
+	assert.Equal(t, 1, len(s.seen[0]), "Only tracked validators should be marked")
+	require.LogsContain(t, hook, "Attestation of tracked validator seen on gossip")
+
+	hook.Reset()
+	require.NoError(t, s.processGossipAttestation(context.Background(), att))
+	require.LogsDoNotContain(t, hook, "Attestation of tracked validator seen on gossip")
+}
+
+func TestService_ProcessGossipAttestation_UntrackedCommittee(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	_, tracked := committeeAttestation(t, beaconState, 1)
+	att, _ := committeeAttestation(t, beaconState, 2)
+	s := NewService(context.Background(), &Config{
+		TrackedIndices: []uint64{tracked[0]},
+		HeadFetcher:    &mock.ChainService{State: beaconState},
+	})
+
+	require.NoError(t, s.processGossipAttestation(context.Background(), att))
+	assert.Equal(t, 0, len(s.seen[0]), "Attestations of untracked committees should be skipped")
+	assert.Equal(t, 1, len(s.committees[0]), "Only the committee of the tracked validator should be kept")
+	require.LogsDoNotContain(t, hook, "Attestation of tracked validator seen on gossip")
+}
+
+func TestService_ProcessBlock_ReportsInclusionAndMisses(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	att, committee := committeeAttestation(t, beaconState, slotsPerEpoch+1)
+	other, err := helpers.BeaconCommitteeFromState(beaconState, slotsPerEpoch+2, 0)
+	require.NoError(t, err)
+	s := NewService(context.Background(), &Config{
+		TrackedIndices: []uint64{committee[0], other[0]},
+		HeadFetcher:    &mock.ChainService{State: beaconState},
+	})
+	// Start checking the missed epochs after epoch 0.
+	s.checkedEpochs = true
+
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = slotsPerEpoch + 2
+	blk.Body.Attestations = []*ethpb.Attestation{att}
+	require.NoError(t, s.processBlock(context.Background(), blk))
+	assert.Equal(t, true, s.included[1][committee[0]])
+	require.LogsContain(t, hook, "Attestation of tracked validator included in block")
+	require.LogsDoNotContain(t, hook, "Attestation of tracked validator missing")
+
+	// Epoch 1 attestations can't be included anymore during epoch 3.
+	s.checkMissedEpochs(3)
+	require.LogsContain(t, hook, "Attestation of tracked validator missing")
+	require.LogsContain(t, hook, "validatorIndex="+indexLabel(other[0]))
+	assert.Equal(t, 0, len(s.included), "Checked epochs should be pruned")
+}
@@ -8,38 +8,55 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/alerts"
+	"github.com/prysmaticlabs/prysm/beacon-chain/archiver"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/inclusion"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	attkv "github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beaconv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/telemetry"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/clockdrift"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/diskmonitor"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
@@ -55,6 +72,15 @@ var log = logrus.WithField("prefix", "node")
 const beaconChainDBName = "beaconchaindata"
 const testSkipPowFlag = "test-skip-pow"
 
+// diskMonitorInterval is how often the disk usage of the data directory is sampled.
+const diskMonitorInterval = time.Minute
+
+// clockDriftCheckInterval is how often the local clock is checked against NTP servers.
+const clockDriftCheckInterval = 10 * time.Minute
+
+// diagnosticLogLines is the number of recent log lines included in diagnostic dumps.
+const diagnosticLogLines = 1000
+
 // BeaconNode defines a struct that handles the services running a random beacon chain
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
@@ -76,6 +102,7 @@ type BeaconNode struct {
 	opFeed            *event.Feed
 	forkChoiceStore   forkchoice.ForkChoicer
 	stateGen          *stategen.State
+	forkSchedule      *forks.Schedule
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -85,13 +112,16 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		"beacon-chain", // service name
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
+		cliCtx.String(cmd.TracingExporterFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
 		cliCtx.Bool(cmd.EnableTracingFlag.Name),
 	); err != nil {
 		return nil, err
 	}
 
-	featureconfig.ConfigureBeaconChain(cliCtx)
+	if err := featureconfig.ConfigureBeaconChain(cliCtx); err != nil {
+		return nil, err
+	}
 	cmd.ConfigureBeaconChain(cliCtx)
 	flags.ConfigureGlobalFlags(cliCtx)
 
@@ -122,6 +152,16 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		params.OverrideBeaconConfig(c)
 	}
 
+	if cliCtx.Bool(flags.ArchiveFlag.Name) {
+		if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
+			log.Warnf("Ignoring --%s, archival nodes save the state of every epoch boundary", flags.SlotsPerArchivedPoint.Name)
+		}
+		c := params.BeaconConfig()
+		c.SlotsPerArchivedPoint = params.BeaconConfig().SlotsPerEpoch
+		params.OverrideBeaconConfig(c)
+		log.Info("Running an archival node, the full history of the chain is kept. This requires additional storage")
+	}
+
 	// Setting chain network specific flags.
 	if cliCtx.IsSet(flags.DepositContractFlag.Name) {
 		c := params.BeaconNetworkConfig()
@@ -149,6 +189,19 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		params.OverrideBeaconNetworkConfig(c)
 	}
 
+	aggregationMode := attkv.AggregationMode(cliCtx.String(flags.AttestationAggregationModeFlag.Name))
+	if aggregationMode != attkv.LazyAggregation && aggregationMode != attkv.EagerAggregation {
+		return nil, fmt.Errorf("unknown attestation aggregation mode %q, expected %s or %s",
+			aggregationMode, attkv.LazyAggregation, attkv.EagerAggregation)
+	}
+
+	forkSchedule, err := forks.NewBeaconSchedule(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create fork schedule")
+	}
+	// The state transition upgrades the states at the epochs of the scheduled forks.
+	transition.UseForkSchedule(forkSchedule)
+
 	registry := shared.NewServiceRegistry()
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -158,14 +211,21 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		cancel:            cancel,
 		services:          registry,
 		stop:              make(chan struct{}),
-		stateFeed:         new(event.Feed),
-		blockFeed:         new(event.Feed),
-		opFeed:            new(event.Feed),
-		attestationPool:   attestations.NewPool(),
+		stateFeed:         &event.Feed{Name: "state"},
+		blockFeed:         &event.Feed{Name: "block"},
+		opFeed:            &event.Feed{Name: "operation"},
 		exitPool:          voluntaryexits.NewPool(),
 		slashingsPool:     slashings.NewPool(),
 		stateSummaryCache: cache.NewStateSummaryCache(),
+		forkSchedule:      forkSchedule,
 	}
+	beacon.attestationPool = attestations.NewPoolWithConfig(&attkv.Config{
+		MaxSize:           cliCtx.Int(flags.AttestationPoolSizeFlag.Name),
+		AggregationMode:   aggregationMode,
+		RetentionSlots:    cliCtx.Uint64(flags.AttestationRetentionSlotsFlag.Name),
+		OperationNotifier: beacon,
+		Shards:            cliCtx.Int(flags.AttestationPoolShardsFlag.Name),
+	})
 
 	if err := beacon.startDB(cliCtx); err != nil {
 		return nil, err
@@ -203,6 +263,50 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerInclusionService(); err != nil {
+		return nil, err
+	}
+
+	if cliCtx.Bool(flags.SlasherFlag.Name) {
+		if err := beacon.registerSlasherService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cliCtx.Bool(flags.ArchiveFlag.Name) {
+		if err := beacon.registerArchiverService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cliCtx.IsSet(flags.MonitorIndicesFlag.Name) {
+		if err := beacon.registerValidatorMonitorService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := beacon.registerDiskMonitorService(); err != nil {
+		return nil, err
+	}
+
+	if cliCtx.Bool(flags.EnableClockDriftCheckFlag.Name) {
+		if err := beacon.registerClockDriftService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cliCtx.IsSet(flags.AlertWebhookURLFlag.Name) {
+		if err := beacon.registerAlertsService(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cliCtx.IsSet(flags.TelemetryEndpointFlag.Name) {
+		if err := beacon.registerTelemetryService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -244,6 +348,7 @@ func (b *BeaconNode) Start() {
 	}).Info("Starting beacon node")
 
 	b.services.StartAll()
+	b.restoreOperationPools()
 
 	stop := b.stop
 	b.lock.Unlock()
@@ -275,14 +380,84 @@ func (b *BeaconNode) Close() {
 	defer b.lock.Unlock()
 
 	log.Info("Stopping beacon node")
-	b.cancel() // Cancel the beacon node struct's context.
+	// Services are stopped before the node context is canceled, so they can flush their
+	// state to the database on the way out.
 	b.services.StopAll()
+	if err := b.persistOperationPools(); err != nil {
+		log.Errorf("Failed to persist operation pools: %v", err)
+	}
+	b.cancel() // Cancel the beacon node struct's context.
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
 	close(b.stop)
 }
 
+// persistOperationPools saves the pending attestations, slashings and exits so that they are
+// not lost on restart.
+func (b *BeaconNode) persistOperationPools() error {
+	proposerSlashings, attesterSlashings := b.slashingsPool.Snapshot()
+	pools := &db.OperationPools{
+		ProposerSlashings: proposerSlashings,
+		AttesterSlashings: attesterSlashings,
+		VoluntaryExits:    b.exitPool.Snapshot(),
+	}
+	if !b.cliCtx.Bool(flags.DisableAttestationPoolPersistenceFlag.Name) {
+		atts, blockAtts, err := attestations.Snapshot(b.attestationPool)
+		if err != nil {
+			return err
+		}
+		pools.Attestations = atts
+		pools.BlockAttestations = blockAtts
+	}
+	return b.db.SaveOperationPools(b.ctx, pools)
+}
+
+// restoreOperationPools inserts the operations saved on the last shutdown back into the pools.
+// Slashings and exits are verified against the head state, they are dropped if there is none yet.
+func (b *BeaconNode) restoreOperationPools() {
+	pools, err := b.db.OperationPools(b.ctx)
+	if err != nil {
+		log.Errorf("Failed to retrieve operation pools: %v", err)
+		return
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		log.Errorf("Failed to fetch blockchain service: %v", err)
+		return
+	}
+	restoredAtts := 0
+	if !b.cliCtx.Bool(flags.DisableAttestationPoolPersistenceFlag.Name) {
+		restoredAtts, err = attestations.Restore(b.attestationPool, pools.Attestations, pools.BlockAttestations, chainService.CurrentSlot())
+		if err != nil {
+			log.WithError(err).Debug("Could not restore attestations")
+		}
+	}
+	headState, err := chainService.HeadState(b.ctx)
+	if err != nil || headState == nil {
+		return
+	}
+	for _, slashing := range pools.ProposerSlashings {
+		if err := b.slashingsPool.InsertProposerSlashing(b.ctx, headState, slashing); err != nil {
+			log.WithError(err).Debug("Could not restore proposer slashing")
+		}
+	}
+	for _, slashing := range pools.AttesterSlashings {
+		if err := b.slashingsPool.InsertAttesterSlashing(b.ctx, headState, slashing); err != nil {
+			log.WithError(err).Debug("Could not restore attester slashing")
+		}
+	}
+	for _, exit := range pools.VoluntaryExits {
+		b.exitPool.InsertVoluntaryExit(b.ctx, headState, exit)
+	}
+	log.WithFields(logrus.Fields{
+		"attestations":      restoredAtts,
+		"proposerSlashings": len(pools.ProposerSlashings),
+		"attesterSlashings": len(pools.AttesterSlashings),
+		"voluntaryExits":    len(pools.VoluntaryExits),
+	}).Debug("Restored operation pools")
+}
+
 func (b *BeaconNode) startForkChoice() {
 	f := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
 	b.forkChoiceStore = f
@@ -329,6 +504,10 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		return err
 	}
 
+	if err := d.EnsureArchiveMode(b.ctx, cliCtx.Bool(flags.ArchiveFlag.Name)); err != nil {
+		return err
+	}
+
 	b.db = d
 
 	depositCache, err := depositcache.NewDepositCache()
@@ -385,24 +564,27 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(&p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootnodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           datadir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
+		NoDiscovery:                    cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:                    sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		BootstrapNodeAddr:              bootnodeAddrs,
+		RelayNodeAddr:                  cliCtx.String(cmd.RelayNode.Name),
+		DataDir:                        datadir,
+		LocalIP:                        cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:                    cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:                        cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:                     cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:                    cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:                        cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:                        cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:                       cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:                  cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:                   sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:                     cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:                  cliCtx.Bool(flags.DisableDiscv5.Name),
+		StateNotifier:                  b,
+		ForkSchedule:                   b.forkSchedule,
+		InvalidAttestationsThreshold:   cliCtx.Int(flags.InvalidAttestationsThresholdFlag.Name),
+		DuplicateAttestationsThreshold: cliCtx.Int(flags.DuplicateAttestationsThresholdFlag.Name),
 	})
 	if err != nil {
 		return err
@@ -420,7 +602,10 @@ func (b *BeaconNode) fetchP2P() p2p.P2P {
 
 func (b *BeaconNode) registerAttestationPool() error {
 	s, err := attestations.NewService(b.ctx, &attestations.Config{
-		Pool: b.attestationPool,
+		Pool:                      b.attestationPool,
+		AggregationInterval:       b.cliCtx.Duration(flags.AttestationAggregationIntervalFlag.Name),
+		AggregationBatchSize:      b.cliCtx.Int(flags.AttestationAggregationBatchSizeFlag.Name),
+		DryRunAggregationStrategy: b.cliCtx.String(flags.AttestationAggregationDryRunStrategyFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register atts pool service")
@@ -439,6 +624,15 @@ func (b *BeaconNode) registerBlockchainService() error {
 		return err
 	}
 
+	var wsCheckpt *ethpb.Checkpoint
+	if b.cliCtx.IsSet(flags.WeakSubjectivityCheckpt.Name) {
+		var err error
+		wsCheckpt, err = blockchain.ParseWeakSubjectivityCheckpoint(b.cliCtx.String(flags.WeakSubjectivityCheckpt.Name))
+		if err != nil {
+			return err
+		}
+	}
+
 	maxRoutines := b.cliCtx.Int(cmd.MaxGoroutines.Name)
 	blockchainService, err := blockchain.NewService(b.ctx, &blockchain.Config{
 		BeaconDB:          b.db,
@@ -453,6 +647,7 @@ func (b *BeaconNode) registerBlockchainService() error {
 		ForkChoiceStore:   b.forkChoiceStore,
 		OpsService:        opsService,
 		StateGen:          b.stateGen,
+		WsCheckpt:         wsCheckpt,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -479,7 +674,7 @@ func (b *BeaconNode) registerPOWChainService() error {
 	}
 
 	cfg := &powchain.Web3ServiceConfig{
-		HTTPEndPoint:    b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name),
+		HTTPEndpoints:   parseEndpoints(b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name)),
 		DepositContract: common.HexToAddress(depAddress),
 		BeaconDB:        b.db,
 		DepositCache:    b.depositCache,
@@ -504,6 +699,17 @@ func (b *BeaconNode) registerPOWChainService() error {
 	return b.services.RegisterService(web3Service)
 }
 
+// parseEndpoints splits a comma-separated list of eth1 endpoints, dropping empty entries.
+func parseEndpoints(list string) []string {
+	var endpoints []string
+	for _, endpoint := range strings.Split(list, ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
 func (b *BeaconNode) registerSyncService() error {
 	var web3Service *powchain.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
@@ -521,21 +727,173 @@ func (b *BeaconNode) registerSyncService() error {
 	}
 
 	rs := prysmsync.NewRegularSync(&prysmsync.Config{
-		DB:                  b.db,
-		P2P:                 b.fetchP2P(),
-		Chain:               chainService,
-		InitialSync:         initSync,
-		StateNotifier:       b,
+		DB:                       b.db,
+		P2P:                      b.fetchP2P(),
+		Chain:                    chainService,
+		InitialSync:              initSync,
+		StateNotifier:            b,
+		BlockNotifier:            b,
+		AttestationNotifier:      b,
+		AttPool:                  b.attestationPool,
+		ExitPool:                 b.exitPool,
+		SlashingPool:             b.slashingsPool,
+		StateSummaryCache:        b.stateSummaryCache,
+		StateGen:                 b.stateGen,
+		ForkSchedule:             b.forkSchedule,
+		AttestationQueueSize:     b.cliCtx.Int(flags.AttestationQueueSizeFlag.Name),
+		AttestationQueuePriority: b.cliCtx.Int(flags.AttestationQueuePriorityFlag.Name),
+	})
+
+	return b.services.RegisterService(rs)
+}
+
+func (b *BeaconNode) registerInclusionService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	svc := inclusion.NewService(b.ctx, &inclusion.Config{
+		BeaconDB:      b.db,
+		HeadFetcher:   chainService,
+		StateNotifier: b,
+		AttPool:       b.attestationPool,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerSlasherService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	svc, err := slasher.NewService(b.ctx, &slasher.Config{
+		BeaconDB:            b.db,
+		HeadFetcher:         chainService,
 		BlockNotifier:       b,
 		AttestationNotifier: b,
-		AttPool:             b.attestationPool,
-		ExitPool:            b.exitPool,
 		SlashingPool:        b.slashingsPool,
-		StateSummaryCache:   b.stateSummaryCache,
-		StateGen:            b.stateGen,
 	})
+	if err != nil {
+		return err
+	}
+	b.attestationPool.SetSlashingChecker(svc)
+	return b.services.RegisterService(svc)
+}
 
-	return b.services.RegisterService(rs)
+func (b *BeaconNode) registerValidatorMonitorService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	indices, err := b.monitorIndices()
+	if err != nil {
+		return err
+	}
+	svc := monitor.NewService(b.ctx, &monitor.Config{
+		TrackedIndices:      indices,
+		BeaconDB:            b.db,
+		HeadFetcher:         chainService,
+		StateNotifier:       b,
+		AttestationNotifier: b,
+	})
+	return b.services.RegisterService(svc)
+}
+
+// monitorIndices returns the indices of the validators tracked by the beacon node.
+func (b *BeaconNode) monitorIndices() ([]uint64, error) {
+	var indices []uint64
+	for _, idx := range b.cliCtx.IntSlice(flags.MonitorIndicesFlag.Name) {
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid validator index to monitor %d", idx)
+		}
+		indices = append(indices, uint64(idx))
+	}
+	return indices, nil
+}
+
+func (b *BeaconNode) registerArchiverService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	svc := archiver.NewService(b.ctx, &archiver.Config{
+		BeaconDB:            b.db,
+		FinalizationFetcher: chainService,
+		StateFetcher:        b.stateGen,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerAlertsService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var web3Service *powchain.Service
+	if err := b.services.FetchService(&web3Service); err != nil {
+		return err
+	}
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	indices, err := b.monitorIndices()
+	if err != nil {
+		return err
+	}
+	svc := alerts.NewService(b.ctx, &alerts.Config{
+		WebhookURL:       b.cliCtx.String(flags.AlertWebhookURLFlag.Name),
+		FinalityEpochs:   b.cliCtx.Uint64(flags.AlertFinalityEpochsFlag.Name),
+		MinPeers:         b.cliCtx.Int(flags.AlertMinPeersFlag.Name),
+		TrackedIndices:   indices,
+		ChainInfoFetcher: chainService,
+		TimeFetcher:      chainService,
+		POWChainInfo:     web3Service,
+		PeersProvider:    b.fetchP2P(),
+		SyncChecker:      initSync,
+		CheckInterval:    time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerTelemetryService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	svc := telemetry.NewService(b.ctx, &telemetry.Config{
+		Endpoint:      b.cliCtx.String(flags.TelemetryEndpointFlag.Name),
+		Secret:        []byte(b.cliCtx.String(flags.TelemetrySecretFlag.Name)),
+		HeadFetcher:   chainService,
+		TimeFetcher:   chainService,
+		PeersProvider: b.fetchP2P(),
+		PushInterval:  b.cliCtx.Duration(flags.TelemetryIntervalFlag.Name),
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerDiskMonitorService() error {
+	svc := diskmonitor.NewService(b.ctx, &diskmonitor.Config{
+		DataDir:                  b.cliCtx.String(cmd.DataDirFlag.Name),
+		FreeDiskWarningThreshold: b.cliCtx.Uint64(flags.FreeDiskWarningThresholdFlag.Name) << 20,
+		SampleInterval:           diskMonitorInterval,
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerClockDriftService() error {
+	svc := clockdrift.NewService(b.ctx, &clockdrift.Config{
+		Servers:       b.cliCtx.StringSlice(flags.NTPServersFlag.Name),
+		Threshold:     b.cliCtx.Duration(flags.ClockDriftThresholdFlag.Name),
+		CheckInterval: clockDriftCheckInterval,
+	})
+	return b.services.RegisterService(svc)
 }
 
 func (b *BeaconNode) registerInitialSyncService() error {
@@ -570,6 +928,11 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var inclusionService *inclusion.Service
+	if err := b.services.FetchService(&inclusionService); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -588,16 +951,24 @@ func (b *BeaconNode) registerRPCService() error {
 
 	host := b.cliCtx.String(flags.RPCHost.Name)
 	port := b.cliCtx.String(flags.RPCPort.Name)
+	unixSocket := b.cliCtx.String(flags.RPCUnixSocket.Name)
 	cert := b.cliCtx.String(flags.CertFlag.Name)
 	key := b.cliCtx.String(flags.KeyFlag.Name)
 	slasherCert := b.cliCtx.String(flags.SlasherCertFlag.Name)
 	slasherProvider := b.cliCtx.String(flags.SlasherProviderFlag.Name)
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	// The recent logs are kept in memory for the diagnostic dumps of the debug endpoints.
+	var logTail *logutil.LogTail
+	if enableDebugRPCEndpoints {
+		logTail = logutil.NewLogTail(diagnosticLogLines)
+		logrus.AddHook(logTail)
+	}
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		Host:                    host,
 		Port:                    port,
+		UnixSocket:              unixSocket,
 		CertFlag:                cert,
 		KeyFlag:                 key,
 		BeaconDB:                b.db,
@@ -626,7 +997,12 @@ func (b *BeaconNode) registerRPCService() error {
 		SlasherCert:             slasherCert,
 		SlasherProvider:         slasherProvider,
 		StateGen:                b.stateGen,
+		ForkSchedule:            b.forkSchedule,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
+		ArchiveMode:             b.cliCtx.Bool(flags.ArchiveFlag.Name),
+		HealthReporter:          b.services,
+		LogTail:                 logTail,
+		InclusionFetcher:        inclusionService,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -651,6 +1027,14 @@ func (b *BeaconNode) registerPrometheusService() error {
 
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/tree", Handler: c.TreeHandler})
 
+	if b.cliCtx.Bool(cmd.MonitoringDebugEndpointsFlag.Name) {
+		token := b.cliCtx.String(cmd.MonitoringDebugAuthTokenFlag.Name)
+		if token == "" {
+			log.Warn("Serving pprof and expvar endpoints on the monitoring port without an auth token")
+		}
+		additionalHandlers = append(additionalHandlers, prometheus.DebugHandlers(token)...)
+	}
+
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int(flags.MonitoringPortFlag.Name)),
 		b.services,
@@ -669,15 +1053,35 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	gatewayHost := b.cliCtx.String(flags.GRPCGatewayHost.Name)
 	rpcHost := b.cliCtx.String(flags.RPCHost.Name)
 	selfAddress := fmt.Sprintf("%s:%d", rpcHost, b.cliCtx.Int(flags.RPCPort.Name))
+	if unixSocket := b.cliCtx.String(flags.RPCUnixSocket.Name); unixSocket != "" {
+		selfAddress = grpcutils.UnixSocketPrefix + unixSocket
+	}
 	gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+
+	// The standard API endpoints are served by the gateway next to the gRPC ones.
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	p2pService := b.fetchP2P()
+	mux := http.NewServeMux()
+	beaconV1Server := &beaconv1.Server{
+		GenesisTimeFetcher:  chainService,
+		HeadFetcher:         chainService,
+		AttestationReceiver: chainService,
+		AttestationsPool:    b.attestationPool,
+		Broadcaster:         p2pService,
+		OperationNotifier:   b,
+	}
+	beaconV1Server.RegisterHandlers(mux)
 	return b.services.RegisterService(
 		gateway.New(
 			b.ctx,
 			selfAddress,
 			gatewayAddress,
-			nil, /*optional mux*/
+			mux,
 			allowedOrigins,
 			enableDebugRPCEndpoints,
 			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
@@ -694,6 +1098,7 @@ func (b *BeaconNode) registerInteropServices() error {
 		svc := interopcoldstart.NewColdStartService(b.ctx, &interopcoldstart.Config{
 			GenesisTime:   genesisTime,
 			NumValidators: genesisValidators,
+			Mnemonic:      b.cliCtx.String(flags.InteropMnemonicFlag.Name),
 			BeaconDB:      b.db,
 			DepositCache:  b.depositCache,
 			GenesisPath:   genesisStatePath,
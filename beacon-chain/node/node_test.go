@@ -80,3 +80,8 @@ func TestBootStrapNodeFile(t *testing.T) {
 		t.Fatalf("TestBootStrapNodeFile failed.  Nodes do not match")
 	}
 }
+
+func TestParseEndpoints(t *testing.T) {
+	require.DeepEqual(t, []string{"http://a", "http://b"}, parseEndpoints(" http://a, ,http://b,"))
+	require.DeepEqual(t, []string(nil), parseEndpoints(""))
+}
@@ -0,0 +1,29 @@
+package attestations
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// Aggregate the unaggregated attestations of the pool four times per slot by default.
+var defaultAggregationInterval = slotutil.DivideSlotBy(4 /* times-per-slot */)
+
+// aggregateRoutine aggregates a batch of the unaggregated attestations of the pool on every
+// aggregation interval, so that proposers find the attestations aggregated as much as possible
+// without waiting for the aggregation duties.
+func (s *Service) aggregateRoutine() {
+	ticker := time.NewTicker(s.aggregationInterval)
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.pool.AggregateUnaggregatedAttestationsBatch(s.aggregationBatchSize); err != nil {
+				log.WithError(err).Error("Could not aggregate unaggregated attestations")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			ticker.Stop()
+			return
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package attestations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAggregateRoutine_Ticker(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	s, err := NewService(ctx, &Config{
+		Pool:                NewPool(),
+		AggregationInterval: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	sig := bls.RandKey().Sign([]byte{'a'})
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1001}, Signature: sig.Marshal()},
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1010}, Signature: sig.Marshal()},
+	}
+	require.NoError(t, s.pool.SaveUnaggregatedAttestations(atts))
+
+	go s.aggregateRoutine()
+	for s.pool.UnaggregatedAttestationCount() > 0 {
+		select {
+		case <-ctx.Done():
+			t.Fatal("Unaggregated attestations were not aggregated")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	assert.Equal(t, 1, s.pool.AggregatedAttestationCount())
+}
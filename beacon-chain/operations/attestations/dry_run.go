@@ -0,0 +1,223 @@
+package attestations
+
+import (
+	"sync"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+)
+
+// dryRunComparisonDelay is the number of slots after the slot of the simulated aggregates they
+// are compared with the attestations on chain, so that the block of the next slot is received.
+const dryRunComparisonDelay = 2
+
+// aggregationDryRun simulates the aggregate an aggregator of the node would produce for each
+// committee of a slot with an aggregation strategy, without broadcasting it, to compare its
+// coverage with the attestations of the same data included on chain.
+type aggregationDryRun struct {
+	strategy   attaggregation.AttestationAggregationStrategy
+	aggregator attaggregation.Aggregator
+	start      sync.Once
+	lock       sync.Mutex
+	// recorded are copies of the unaggregated attestations saved in the pool by slot, recorded as
+	// they are saved since the pool aggregates and deletes them in the background.
+	recorded map[uint64][]*ethpb.Attestation
+	// onChain are copies of the attestations of the blocks saved in the block pool by slot,
+	// recorded as they are saved since the block pool is emptied for fork choice every few seconds.
+	onChain map[uint64][]*ethpb.Attestation
+	// simulated are the simulated aggregates by slot and committee index, until they are compared.
+	simulated map[uint64]map[uint64]*ethpb.Attestation
+}
+
+func newAggregationDryRun(strategy attaggregation.AttestationAggregationStrategy) (*aggregationDryRun, error) {
+	aggregator, err := attaggregation.StrategyAggregator(strategy)
+	if err != nil {
+		return nil, err
+	}
+	return &aggregationDryRun{
+		strategy:   strategy,
+		aggregator: aggregator,
+		recorded:   make(map[uint64][]*ethpb.Attestation),
+		onChain:    make(map[uint64][]*ethpb.Attestation),
+		simulated:  make(map[uint64]map[uint64]*ethpb.Attestation),
+	}, nil
+}
+
+// dryRunRoutine simulates the aggregates of the previous slot at the start of every slot, and
+// compares the ones simulated dryRunComparisonDelay slots ago with the attestations on chain.
+func (s *Service) dryRunRoutine(genesisTime uint64) {
+	go s.recordDryRunAttestations(s.pool.SubscribeAttestations(s.ctx, kv.FilterUnaggregated()))
+	go s.recordDryRunBlockAttestations(s.pool.SubscribeBlockAttestations(s.ctx))
+	ticker := slotutil.GetSlotTicker(time.Unix(int64(genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if slot >= 1 {
+				if err := s.simulateAggregates(slot - 1); err != nil {
+					log.WithError(err).Error("Could not simulate aggregates")
+				}
+			}
+			if slot >= dryRunComparisonDelay {
+				if err := s.compareSimulatedAggregates(slot - dryRunComparisonDelay); err != nil {
+					log.WithError(err).Error("Could not compare simulated aggregates with the attestations on chain")
+				}
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// recordDryRunAttestations records the unaggregated attestations saved in the pool until the
+// subscription is closed.
+func (s *Service) recordDryRunAttestations(atts <-chan *ethpb.Attestation) {
+	for att := range atts {
+		s.dryRun.record(att)
+	}
+}
+
+// recordDryRunBlockAttestations records the attestations of the blocks saved in the block pool
+// until the subscription is closed.
+func (s *Service) recordDryRunBlockAttestations(atts <-chan *ethpb.Attestation) {
+	for att := range atts {
+		s.dryRun.recordOnChain(att)
+	}
+}
+
+// record keeps the unaggregated attestation until the aggregates of its slot are simulated.
+func (d *aggregationDryRun) record(att *ethpb.Attestation) {
+	if att.Data == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.recorded[att.Data.Slot] = append(d.recorded[att.Data.Slot], att)
+}
+
+// recordOnChain keeps the block attestation until the aggregates of its slot are compared.
+func (d *aggregationDryRun) recordOnChain(att *ethpb.Attestation) {
+	if att.Data == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.onChain[att.Data.Slot] = append(d.onChain[att.Data.Slot], att)
+}
+
+// simulateAggregates aggregates the unaggregated attestations recorded for the slot with the dry
+// run strategy, keeping the aggregate with the most attesters of each committee like an
+// aggregator does. The aggregation of the pool and the aggregates received from the network play
+// no part in it, and the attestations recorded up to the slot are discarded.
+func (s *Service) simulateAggregates(slot uint64) error {
+	s.dryRun.lock.Lock()
+	atts := s.dryRun.recorded[slot]
+	for sl := range s.dryRun.recorded {
+		if sl <= slot {
+			delete(s.dryRun.recorded, sl)
+		}
+	}
+	s.dryRun.lock.Unlock()
+
+	attsByDataRoot := make(map[[32]byte][]*ethpb.Attestation)
+	for _, att := range atts {
+		r, err := stateutil.AttestationDataRoot(att.Data)
+		if err != nil {
+			return err
+		}
+		attsByDataRoot[r] = append(attsByDataRoot[r], att)
+	}
+
+	best := make(map[uint64]*ethpb.Attestation)
+	for _, atts := range attsByDataRoot {
+		aggregatedAtts, err := s.dryRun.aggregator.Aggregate(atts)
+		if err != nil {
+			return err
+		}
+		for _, att := range aggregatedAtts {
+			b, ok := best[att.Data.CommitteeIndex]
+			if !ok || att.AggregationBits.Count() > b.AggregationBits.Count() {
+				best[att.Data.CommitteeIndex] = att
+			}
+		}
+	}
+
+	s.dryRun.lock.Lock()
+	defer s.dryRun.lock.Unlock()
+	s.dryRun.simulated[slot] = best
+	return nil
+}
+
+// compareSimulatedAggregates compares the number of attesters of the aggregates simulated for
+// the slot with the attesters of the block attestations of the same data recorded so far, and
+// discards the aggregates simulated and the block attestations recorded up to the slot.
+func (s *Service) compareSimulatedAggregates(slot uint64) error {
+	s.dryRun.lock.Lock()
+	simulated, ok := s.dryRun.simulated[slot]
+	for sl := range s.dryRun.simulated {
+		if sl <= slot {
+			delete(s.dryRun.simulated, sl)
+		}
+	}
+	onChain := s.dryRun.onChain[slot]
+	for sl := range s.dryRun.onChain {
+		if sl <= slot {
+			delete(s.dryRun.onChain, sl)
+		}
+	}
+	s.dryRun.lock.Unlock()
+	if !ok || len(simulated) == 0 {
+		return nil
+	}
+
+	onChainBits := make(map[[32]byte]bitfield.Bitlist)
+	for _, att := range onChain {
+		r, err := stateutil.AttestationDataRoot(att.Data)
+		if err != nil {
+			return err
+		}
+		bits, ok := onChainBits[r]
+		if !ok {
+			onChainBits[r] = att.AggregationBits
+		} else if bits.Len() == att.AggregationBits.Len() {
+			onChainBits[r] = bits.Or(att.AggregationBits)
+		}
+	}
+
+	var simulatedAttesters, onChainAttesters, simulatedOnlyAttesters uint64
+	for _, att := range simulated {
+		r, err := stateutil.AttestationDataRoot(att.Data)
+		if err != nil {
+			return err
+		}
+		simulatedAttesters += att.AggregationBits.Count()
+		bits, ok := onChainBits[r]
+		if !ok || bits.Len() != att.AggregationBits.Len() {
+			simulatedOnlyAttesters += att.AggregationBits.Count()
+			continue
+		}
+		onChainAttesters += bits.Count()
+		simulatedOnlyAttesters += att.AggregationBits.Count() - att.AggregationBits.And(bits).Count()
+	}
+
+	dryRunAttestersCount.WithLabelValues("simulated").Add(float64(simulatedAttesters))
+	dryRunAttestersCount.WithLabelValues("on_chain").Add(float64(onChainAttesters))
+	dryRunAttestersCount.WithLabelValues("simulated_only").Add(float64(simulatedOnlyAttesters))
+	log.WithFields(logrus.Fields{
+		"slot":                   slot,
+		"strategy":               s.dryRun.strategy,
+		"committees":             len(simulated),
+		"simulatedAttesters":     simulatedAttesters,
+		"onChainAttesters":       onChainAttesters,
+		"simulatedOnlyAttesters": simulatedOnlyAttesters,
+	}).Info("Compared simulated aggregates with the attestations on chain")
+	return nil
+}
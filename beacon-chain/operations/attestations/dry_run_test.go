@@ -0,0 +1,99 @@
+package attestations
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations/kv"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestNewService_InvalidDryRunStrategy(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{
+		Pool:                      NewPool(),
+		DryRunAggregationStrategy: "foobar",
+	})
+	require.ErrorContains(t, "could not set up the aggregation dry run", err)
+}
+
+func TestAggregationDryRun_CompareWithOnChain(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s, err := NewService(context.Background(), &Config{
+		Pool:                      NewPool(),
+		DryRunAggregationStrategy: "naive",
+	})
+	require.NoError(t, err)
+
+	sig := bls.RandKey().Sign([]byte{'a'})
+	data := &ethpb.AttestationData{Slot: 1, CommitteeIndex: 2}
+	atts := []*ethpb.Attestation{
+		{Data: data, AggregationBits: bitfield.Bitlist{0b10001}, Signature: sig.Marshal()},
+		{Data: data, AggregationBits: bitfield.Bitlist{0b10010}, Signature: sig.Marshal()},
+		{Data: data, AggregationBits: bitfield.Bitlist{0b10100}, Signature: sig.Marshal()},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b10001}, Signature: sig.Marshal()},
+	}
+	for _, att := range atts {
+		s.dryRun.record(att)
+	}
+	// Aggregates of the pool play no part in the simulation.
+	require.NoError(t, s.pool.SaveAggregatedAttestation(&ethpb.Attestation{
+		Data:            data,
+		AggregationBits: bitfield.Bitlist{0b11011},
+		Signature:       sig.Marshal(),
+	}))
+
+	require.NoError(t, s.simulateAggregates(1))
+	simulated := s.dryRun.simulated[1]
+	require.Equal(t, 1, len(simulated))
+	assert.DeepEqual(t, bitfield.Bitlist{0b10111}, simulated[2].AggregationBits)
+	assert.Equal(t, 1, len(s.dryRun.recorded), "Expected the simulated attestations to be discarded")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := s.pool.SubscribeBlockAttestations(ctx)
+	require.NoError(t, s.pool.SaveBlockAttestation(&ethpb.Attestation{
+		Data:            data,
+		AggregationBits: bitfield.Bitlist{0b10011},
+		Signature:       sig.Marshal(),
+	}))
+	// The block attestations are recorded even once the block pool is emptied for fork choice.
+	require.NoError(t, s.batchForkChoiceAtts(context.Background()))
+	assert.Equal(t, 0, len(s.pool.BlockAttestations()))
+	cancel()
+	s.recordDryRunBlockAttestations(sub)
+
+	require.NoError(t, s.compareSimulatedAggregates(1))
+	require.LogsContain(t, hook, "simulatedAttesters=3")
+	require.LogsContain(t, hook, "onChainAttesters=2")
+	require.LogsContain(t, hook, "simulatedOnlyAttesters=1")
+	assert.Equal(t, 0, len(s.dryRun.simulated), "Expected the compared aggregates to be discarded")
+	assert.Equal(t, 0, len(s.dryRun.onChain), "Expected the compared block attestations to be discarded")
+}
+
+func TestAggregationDryRun_RecordsSavedAttestations(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{
+		Pool:                      NewPool(),
+		DryRunAggregationStrategy: "naive",
+	})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := s.pool.SubscribeAttestations(ctx, kv.FilterUnaggregated())
+
+	sig := bls.RandKey().Sign([]byte{'a'})
+	data := &ethpb.AttestationData{Slot: 1, CommitteeIndex: 2}
+	require.NoError(t, s.pool.SaveUnaggregatedAttestations([]*ethpb.Attestation{
+		{Data: data, AggregationBits: bitfield.Bitlist{0b10001}, Signature: sig.Marshal()},
+		{Data: data, AggregationBits: bitfield.Bitlist{0b10010}, Signature: sig.Marshal()},
+	}))
+	// The attestations are recorded even once the pool aggregated and deleted them.
+	require.NoError(t, s.pool.AggregateUnaggregatedAttestations())
+	assert.Equal(t, 0, s.pool.UnaggregatedAttestationCount())
+	cancel()
+	s.recordDryRunAttestations(sub)
+
+	assert.Equal(t, 2, len(s.dryRun.recorded[1]))
+}
@@ -0,0 +1,46 @@
+package attestations
+
+import (
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// ReadDump reads a protobuf encoded attestation pool dump, as written by the pool dump command
+// of pcli from the attestation pool debug RPC.
+func ReadDump(path string) (*pbrpc.AttestationPoolResponse, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dump := &pbrpc.AttestationPoolResponse{}
+	if err := proto.Unmarshal(enc, dump); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal attestation pool dump")
+	}
+	return dump, nil
+}
+
+// LoadDump saves the attestations of a pool dump into the pool, each into the part of the pool
+// it was dumped from, and returns the number of attestations loaded. Unlike Restore, expired
+// attestations are loaded too so that real world pool contents can be replayed in benchmarks
+// and regression tests.
+func LoadDump(pool Pool, dump *pbrpc.AttestationPoolResponse) (int, error) {
+	for _, att := range dump.UnaggregatedAttestations {
+		if err := pool.SaveUnaggregatedAttestation(att); err != nil {
+			return 0, errors.Wrap(err, "could not save unaggregated attestation")
+		}
+	}
+	if err := pool.SaveAggregatedAttestations(dump.AggregatedAttestations); err != nil {
+		return 0, errors.Wrap(err, "could not save aggregated attestations")
+	}
+	if err := pool.SaveBlockAttestations(dump.BlockAttestations); err != nil {
+		return 0, errors.Wrap(err, "could not save block attestations")
+	}
+	if err := pool.SaveForkchoiceAttestations(dump.ForkchoiceAttestations); err != nil {
+		return 0, errors.Wrap(err, "could not save fork choice attestations")
+	}
+	return len(dump.UnaggregatedAttestations) + len(dump.AggregatedAttestations) +
+		len(dump.BlockAttestations) + len(dump.ForkchoiceAttestations), nil
+}
@@ -0,0 +1,46 @@
+package attestations
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReadDump_LoadDump(t *testing.T) {
+	dump := &pbrpc.AttestationPoolResponse{
+		UnaggregatedAttestations: []*ethpb.Attestation{{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1001}}},
+		AggregatedAttestations:   []*ethpb.Attestation{{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1101}}},
+		BlockAttestations:        []*ethpb.Attestation{{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b1111}}},
+		ForkchoiceAttestations:   []*ethpb.Attestation{{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b1011}}},
+	}
+	enc, err := proto.Marshal(dump)
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "attestation-pool-dump")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(dir))
+	}()
+	path := filepath.Join(dir, "pool.dump")
+	require.NoError(t, ioutil.WriteFile(path, enc, 0600))
+
+	read, err := ReadDump(path)
+	require.NoError(t, err)
+	pool := NewPool()
+	loaded, err := LoadDump(pool, read)
+	require.NoError(t, err)
+	assert.Equal(t, 4, loaded)
+	unaggregated, err := pool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.DeepEqual(t, dump.UnaggregatedAttestations, unaggregated)
+	assert.DeepEqual(t, dump.AggregatedAttestations, pool.AggregatedAttestations())
+	assert.DeepEqual(t, dump.BlockAttestations, pool.BlockAttestations())
+	assert.DeepEqual(t, dump.ForkchoiceAttestations, pool.ForkchoiceAttestations())
+}
@@ -1,11 +1,13 @@
 package kv
 
 import (
+	"math"
+	"sort"
+
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
-	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
 )
 
@@ -14,17 +16,25 @@ import (
 // It tracks the unaggregated attestations that weren't able to aggregate to prevent
 // the deletion of unaggregated attestations in the pool.
 func (p *AttCaches) AggregateUnaggregatedAttestations() error {
-	unaggregatedAtts, err := p.UnaggregatedAttestations()
-	if err != nil {
-		return err
-	}
-	attsByDataRoot := make(map[[32]byte][]*ethpb.Attestation, len(unaggregatedAtts))
-	for _, att := range unaggregatedAtts {
-		attDataRoot, err := stateutil.AttestationDataRoot(att.Data)
-		if err != nil {
+	return p.AggregateUnaggregatedAttestationsBatch(0)
+}
+
+// AggregateUnaggregatedAttestationsBatch aggregates at most batch size unaggregated attestations,
+// or all of them if the batch size is 0, the remaining ones are left for the next batch. An
+// attestation alone with its data has nothing to aggregate with and doesn't count towards the batch.
+// The attestations of the oldest slots are aggregated first.
+func (p *AttCaches) AggregateUnaggregatedAttestationsBatch(batchSize int) error {
+	attsByDataRoot := make(map[[32]byte][]*ethpb.Attestation)
+	batch := make([]*ethpb.Attestation, 0, batchSize)
+	for _, s := range p.shards {
+		var full bool
+		var err error
+		if batch, full, err = p.unaggregatedBatch(s, batchSize, attsByDataRoot, batch); err != nil {
 			return err
 		}
-		attsByDataRoot[attDataRoot] = append(attsByDataRoot[attDataRoot], att)
+		if full {
+			break
+		}
 	}
 
 	// Aggregate unaggregated attestations from the pool and save them in the pool.
@@ -40,7 +50,7 @@ func (p *AttCaches) AggregateUnaggregatedAttestations() error {
 			if helpers.IsAggregated(att) {
 				aggregatedAtts = append(aggregatedAtts, att)
 			} else {
-				h, err := hashFn(att)
+				h, err := p.hash(att)
 				if err != nil {
 					return err
 				}
@@ -53,8 +63,8 @@ func (p *AttCaches) AggregateUnaggregatedAttestations() error {
 	}
 
 	// Remove the unaggregated attestations from the pool that were successfully aggregated.
-	for _, att := range unaggregatedAtts {
-		h, err := hashFn(att)
+	for _, att := range batch {
+		h, err := p.hash(att)
 		if err != nil {
 			return err
 		}
@@ -71,49 +81,135 @@ func (p *AttCaches) AggregateUnaggregatedAttestations() error {
 
 // SaveAggregatedAttestation saves an aggregated attestation in cache.
 func (p *AttCaches) SaveAggregatedAttestation(att *ethpb.Attestation) error {
+	_, err := p.saveAggregated(att)
+	return err
+}
+
+// saveAggregated saves an aggregated attestation in cache. It returns false if the attestation
+// was not stored, as it is known, seen, slashable or older than the ones in a full pool.
+func (p *AttCaches) saveAggregated(att *ethpb.Attestation) (bool, error) {
 	if att == nil || att.Data == nil {
-		return nil
+		return false, nil
 	}
 	if !helpers.IsAggregated(att) {
-		return errors.New("attestation is not aggregated")
+		return false, errors.New("attestation is not aggregated")
 	}
 	has, err := p.HasAggregatedAttestation(att)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if has {
-		return nil
+		return false, nil
 	}
 
 	seen, err := p.hasSeenBit(att)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if seen {
-		return nil
+		return false, nil
 	}
 
-	r, err := hashFn(att.Data)
+	slashable, err := p.rejectSlashable(att)
+	if err != nil {
+		return false, err
+	}
+	if slashable {
+		return false, nil
+	}
+
+	r, err := p.hash(att.Data)
+	if err != nil {
+		return false, errors.Wrap(err, "could not tree hash attestation")
+	}
+	saved, err := p.saveAggregatedAtt(r, stateTrie.CopyAttestation(att))
 	if err != nil {
-		return errors.Wrap(err, "could not tree hash attestation")
+		return false, err
 	}
-	copiedAtt := stateTrie.CopyAttestation(att)
-	p.aggregatedAttLock.Lock()
-	defer p.aggregatedAttLock.Unlock()
-	atts, ok := p.aggregatedAtt[r]
+	if saved {
+		p.observeSavedAtt(att, true)
+		p.publish(att)
+	}
+
+	return saved, nil
+}
+
+// saveAggregatedAtt aggregates the attestation with the ones of the same data root in cache. It
+// returns false if the pool is full of attestations newer than the attestation, which is dropped.
+func (p *AttCaches) saveAggregatedAtt(r [32]byte, copiedAtt *ethpb.Attestation) (bool, error) {
+	s := p.shard(copiedAtt.Data.CommitteeIndex)
+	s.aggregatedAttLock.Lock()
+	defer s.aggregatedAttLock.Unlock()
+	atts, ok := s.aggregatedAtt[r]
 	if !ok {
-		atts := []*ethpb.Attestation{copiedAtt}
-		p.aggregatedAtt[r] = atts
-		return nil
+		if !s.evictAggregatedAtt(copiedAtt.Data.Slot, p.maxSize) {
+			return false, nil
+		}
+		s.addAggregatedAtts(r, []*ethpb.Attestation{copiedAtt})
+		return true, nil
 	}
 
-	atts, err = attaggregation.Aggregate(append(atts, copiedAtt))
+	atts, err := attaggregation.Aggregate(append(atts, copiedAtt))
 	if err != nil {
-		return err
+		return false, err
 	}
-	p.aggregatedAtt[r] = atts
+	s.aggregatedAtt[r] = atts
 
-	return nil
+	return true, nil
+}
+
+// addAggregatedAtts sets the attestations of the data root in the shard and in the slot index.
+// The caller must hold the aggregated attestations lock of the shard.
+func (s *attShard) addAggregatedAtts(r [32]byte, atts []*ethpb.Attestation) {
+	s.aggregatedAtt[r] = atts
+	slot := atts[0].Data.Slot
+	roots, ok := s.aggregatedBySlot[slot]
+	if !ok {
+		roots = make(map[[32]byte]bool)
+		s.aggregatedBySlot[slot] = roots
+	}
+	roots[r] = true
+}
+
+// deleteAggregatedAtts removes the attestations of the data root from the shard and from the slot
+// index, dropping the emptied index entries. The caller must hold the aggregated attestations lock
+// of the shard.
+func (s *attShard) deleteAggregatedAtts(r [32]byte) {
+	atts, ok := s.aggregatedAtt[r]
+	if !ok {
+		return
+	}
+	delete(s.aggregatedAtt, r)
+	slot := atts[0].Data.Slot
+	delete(s.aggregatedBySlot[slot], r)
+	if len(s.aggregatedBySlot[slot]) == 0 {
+		delete(s.aggregatedBySlot, slot)
+	}
+}
+
+// evictAggregatedAtt makes room for an attestation of the slot in a shard full with the maximum
+// size by evicting the attestations of a data root of the oldest slot. It returns false if the slot
+// is older than the ones in the shard. The caller must hold the aggregated attestations lock of
+// the shard.
+func (s *attShard) evictAggregatedAtt(slot uint64, maxSize int) bool {
+	if maxSize == 0 || len(s.aggregatedAtt) < maxSize {
+		return true
+	}
+	oldestSlot := uint64(math.MaxUint64)
+	for sl := range s.aggregatedBySlot {
+		if sl < oldestSlot {
+			oldestSlot = sl
+		}
+	}
+	if slot < oldestSlot {
+		return false
+	}
+	evictedAggregatedAtts.Inc()
+	for r := range s.aggregatedBySlot[oldestSlot] {
+		s.deleteAggregatedAtts(r)
+		break
+	}
+	return true
 }
 
 // SaveAggregatedAttestations saves a list of aggregated attestations in cache.
@@ -128,13 +224,14 @@ func (p *AttCaches) SaveAggregatedAttestations(atts []*ethpb.Attestation) error
 
 // AggregatedAttestations returns the aggregated attestations in cache.
 func (p *AttCaches) AggregatedAttestations() []*ethpb.Attestation {
-	p.aggregatedAttLock.RLock()
-	defer p.aggregatedAttLock.RUnlock()
-
 	atts := make([]*ethpb.Attestation, 0)
 
-	for _, a := range p.aggregatedAtt {
-		atts = append(atts, a...)
+	for _, s := range p.shards {
+		s.aggregatedAttLock.RLock()
+		for _, a := range s.aggregatedAtt {
+			atts = append(atts, a...)
+		}
+		s.aggregatedAttLock.RUnlock()
 	}
 
 	return atts
@@ -145,10 +242,12 @@ func (p *AttCaches) AggregatedAttestations() []*ethpb.Attestation {
 func (p *AttCaches) AggregatedAttestationsBySlotIndex(slot uint64, committeeIndex uint64) []*ethpb.Attestation {
 	atts := make([]*ethpb.Attestation, 0)
 
-	p.aggregatedAttLock.RLock()
-	defer p.aggregatedAttLock.RUnlock()
-	for _, a := range p.aggregatedAtt {
-		if slot == a[0].Data.Slot && committeeIndex == a[0].Data.CommitteeIndex {
+	s := p.shard(committeeIndex)
+	s.aggregatedAttLock.RLock()
+	defer s.aggregatedAttLock.RUnlock()
+	for r := range s.aggregatedBySlot[slot] {
+		a := s.aggregatedAtt[r]
+		if committeeIndex == a[0].Data.CommitteeIndex {
 			atts = append(atts, a...)
 		}
 	}
@@ -164,7 +263,7 @@ func (p *AttCaches) DeleteAggregatedAttestation(att *ethpb.Attestation) error {
 	if !helpers.IsAggregated(att) {
 		return errors.New("attestation is not aggregated")
 	}
-	r, err := hashFn(att.Data)
+	r, err := p.hash(att.Data)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation data")
 	}
@@ -173,9 +272,10 @@ func (p *AttCaches) DeleteAggregatedAttestation(att *ethpb.Attestation) error {
 		return err
 	}
 
-	p.aggregatedAttLock.Lock()
-	defer p.aggregatedAttLock.Unlock()
-	attList, ok := p.aggregatedAtt[r]
+	s := p.shard(att.Data.CommitteeIndex)
+	s.aggregatedAttLock.Lock()
+	defer s.aggregatedAttLock.Unlock()
+	attList, ok := s.aggregatedAtt[r]
 	if !ok {
 		return nil
 	}
@@ -187,27 +287,134 @@ func (p *AttCaches) DeleteAggregatedAttestation(att *ethpb.Attestation) error {
 		}
 	}
 	if len(filtered) == 0 {
-		delete(p.aggregatedAtt, r)
+		s.deleteAggregatedAtts(r)
 	} else {
-		p.aggregatedAtt[r] = filtered
+		s.aggregatedAtt[r] = filtered
 	}
 
 	return nil
 }
 
+// ClaimAggregatedAttestations removes the aggregated attestations of the slot from cache and
+// saves them as block attestations in a locked operation for each shard, so the same aggregates
+// are never handed to two callers. The claimed attestations are marked as seen and returned as copies.
+func (p *AttCaches) ClaimAggregatedAttestations(slot uint64) ([]*ethpb.Attestation, error) {
+	claimed := make([]*ethpb.Attestation, 0)
+	for _, s := range p.shards {
+		var err error
+		if claimed, err = p.claimAggregatedAttestations(s, slot, claimed); err != nil {
+			return nil, err
+		}
+	}
+	return claimed, nil
+}
+
+// claimAggregatedAttestations claims the aggregated attestations of the slot in the shard,
+// appending their copies to claimed.
+func (p *AttCaches) claimAggregatedAttestations(s *attShard, slot uint64, claimed []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
+	s.aggregatedAttLock.Lock()
+	defer s.aggregatedAttLock.Unlock()
+	// Locked in the same order as in HasAggregatedAttestation.
+	p.blockAttLock.Lock()
+	defer p.blockAttLock.Unlock()
+
+	for r := range s.aggregatedBySlot[slot] {
+		atts := s.aggregatedAtt[r]
+		s.deleteAggregatedAtts(r)
+		for _, att := range atts {
+			if err := p.insertSeenBit(att); err != nil {
+				return nil, err
+			}
+			contained := false
+			for _, a := range p.blockAtt[r] {
+				if a.AggregationBits.Len() == att.AggregationBits.Len() && a.AggregationBits.Contains(att.AggregationBits) {
+					contained = true
+					break
+				}
+			}
+			if !contained {
+				p.blockAtt[r] = append(p.blockAtt[r], att)
+			}
+			claimed = append(claimed, stateTrie.CopyAttestation(att))
+		}
+	}
+	return claimed, nil
+}
+
+// ClaimBlockAttestations removes the aggregated attestations covered by the attestations of a
+// block from cache and saves the block attestations in the block pool, in a single locked
+// operation for each attestation, so the aggregates of a published block are never handed to
+// another caller. The claimed attestations are marked as seen.
+func (p *AttCaches) ClaimBlockAttestations(atts []*ethpb.Attestation) error {
+	for _, att := range atts {
+		if att == nil || att.Data == nil {
+			continue
+		}
+		r, err := p.hash(att.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not tree hash attestation data")
+		}
+		saved, err := p.claimBlockAttestation(r, att)
+		if err != nil {
+			return err
+		}
+		if saved {
+			p.publishBlock(att)
+		}
+	}
+	return nil
+}
+
+// claimBlockAttestation removes the aggregated attestations of the data root covered by the block
+// attestation from the shard, and saves a copy of the block attestation in the block pool. It
+// returns false if the block attestation is already contained in the block pool.
+func (p *AttCaches) claimBlockAttestation(r [32]byte, att *ethpb.Attestation) (bool, error) {
+	s := p.shard(att.Data.CommitteeIndex)
+	s.aggregatedAttLock.Lock()
+	defer s.aggregatedAttLock.Unlock()
+	// Locked in the same order as in HasAggregatedAttestation.
+	p.blockAttLock.Lock()
+	defer p.blockAttLock.Unlock()
+
+	if err := p.insertSeenBit(att); err != nil {
+		return false, err
+	}
+	if attList, ok := s.aggregatedAtt[r]; ok {
+		filtered := make([]*ethpb.Attestation, 0, len(attList))
+		for _, a := range attList {
+			if a.AggregationBits.Len() != att.AggregationBits.Len() || !att.AggregationBits.Contains(a.AggregationBits) {
+				filtered = append(filtered, a)
+			}
+		}
+		if len(filtered) == 0 {
+			s.deleteAggregatedAtts(r)
+		} else {
+			s.aggregatedAtt[r] = filtered
+		}
+	}
+	for _, a := range p.blockAtt[r] {
+		if a.AggregationBits.Len() == att.AggregationBits.Len() && a.AggregationBits.Contains(att.AggregationBits) {
+			return false, nil
+		}
+	}
+	p.blockAtt[r] = append(p.blockAtt[r], stateTrie.CopyAttestation(att))
+	return true, nil
+}
+
 // HasAggregatedAttestation checks if the input attestations has already existed in cache.
 func (p *AttCaches) HasAggregatedAttestation(att *ethpb.Attestation) (bool, error) {
 	if att == nil || att.Data == nil {
 		return false, nil
 	}
-	r, err := hashFn(att.Data)
+	r, err := p.hash(att.Data)
 	if err != nil {
 		return false, errors.Wrap(err, "could not tree hash attestation")
 	}
 
-	p.aggregatedAttLock.RLock()
-	defer p.aggregatedAttLock.RUnlock()
-	if atts, ok := p.aggregatedAtt[r]; ok {
+	s := p.shard(att.Data.CommitteeIndex)
+	s.aggregatedAttLock.RLock()
+	defer s.aggregatedAttLock.RUnlock()
+	if atts, ok := s.aggregatedAtt[r]; ok {
 		for _, a := range atts {
 			if a.AggregationBits.Len() == att.AggregationBits.Len() && a.AggregationBits.Contains(att.AggregationBits) {
 				return true, nil
@@ -230,7 +437,65 @@ func (p *AttCaches) HasAggregatedAttestation(att *ethpb.Attestation) (bool, erro
 
 // AggregatedAttestationCount returns the number of aggregated attestations key in the pool.
 func (p *AttCaches) AggregatedAttestationCount() int {
-	p.aggregatedAttLock.RLock()
-	defer p.aggregatedAttLock.RUnlock()
-	return len(p.aggregatedAtt)
+	count := 0
+	for _, s := range p.shards {
+		s.aggregatedAttLock.RLock()
+		count += len(s.aggregatedAtt)
+		s.aggregatedAttLock.RUnlock()
+	}
+	return count
+}
+
+// unaggregatedBatch adds copies of the unaggregated attestations of the shard not seen yet to the
+// batch, by data root, for the data with several such attestations. The slot index of the shard is
+// walked from the oldest slot, and the walk stops once the attestations of a data don't fit in the
+// batch anymore, in which case it returns true.
+func (p *AttCaches) unaggregatedBatch(
+	s *attShard,
+	batchSize int,
+	attsByDataRoot map[[32]byte][]*ethpb.Attestation,
+	batch []*ethpb.Attestation,
+) ([]*ethpb.Attestation, bool, error) {
+	s.unAggregateAttLock.RLock()
+	defer s.unAggregateAttLock.RUnlock()
+	slots := make([]uint64, 0, len(s.unAggregatedBySlot))
+	for slot := range s.unAggregatedBySlot {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	for _, slot := range slots {
+		for _, committeeAtts := range s.unAggregatedBySlot[slot] {
+			byDataRoot := make(map[[32]byte][]*ethpb.Attestation)
+			for _, att := range committeeAtts {
+				seen, err := p.hasSeenBit(att)
+				if err != nil {
+					return nil, false, errors.Wrap(err, "could not tree hash attestation data")
+				}
+				if seen {
+					continue
+				}
+				r, err := p.hash(att.Data)
+				if err != nil {
+					return nil, false, errors.Wrap(err, "could not tree hash attestation data")
+				}
+				byDataRoot[r] = append(byDataRoot[r], att)
+			}
+			for r, atts := range byDataRoot {
+				if len(atts) == 1 {
+					continue
+				}
+				if batchSize > 0 && len(batch) > 0 && len(batch)+len(atts) > batchSize {
+					return batch, true, nil
+				}
+				for _, att := range atts {
+					att = stateTrie.CopyAttestation(att)
+					attsByDataRoot[r] = append(attsByDataRoot[r], att)
+					batch = append(batch, att)
+				}
+			}
+		}
+	}
+	return batch, false, nil
 }
@@ -5,7 +5,6 @@ import (
 	"sort"
 	"testing"
 
-	c "github.com/patrickmn/go-cache"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -42,6 +41,29 @@ func TestKV_Aggregated_AggregateUnaggregatedAttestations(t *testing.T) {
 	}
 }
 
+func TestKV_Aggregated_AggregateUnaggregatedAttestationsBatch(t *testing.T) {
+	cache := NewAttCaches()
+	priv := bls.RandKey()
+	sig := priv.Sign([]byte{'a'})
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1001}, Signature: sig.Marshal()},
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1010}, Signature: sig.Marshal()},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1001}, Signature: sig.Marshal()},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1010}, Signature: sig.Marshal()},
+		{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b1001}, Signature: sig.Marshal()},
+	}
+	require.NoError(t, cache.SaveUnaggregatedAttestations(atts))
+
+	// Only the attestations of one data fit in the batch, the lone attestation of slot 3 is skipped.
+	require.NoError(t, cache.AggregateUnaggregatedAttestationsBatch(3))
+	assert.Equal(t, 1, cache.AggregatedAttestationCount())
+	assert.Equal(t, 3, cache.UnaggregatedAttestationCount())
+
+	require.NoError(t, cache.AggregateUnaggregatedAttestationsBatch(3))
+	assert.Equal(t, 2, cache.AggregatedAttestationCount())
+	assert.Equal(t, 1, len(cache.UnaggregatedAttestationsBySlotIndex(3, 0)))
+}
+
 func TestKV_Aggregated_SaveAggregatedAttestation(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -102,9 +124,9 @@ func TestKV_Aggregated_SaveAggregatedAttestation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cache := NewAttCaches()
-			cache.seenAtt.Set(string(r[:]), []bitfield.Bitlist{{0xff}}, c.DefaultExpiration)
-			if len(cache.unAggregatedAtt) != 0 {
-				t.Errorf("Invalid start pool, atts: %d", len(cache.unAggregatedAtt))
+			cache.seenAtt.insert(r, bitfield.Bitlist{0xff})
+			if cache.UnaggregatedAttestationCount() != 0 {
+				t.Errorf("Invalid start pool, atts: %d", cache.UnaggregatedAttestationCount())
 			}
 
 			err := cache.SaveAggregatedAttestation(tt.att)
@@ -116,8 +138,8 @@ func TestKV_Aggregated_SaveAggregatedAttestation(t *testing.T) {
 				t.Error(err)
 				return
 			}
-			if len(cache.aggregatedAtt) != tt.count {
-				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, len(cache.aggregatedAtt))
+			if len(cache.shards[0].aggregatedAtt) != tt.count {
+				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, len(cache.shards[0].aggregatedAtt))
 			}
 			if cache.AggregatedAttestationCount() != tt.count {
 				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, cache.AggregatedAttestationCount())
@@ -148,16 +170,16 @@ func TestKV_Aggregated_SaveAggregatedAttestations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cache := NewAttCaches()
-			if len(cache.aggregatedAtt) != 0 {
-				t.Errorf("Invalid start pool, atts: %d", len(cache.unAggregatedAtt))
+			if cache.AggregatedAttestationCount() != 0 {
+				t.Errorf("Invalid start pool, atts: %d", cache.UnaggregatedAttestationCount())
 			}
 			err := cache.SaveAggregatedAttestations(tt.atts)
 			if tt.wantErrString == "" && err != nil {
 				t.Error(err)
 				return
 			}
-			if len(cache.aggregatedAtt) != tt.count {
-				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, len(cache.aggregatedAtt))
+			if len(cache.shards[0].aggregatedAtt) != tt.count {
+				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, len(cache.shards[0].aggregatedAtt))
 			}
 			if cache.AggregatedAttestationCount() != tt.count {
 				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, cache.AggregatedAttestationCount())
@@ -501,3 +523,80 @@ func TestKV_Aggregated_DuplicateAggregatedAttestations(t *testing.T) {
 		t.Error("Did not receive correct aggregated atts")
 	}
 }
+
+func TestKV_Aggregated_SaveAggregatedAttestation_EvictsOldestSlot(t *testing.T) {
+	cache := NewAttCachesWithConfig(&Config{MaxSize: 2})
+	att1 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1101}}
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b1101}}
+	att3 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b1101}}
+	att4 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	require.NoError(t, cache.SaveAggregatedAttestations([]*ethpb.Attestation{att1, att2, att3}))
+	assert.Equal(t, 2, cache.AggregatedAttestationCount())
+	assert.Equal(t, 0, len(cache.AggregatedAttestationsBySlotIndex(2, 0)), "Expected the oldest slot to be evicted")
+	assert.Equal(t, 1, len(cache.AggregatedAttestationsBySlotIndex(4, 0)))
+
+	// An attestation older than the ones in the full pool is dropped.
+	require.NoError(t, cache.SaveAggregatedAttestation(att4))
+	assert.Equal(t, 2, cache.AggregatedAttestationCount())
+	assert.Equal(t, 0, len(cache.AggregatedAttestationsBySlotIndex(1, 0)))
+	assert.Equal(t, 1, len(cache.AggregatedAttestationsBySlotIndex(3, 0)))
+
+	// The slot index follows the evictions and the deletions.
+	_, ok := cache.shards[0].aggregatedBySlot[2]
+	assert.Equal(t, false, ok, "Expected the evicted slot to leave the index")
+	require.NoError(t, cache.DeleteAggregatedAttestation(att2))
+	_, ok = cache.shards[0].aggregatedBySlot[3]
+	assert.Equal(t, false, ok, "Expected the deleted slot to leave the index")
+	assert.Equal(t, 1, len(cache.shards[0].aggregatedBySlot))
+}
+
+func TestKV_Aggregated_ClaimAggregatedAttestations(t *testing.T) {
+	cache := NewAttCaches()
+	att1 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	att3 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1101}}
+	require.NoError(t, cache.SaveAggregatedAttestations([]*ethpb.Attestation{att1, att2, att3}))
+
+	// Concurrent claims of the slot never hand out the same aggregates twice.
+	results := make(chan []*ethpb.Attestation, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			claimed, err := cache.ClaimAggregatedAttestations(1)
+			assert.NoError(t, err)
+			results <- claimed
+		}()
+	}
+	claimed := append(<-results, <-results...)
+	sort.Slice(claimed, func(i, j int) bool {
+		return claimed[i].Data.CommitteeIndex < claimed[j].Data.CommitteeIndex
+	})
+	assert.DeepEqual(t, []*ethpb.Attestation{att1, att2}, claimed)
+	assert.DeepEqual(t, []*ethpb.Attestation{att3}, cache.AggregatedAttestations())
+	assert.Equal(t, 2, len(cache.BlockAttestations()))
+
+	// Claimed attestations are seen and not saved again.
+	require.NoError(t, cache.SaveAggregatedAttestation(att1))
+	assert.Equal(t, 1, cache.AggregatedAttestationCount())
+}
+
+func TestKV_Aggregated_ClaimBlockAttestations(t *testing.T) {
+	cache := NewAttCaches()
+	att1 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1011}}
+	att3 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1101}}
+	require.NoError(t, cache.SaveAggregatedAttestations([]*ethpb.Attestation{att1, att2, att3}))
+
+	// Only the aggregates covered by the block attestations are claimed.
+	blockAtt := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	require.NoError(t, cache.ClaimBlockAttestations([]*ethpb.Attestation{blockAtt}))
+	atts := cache.AggregatedAttestations()
+	sort.Slice(atts, func(i, j int) bool {
+		return atts[i].Data.Slot < atts[j].Data.Slot
+	})
+	assert.DeepEqual(t, []*ethpb.Attestation{att2, att3}, atts)
+	assert.DeepEqual(t, []*ethpb.Attestation{blockAtt}, cache.BlockAttestations())
+
+	// Claimed attestations are seen and not saved again.
+	require.NoError(t, cache.SaveAggregatedAttestation(att1))
+	assert.Equal(t, 2, cache.AggregatedAttestationCount())
+}
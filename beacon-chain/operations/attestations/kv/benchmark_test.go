@@ -1,9 +1,14 @@
 package kv_test
 
 import (
+	"context"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations/kv"
 )
 
@@ -21,3 +26,122 @@ func BenchmarkAttCaches(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkAttCaches_SeenBits saves and deletes the 500k unaggregated attestations of an epoch of
+// 64 committees per slot, marking them as seen.
+func BenchmarkAttCaches_SeenBits(b *testing.B) {
+	const (
+		attsPerEpoch      = 500000
+		slots             = 32
+		committeesPerSlot = 64
+		committeeSize     = attsPerEpoch / (slots * committeesPerSlot)
+	)
+	atts := make([]*ethpb.Attestation, 0, attsPerEpoch)
+	for slot := uint64(0); slot < slots; slot++ {
+		for committee := uint64(0); committee < committeesPerSlot; committee++ {
+			data := &ethpb.AttestationData{Slot: slot, CommitteeIndex: committee}
+			for i := uint64(0); i < committeeSize; i++ {
+				bits := bitfield.NewBitlist(committeeSize)
+				bits.SetBitAt(i, true)
+				atts = append(atts, &ethpb.Attestation{Data: data, AggregationBits: bits})
+			}
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ac := kv.NewAttCaches()
+		for _, att := range atts {
+			if err := ac.SaveUnaggregatedAttestation(att); err != nil {
+				b.Fatal(err)
+			}
+			if err := ac.DeleteUnaggregatedAttestation(att); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkAttCaches_UnaggregatedAttestations compares the allocations of copying the unaggregated
+// attestations of the pool with the ones of iterating over them.
+func BenchmarkAttCaches_UnaggregatedAttestations(b *testing.B) {
+	ac := kv.NewAttCaches()
+	for slot := uint64(0); slot < 10000; slot++ {
+		att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: slot}, AggregationBits: bitfield.Bitlist{0b101}}
+		if err := ac.SaveUnaggregatedAttestation(att); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.Run("Copy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ac.UnaggregatedAttestations(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("ForEach", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := ac.ForEachUnaggregated(func(*ethpb.Attestation) bool { return true }); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkAttCaches_Shards measures saving the attestations of a committee while the attestations
+// of the 64 committees of a slot are received at 1000 attestations per second, by number of shards.
+func BenchmarkAttCaches_Shards(b *testing.B) {
+	const (
+		committeesPerSlot = 64
+		attsPerSecond     = 1000
+		committeeSize     = 128
+	)
+	newAtt := func(committee uint64, i uint64) *ethpb.Attestation {
+		bits := bitfield.NewBitlist(committeeSize)
+		bits.SetBitAt(i%committeeSize, true)
+		return &ethpb.Attestation{
+			Data:            &ethpb.AttestationData{Slot: i / committeeSize, CommitteeIndex: committee},
+			AggregationBits: bits,
+		}
+	}
+
+	for _, shards := range []int{1, 16, 64} {
+		b.Run(fmt.Sprintf("%d_shards", shards), func(b *testing.B) {
+			ac := kv.NewAttCachesWithConfig(&kv.Config{Shards: shards})
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			// The attestations of each committee are received at an even share of the rate.
+			for committee := uint64(1); committee < committeesPerSlot; committee++ {
+				wg.Add(1)
+				go func(committee uint64) {
+					defer wg.Done()
+					ticker := time.NewTicker(time.Second * committeesPerSlot / attsPerSecond)
+					defer ticker.Stop()
+					for i := uint64(0); ; i++ {
+						select {
+						case <-ticker.C:
+							if err := ac.SaveUnaggregatedAttestation(newAtt(committee, i)); err != nil {
+								b.Error(err)
+							}
+						case <-ctx.Done():
+							return
+						}
+					}
+				}(committee)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := ac.SaveUnaggregatedAttestation(newAtt(0, uint64(i))); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+			cancel()
+			wg.Wait()
+		})
+	}
+}
@@ -11,11 +11,20 @@ func (p *AttCaches) SaveBlockAttestation(att *ethpb.Attestation) error {
 	if att == nil {
 		return nil
 	}
-	r, err := hashFn(att.Data)
+	r, err := p.hash(att.Data)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
 
+	if p.saveBlockAtt(r, att) {
+		p.publishBlock(att)
+	}
+	return nil
+}
+
+// saveBlockAtt saves a copy of the attestation in the block pool, unless it is already fully
+// contained in an existing attestation, in which case it returns false.
+func (p *AttCaches) saveBlockAtt(r [32]byte, att *ethpb.Attestation) bool {
 	p.blockAttLock.Lock()
 	defer p.blockAttLock.Unlock()
 	atts, ok := p.blockAtt[r]
@@ -26,13 +35,13 @@ func (p *AttCaches) SaveBlockAttestation(att *ethpb.Attestation) error {
 	// Ensure that this attestation is not already fully contained in an existing attestation.
 	for _, a := range atts {
 		if a.AggregationBits.Contains(att.AggregationBits) {
-			return nil
+			return false
 		}
 	}
 
 	p.blockAtt[r] = append(atts, stateTrie.CopyAttestation(att))
 
-	return nil
+	return true
 }
 
 // SaveBlockAttestations saves a list of block attestations in cache.
@@ -64,7 +73,7 @@ func (p *AttCaches) DeleteBlockAttestation(att *ethpb.Attestation) error {
 	if att == nil {
 		return nil
 	}
-	r, err := hashFn(att.Data)
+	r, err := p.hash(att.Data)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
@@ -11,14 +11,14 @@ func (p *AttCaches) SaveForkchoiceAttestation(att *ethpb.Attestation) error {
 	if att == nil {
 		return nil
 	}
-	r, err := hashFn(att)
+	r, err := p.hash(att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
 
 	p.forkchoiceAttLock.Lock()
 	defer p.forkchoiceAttLock.Unlock()
-	p.forkchoiceAtt[r] = stateTrie.CopyAttestation(att) // Copied.
+	p.addForkchoiceAtt(r, stateTrie.CopyAttestation(att)) // Copied.
 
 	return nil
 }
@@ -47,19 +47,67 @@ func (p *AttCaches) ForkchoiceAttestations() []*ethpb.Attestation {
 	return atts
 }
 
+// ForkchoiceAttestationsByTargetEpoch returns the forkchoice attestations in cache voting for a
+// target checkpoint of the epoch.
+func (p *AttCaches) ForkchoiceAttestationsByTargetEpoch(epoch uint64) []*ethpb.Attestation {
+	p.forkchoiceAttLock.RLock()
+	defer p.forkchoiceAttLock.RUnlock()
+
+	targetAtts := p.forkchoiceByTarget[epoch]
+	atts := make([]*ethpb.Attestation, 0, len(targetAtts))
+	for _, att := range targetAtts {
+		atts = append(atts, stateTrie.CopyAttestation(att) /* Copied */)
+	}
+
+	return atts
+}
+
 // DeleteForkchoiceAttestation deletes a forkchoice attestation in cache.
 func (p *AttCaches) DeleteForkchoiceAttestation(att *ethpb.Attestation) error {
 	if att == nil {
 		return nil
 	}
-	r, err := hashFn(att)
+	r, err := p.hash(att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
 
 	p.forkchoiceAttLock.Lock()
 	defer p.forkchoiceAttLock.Unlock()
-	delete(p.forkchoiceAtt, r)
+	p.deleteForkchoiceAtt(r)
 
 	return nil
 }
+
+// addForkchoiceAtt adds the attestation with the root to the pool and, unless it has no target,
+// to the target epoch index. The caller must hold the forkchoice attestations lock.
+func (p *AttCaches) addForkchoiceAtt(r [32]byte, att *ethpb.Attestation) {
+	p.forkchoiceAtt[r] = att
+	if att.Data == nil || att.Data.Target == nil {
+		return
+	}
+	atts, ok := p.forkchoiceByTarget[att.Data.Target.Epoch]
+	if !ok {
+		atts = make(map[[32]byte]*ethpb.Attestation)
+		p.forkchoiceByTarget[att.Data.Target.Epoch] = atts
+	}
+	atts[r] = att
+}
+
+// deleteForkchoiceAtt removes the attestation with the root from the pool and from the target
+// epoch index. The caller must hold the forkchoice attestations lock.
+func (p *AttCaches) deleteForkchoiceAtt(r [32]byte) {
+	att, ok := p.forkchoiceAtt[r]
+	if !ok {
+		return
+	}
+	delete(p.forkchoiceAtt, r)
+	if att.Data == nil || att.Data.Target == nil {
+		return
+	}
+	epoch := att.Data.Target.Epoch
+	delete(p.forkchoiceByTarget[epoch], r)
+	if len(p.forkchoiceByTarget[epoch]) == 0 {
+		delete(p.forkchoiceByTarget, epoch)
+	}
+}
@@ -57,3 +57,35 @@ func TestKV_Forkchoice_CanDelete(t *testing.T) {
 	wanted := []*ethpb.Attestation{att2}
 	assert.DeepEqual(t, wanted, returned)
 }
+
+func TestKV_Forkchoice_ByTargetEpoch(t *testing.T) {
+	cache := NewAttCaches()
+
+	newAtt := func(slot uint64, epoch uint64) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			Data:            &ethpb.AttestationData{Slot: slot, Target: &ethpb.Checkpoint{Epoch: epoch}},
+			AggregationBits: bitfield.Bitlist{0b1101},
+		}
+	}
+	att1 := newAtt(1, 0)
+	att2 := newAtt(40, 1)
+	att3 := newAtt(41, 1)
+	for _, att := range []*ethpb.Attestation{att1, att2, att3} {
+		if err := cache.SaveForkchoiceAttestation(att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	returned := cache.ForkchoiceAttestationsByTargetEpoch(1)
+	sort.Slice(returned, func(i, j int) bool {
+		return returned[i].Data.Slot < returned[j].Data.Slot
+	})
+	assert.DeepEqual(t, []*ethpb.Attestation{att2, att3}, returned)
+
+	if err := cache.DeleteForkchoiceAttestation(att1); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(cache.ForkchoiceAttestationsByTargetEpoch(0)))
+	_, ok := cache.forkchoiceByTarget[0]
+	assert.Equal(t, false, ok, "Expected emptied target epoch to be removed")
+}
@@ -0,0 +1,60 @@
+package kv
+
+import (
+	"bytes"
+	"sort"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ForkAttestations are the attestations in cache of a committee voting for the same beacon block
+// root, when the committee's votes are split between forks.
+type ForkAttestations struct {
+	BeaconBlockRoot [32]byte
+	Attestations    []*ethpb.Attestation
+	// Votes is the number of distinct validators of the committee voting for the block root, the
+	// validators of attestations both aggregated and unaggregated are only counted once.
+	Votes uint64
+}
+
+// AttestationsByFork returns the aggregated and unaggregated attestations in cache of the committee
+// at the slot and committee index, grouped by the beacon block root they vote for. The forks with
+// the most votes come first.
+func (p *AttCaches) AttestationsByFork(slot uint64, committeeIndex uint64) []*ForkAttestations {
+	atts := append(p.AggregatedAttestationsBySlotIndex(slot, committeeIndex), p.UnaggregatedAttestationsBySlotIndex(slot, committeeIndex)...)
+
+	forks := make(map[[32]byte]*ForkAttestations)
+	votes := make(map[[32]byte]bitfield.Bitlist)
+	for _, att := range atts {
+		root := bytesutil.ToBytes32(att.Data.BeaconBlockRoot)
+		fork, ok := forks[root]
+		if !ok {
+			fork = &ForkAttestations{BeaconBlockRoot: root}
+			forks[root] = fork
+		}
+		fork.Attestations = append(fork.Attestations, att)
+		bits, ok := votes[root]
+		if !ok {
+			votes[root] = att.AggregationBits
+			continue
+		}
+		if bits.Len() == att.AggregationBits.Len() {
+			votes[root] = bits.Or(att.AggregationBits)
+		}
+	}
+
+	sorted := make([]*ForkAttestations, 0, len(forks))
+	for root, fork := range forks {
+		fork.Votes = votes[root].Count()
+		sorted = append(sorted, fork)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Votes == sorted[j].Votes {
+			return bytes.Compare(sorted[i].BeaconBlockRoot[:], sorted[j].BeaconBlockRoot[:]) < 0
+		}
+		return sorted[i].Votes > sorted[j].Votes
+	})
+	return sorted
+}
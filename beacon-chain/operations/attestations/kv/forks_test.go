@@ -0,0 +1,41 @@
+package kv
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestKV_AttestationsByFork(t *testing.T) {
+	cache := NewAttCaches()
+	rootA := bytesutil.PadTo([]byte{'a'}, 32)
+	rootB := bytesutil.PadTo([]byte{'b'}, 32)
+	newAtt := func(root []byte, committeeIndex uint64, bits bitfield.Bitlist) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			Data:            &ethpb.AttestationData{Slot: 1, CommitteeIndex: committeeIndex, BeaconBlockRoot: root},
+			AggregationBits: bits,
+		}
+	}
+	unaggA := newAtt(rootA, 0, bitfield.Bitlist{0b10100})
+	dupA := newAtt(rootA, 0, bitfield.Bitlist{0b10001})
+	aggA := newAtt(rootA, 0, bitfield.Bitlist{0b10011})
+	aggB := newAtt(rootB, 0, bitfield.Bitlist{0b11100})
+	other := newAtt(rootB, 1, bitfield.Bitlist{0b11100})
+	require.NoError(t, cache.SaveUnaggregatedAttestations([]*ethpb.Attestation{unaggA, dupA}))
+	require.NoError(t, cache.SaveAggregatedAttestations([]*ethpb.Attestation{aggA, aggB, other}))
+
+	forks := cache.AttestationsByFork(1, 0)
+	require.Equal(t, 2, len(forks))
+	assert.Equal(t, bytesutil.ToBytes32(rootA), forks[0].BeaconBlockRoot)
+	assert.Equal(t, uint64(3), forks[0].Votes, "Expected the duplicate vote to be counted once")
+	assert.Equal(t, 3, len(forks[0].Attestations))
+	assert.Equal(t, bytesutil.ToBytes32(rootB), forks[1].BeaconBlockRoot)
+	assert.Equal(t, uint64(2), forks[1].Votes)
+	assert.DeepEqual(t, []*ethpb.Attestation{aggB}, forks[1].Attestations)
+
+	assert.Equal(t, 0, len(cache.AttestationsByFork(2, 0)))
+}
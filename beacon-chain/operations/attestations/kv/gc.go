@@ -0,0 +1,100 @@
+package kv
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// StartGC collects the expired attestations of the pool at the start of every slot, until the
+// context is done. It does nothing if the garbage collection was already started. The genesis
+// time is also used to measure the age of the attestations saved from now on.
+func (p *AttCaches) StartGC(ctx context.Context, genesisTime uint64) {
+	p.gcLock.Lock()
+	defer p.gcLock.Unlock()
+	if p.gcStarted {
+		return
+	}
+	p.gcStarted = true
+	atomic.StoreUint64(&p.genesisTime, genesisTime)
+	ticker := slotutil.GetSlotTicker(time.Unix(int64(genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+	go func() {
+		defer ticker.Done()
+		for {
+			select {
+			case slot := <-ticker.C():
+				p.CollectExpiredAttestations(slot)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// RetentionSlots returns the number of slots the attestations are kept in the pool for.
+func (p *AttCaches) RetentionSlots() uint64 {
+	return p.retentionSlots
+}
+
+// CollectExpiredAttestations deletes the unaggregated, aggregated, block and fork choice attestations
+// older than the retention window at the current slot, and returns the number of attestations deleted.
+func (p *AttCaches) CollectExpiredAttestations(currentSlot uint64) int {
+	if currentSlot < p.retentionSlots {
+		return 0
+	}
+	// Attestations of the slots up to the cutoff slot are expired.
+	cutoff := currentSlot - p.retentionSlots
+	collected := 0
+
+	for _, s := range p.shards {
+		s.unAggregateAttLock.Lock()
+		for slot, committees := range s.unAggregatedBySlot {
+			if slot > cutoff {
+				continue
+			}
+			for _, atts := range committees {
+				for r := range atts {
+					s.deleteUnaggregatedAtt(r)
+					collected++
+				}
+			}
+		}
+		s.unAggregateAttLock.Unlock()
+
+		s.aggregatedAttLock.Lock()
+		for slot, roots := range s.aggregatedBySlot {
+			if slot > cutoff {
+				continue
+			}
+			for r := range roots {
+				collected += len(s.aggregatedAtt[r])
+				s.deleteAggregatedAtts(r)
+			}
+		}
+		s.aggregatedAttLock.Unlock()
+	}
+
+	p.blockAttLock.Lock()
+	for r, atts := range p.blockAtt {
+		if atts[0].Data.Slot <= cutoff {
+			delete(p.blockAtt, r)
+			collected += len(atts)
+		}
+	}
+	p.blockAttLock.Unlock()
+
+	p.forkchoiceAttLock.Lock()
+	for r, att := range p.forkchoiceAtt {
+		if att.Data.Slot <= cutoff {
+			p.deleteForkchoiceAtt(r)
+			collected++
+		}
+	}
+	p.forkchoiceAttLock.Unlock()
+
+	collectedExpiredAtts.Add(float64(collected))
+	return collected
+}
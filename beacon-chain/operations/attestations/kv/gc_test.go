@@ -0,0 +1,69 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func saveAttsOfSlots(t *testing.T, cache *AttCaches, slots ...uint64) {
+	for _, slot := range slots {
+		require.NoError(t, cache.SaveUnaggregatedAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: slot}, AggregationBits: bitfield.Bitlist{0b1001}}))
+		att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: slot}, AggregationBits: bitfield.Bitlist{0b1101}}
+		require.NoError(t, cache.SaveAggregatedAttestation(att))
+		require.NoError(t, cache.SaveBlockAttestation(att))
+		require.NoError(t, cache.SaveForkchoiceAttestation(att))
+	}
+}
+
+func TestKV_CollectExpiredAttestations(t *testing.T) {
+	cache := NewAttCachesWithConfig(&Config{RetentionSlots: 4})
+	saveAttsOfSlots(t, cache, 1, 2, 3)
+
+	assert.Equal(t, 0, cache.CollectExpiredAttestations(3))
+	// The attestations of slots 1 and 2 are collected at slot 6.
+	assert.Equal(t, 8, cache.CollectExpiredAttestations(6))
+	for _, atts := range [][]*ethpb.Attestation{
+		cache.AggregatedAttestations(),
+		cache.BlockAttestations(),
+		cache.ForkchoiceAttestations(),
+	} {
+		require.Equal(t, 1, len(atts))
+		assert.Equal(t, uint64(3), atts[0].Data.Slot)
+	}
+	atts, err := cache.UnaggregatedAttestations()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.Equal(t, uint64(3), atts[0].Data.Slot)
+	assert.Equal(t, 0, len(cache.UnaggregatedAttestationsBySlotIndex(1, 0)))
+}
+
+func TestKV_StartGC(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cache := NewAttCaches()
+	saveAttsOfSlots(t, cache, 0, cfg.SlotsPerEpoch)
+	// The attestations of slot 0 expire at the next slot.
+	genesisTime := uint64(roughtime.Now().Unix()) - cfg.SlotsPerEpoch + 1
+	cache.StartGC(ctx, genesisTime)
+	for cache.AggregatedAttestationCount() != 1 {
+		select {
+		case <-ctx.Done():
+			t.Fatal("Expired attestations were not collected")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+}
@@ -0,0 +1,68 @@
+package kv
+
+import (
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// dataRootCacheSize is the number of attestation data roots memoized by the pool.
+const dataRootCacheSize = 1 << 12
+
+// HashFunc computes the roots the pool keys attestations and attestation data by.
+type HashFunc func(proto.Message) ([32]byte, error)
+
+// dataRootKey holds the fields of an attestation data, so that equal data share their memoized
+// root whichever object holds them. Roots are kept as strings and checkpoints flagged as nil as
+// nil and empty fields may hash differently.
+type dataRootKey struct {
+	slot            uint64
+	committeeIndex  uint64
+	beaconBlockRoot string
+	hasSource       bool
+	sourceEpoch     uint64
+	sourceRoot      string
+	hasTarget       bool
+	targetEpoch     uint64
+	targetRoot      string
+}
+
+func newDataRootKey(data *ethpb.AttestationData) dataRootKey {
+	key := dataRootKey{
+		slot:            data.Slot,
+		committeeIndex:  data.CommitteeIndex,
+		beaconBlockRoot: string(data.BeaconBlockRoot),
+	}
+	if data.Source != nil {
+		key.hasSource = true
+		key.sourceEpoch = data.Source.Epoch
+		key.sourceRoot = string(data.Source.Root)
+	}
+	if data.Target != nil {
+		key.hasTarget = true
+		key.targetEpoch = data.Target.Epoch
+		key.targetRoot = string(data.Target.Root)
+	}
+	return key
+}
+
+// hash returns the root of the message with the hash function of the pool. Attestation data roots
+// are memoized, as the same data is hashed on every save, lookup and deletion of its attestations.
+func (p *AttCaches) hash(msg proto.Message) ([32]byte, error) {
+	data, ok := msg.(*ethpb.AttestationData)
+	if !ok || data == nil {
+		return p.hashFunc(msg)
+	}
+	key := newDataRootKey(data)
+	if r, ok := p.dataRoots.Get(key); ok {
+		dataRootCacheHits.Inc()
+		return r.([32]byte), nil
+	}
+	dataRootCacheMisses.Inc()
+	r, err := p.hashFunc(msg)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	p.dataRoots.Add(key, r)
+	return r, nil
+}
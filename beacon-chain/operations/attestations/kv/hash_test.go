@@ -0,0 +1,47 @@
+package kv
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAttCaches_Hash_MemoizesDataRoots(t *testing.T) {
+	calls := 0
+	cache := NewAttCachesWithConfig(&Config{HashFunc: func(msg proto.Message) ([32]byte, error) {
+		calls++
+		return hashFn(msg)
+	}})
+
+	data := &ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{Epoch: 1}}
+	want, err := hashFn(data)
+	require.NoError(t, err)
+	r, err := cache.hash(data)
+	require.NoError(t, err)
+	assert.Equal(t, want, r)
+	// Equal data held by another object share the memoized root.
+	r, err = cache.hash(&ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{Epoch: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, want, r)
+	assert.Equal(t, 1, calls)
+
+	// Nil and empty checkpoints hash differently and are not mixed up.
+	want, err = hashFn(&ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{Epoch: 1}, Source: &ethpb.Checkpoint{}})
+	require.NoError(t, err)
+	r, err = cache.hash(&ethpb.AttestationData{Slot: 1, Target: &ethpb.Checkpoint{Epoch: 1}, Source: &ethpb.Checkpoint{}})
+	require.NoError(t, err)
+	assert.Equal(t, want, r)
+	assert.Equal(t, 2, calls)
+
+	// Attestation roots are not memoized.
+	att := &ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0b101}}
+	_, err = cache.hash(att)
+	require.NoError(t, err)
+	_, err = cache.hash(att)
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
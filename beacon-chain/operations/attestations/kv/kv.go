@@ -5,43 +5,153 @@ package kv
 
 import (
 	"sync"
-	"time"
 
-	"github.com/patrickmn/go-cache"
+	lru "github.com/hashicorp/golang-lru"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
-var hashFn = hashutil.HashProto
+// hashFn is the default hash function of the pool.
+var hashFn HashFunc = hashutil.HashProto
+
+// AggregationMode defines when unaggregated attestations of the same data are aggregated.
+type AggregationMode string
+
+const (
+	// LazyAggregation defers the aggregation to the aggregation routine and duties.
+	LazyAggregation AggregationMode = "lazy"
+	// EagerAggregation greedily aggregates an unaggregated attestation with the ones of the same
+	// data as soon as it's saved.
+	EagerAggregation AggregationMode = "eager"
+)
+
+// Config options for the attestation caches.
+type Config struct {
+	// MaxSize is the maximum number of unaggregated and of aggregated attestation keys, once
+	// full the attestations of the oldest slot are evicted first. The caches are unbounded if 0.
+	MaxSize int
+	// AggregationMode defines when unaggregated attestations are aggregated, lazily if unset.
+	AggregationMode AggregationMode
+	// RetentionSlots is the number of slots the attestations are kept in the pool for before they
+	// are collected, an epoch if 0.
+	RetentionSlots uint64
+	// OperationNotifier receives the slashable attestations rejected by the pool.
+	OperationNotifier operation.Notifier
+	// HashFunc computes the roots of attestations and attestation data, the protobuf encoding
+	// is hashed if unset.
+	HashFunc HashFunc
+	// Shards is the number of shards the unaggregated and aggregated attestations are split in
+	// by committee index, each locked separately, 1 if 0. The maximum size is split evenly
+	// between the shards.
+	Shards int
+}
 
 // AttCaches defines the caches used to satisfy attestation pool interface.
 // These caches are KV store for various attestations
 // such are unaggregated, aggregated or attestations within a block.
 type AttCaches struct {
+	shards              []*attShard
+	forkchoiceAttLock   sync.RWMutex
+	forkchoiceAtt       map[[32]byte]*ethpb.Attestation
+	forkchoiceByTarget  map[uint64]map[[32]byte]*ethpb.Attestation // target epoch -> attestations.
+	blockAttLock        sync.RWMutex
+	blockAtt            map[[32]byte][]*ethpb.Attestation
+	seenAtt             *seenBitsCache
+	subscriptionsLock   sync.RWMutex
+	subscriptions       map[*subscription]bool
+	blockSubscriptions  map[*subscription]bool
+	maxSize             int // Maximum number of unaggregated and of aggregated attestation keys of a shard, 0 if unbounded.
+	aggregationMode     AggregationMode
+	slashingCheckerLock sync.RWMutex
+	slashingChecker     SlashingChecker
+	operationNotifier   operation.Notifier
+	retentionSlots      uint64
+	gcLock              sync.Mutex
+	gcStarted           bool
+	genesisTime         uint64 // Accessed atomically, 0 until the garbage collection started.
+	hashFunc            HashFunc
+	dataRoots           *lru.Cache
+}
+
+// attShard holds the unaggregated and aggregated attestations of the committees mapped to the
+// shard, so that the attestations of different committees don't contend on the same locks.
+type attShard struct {
 	aggregatedAttLock  sync.RWMutex
 	aggregatedAtt      map[[32]byte][]*ethpb.Attestation
+	aggregatedBySlot   map[uint64]map[[32]byte]bool // slot -> data roots of the aggregated attestations.
 	unAggregateAttLock sync.RWMutex
 	unAggregatedAtt    map[[32]byte]*ethpb.Attestation
-	forkchoiceAttLock  sync.RWMutex
-	forkchoiceAtt      map[[32]byte]*ethpb.Attestation
-	blockAttLock       sync.RWMutex
-	blockAtt           map[[32]byte][]*ethpb.Attestation
-	seenAtt            *cache.Cache
+	unAggregatedBySlot map[uint64]map[uint64]map[[32]byte]*ethpb.Attestation // slot -> committee index -> attestations.
 }
 
 // NewAttCaches initializes a new attestation pool consists of multiple KV store in cache for
 // various kind of attestations.
 func NewAttCaches() *AttCaches {
-	secsInEpoch := time.Duration(params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot)
-	c := cache.New(secsInEpoch*time.Second, 2*secsInEpoch*time.Second)
+	return NewAttCachesWithConfig(&Config{})
+}
+
+// NewAttCachesWithConfig initializes a new attestation pool with the config options.
+func NewAttCachesWithConfig(cfg *Config) *AttCaches {
+	retentionSlots := cfg.RetentionSlots
+	if retentionSlots == 0 {
+		retentionSlots = params.BeaconConfig().SlotsPerEpoch
+	}
+	hashFunc := cfg.HashFunc
+	if hashFunc == nil {
+		hashFunc = hashFn
+	}
+	shards := cfg.Shards
+	if shards <= 0 {
+		shards = 1
+	}
+	maxSize := cfg.MaxSize
+	if maxSize > 0 {
+		maxSize = (maxSize + shards - 1) / shards
+	}
+	dataRoots, err := lru.New(dataRootCacheSize)
+	if err != nil {
+		// The cache size is a positive constant.
+		panic(err)
+	}
 	pool := &AttCaches{
-		unAggregatedAtt: make(map[[32]byte]*ethpb.Attestation),
-		aggregatedAtt:   make(map[[32]byte][]*ethpb.Attestation),
-		forkchoiceAtt:   make(map[[32]byte]*ethpb.Attestation),
-		blockAtt:        make(map[[32]byte][]*ethpb.Attestation),
-		seenAtt:         c,
+		shards:             make([]*attShard, shards),
+		forkchoiceAtt:      make(map[[32]byte]*ethpb.Attestation),
+		forkchoiceByTarget: make(map[uint64]map[[32]byte]*ethpb.Attestation),
+		blockAtt:           make(map[[32]byte][]*ethpb.Attestation),
+		seenAtt:            newSeenBitsCache(),
+		subscriptions:      make(map[*subscription]bool),
+		blockSubscriptions: make(map[*subscription]bool),
+		maxSize:            maxSize,
+		aggregationMode:    cfg.AggregationMode,
+		operationNotifier:  cfg.OperationNotifier,
+		retentionSlots:     retentionSlots,
+		hashFunc:           hashFunc,
+		dataRoots:          dataRoots,
+	}
+	for i := range pool.shards {
+		pool.shards[i] = &attShard{
+			aggregatedAtt:      make(map[[32]byte][]*ethpb.Attestation),
+			aggregatedBySlot:   make(map[uint64]map[[32]byte]bool),
+			unAggregatedAtt:    make(map[[32]byte]*ethpb.Attestation),
+			unAggregatedBySlot: make(map[uint64]map[uint64]map[[32]byte]*ethpb.Attestation),
+		}
 	}
 
 	return pool
 }
+
+// shard returns the shard of the attestations of the committee.
+func (p *AttCaches) shard(committeeIndex uint64) *attShard {
+	return p.shards[committeeIndex%uint64(len(p.shards))]
+}
+
+// dataShard returns the shard of the attestations of the data's committee, attestations without
+// data are kept in the first shard.
+func (p *AttCaches) dataShard(data *ethpb.AttestationData) *attShard {
+	if data == nil {
+		return p.shards[0]
+	}
+	return p.shard(data.CommitteeIndex)
+}
@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestKV_Shards(t *testing.T) {
+	cache := NewAttCachesWithConfig(&Config{Shards: 4, MaxSize: 10})
+	require.Equal(t, 4, len(cache.shards))
+	assert.Equal(t, 3, cache.maxSize, "Expected the maximum size to be split between the shards")
+
+	for committee := uint64(0); committee < 8; committee++ {
+		data := &ethpb.AttestationData{Slot: 1, CommitteeIndex: committee}
+		require.NoError(t, cache.SaveUnaggregatedAttestation(&ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0b101}}))
+		require.NoError(t, cache.SaveAggregatedAttestation(&ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0b1011}}))
+	}
+	for i, s := range cache.shards {
+		assert.Equal(t, 2, len(s.unAggregatedAtt), "Wrong number of unaggregated attestations in shard %d", i)
+		assert.Equal(t, 2, len(s.aggregatedAtt), "Wrong number of aggregated attestations in shard %d", i)
+	}
+
+	unaggregated, err := cache.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.Equal(t, 8, len(unaggregated))
+	assert.Equal(t, 8, cache.UnaggregatedAttestationCount())
+	assert.Equal(t, 8, len(cache.AggregatedAttestations()))
+	assert.Equal(t, 8, cache.AggregatedAttestationCount())
+	for committee := uint64(0); committee < 8; committee++ {
+		assert.Equal(t, 1, len(cache.UnaggregatedAttestationsBySlotIndex(1, committee)))
+		assert.Equal(t, 1, len(cache.AggregatedAttestationsBySlotIndex(1, committee)))
+	}
+
+	claimed, err := cache.ClaimAggregatedAttestations(1)
+	require.NoError(t, err)
+	assert.Equal(t, 8, len(claimed))
+	assert.Equal(t, 0, cache.AggregatedAttestationCount())
+	assert.Equal(t, 16, cache.CollectExpiredAttestations(1+cache.retentionSlots), "Expected the unaggregated and block attestations to be collected")
+	assert.Equal(t, 0, cache.UnaggregatedAttestationCount())
+}
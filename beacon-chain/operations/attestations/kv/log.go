@@ -0,0 +1,7 @@
+package kv
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "pool/attestations/kv")
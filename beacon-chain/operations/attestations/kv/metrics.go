@@ -0,0 +1,91 @@
+package kv
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+var (
+	evictedAggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "evicted_aggregated_atts_total",
+		Help: "The number of aggregated attestations evicted from or dropped by the full pool.",
+	})
+	evictedUnaggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "evicted_unaggregated_atts_total",
+		Help: "The number of unaggregated attestations evicted from or dropped by the full pool.",
+	})
+	rejectedSlashableAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rejected_slashable_atts_total",
+		Help: "The number of slashable attestations rejected by the pool.",
+	})
+	collectedExpiredAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "collected_expired_atts_total",
+		Help: "The number of expired attestations collected from the pool.",
+	})
+	dataRootCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "att_data_root_cache_hits_total",
+		Help: "The number of attestation data roots found memoized by the pool.",
+	})
+	dataRootCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "att_data_root_cache_misses_total",
+		Help: "The number of attestation data roots hashed by the pool.",
+	})
+	savedAttAge = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "saved_att_age_seconds",
+		Help:    "The time between the start of the slot of the attestations and their save in the pool.",
+		Buckets: []float64{1, 2, 4, 6, 12, 24, 48, 96, 192, 384},
+	})
+	aggregateCoverage = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "saved_aggregate_coverage_ratio",
+		Help:    "The ratio of the committee members included in the aggregated attestations saved in the pool.",
+		Buckets: prometheus.LinearBuckets(0.1, 0.1, 10),
+	})
+)
+
+// observeSavedAtt records the age and, for aggregated attestations, the committee coverage of the
+// attestation saved in the pool. The age is only known once the garbage collection started, and
+// for attestations with data.
+func (p *AttCaches) observeSavedAtt(att *ethpb.Attestation, aggregated bool) {
+	if aggregated && att.AggregationBits.Len() > 0 {
+		aggregateCoverage.Observe(float64(att.AggregationBits.Count()) / float64(att.AggregationBits.Len()))
+	}
+	genesisTime := atomic.LoadUint64(&p.genesisTime)
+	if genesisTime == 0 || att.Data == nil {
+		return
+	}
+	slotStart := int64(genesisTime + att.Data.Slot*params.BeaconConfig().SecondsPerSlot)
+	if age := roughtime.Now().Unix() - slotStart; age >= 0 {
+		savedAttAge.Observe(float64(age))
+	}
+}
+
+// AttestationCountsBySlot returns the number of unaggregated and of aggregated attestations in
+// cache by slot.
+func (p *AttCaches) AttestationCountsBySlot() (map[uint64]int, map[uint64]int) {
+	unaggregated := make(map[uint64]int)
+	aggregated := make(map[uint64]int)
+	for _, s := range p.shards {
+		s.unAggregateAttLock.RLock()
+		for slot, committees := range s.unAggregatedBySlot {
+			for _, atts := range committees {
+				unaggregated[slot] += len(atts)
+			}
+		}
+		s.unAggregateAttLock.RUnlock()
+
+		s.aggregatedAttLock.RLock()
+		for slot, roots := range s.aggregatedBySlot {
+			for r := range roots {
+				aggregated[slot] += len(s.aggregatedAtt[r])
+			}
+		}
+		s.aggregatedAttLock.RUnlock()
+	}
+
+	return unaggregated, aggregated
+}
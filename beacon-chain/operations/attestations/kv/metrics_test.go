@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"sync/atomic"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestKV_AttestationCountsBySlot(t *testing.T) {
+	cache := NewAttCaches()
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1001}},
+		{Data: &ethpb.AttestationData{Slot: 1, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b1001}},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1001}},
+	}
+	require.NoError(t, cache.SaveUnaggregatedAttestations(atts))
+	require.NoError(t, cache.SaveAggregatedAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1101}}))
+
+	unaggregated, aggregated := cache.AttestationCountsBySlot()
+	assert.DeepEqual(t, map[uint64]int{1: 2, 2: 1}, unaggregated)
+	assert.DeepEqual(t, map[uint64]int{2: 1}, aggregated)
+}
+
+func TestKV_ObserveSavedAtt_NilData(t *testing.T) {
+	cache := NewAttCaches()
+	atomic.StoreUint64(&cache.genesisTime, uint64(roughtime.Now().Unix()))
+	require.NoError(t, cache.SaveUnaggregatedAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1001}}))
+	assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+}
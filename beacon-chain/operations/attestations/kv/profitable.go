@@ -0,0 +1,77 @@
+package kv
+
+import (
+	"sort"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// committeeKey identifies the committee of an attestation, the aggregation bits of attestations
+// of the same committee refer to the same validators.
+type committeeKey struct {
+	slot           uint64
+	committeeIndex uint64
+}
+
+type scoredAtt struct {
+	att     *ethpb.Attestation
+	newBits uint64
+}
+
+// ProfitableAttestations returns the aggregated attestations in cache which can be included in
+// a block on top of the state, sorted by profitability for the proposer: first by the number of
+// validators they reward which the state's pending attestations don't include yet, then by the
+// oldest slot. Attestations not rewarding any new validator are left out.
+func (p *AttCaches) ProfitableAttestations(state *stateTrie.BeaconState) []*ethpb.Attestation {
+	return SortByProfitability(state, p.AggregatedAttestations())
+}
+
+// SortByProfitability returns the attestations which can be included in a block on top of the
+// state, sorted by profitability for the proposer like ProfitableAttestations.
+func SortByProfitability(state *stateTrie.BeaconState, atts []*ethpb.Attestation) []*ethpb.Attestation {
+	included := make(map[committeeKey]bitfield.Bitlist)
+	for _, a := range append(state.PreviousEpochAttestations(), state.CurrentEpochAttestations()...) {
+		key := committeeKey{slot: a.Data.Slot, committeeIndex: a.Data.CommitteeIndex}
+		bits, ok := included[key]
+		if !ok || bits.Len() != a.AggregationBits.Len() {
+			included[key] = a.AggregationBits
+			continue
+		}
+		included[key] = bits.Or(a.AggregationBits)
+	}
+
+	stateSlot := state.Slot()
+	scored := make([]*scoredAtt, 0)
+	for _, att := range atts {
+		if att.Data.Slot+params.BeaconConfig().MinAttestationInclusionDelay > stateSlot ||
+			stateSlot > att.Data.Slot+params.BeaconConfig().SlotsPerEpoch {
+			continue
+		}
+		bits := included[committeeKey{slot: att.Data.Slot, committeeIndex: att.Data.CommitteeIndex}]
+		newBits := uint64(0)
+		for _, i := range att.AggregationBits.BitIndices() {
+			if bits.Len() != att.AggregationBits.Len() || !bits.BitAt(uint64(i)) {
+				newBits++
+			}
+		}
+		if newBits == 0 {
+			continue
+		}
+		scored = append(scored, &scoredAtt{att: att, newBits: newBits})
+	}
+
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].newBits == scored[j].newBits {
+			return scored[i].att.Data.Slot < scored[j].att.Data.Slot
+		}
+		return scored[i].newBits > scored[j].newBits
+	})
+	sorted := make([]*ethpb.Attestation, len(scored))
+	for i, s := range scored {
+		sorted[i] = s.att
+	}
+	return sorted
+}
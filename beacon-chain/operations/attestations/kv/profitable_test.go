@@ -0,0 +1,58 @@
+package kv
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestKV_ProfitableAttestations(t *testing.T) {
+	cache := NewAttCaches()
+	newAtt := func(slot uint64, root byte, bits bitfield.Bitlist) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			Data:            &ethpb.AttestationData{Slot: slot, BeaconBlockRoot: []byte{root}},
+			AggregationBits: bits,
+		}
+	}
+	// The state includes the first two validators of the committee of slot 4.
+	state, err := stateTrie.InitializeFromProto(&pb.BeaconState{
+		Slot: 10,
+		CurrentEpochAttestations: []*pb.PendingAttestation{
+			{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b10011}},
+		},
+	})
+	require.NoError(t, err)
+
+	oldest := newAtt(3, 1, bitfield.Bitlist{0b10011})
+	mostNewBits := newAtt(5, 1, bitfield.Bitlist{0b11011})
+	partiallyIncluded := newAtt(4, 2, bitfield.Bitlist{0b11110})
+	newest := newAtt(6, 1, bitfield.Bitlist{0b10011})
+	included := newAtt(4, 1, bitfield.Bitlist{0b10011})
+	tooNew := newAtt(10, 1, bitfield.Bitlist{0b11111})
+	for _, att := range []*ethpb.Attestation{oldest, mostNewBits, partiallyIncluded, newest, included, tooNew} {
+		require.NoError(t, cache.SaveAggregatedAttestation(att))
+	}
+
+	want := []*ethpb.Attestation{mostNewBits, oldest, partiallyIncluded, newest}
+	assert.DeepEqual(t, want, cache.ProfitableAttestations(state))
+}
+
+func TestSortByProfitability_NewBitsOverBitCount(t *testing.T) {
+	// The state includes the first two validators of the committee of slot 4.
+	state, err := stateTrie.InitializeFromProto(&pb.BeaconState{
+		Slot: 10,
+		CurrentEpochAttestations: []*pb.PendingAttestation{
+			{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b10011}},
+		},
+	})
+	require.NoError(t, err)
+
+	mostBits := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b10111}}
+	mostNewBits := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b11100}}
+	assert.DeepEqual(t, []*ethpb.Attestation{mostNewBits, mostBits}, SortByProfitability(state, []*ethpb.Attestation{mostBits, mostNewBits}))
+}
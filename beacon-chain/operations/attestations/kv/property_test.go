@@ -0,0 +1,105 @@
+package kv
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// poolOp is a random operation on the pool, on the attestation of the slot with the bits set.
+type poolOp struct {
+	Delete bool
+	Slot   bool
+	Bits   uint8
+}
+
+func (op *poolOp) attestation(sig []byte) *ethpb.Attestation {
+	bits := bitfield.NewBitlist(8)
+	for i := uint64(0); i < 8; i++ {
+		bits.SetBitAt(i, op.Bits&(1<<i) != 0)
+	}
+	if bits.Count() == 0 {
+		bits.SetBitAt(0, true)
+	}
+	slot := uint64(0)
+	if op.Slot {
+		slot = 1
+	}
+	return &ethpb.Attestation{
+		Data:            &ethpb.AttestationData{Slot: slot},
+		AggregationBits: bits,
+		Signature:       sig,
+	}
+}
+
+// poolSnapshot lists the slots and bits of the aggregated and unaggregated attestations in cache.
+func poolSnapshot(t *testing.T, cache *AttCaches) []string {
+	unaggregated, err := cache.UnaggregatedAttestations()
+	require.NoError(t, err)
+	snapshot := make([]string, 0)
+	for _, att := range cache.AggregatedAttestations() {
+		snapshot = append(snapshot, fmt.Sprintf("aggregated %d %#x", att.Data.Slot, att.AggregationBits))
+	}
+	for _, att := range unaggregated {
+		snapshot = append(snapshot, fmt.Sprintf("unaggregated %d %#x", att.Data.Slot, att.AggregationBits))
+	}
+	sort.Strings(snapshot)
+	return snapshot
+}
+
+func TestKV_PoolInvariants(t *testing.T) {
+	cache := NewAttCaches()
+	fuzzer := fuzz.NewWithSeed(0)
+	sig := bls.RandKey().Sign([]byte{'a'}).Marshal()
+	op := &poolOp{}
+
+	for i := 0; i < 1000; i++ {
+		fuzzer.Fuzz(op)
+		att := op.attestation(sig)
+		aggregated := helpers.IsAggregated(att)
+
+		if op.Delete {
+			if aggregated {
+				require.NoError(t, cache.DeleteAggregatedAttestation(att))
+				for _, a := range cache.AggregatedAttestationsBySlotIndex(att.Data.Slot, 0) {
+					assert.Equal(t, false, att.AggregationBits.Contains(a.AggregationBits), "Deleted attestation %#x is still listed", a.AggregationBits)
+				}
+			} else {
+				require.NoError(t, cache.DeleteUnaggregatedAttestation(att))
+				for _, a := range cache.UnaggregatedAttestationsBySlotIndex(att.Data.Slot, 0) {
+					assert.Equal(t, false, att.AggregationBits.Contains(a.AggregationBits), "Deleted attestation %#x is still listed", a.AggregationBits)
+				}
+			}
+		} else {
+			save := cache.SaveUnaggregatedAttestation
+			if aggregated {
+				save = cache.SaveAggregatedAttestation
+			}
+			require.NoError(t, save(att))
+			// Saving an attestation again changes nothing.
+			snapshot := poolSnapshot(t, cache)
+			require.NoError(t, save(att))
+			assert.DeepEqual(t, snapshot, poolSnapshot(t, cache), "Saving attestation %#x is not idempotent", att.AggregationBits)
+		}
+
+		// No aggregate is eclipsed by another of the same data.
+		for slot := uint64(0); slot < 2; slot++ {
+			atts := cache.AggregatedAttestationsBySlotIndex(slot, 0)
+			for j, a := range atts {
+				for k, b := range atts {
+					if j != k && a.AggregationBits.Contains(b.AggregationBits) {
+						t.Fatalf("Aggregated attestation %#x of slot %d is eclipsed by %#x", b.AggregationBits, slot, a.AggregationBits)
+					}
+				}
+			}
+		}
+	}
+}
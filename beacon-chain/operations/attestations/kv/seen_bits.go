@@ -1,50 +1,105 @@
 package kv
 
 import (
-	"github.com/patrickmn/go-cache"
-	"github.com/pkg/errors"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 )
 
+const (
+	// seenBitsShards is the number of shards of the seen bits cache, locked separately.
+	seenBitsShards = 16
+	// seenBitsShardSize is the number of attestation data roots kept in each shard, the roots
+	// least recently used are evicted first.
+	seenBitsShardSize = 1 << 10
+)
+
+// seenBitsCache keeps the union of the aggregation bits of the attestations seen by attestation
+// data root and committee size, in size bounded LRU shards so that it doesn't grow with the number
+// of attestations received. Checking if bits were seen is a single containment check of the union.
+type seenBitsCache struct {
+	shards [seenBitsShards]*seenBitsShard
+}
+
+type seenBitsShard struct {
+	lock sync.Mutex
+	lru  *simplelru.LRU
+}
+
+// seenBits are the unions of the seen aggregation bits of a data root, by bitlist length.
+type seenBits map[uint64]bitfield.Bitlist
+
+func newSeenBitsCache() *seenBitsCache {
+	c := &seenBitsCache{}
+	for i := range c.shards {
+		lru, err := simplelru.NewLRU(seenBitsShardSize, nil)
+		if err != nil {
+			// The shard size is a positive constant.
+			panic(err)
+		}
+		c.shards[i] = &seenBitsShard{lru: lru}
+	}
+	return c
+}
+
+func (c *seenBitsCache) shard(r [32]byte) *seenBitsShard {
+	return c.shards[r[0]%seenBitsShards]
+}
+
+// insert adds the bits to the union of the seen bits of the data root with the same length.
+func (c *seenBitsCache) insert(r [32]byte, bits bitfield.Bitlist) {
+	s := c.shard(r)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var seen seenBits
+	if v, ok := s.lru.Get(r); ok {
+		seen = v.(seenBits)
+	} else {
+		seen = make(seenBits)
+		s.lru.Add(r, seen)
+	}
+	union, ok := seen[bits.Len()]
+	if !ok {
+		seen[bits.Len()] = bits
+		return
+	}
+	if !union.Contains(bits) {
+		seen[bits.Len()] = union.Or(bits)
+	}
+}
+
+// contains returns true if the bits are contained by the union of the seen bits of the data root.
+func (c *seenBitsCache) contains(r [32]byte, bits bitfield.Bitlist) bool {
+	s := c.shard(r)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	v, ok := s.lru.Get(r)
+	if !ok {
+		return false
+	}
+	union, ok := v.(seenBits)[bits.Len()]
+	return ok && union.Contains(bits)
+}
+
 func (p *AttCaches) insertSeenBit(att *ethpb.Attestation) error {
-	r, err := hashFn(att.Data)
+	r, err := p.hash(att.Data)
 	if err != nil {
 		return err
 	}
 
-	v, ok := p.seenAtt.Get(string(r[:]))
-	if ok {
-		seenBits, ok := v.([]bitfield.Bitlist)
-		if !ok {
-			return errors.New("could not convert to bitlist type")
-		}
-		seenBits = append(seenBits, att.AggregationBits)
-		p.seenAtt.Set(string(r[:]), seenBits, cache.DefaultExpiration /* one epoch */)
-		return nil
-	}
-
-	p.seenAtt.Set(string(r[:]), []bitfield.Bitlist{att.AggregationBits}, cache.DefaultExpiration /* one epoch */)
+	p.seenAtt.insert(r, att.AggregationBits)
 	return nil
 }
 
 func (p *AttCaches) hasSeenBit(att *ethpb.Attestation) (bool, error) {
-	r, err := hashFn(att.Data)
+	r, err := p.hash(att.Data)
 	if err != nil {
 		return false, err
 	}
 
-	v, ok := p.seenAtt.Get(string(r[:]))
-	if ok {
-		seenBits, ok := v.([]bitfield.Bitlist)
-		if !ok {
-			return false, errors.New("could not convert to bitlist type")
-		}
-		for _, bit := range seenBits {
-			if bit.Len() == att.AggregationBits.Len() && bit.Contains(att.AggregationBits) {
-				return true, nil
-			}
-		}
-	}
-	return false, nil
+	return p.seenAtt.contains(r, att.AggregationBits), nil
 }
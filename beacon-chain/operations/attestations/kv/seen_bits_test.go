@@ -5,6 +5,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -34,3 +35,19 @@ func TestAttCaches_hasSeenBit(t *testing.T) {
 		}
 	}
 }
+
+func TestSeenBitsCache_Insert(t *testing.T) {
+	c := newSeenBitsCache()
+	r := [32]byte{'a'}
+	c.insert(r, bitfield.Bitlist{0b10000011})
+	// Bits contained by seen bits leave the union unchanged.
+	c.insert(r, bitfield.Bitlist{0b10000001})
+	assert.Equal(t, false, c.contains(r, bitfield.Bitlist{0b10000100}))
+	// Bits of a different length are kept apart.
+	c.insert(r, bitfield.Bitlist{0b1010})
+	c.insert(r, bitfield.Bitlist{0b11000100})
+	assert.Equal(t, true, c.contains(r, bitfield.Bitlist{0b11000111}), "Union of the seen bits should be contained")
+	assert.Equal(t, true, c.contains(r, bitfield.Bitlist{0b1010}))
+	assert.Equal(t, false, c.contains(r, bitfield.Bitlist{0b1001}))
+	assert.Equal(t, false, c.contains([32]byte{'b'}, bitfield.Bitlist{0b10000001}))
+}
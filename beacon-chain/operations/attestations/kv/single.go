@@ -0,0 +1,109 @@
+package kv
+
+import (
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// SingleAttestation is the attestation of a single attester, identified by its validator index
+// instead of its aggregation bit, like the single attestations gossiped from Electra on. It is
+// saved in the pool as the unaggregated attestation of the attester's committee.
+type SingleAttestation struct {
+	CommitteeIndex uint64
+	AttesterIndex  uint64
+	Data           *ethpb.AttestationData
+	Signature      []byte
+}
+
+// ToAttestation converts the single attestation to the unaggregated attestation of the committee,
+// with the aggregation bit of the attester set. The committee is the list of the validator indices
+// of the attestation's committee. The committee index of the data is 0 in the single attestations
+// gossiped from Electra on, it is then filled in from the committee index of the attestation.
+func (s *SingleAttestation) ToAttestation(committee []uint64) (*ethpb.Attestation, error) {
+	if s.Data == nil {
+		return nil, errors.New("nil attestation data")
+	}
+	data := s.Data
+	if data.CommitteeIndex == 0 && s.CommitteeIndex != 0 {
+		data = stateTrie.CopyAttestationData(s.Data)
+		data.CommitteeIndex = s.CommitteeIndex
+	}
+	if data.CommitteeIndex != s.CommitteeIndex {
+		return nil, errors.Errorf("committee index %d does not match attestation data committee index %d", s.CommitteeIndex, data.CommitteeIndex)
+	}
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	found := false
+	for i, idx := range committee {
+		if idx == s.AttesterIndex {
+			bits.SetBitAt(uint64(i), true)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.Errorf("attester %d is not in the committee", s.AttesterIndex)
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            data,
+		Signature:       bytesutil.SafeCopyBytes(s.Signature),
+	}, nil
+}
+
+// SingleAttestationFromAttestation converts an unaggregated attestation of the committee to the
+// single attestation of its attester. The attestation must have exactly one aggregation bit set.
+func SingleAttestationFromAttestation(att *ethpb.Attestation, committee []uint64) (*SingleAttestation, error) {
+	if att == nil || att.Data == nil {
+		return nil, errors.New("nil attestation")
+	}
+	if att.AggregationBits.Len() != uint64(len(committee)) {
+		return nil, errors.Errorf("aggregation bits length %d does not match committee size %d", att.AggregationBits.Len(), len(committee))
+	}
+	indices := att.AggregationBits.BitIndices()
+	if len(indices) != 1 {
+		return nil, errors.Errorf("attestation has %d aggregation bits set, wanted 1", len(indices))
+	}
+	return &SingleAttestation{
+		CommitteeIndex: att.Data.CommitteeIndex,
+		AttesterIndex:  committee[indices[0]],
+		Data:           att.Data,
+		Signature:      bytesutil.SafeCopyBytes(att.Signature),
+	}, nil
+}
+
+// SaveSingleAttestation saves a single attestation in cache as the unaggregated attestation of
+// the committee.
+func (p *AttCaches) SaveSingleAttestation(s *SingleAttestation, committee []uint64) error {
+	if s == nil {
+		return nil
+	}
+	att, err := s.ToAttestation(committee)
+	if err != nil {
+		return errors.Wrap(err, "could not convert single attestation")
+	}
+	return p.SaveUnaggregatedAttestation(att)
+}
+
+// SingleAttestationsBySlotIndex returns the unaggregated attestations in cache of the committee
+// at the slot and committee index, as single attestations. The attestations which can't be
+// converted, e.g. with another committee size, are skipped.
+func (p *AttCaches) SingleAttestationsBySlotIndex(slot uint64, committeeIndex uint64, committee []uint64) []*SingleAttestation {
+	atts := p.UnaggregatedAttestationsBySlotIndex(slot, committeeIndex)
+	singles := make([]*SingleAttestation, 0, len(atts))
+	for _, att := range atts {
+		s, err := SingleAttestationFromAttestation(att, committee)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"slot":           slot,
+				"committeeIndex": committeeIndex,
+			}).Debug("Skipping attestation which could not be converted to a single attestation")
+			continue
+		}
+		singles = append(singles, s)
+	}
+	return singles
+}
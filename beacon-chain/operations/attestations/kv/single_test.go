@@ -0,0 +1,77 @@
+package kv
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSingleAttestation_ToAttestation(t *testing.T) {
+	committee := []uint64{7, 3, 9}
+	data := &ethpb.AttestationData{Slot: 1, CommitteeIndex: 2}
+	s := &SingleAttestation{CommitteeIndex: 2, AttesterIndex: 3, Data: data, Signature: []byte{'a'}}
+
+	att, err := s.ToAttestation(committee)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bitfield.Bitlist{0b1010}, att.AggregationBits)
+	assert.DeepEqual(t, []byte{'a'}, att.Signature)
+
+	back, err := SingleAttestationFromAttestation(att, committee)
+	require.NoError(t, err)
+	assert.DeepEqual(t, s, back)
+
+	_, err = (&SingleAttestation{CommitteeIndex: 2, AttesterIndex: 4, Data: data}).ToAttestation(committee)
+	assert.ErrorContains(t, "attester 4 is not in the committee", err)
+	_, err = (&SingleAttestation{CommitteeIndex: 1, AttesterIndex: 3, Data: data}).ToAttestation(committee)
+	assert.ErrorContains(t, "does not match attestation data committee index", err)
+}
+
+func TestSingleAttestationFromAttestation_Invalid(t *testing.T) {
+	committee := []uint64{7, 3, 9}
+	data := &ethpb.AttestationData{Slot: 1}
+
+	_, err := SingleAttestationFromAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1011}, Data: data}, committee)
+	assert.ErrorContains(t, "attestation has 2 aggregation bits set, wanted 1", err)
+	_, err = SingleAttestationFromAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b101}, Data: data}, committee)
+	assert.ErrorContains(t, "does not match committee size", err)
+	_, err = SingleAttestationFromAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1001}}, committee)
+	assert.ErrorContains(t, "nil attestation", err)
+}
+
+func TestKV_SaveSingleAttestation(t *testing.T) {
+	cache := NewAttCaches()
+	committee := []uint64{7, 3, 9}
+	data := &ethpb.AttestationData{Slot: 1, CommitteeIndex: 2}
+	s1 := &SingleAttestation{CommitteeIndex: 2, AttesterIndex: 7, Data: data, Signature: make([]byte, 96)}
+	s2 := &SingleAttestation{CommitteeIndex: 2, AttesterIndex: 9, Data: data, Signature: make([]byte, 96)}
+
+	require.NoError(t, cache.SaveSingleAttestation(s1, committee))
+	require.NoError(t, cache.SaveSingleAttestation(s2, committee))
+	assert.Equal(t, 2, cache.UnaggregatedAttestationCount())
+
+	singles := cache.SingleAttestationsBySlotIndex(1, 2, committee)
+	require.Equal(t, 2, len(singles))
+	attesters := map[uint64]bool{singles[0].AttesterIndex: true, singles[1].AttesterIndex: true}
+	assert.DeepEqual(t, map[uint64]bool{7: true, 9: true}, attesters)
+
+	assert.ErrorContains(t, "could not convert single attestation", cache.SaveSingleAttestation(&SingleAttestation{CommitteeIndex: 2, AttesterIndex: 1, Data: data}, committee))
+
+	// Attestations which can't be converted for the committee are skipped.
+	require.NoError(t, cache.SaveUnaggregatedAttestation(&ethpb.Attestation{Data: data, AggregationBits: bitfield.Bitlist{0b101}, Signature: make([]byte, 96)}))
+	assert.Equal(t, 2, len(cache.SingleAttestationsBySlotIndex(1, 2, committee)))
+}
+
+func TestSingleAttestation_ToAttestation_ZeroDataIndex(t *testing.T) {
+	committee := []uint64{7, 3, 9}
+	data := &ethpb.AttestationData{Slot: 1}
+	s := &SingleAttestation{CommitteeIndex: 2, AttesterIndex: 9, Data: data}
+
+	att, err := s.ToAttestation(committee)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), att.Data.CommitteeIndex)
+	assert.Equal(t, uint64(0), data.CommitteeIndex, "Expected the single attestation data to be left as is")
+	assert.DeepEqual(t, bitfield.Bitlist{0b1100}, att.AggregationBits)
+}
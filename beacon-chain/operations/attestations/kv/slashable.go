@@ -0,0 +1,64 @@
+package kv
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// SlashingChecker checks the attestations saved in the pool against the attestations already
+// seen from the same validators.
+type SlashingChecker interface {
+	// CheckAttestation returns the attester slashings the attestation makes with the attestations
+	// already seen, as double or surround votes, or none if it's not slashable.
+	CheckAttestation(att *ethpb.Attestation) ([]*ethpb.AttesterSlashing, error)
+}
+
+// SetSlashingChecker sets the checker the attestations are checked with before they are saved.
+// Slashable attestations are rejected and sent to the operation feed instead.
+func (p *AttCaches) SetSlashingChecker(checker SlashingChecker) {
+	p.slashingCheckerLock.Lock()
+	defer p.slashingCheckerLock.Unlock()
+	p.slashingChecker = checker
+}
+
+// rejectSlashable sends the slashings of a slashable attestation to the operation feed, and returns
+// true if every attester of the attestation is slashable. Aggregates with only some slashable
+// attesters are still saved, as the votes of the other attesters cannot be split off the aggregate
+// signature. Attestations are not checked if the pool has no slashing checker.
+func (p *AttCaches) rejectSlashable(att *ethpb.Attestation) (bool, error) {
+	p.slashingCheckerLock.RLock()
+	checker := p.slashingChecker
+	p.slashingCheckerLock.RUnlock()
+	if checker == nil {
+		return false, nil
+	}
+	slashings, err := checker.CheckAttestation(att)
+	if err != nil {
+		return false, err
+	}
+	if len(slashings) == 0 {
+		return false, nil
+	}
+	if p.operationNotifier != nil {
+		p.operationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.SlashableAttReceived,
+			Data: &operation.SlashableAttReceivedData{
+				Attestation: att,
+				Slashings:   slashings,
+			},
+		})
+	}
+	slashable := make(map[uint64]bool)
+	for _, slashing := range slashings {
+		for _, idx := range sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices) {
+			slashable[idx] = true
+		}
+	}
+	if uint64(len(slashable)) < att.AggregationBits.Count() {
+		return false, nil
+	}
+	rejectedSlashableAtts.Inc()
+	return true, nil
+}
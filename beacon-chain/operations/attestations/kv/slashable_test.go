@@ -0,0 +1,77 @@
+package kv
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockOperationNotifier struct {
+	feed *event.Feed
+}
+
+func (m *mockOperationNotifier) OperationFeed() *event.Feed {
+	return m.feed
+}
+
+// mockSlashingChecker reports the given validators as slashable in the attestations of each target epoch.
+type mockSlashingChecker struct {
+	slashable map[uint64][]uint64
+}
+
+func (m *mockSlashingChecker) CheckAttestation(att *ethpb.Attestation) ([]*ethpb.AttesterSlashing, error) {
+	indices, ok := m.slashable[att.Data.Target.Epoch]
+	if !ok {
+		return nil, nil
+	}
+	return []*ethpb.AttesterSlashing{{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: indices},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: indices},
+	}}, nil
+}
+
+func TestKV_RejectsSlashableAttestations(t *testing.T) {
+	notifier := &mockOperationNotifier{feed: new(event.Feed)}
+	cache := NewAttCachesWithConfig(&Config{OperationNotifier: notifier})
+	// All the attesters of epoch 1 are slashable, and one of the two attesters of epoch 3.
+	cache.SetSlashingChecker(&mockSlashingChecker{slashable: map[uint64][]uint64{1: {0, 1}, 3: {1}}})
+	events := make(chan *feed.Event, 3)
+	sub := notifier.feed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	newAtt := func(epoch uint64, bits bitfield.Bitlist) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Slot:   epoch,
+				Source: &ethpb.Checkpoint{},
+				Target: &ethpb.Checkpoint{Epoch: epoch},
+			},
+			AggregationBits: bits,
+		}
+	}
+	slashableUnaggregated := newAtt(1, bitfield.Bitlist{0b101})
+	slashableAggregated := newAtt(1, bitfield.Bitlist{0b111})
+	require.NoError(t, cache.SaveUnaggregatedAttestation(slashableUnaggregated))
+	require.NoError(t, cache.SaveAggregatedAttestation(slashableAggregated))
+	require.NoError(t, cache.SaveUnaggregatedAttestation(newAtt(2, bitfield.Bitlist{0b101})))
+	require.NoError(t, cache.SaveAggregatedAttestation(newAtt(2, bitfield.Bitlist{0b111})))
+	partlySlashable := newAtt(3, bitfield.Bitlist{0b111})
+	require.NoError(t, cache.SaveAggregatedAttestation(partlySlashable))
+
+	assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+	assert.Equal(t, 2, cache.AggregatedAttestationCount(), "Partly slashable aggregate should be saved")
+	for _, want := range []*ethpb.Attestation{slashableUnaggregated, slashableAggregated, partlySlashable} {
+		e := <-events
+		assert.Equal(t, feed.EventType(operation.SlashableAttReceived), e.Type)
+		data, ok := e.Data.(*operation.SlashableAttReceivedData)
+		require.Equal(t, true, ok, "Unexpected event data")
+		assert.DeepEqual(t, want, data.Attestation)
+		assert.Equal(t, 1, len(data.Slashings))
+	}
+}
@@ -0,0 +1,111 @@
+package kv
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+)
+
+// subscriptionBufferSize is the number of attestations buffered for each subscriber. Attestations
+// are dropped for subscribers too slow to keep up, so they never stall the pool.
+const subscriptionBufferSize = 256
+
+// SubscriptionFilter selects the attestations sent to a subscriber.
+type SubscriptionFilter func(att *ethpb.Attestation) bool
+
+// FilterBySlot selects the attestations of the slot.
+func FilterBySlot(slot uint64) SubscriptionFilter {
+	return func(att *ethpb.Attestation) bool {
+		return att.Data.Slot == slot
+	}
+}
+
+// FilterUnaggregated selects the unaggregated attestations.
+func FilterUnaggregated() SubscriptionFilter {
+	return func(att *ethpb.Attestation) bool {
+		return !helpers.IsAggregated(att)
+	}
+}
+
+// FilterByCommitteeIndex selects the attestations of the committee index.
+func FilterByCommitteeIndex(committeeIndex uint64) SubscriptionFilter {
+	return func(att *ethpb.Attestation) bool {
+		return att.Data.CommitteeIndex == committeeIndex
+	}
+}
+
+type subscription struct {
+	ch      chan *ethpb.Attestation
+	filters []SubscriptionFilter
+}
+
+// SubscribeAttestations returns a channel receiving the unaggregated and aggregated attestations
+// saved in the pool from now on, matching all the filters. The channel is closed once the context
+// is done.
+func (p *AttCaches) SubscribeAttestations(ctx context.Context, filters ...SubscriptionFilter) <-chan *ethpb.Attestation {
+	return p.subscribe(ctx, p.subscriptions, filters)
+}
+
+// SubscribeBlockAttestations returns a channel receiving the attestations of the blocks saved in
+// the block pool from now on, matching all the filters. The channel is closed once the context
+// is done.
+func (p *AttCaches) SubscribeBlockAttestations(ctx context.Context, filters ...SubscriptionFilter) <-chan *ethpb.Attestation {
+	return p.subscribe(ctx, p.blockSubscriptions, filters)
+}
+
+// subscribe adds a subscription with the filters to the subscriptions until the context is done.
+func (p *AttCaches) subscribe(ctx context.Context, subscriptions map[*subscription]bool, filters []SubscriptionFilter) <-chan *ethpb.Attestation {
+	sub := &subscription{
+		ch:      make(chan *ethpb.Attestation, subscriptionBufferSize),
+		filters: filters,
+	}
+	p.subscriptionsLock.Lock()
+	subscriptions[sub] = true
+	p.subscriptionsLock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		p.subscriptionsLock.Lock()
+		defer p.subscriptionsLock.Unlock()
+		delete(subscriptions, sub)
+		close(sub.ch)
+	}()
+	return sub.ch
+}
+
+// publish sends a copy of the attestation saved in the pool to the subscribers it matches the
+// filters of.
+func (p *AttCaches) publish(att *ethpb.Attestation) {
+	p.publishTo(p.subscriptions, att)
+}
+
+// publishBlock sends a copy of the attestation saved in the block pool to the block subscribers it
+// matches the filters of.
+func (p *AttCaches) publishBlock(att *ethpb.Attestation) {
+	p.publishTo(p.blockSubscriptions, att)
+}
+
+func (p *AttCaches) publishTo(subscriptions map[*subscription]bool, att *ethpb.Attestation) {
+	p.subscriptionsLock.RLock()
+	defer p.subscriptionsLock.RUnlock()
+	for sub := range subscriptions {
+		if !sub.matches(att) {
+			continue
+		}
+		select {
+		case sub.ch <- stateTrie.CopyAttestation(att):
+		default:
+		}
+	}
+}
+
+func (s *subscription) matches(att *ethpb.Attestation) bool {
+	for _, f := range s.filters {
+		if !f(att) {
+			return false
+		}
+	}
+	return true
+}
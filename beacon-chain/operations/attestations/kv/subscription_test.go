@@ -0,0 +1,76 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestKV_SubscribeAttestations(t *testing.T) {
+	cache := NewAttCaches()
+	ctx, cancel := context.WithCancel(context.Background())
+	all := cache.SubscribeAttestations(ctx)
+	filtered := cache.SubscribeAttestations(ctx, FilterBySlot(2), FilterByCommitteeIndex(1))
+	unaggregatedOnly := cache.SubscribeAttestations(ctx, FilterUnaggregated())
+
+	unaggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b101}}
+	aggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2, CommitteeIndex: 2}, AggregationBits: bitfield.Bitlist{0b111}}
+	require.NoError(t, cache.SaveUnaggregatedAttestation(unaggregated))
+	require.NoError(t, cache.SaveAggregatedAttestation(aggregated))
+
+	assert.DeepEqual(t, unaggregated, <-all)
+	assert.DeepEqual(t, aggregated, <-all)
+	assert.DeepEqual(t, unaggregated, <-filtered)
+	assert.DeepEqual(t, unaggregated, <-unaggregatedOnly)
+	for _, ch := range []<-chan *ethpb.Attestation{filtered, unaggregatedOnly} {
+		select {
+		case att := <-ch:
+			t.Errorf("Received attestation not matching the filters: %v", att)
+		default:
+		}
+	}
+
+	// Attestations already in the pool aren't sent again.
+	require.NoError(t, cache.SaveAggregatedAttestation(aggregated))
+	select {
+	case att := <-all:
+		t.Errorf("Received attestation already in the pool: %v", att)
+	default:
+	}
+
+	cancel()
+	_, ok := <-all
+	assert.Equal(t, false, ok, "Expected channel to be closed once the context is done")
+}
+
+func TestKV_SubscribeBlockAttestations(t *testing.T) {
+	cache := NewAttCaches()
+	ctx, cancel := context.WithCancel(context.Background())
+	blockAtts := cache.SubscribeBlockAttestations(ctx)
+	atts := cache.SubscribeAttestations(ctx)
+
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b111}}
+	require.NoError(t, cache.SaveBlockAttestation(att))
+	assert.DeepEqual(t, att, <-blockAtts)
+	select {
+	case a := <-atts:
+		t.Errorf("Received block attestation as a pool attestation: %v", a)
+	default:
+	}
+
+	// Attestations contained in the block pool aren't sent again.
+	require.NoError(t, cache.SaveBlockAttestation(att))
+	select {
+	case a := <-blockAtts:
+		t.Errorf("Received attestation already in the block pool: %v", a)
+	default:
+	}
+
+	cancel()
+	_, ok := <-blockAtts
+	assert.Equal(t, false, ok, "Expected channel to be closed once the context is done")
+}
@@ -1,13 +1,20 @@
 package kv
 
 import (
+	"math"
+
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
 )
 
+// forEachChunkSize is the number of unaggregated attestations ForEachUnaggregated reads at once
+// under the lock.
+const forEachChunkSize = 256
+
 // SaveUnaggregatedAttestation saves an unaggregated attestation in cache.
 func (p *AttCaches) SaveUnaggregatedAttestation(att *ethpb.Attestation) error {
 	if att == nil {
@@ -25,13 +32,38 @@ func (p *AttCaches) SaveUnaggregatedAttestation(att *ethpb.Attestation) error {
 		return nil
 	}
 
-	r, err := hashFn(att)
+	slashable, err := p.rejectSlashable(att)
+	if err != nil {
+		return err
+	}
+	if slashable {
+		return nil
+	}
+
+	if p.aggregationMode == EagerAggregation && att.Data != nil {
+		aggregated, err := p.aggregateOnSave(att)
+		if err != nil {
+			return err
+		}
+		if aggregated {
+			return nil
+		}
+	}
+
+	r, err := p.hash(att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
-	p.unAggregateAttLock.Lock()
-	defer p.unAggregateAttLock.Unlock()
-	p.unAggregatedAtt[r] = stateTrie.CopyAttestation(att) // Copied.
+	s := p.dataShard(att.Data)
+	s.unAggregateAttLock.Lock()
+	if _, ok := s.unAggregatedAtt[r]; !ok && !s.evictUnaggregatedAtt(att, p.maxSize) {
+		s.unAggregateAttLock.Unlock()
+		return nil
+	}
+	s.addUnaggregatedAtt(r, stateTrie.CopyAttestation(att)) // Copied.
+	s.unAggregateAttLock.Unlock()
+	p.observeSavedAtt(att, false)
+	p.publish(att)
 
 	return nil
 }
@@ -49,31 +81,33 @@ func (p *AttCaches) SaveUnaggregatedAttestations(atts []*ethpb.Attestation) erro
 
 // UnaggregatedAttestations returns all the unaggregated attestations in cache.
 func (p *AttCaches) UnaggregatedAttestations() ([]*ethpb.Attestation, error) {
-	p.unAggregateAttLock.Lock()
-	defer p.unAggregateAttLock.Unlock()
-	unAggregatedAtts := p.unAggregatedAtt
-	atts := make([]*ethpb.Attestation, 0, len(unAggregatedAtts))
-	for _, att := range unAggregatedAtts {
-		r, err := hashFn(att.Data)
+	atts := make([]*ethpb.Attestation, 0)
+	for _, s := range p.shards {
+		var err error
+		if atts, err = p.unaggregatedAttestations(s, atts); err != nil {
+			return nil, err
+		}
+	}
+
+	return atts, nil
+}
+
+// unaggregatedAttestations appends the unaggregated attestations of the shard to atts, removing
+// the seen ones from the shard.
+func (p *AttCaches) unaggregatedAttestations(s *attShard, atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
+	s.unAggregateAttLock.Lock()
+	defer s.unAggregateAttLock.Unlock()
+	for _, att := range s.unAggregatedAtt {
+		r, err := p.hash(att.Data)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not tree hash attestation")
 		}
-		v, ok := p.seenAtt.Get(string(r[:]))
-		if ok {
-			seenBits, ok := v.([]bitfield.Bitlist)
-			if !ok {
-				return nil, errors.New("could not convert to bitlist type")
-			}
-			for _, bit := range seenBits {
-				if bit.Len() == att.AggregationBits.Len() && bit.Contains(att.AggregationBits) {
-					r, err := hashFn(att)
-					if err != nil {
-						return nil, errors.Wrap(err, "could not tree hash attestation")
-					}
-					delete(p.unAggregatedAtt, r)
-					continue
-				}
+		if p.seenAtt.contains(r, att.AggregationBits) {
+			r, err := p.hash(att)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not tree hash attestation")
 			}
+			s.deleteUnaggregatedAtt(r)
 		}
 
 		atts = append(atts, stateTrie.CopyAttestation(att) /* Copied */)
@@ -82,19 +116,64 @@ func (p *AttCaches) UnaggregatedAttestations() ([]*ethpb.Attestation, error) {
 	return atts, nil
 }
 
+// ForEachUnaggregated calls fn with each unaggregated attestation in cache not seen yet, until
+// it returns false. Unlike UnaggregatedAttestations, the attestations are not copied: fn must
+// not modify them. The lock is held while reading chunks of attestations but not while calling
+// fn, so fn may use the pool. Attestations saved or deleted during the iteration may or may not
+// be visited.
+func (p *AttCaches) ForEachUnaggregated(fn func(*ethpb.Attestation) bool) error {
+	chunk := make([]*ethpb.Attestation, 0, forEachChunkSize)
+	for _, s := range p.shards {
+		s.unAggregateAttLock.RLock()
+		roots := make([][32]byte, 0, len(s.unAggregatedAtt))
+		for r := range s.unAggregatedAtt {
+			roots = append(roots, r)
+		}
+		s.unAggregateAttLock.RUnlock()
+
+		for len(roots) > 0 {
+			n := forEachChunkSize
+			if len(roots) < n {
+				n = len(roots)
+			}
+			chunk = chunk[:0]
+			s.unAggregateAttLock.RLock()
+			for _, r := range roots[:n] {
+				if att, ok := s.unAggregatedAtt[r]; ok {
+					chunk = append(chunk, att)
+				}
+			}
+			s.unAggregateAttLock.RUnlock()
+			roots = roots[n:]
+
+			for _, att := range chunk {
+				seen, err := p.hasSeenBit(att)
+				if err != nil {
+					return err
+				}
+				if seen {
+					continue
+				}
+				if !fn(att) {
+					return nil
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // UnaggregatedAttestationsBySlotIndex returns the unaggregated attestations in cache,
 // filtered by committee index and slot.
 func (p *AttCaches) UnaggregatedAttestationsBySlotIndex(slot uint64, committeeIndex uint64) []*ethpb.Attestation {
-	atts := make([]*ethpb.Attestation, 0)
-
-	p.unAggregateAttLock.RLock()
-	defer p.unAggregateAttLock.RUnlock()
+	s := p.shard(committeeIndex)
+	s.unAggregateAttLock.RLock()
+	defer s.unAggregateAttLock.RUnlock()
 
-	unAggregatedAtts := p.unAggregatedAtt
-	for _, a := range unAggregatedAtts {
-		if slot == a.Data.Slot && committeeIndex == a.Data.CommitteeIndex {
-			atts = append(atts, a)
-		}
+	committeeAtts := s.unAggregatedBySlot[slot][committeeIndex]
+	atts := make([]*ethpb.Attestation, 0, len(committeeAtts))
+	for _, a := range committeeAtts {
+		atts = append(atts, a)
 	}
 
 	return atts
@@ -113,21 +192,187 @@ func (p *AttCaches) DeleteUnaggregatedAttestation(att *ethpb.Attestation) error
 		return err
 	}
 
-	r, err := hashFn(att)
+	r, err := p.hash(att)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash attestation")
 	}
 
-	p.unAggregateAttLock.Lock()
-	defer p.unAggregateAttLock.Unlock()
-	delete(p.unAggregatedAtt, r)
+	s := p.dataShard(att.Data)
+	s.unAggregateAttLock.Lock()
+	defer s.unAggregateAttLock.Unlock()
+	s.deleteUnaggregatedAtt(r)
 
 	return nil
 }
 
+// addUnaggregatedAtt adds the attestation with the root to the shard and, unless it has no
+// data, to the slot index. The caller must hold the unaggregated attestations lock of the shard.
+func (s *attShard) addUnaggregatedAtt(r [32]byte, att *ethpb.Attestation) {
+	s.unAggregatedAtt[r] = att
+	if att.Data == nil {
+		return
+	}
+	committees, ok := s.unAggregatedBySlot[att.Data.Slot]
+	if !ok {
+		committees = make(map[uint64]map[[32]byte]*ethpb.Attestation)
+		s.unAggregatedBySlot[att.Data.Slot] = committees
+	}
+	atts, ok := committees[att.Data.CommitteeIndex]
+	if !ok {
+		atts = make(map[[32]byte]*ethpb.Attestation)
+		committees[att.Data.CommitteeIndex] = atts
+	}
+	atts[r] = att
+}
+
+// deleteUnaggregatedAtt removes the attestation with the root from the shard and from the slot
+// index, dropping the emptied index entries. The caller must hold the unaggregated attestations
+// lock of the shard.
+func (s *attShard) deleteUnaggregatedAtt(r [32]byte) {
+	att, ok := s.unAggregatedAtt[r]
+	if !ok {
+		return
+	}
+	delete(s.unAggregatedAtt, r)
+	if att.Data == nil {
+		return
+	}
+	committees := s.unAggregatedBySlot[att.Data.Slot]
+	delete(committees[att.Data.CommitteeIndex], r)
+	if len(committees[att.Data.CommitteeIndex]) == 0 {
+		delete(committees, att.Data.CommitteeIndex)
+	}
+	if len(committees) == 0 {
+		delete(s.unAggregatedBySlot, att.Data.Slot)
+	}
+}
+
+// aggregateOnSave greedily aggregates the attestation with the unaggregated attestations of the
+// same data and disjoint bits, saving the aggregate in the aggregated attestations and removing the
+// aggregated ones once it is stored. Without such unaggregated attestations, it aggregates the
+// attestation into an aggregated attestation of the same data instead. It returns false if there
+// was no attestation to aggregate with or the aggregate was not stored.
+func (p *AttCaches) aggregateOnSave(att *ethpb.Attestation) (bool, error) {
+	s := p.dataShard(att.Data)
+	s.unAggregateAttLock.Lock()
+	aggregate := att
+	var aggregated []*ethpb.Attestation
+	var aggregatedRoots [][32]byte
+	for r, a := range s.unAggregatedBySlot[att.Data.Slot][att.Data.CommitteeIndex] {
+		if a.AggregationBits.Len() != aggregate.AggregationBits.Len() ||
+			a.AggregationBits.Overlaps(aggregate.AggregationBits) || !proto.Equal(a.Data, att.Data) {
+			continue
+		}
+		agg, err := attaggregation.AggregatePair(aggregate, a)
+		if err != nil {
+			s.unAggregateAttLock.Unlock()
+			return false, errors.Wrap(err, "could not aggregate attestations")
+		}
+		aggregate = agg
+		aggregated = append(aggregated, a)
+		aggregatedRoots = append(aggregatedRoots, r)
+	}
+	s.unAggregateAttLock.Unlock()
+	if len(aggregated) == 0 {
+		return p.aggregateIntoAggregated(att)
+	}
+
+	// The aggregate is saved before the aggregated attestations are marked as seen, so they are not
+	// saved again, as the seen bits union would otherwise contain the aggregate and drop it. The
+	// aggregated attestations are kept if the aggregate is dropped.
+	saved, err := p.saveAggregated(aggregate)
+	if err != nil || !saved {
+		return false, err
+	}
+	s.unAggregateAttLock.Lock()
+	for _, r := range aggregatedRoots {
+		s.deleteUnaggregatedAtt(r)
+	}
+	s.unAggregateAttLock.Unlock()
+	for _, a := range append(aggregated, att) {
+		if err := p.insertSeenBit(a); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// aggregateIntoAggregated aggregates the attestation into the first aggregated attestation of the
+// same data with disjoint bits. It returns false if there is no such aggregated attestation.
+func (p *AttCaches) aggregateIntoAggregated(att *ethpb.Attestation) (bool, error) {
+	r, err := p.hash(att.Data)
+	if err != nil {
+		return false, errors.Wrap(err, "could not tree hash attestation data")
+	}
+	s := p.dataShard(att.Data)
+	s.aggregatedAttLock.Lock()
+	var aggregate *ethpb.Attestation
+	for i, a := range s.aggregatedAtt[r] {
+		if a.AggregationBits.Len() != att.AggregationBits.Len() || a.AggregationBits.Overlaps(att.AggregationBits) {
+			continue
+		}
+		aggregate, err = attaggregation.AggregatePair(a, att)
+		if err != nil {
+			s.aggregatedAttLock.Unlock()
+			return false, errors.Wrap(err, "could not aggregate attestations")
+		}
+		s.aggregatedAtt[r][i] = aggregate
+		break
+	}
+	s.aggregatedAttLock.Unlock()
+	if aggregate == nil {
+		return false, nil
+	}
+
+	if err := p.insertSeenBit(att); err != nil {
+		return true, err
+	}
+	p.publish(aggregate)
+	return true, nil
+}
+
+// evictUnaggregatedAtt makes room for the attestation in a shard full with the maximum size by
+// evicting an attestation of the oldest slot. It returns false if the attestation is older than the
+// ones in the shard, in which case the attestation itself is dropped. The caller must hold the
+// unaggregated attestations lock of the shard.
+func (s *attShard) evictUnaggregatedAtt(att *ethpb.Attestation, maxSize int) bool {
+	if maxSize == 0 || len(s.unAggregatedAtt) < maxSize {
+		return true
+	}
+	if len(s.unAggregatedBySlot) == 0 {
+		// Only attestations without data are left, they aren't indexed by slot.
+		evictedUnaggregatedAtts.Inc()
+		for r := range s.unAggregatedAtt {
+			s.deleteUnaggregatedAtt(r)
+			return true
+		}
+	}
+	oldestSlot := uint64(math.MaxUint64)
+	for slot := range s.unAggregatedBySlot {
+		if slot < oldestSlot {
+			oldestSlot = slot
+		}
+	}
+	if att.Data == nil || att.Data.Slot < oldestSlot {
+		return false
+	}
+	evictedUnaggregatedAtts.Inc()
+	for _, atts := range s.unAggregatedBySlot[oldestSlot] {
+		for r := range atts {
+			s.deleteUnaggregatedAtt(r)
+			return true
+		}
+	}
+	return true
+}
+
 // UnaggregatedAttestationCount returns the number of unaggregated attestations key in the pool.
 func (p *AttCaches) UnaggregatedAttestationCount() int {
-	p.unAggregateAttLock.RLock()
-	defer p.unAggregateAttLock.RUnlock()
-	return len(p.unAggregatedAtt)
+	count := 0
+	for _, s := range p.shards {
+		s.unAggregateAttLock.RLock()
+		count += len(s.unAggregatedAtt)
+		s.unAggregateAttLock.RUnlock()
+	}
+	return count
 }
@@ -1,11 +1,12 @@
 package kv
 
 import (
+	"fmt"
 	"testing"
 
-	c "github.com/patrickmn/go-cache"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -64,9 +65,9 @@ func TestKV_Unaggregated_SaveUnaggregatedAttestation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cache := NewAttCaches()
-			cache.seenAtt.Set(string(r[:]), []bitfield.Bitlist{{0xff}}, c.DefaultExpiration)
-			if len(cache.unAggregatedAtt) != 0 {
-				t.Errorf("Invalid start pool, atts: %d", len(cache.unAggregatedAtt))
+			cache.seenAtt.insert(r, bitfield.Bitlist{0xff})
+			if cache.UnaggregatedAttestationCount() != 0 {
+				t.Errorf("Invalid start pool, atts: %d", cache.UnaggregatedAttestationCount())
 			}
 
 			err := cache.SaveUnaggregatedAttestation(tt.att)
@@ -78,9 +79,6 @@ func TestKV_Unaggregated_SaveUnaggregatedAttestation(t *testing.T) {
 				t.Error(err)
 				return
 			}
-			if len(cache.unAggregatedAtt) != tt.count {
-				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, len(cache.unAggregatedAtt))
-			}
 			if cache.UnaggregatedAttestationCount() != tt.count {
 				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, cache.UnaggregatedAttestationCount())
 			}
@@ -119,8 +117,8 @@ func TestKV_Unaggregated_SaveUnaggregatedAttestations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cache := NewAttCaches()
-			if len(cache.unAggregatedAtt) != 0 {
-				t.Errorf("Invalid start pool, atts: %d", len(cache.unAggregatedAtt))
+			if cache.UnaggregatedAttestationCount() != 0 {
+				t.Errorf("Invalid start pool, atts: %d", cache.UnaggregatedAttestationCount())
 			}
 
 			err := cache.SaveUnaggregatedAttestations(tt.atts)
@@ -130,9 +128,6 @@ func TestKV_Unaggregated_SaveUnaggregatedAttestations(t *testing.T) {
 			if tt.wantErrString == "" && err != nil {
 				t.Error(err)
 			}
-			if len(cache.unAggregatedAtt) != tt.count {
-				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, len(cache.unAggregatedAtt))
-			}
 			if cache.UnaggregatedAttestationCount() != tt.count {
 				t.Errorf("Wrong attestation count, want: %d, got: %d", tt.count, cache.UnaggregatedAttestationCount())
 			}
@@ -199,3 +194,145 @@ func TestKV_Unaggregated_UnaggregatedAttestationsBySlotIndex(t *testing.T) {
 	returned = cache.UnaggregatedAttestationsBySlotIndex(2, 1)
 	assert.DeepEqual(t, []*ethpb.Attestation{att3}, returned)
 }
+
+func TestKV_Unaggregated_DeleteUnaggregatedAttestation_PrunesSlotIndex(t *testing.T) {
+	cache := NewAttCaches()
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b101}}
+	require.NoError(t, cache.SaveUnaggregatedAttestation(att))
+	assert.Equal(t, 1, len(cache.UnaggregatedAttestationsBySlotIndex(1, 1)))
+
+	require.NoError(t, cache.DeleteUnaggregatedAttestation(att))
+	assert.Equal(t, 0, len(cache.UnaggregatedAttestationsBySlotIndex(1, 1)))
+	assert.Equal(t, 0, len(cache.shards[0].unAggregatedBySlot), "Expected emptied slot index entries to be removed")
+}
+
+func TestKV_Unaggregated_SaveUnaggregatedAttestation_EvictsOldestSlot(t *testing.T) {
+	cache := NewAttCachesWithConfig(&Config{MaxSize: 2})
+	att1 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b101}}
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b101}}
+	att3 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b101}}
+	att4 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b101}}
+	require.NoError(t, cache.SaveUnaggregatedAttestations([]*ethpb.Attestation{att1, att2, att3}))
+	assert.Equal(t, 2, cache.UnaggregatedAttestationCount())
+	assert.Equal(t, 0, len(cache.UnaggregatedAttestationsBySlotIndex(2, 0)), "Expected the oldest slot to be evicted")
+	assert.Equal(t, 1, len(cache.UnaggregatedAttestationsBySlotIndex(4, 0)))
+
+	// An attestation older than the ones in the full pool is dropped.
+	require.NoError(t, cache.SaveUnaggregatedAttestation(att4))
+	assert.Equal(t, 2, cache.UnaggregatedAttestationCount())
+	assert.Equal(t, 0, len(cache.UnaggregatedAttestationsBySlotIndex(1, 0)))
+	assert.Equal(t, 1, len(cache.UnaggregatedAttestationsBySlotIndex(3, 0)))
+}
+
+func TestKV_Unaggregated_SaveUnaggregatedAttestation_EagerAggregation(t *testing.T) {
+	cache := NewAttCachesWithConfig(&Config{AggregationMode: EagerAggregation})
+	sig := bls.RandKey().Sign([]byte{'a'})
+	att1 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1001}, Signature: sig.Marshal()}
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1010}, Signature: sig.Marshal()}
+	att3 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1100}, Signature: sig.Marshal()}
+	att4 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1001}, Signature: sig.Marshal()}
+
+	require.NoError(t, cache.SaveUnaggregatedAttestation(att1))
+	assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+	require.NoError(t, cache.SaveUnaggregatedAttestation(att2))
+	assert.Equal(t, 0, cache.UnaggregatedAttestationCount(), "Expected the attestations of the same data to be aggregated")
+	require.NoError(t, cache.SaveUnaggregatedAttestations([]*ethpb.Attestation{att3, att4}))
+
+	aggregated := cache.AggregatedAttestationsBySlotIndex(1, 0)
+	require.Equal(t, 1, len(aggregated))
+	assert.DeepEqual(t, bitfield.Bitlist{0b1111}, aggregated[0].AggregationBits)
+	assert.Equal(t, 1, len(cache.UnaggregatedAttestationsBySlotIndex(2, 0)))
+}
+
+func TestKV_Unaggregated_SaveUnaggregatedAttestation_EagerAggregationDropped(t *testing.T) {
+	cache := NewAttCachesWithConfig(&Config{AggregationMode: EagerAggregation, MaxSize: 2})
+	sig := bls.RandKey().Sign([]byte{'a'})
+	newer := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 5}, AggregationBits: bitfield.Bitlist{0b1101}, Signature: sig.Marshal()},
+		{Data: &ethpb.AttestationData{Slot: 6}, AggregationBits: bitfield.Bitlist{0b1101}, Signature: sig.Marshal()},
+	}
+	require.NoError(t, cache.SaveAggregatedAttestations(newer))
+	att1 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1001}, Signature: sig.Marshal()}
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1010}, Signature: sig.Marshal()}
+
+	// The aggregate is dropped by the full aggregated pool, so the attestations are kept unaggregated.
+	require.NoError(t, cache.SaveUnaggregatedAttestations([]*ethpb.Attestation{att1, att2}))
+	assert.Equal(t, 0, len(cache.AggregatedAttestationsBySlotIndex(1, 0)))
+	assert.Equal(t, 2, len(cache.UnaggregatedAttestationsBySlotIndex(1, 0)))
+}
+
+func BenchmarkKV_Unaggregated_UnaggregatedAttestationsBySlotIndex(b *testing.B) {
+	const slotsPerEpoch, committeesPerSlot = 32, 64
+	for _, validators := range []int{16384, 100000} {
+		cache := NewAttCaches()
+		for i := 0; i < validators; i++ {
+			bits := bitfield.NewBitlist(uint64(validators/(slotsPerEpoch*committeesPerSlot) + 1))
+			bits.SetBitAt(uint64(i/(slotsPerEpoch*committeesPerSlot)), true)
+			att := &ethpb.Attestation{
+				Data: &ethpb.AttestationData{
+					Slot:            uint64(i % slotsPerEpoch),
+					CommitteeIndex:  uint64(i / slotsPerEpoch % committeesPerSlot),
+					BeaconBlockRoot: make([]byte, 32),
+					Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+					Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				},
+				AggregationBits: bits,
+				Signature:       make([]byte, 96),
+			}
+			require.NoError(b, cache.SaveUnaggregatedAttestation(att))
+		}
+
+		b.Run(fmt.Sprintf("indexed_%d_validators", validators), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				cache.UnaggregatedAttestationsBySlotIndex(uint64(i%slotsPerEpoch), uint64(i%committeesPerSlot))
+			}
+		})
+		// The linear scan of the whole pool the slot index replaces.
+		b.Run(fmt.Sprintf("scan_%d_validators", validators), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				slot, committeeIndex := uint64(i%slotsPerEpoch), uint64(i%committeesPerSlot)
+				atts := make([]*ethpb.Attestation, 0)
+				cache.shards[0].unAggregateAttLock.RLock()
+				for _, a := range cache.shards[0].unAggregatedAtt {
+					if slot == a.Data.Slot && committeeIndex == a.Data.CommitteeIndex {
+						atts = append(atts, a)
+					}
+				}
+				cache.shards[0].unAggregateAttLock.RUnlock()
+			}
+		})
+	}
+}
+
+func TestKV_Unaggregated_ForEachUnaggregated(t *testing.T) {
+	cache := NewAttCaches()
+	atts := make([]*ethpb.Attestation, forEachChunkSize+10)
+	for i := range atts {
+		atts[i] = &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: uint64(i)}, AggregationBits: bitfield.Bitlist{0b101}}
+	}
+	require.NoError(t, cache.SaveUnaggregatedAttestations(atts))
+	seen := atts[len(atts)-1]
+	require.NoError(t, cache.insertSeenBit(seen))
+
+	visited := make(map[uint64]bool)
+	require.NoError(t, cache.ForEachUnaggregated(func(att *ethpb.Attestation) bool {
+		visited[att.Data.Slot] = true
+		// The pool can be used while iterating.
+		require.NoError(t, cache.DeleteUnaggregatedAttestation(att))
+		return true
+	}))
+	assert.Equal(t, len(atts)-1, len(visited))
+	assert.Equal(t, false, visited[seen.Data.Slot], "Seen attestation should not be visited")
+	assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+
+	require.NoError(t, cache.SaveUnaggregatedAttestations([]*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b110}},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b110}},
+	}))
+	calls := 0
+	require.NoError(t, cache.ForEachUnaggregated(func(att *ethpb.Attestation) bool {
+		calls++
+		return false
+	}))
+	assert.Equal(t, 1, calls, "Iteration should stop when fn returns false")
+}
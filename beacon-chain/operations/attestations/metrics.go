@@ -1,8 +1,13 @@
 package attestations
 
 import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
 
 var (
@@ -18,21 +23,40 @@ var (
 			Help: "The number of unaggregated attestations in the pool.",
 		},
 	)
-	expiredAggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
-		Name: "expired_aggregated_atts_total",
-		Help: "The number of expired and deleted aggregated attestations in the pool.",
-	})
-	expiredUnaggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
-		Name: "expired_unaggregated_atts_total",
-		Help: "The number of expired and deleted unaggregated attestations in the pool.",
-	})
-	expiredBlockAtts = promauto.NewCounter(prometheus.CounterOpts{
-		Name: "expired_block_atts_total",
-		Help: "The number of expired and deleted block attestations in the pool.",
-	})
+	attsBySlotCount = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "attestations_in_pool_by_slot",
+			Help: "The number of unaggregated and aggregated attestations in the pool by number of slots since their slot.",
+		},
+		[]string{"type", "slots_ago"},
+	)
+	dryRunAttestersCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "aggregation_dry_run_attesters_total",
+			Help: "The number of attesters covered by the aggregates simulated in the aggregation dry run, " +
+				"by the attestations of the same data on chain, and by the simulated aggregates only.",
+		},
+		[]string{"coverage"},
+	)
 )
 
 func (s *Service) updateMetrics() {
 	aggregatedAttsCount.Set(float64(s.pool.AggregatedAttestationCount()))
 	unaggregatedAttsCount.Set(float64(s.pool.UnaggregatedAttestationCount()))
+
+	genesisTime := atomic.LoadUint64(&s.genesisTime)
+	if genesisTime == 0 {
+		return
+	}
+	currentSlot := slotutil.SlotsSinceGenesis(time.Unix(int64(genesisTime), 0))
+	unaggregated, aggregated := s.pool.AttestationCountsBySlot()
+	attsBySlotCount.Reset()
+	for attType, counts := range map[string]map[uint64]int{"unaggregated": unaggregated, "aggregated": aggregated} {
+		for slot, count := range counts {
+			if slot > currentSlot {
+				continue
+			}
+			attsBySlotCount.WithLabelValues(attType, strconv.FormatUint(currentSlot-slot, 10)).Set(float64(count))
+		}
+	}
 }
@@ -0,0 +1,53 @@
+package attestations
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+// Snapshot returns the aggregated and unaggregated attestations of the pool, along with the
+// attestations included in blocks, so they can be persisted on shutdown.
+func Snapshot(pool Pool) ([]*ethpb.Attestation, []*ethpb.Attestation, error) {
+	unaggregated, err := pool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(pool.AggregatedAttestations(), unaggregated...), pool.BlockAttestations(), nil
+}
+
+// Restore saves the persisted attestations not yet expired at the current slot back into the
+// pool, returning the number of attestations restored. As in the garbage collection of the pool,
+// attestations expire once the retention slots of the pool have passed since their slot.
+func Restore(pool Pool, atts []*ethpb.Attestation, blockAtts []*ethpb.Attestation, currentSlot uint64) (int, error) {
+	restored := 0
+	retentionSlots := pool.RetentionSlots()
+	for _, att := range atts {
+		if restoredExpired(att, currentSlot, retentionSlots) {
+			continue
+		}
+		var err error
+		if helpers.IsAggregated(att) {
+			err = pool.SaveAggregatedAttestation(att)
+		} else {
+			err = pool.SaveUnaggregatedAttestation(att)
+		}
+		if err != nil {
+			return restored, err
+		}
+		restored++
+	}
+	for _, att := range blockAtts {
+		if restoredExpired(att, currentSlot, retentionSlots) {
+			continue
+		}
+		if err := pool.SaveBlockAttestation(att); err != nil {
+			return restored, err
+		}
+		restored++
+	}
+	return restored, nil
+}
+
+func restoredExpired(att *ethpb.Attestation, currentSlot uint64, retentionSlots uint64) bool {
+	return att.Data == nil || att.Data.Slot+retentionSlots <= currentSlot
+}
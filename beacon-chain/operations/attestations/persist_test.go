@@ -0,0 +1,54 @@
+package attestations
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations/kv"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	aggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: slotsPerEpoch + 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	unaggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: slotsPerEpoch + 2}, AggregationBits: bitfield.Bitlist{0b1001}}
+	expired := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1010}}
+	block := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: slotsPerEpoch + 3}, AggregationBits: bitfield.Bitlist{0b1111}}
+
+	pool := NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestation(aggregated))
+	require.NoError(t, pool.SaveUnaggregatedAttestation(unaggregated))
+	require.NoError(t, pool.SaveUnaggregatedAttestation(expired))
+	require.NoError(t, pool.SaveBlockAttestation(block))
+	atts, blockAtts, err := Snapshot(pool)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(atts))
+	assert.Equal(t, 1, len(blockAtts))
+
+	restoredPool := NewPool()
+	restored, err := Restore(restoredPool, atts, blockAtts, 2*slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored, "Expected expired attestation to be dropped")
+	assert.DeepEqual(t, []*ethpb.Attestation{aggregated}, restoredPool.AggregatedAttestations())
+	restoredUnaggregated, err := restoredPool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.Attestation{unaggregated}, restoredUnaggregated)
+	assert.DeepEqual(t, []*ethpb.Attestation{block}, restoredPool.BlockAttestations())
+}
+
+func TestRestore_RetentionSlots(t *testing.T) {
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 5}, AggregationBits: bitfield.Bitlist{0b1001}},
+		{Data: &ethpb.AttestationData{Slot: 6}, AggregationBits: bitfield.Bitlist{0b1001}},
+	}
+	pool := NewPoolWithConfig(&kv.Config{RetentionSlots: 4})
+	restored, err := Restore(pool, atts, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, restored, "Expected the attestations older than the retention slots to be dropped")
+	unaggregated, err := pool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.DeepEqual(t, atts[1:], unaggregated)
+}
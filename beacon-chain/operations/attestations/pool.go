@@ -1,8 +1,11 @@
 package attestations
 
 import (
+	"context"
+
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations/kv"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 )
 
 // Pool defines the necessary methods for Prysm attestations pool to serve
@@ -12,20 +15,29 @@ import (
 type Pool interface {
 	// For Aggregated attestations
 	AggregateUnaggregatedAttestations() error
+	AggregateUnaggregatedAttestationsBatch(batchSize int) error
 	SaveAggregatedAttestation(att *ethpb.Attestation) error
 	SaveAggregatedAttestations(atts []*ethpb.Attestation) error
 	AggregatedAttestations() []*ethpb.Attestation
 	AggregatedAttestationsBySlotIndex(slot uint64, committeeIndex uint64) []*ethpb.Attestation
 	DeleteAggregatedAttestation(att *ethpb.Attestation) error
+	ClaimAggregatedAttestations(slot uint64) ([]*ethpb.Attestation, error)
+	ClaimBlockAttestations(atts []*ethpb.Attestation) error
 	HasAggregatedAttestation(att *ethpb.Attestation) (bool, error)
 	AggregatedAttestationCount() int
+	ProfitableAttestations(state *stateTrie.BeaconState) []*ethpb.Attestation
+	AttestationsByFork(slot uint64, committeeIndex uint64) []*kv.ForkAttestations
 	// For unaggregated attestations.
 	SaveUnaggregatedAttestation(att *ethpb.Attestation) error
 	SaveUnaggregatedAttestations(atts []*ethpb.Attestation) error
 	UnaggregatedAttestations() ([]*ethpb.Attestation, error)
+	ForEachUnaggregated(fn func(*ethpb.Attestation) bool) error
 	UnaggregatedAttestationsBySlotIndex(slot uint64, committeeIndex uint64) []*ethpb.Attestation
 	DeleteUnaggregatedAttestation(att *ethpb.Attestation) error
 	UnaggregatedAttestationCount() int
+	// For single attestations, saved as unaggregated attestations.
+	SaveSingleAttestation(s *kv.SingleAttestation, committee []uint64) error
+	SingleAttestationsBySlotIndex(slot uint64, committeeIndex uint64, committee []uint64) []*kv.SingleAttestation
 	// For attestations that were included in the block.
 	SaveBlockAttestation(att *ethpb.Attestation) error
 	SaveBlockAttestations(atts []*ethpb.Attestation) error
@@ -35,10 +47,27 @@ type Pool interface {
 	SaveForkchoiceAttestation(att *ethpb.Attestation) error
 	SaveForkchoiceAttestations(atts []*ethpb.Attestation) error
 	ForkchoiceAttestations() []*ethpb.Attestation
+	ForkchoiceAttestationsByTargetEpoch(epoch uint64) []*ethpb.Attestation
 	DeleteForkchoiceAttestation(att *ethpb.Attestation) error
+	// For subscribers to the attestations saved in the pool.
+	SubscribeAttestations(ctx context.Context, filters ...kv.SubscriptionFilter) <-chan *ethpb.Attestation
+	SubscribeBlockAttestations(ctx context.Context, filters ...kv.SubscriptionFilter) <-chan *ethpb.Attestation
+	// For the pool metrics.
+	AttestationCountsBySlot() (map[uint64]int, map[uint64]int)
+	// For collecting expired attestations.
+	StartGC(ctx context.Context, genesisTime uint64)
+	RetentionSlots() uint64
+	// For rejecting slashable attestations.
+	SetSlashingChecker(checker kv.SlashingChecker)
 }
 
 // NewPool initializes a new attestation pool.
 func NewPool() *kv.AttCaches {
 	return kv.NewAttCaches()
 }
+
+// NewPoolWithConfig initializes a new attestation pool with the config options, such as its
+// maximum size and aggregation mode.
+func NewPoolWithConfig(cfg *kv.Config) *kv.AttCaches {
+	return kv.NewAttCachesWithConfig(cfg)
+}
@@ -5,10 +5,14 @@ package attestations
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
 )
 
 var forkChoiceProcessedRootsSize = 1 << 16
@@ -20,14 +24,25 @@ type Service struct {
 	pool                     Pool
 	err                      error
 	forkChoiceProcessedRoots *lru.Cache
-	genesisTime              uint64
-	pruneInterval            time.Duration
+	genesisTime              uint64 // Accessed atomically, 0 until the genesis time is set.
+	aggregationInterval      time.Duration
+	aggregationBatchSize     int
+	dryRun                   *aggregationDryRun
 }
 
 // Config options for the service.
 type Config struct {
-	Pool          Pool
-	pruneInterval time.Duration
+	Pool Pool
+	// AggregationInterval is how often unaggregated attestations are aggregated in the background,
+	// a quarter of a slot if not positive.
+	AggregationInterval time.Duration
+	// AggregationBatchSize is the maximum number of unaggregated attestations aggregated at once,
+	// unbounded if 0.
+	AggregationBatchSize int
+	// DryRunAggregationStrategy is the aggregation strategy of the aggregates simulated for every
+	// slot without being broadcast, to compare their coverage with the attestations on chain. There
+	// is no dry run if empty.
+	DryRunAggregationStrategy string
 }
 
 // NewService instantiates a new attestation pool service instance that will
@@ -38,10 +53,20 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 
-	pruneInterval := cfg.pruneInterval
-	if pruneInterval == 0 {
-		// Prune expired attestations from the pool every slot interval.
-		pruneInterval = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	aggregationInterval := cfg.AggregationInterval
+	if aggregationInterval <= 0 {
+		aggregationInterval = defaultAggregationInterval
+	}
+	if cfg.AggregationBatchSize < 0 {
+		return nil, errors.Errorf("invalid aggregation batch size %d, must not be negative", cfg.AggregationBatchSize)
+	}
+
+	var dryRun *aggregationDryRun
+	if cfg.DryRunAggregationStrategy != "" {
+		dryRun, err = newAggregationDryRun(attaggregation.AttestationAggregationStrategy(cfg.DryRunAggregationStrategy))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not set up the aggregation dry run")
+		}
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -50,14 +75,17 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		cancel:                   cancel,
 		pool:                     cfg.Pool,
 		forkChoiceProcessedRoots: cache,
-		pruneInterval:            pruneInterval,
+		aggregationInterval:      aggregationInterval,
+		aggregationBatchSize:     cfg.AggregationBatchSize,
+		dryRun:                   dryRun,
 	}, nil
 }
 
 // Start an attestation pool service's main event loop.
 func (s *Service) Start() {
 	go s.prepareForkChoiceAtts()
-	go s.pruneAttsPool()
+	go s.aggregateRoutine()
+	runutil.RunEvery(s.ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second, s.updateMetrics)
 }
 
 // Stop the beacon block attestation pool service's main event loop
@@ -75,7 +103,14 @@ func (s *Service) Status() error {
 	return nil
 }
 
-// SetGenesisTime sets genesis time for operation service to use.
+// SetGenesisTime sets genesis time for operation service to use, starting the collection of the
+// expired attestations of the pool and the aggregation dry run.
 func (s *Service) SetGenesisTime(t uint64) {
-	s.genesisTime = t
+	atomic.StoreUint64(&s.genesisTime, t)
+	s.pool.StartGC(s.ctx, t)
+	if s.dryRun != nil {
+		s.dryRun.start.Do(func() {
+			go s.dryRunRoutine(t)
+		})
+	}
 }
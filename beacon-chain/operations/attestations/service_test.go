@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -29,3 +31,14 @@ func TestStatus_Error(t *testing.T) {
 		t.Errorf("Wanted: %v, got: %v", s.err, s.Status())
 	}
 }
+
+func TestNewService_InvalidAggregationInterval(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{AggregationInterval: -time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, defaultAggregationInterval, s.aggregationInterval)
+}
+
+func TestNewService_NegativeAggregationBatchSize(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{AggregationBatchSize: -1})
+	assert.ErrorContains(t, "invalid aggregation batch size -1", err)
+}
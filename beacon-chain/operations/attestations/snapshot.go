@@ -0,0 +1,135 @@
+package attestations
+
+import (
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// maxSnapshotAttestations is the maximum number of attestations of each list of a pool snapshot.
+const maxSnapshotAttestations = 1 << 24
+
+// snapshotFixedSize is the size of the offsets of the two lists of a SSZ encoded pool snapshot.
+const snapshotFixedSize = 8
+
+var (
+	errSnapshotOffset   = errors.New("incorrect pool snapshot offset")
+	errSnapshotTooBig   = errors.New("too many attestations in pool snapshot")
+	errSnapshotTooSmall = errors.New("pool snapshot too small")
+)
+
+// PoolSnapshot holds the attestations of a pool, so they can be stored or sent to another node
+// and restored in its pool. Its SSZ encoding is the one of a container of the list of the
+// aggregated and unaggregated attestations followed by the list of the block attestations.
+type PoolSnapshot struct {
+	Attestations      []*ethpb.Attestation
+	BlockAttestations []*ethpb.Attestation
+}
+
+// NewPoolSnapshot returns a snapshot of the attestations of the pool.
+func NewPoolSnapshot(pool Pool) (*PoolSnapshot, error) {
+	atts, blockAtts, err := Snapshot(pool)
+	if err != nil {
+		return nil, err
+	}
+	return &PoolSnapshot{Attestations: atts, BlockAttestations: blockAtts}, nil
+}
+
+// Restore saves the attestations of the snapshot not yet expired at the current slot in the
+// pool, returning the number of attestations restored.
+func (s *PoolSnapshot) Restore(pool Pool, currentSlot uint64) (int, error) {
+	return Restore(pool, s.Attestations, s.BlockAttestations, currentSlot)
+}
+
+// SizeSSZ returns the size of the SSZ encoding of the snapshot.
+func (s *PoolSnapshot) SizeSSZ() int {
+	return snapshotFixedSize + attestationListSize(s.Attestations) + attestationListSize(s.BlockAttestations)
+}
+
+// MarshalSSZ returns the SSZ encoding of the snapshot.
+func (s *PoolSnapshot) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// MarshalSSZTo appends the SSZ encoding of the snapshot to dst.
+func (s *PoolSnapshot) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if len(s.Attestations) > maxSnapshotAttestations || len(s.BlockAttestations) > maxSnapshotAttestations {
+		return nil, errSnapshotTooBig
+	}
+	offset := snapshotFixedSize
+	dst = ssz.WriteOffset(dst, offset)
+	offset += attestationListSize(s.Attestations)
+	dst = ssz.WriteOffset(dst, offset)
+
+	var err error
+	if dst, err = marshalAttestationList(dst, s.Attestations); err != nil {
+		return nil, err
+	}
+	return marshalAttestationList(dst, s.BlockAttestations)
+}
+
+// UnmarshalSSZ decodes the SSZ encoding of a snapshot.
+func (s *PoolSnapshot) UnmarshalSSZ(buf []byte) error {
+	size := uint64(len(buf))
+	if size < snapshotFixedSize {
+		return errSnapshotTooSmall
+	}
+	o0 := ssz.ReadOffset(buf[0:4])
+	if o0 != snapshotFixedSize {
+		return errSnapshotOffset
+	}
+	o1 := ssz.ReadOffset(buf[4:8])
+	if o1 > size || o1 < o0 {
+		return errSnapshotOffset
+	}
+
+	var err error
+	if s.Attestations, err = unmarshalAttestationList(buf[o0:o1]); err != nil {
+		return errors.Wrap(err, "could not unmarshal attestations")
+	}
+	if s.BlockAttestations, err = unmarshalAttestationList(buf[o1:]); err != nil {
+		return errors.Wrap(err, "could not unmarshal block attestations")
+	}
+	return nil
+}
+
+// attestationListSize returns the size of the SSZ encoding of the list of attestations, made of
+// the offsets of the attestations followed by the attestations.
+func attestationListSize(atts []*ethpb.Attestation) int {
+	size := 4 * len(atts)
+	for _, att := range atts {
+		size += att.SizeSSZ()
+	}
+	return size
+}
+
+func marshalAttestationList(dst []byte, atts []*ethpb.Attestation) ([]byte, error) {
+	offset := 4 * len(atts)
+	for _, att := range atts {
+		dst = ssz.WriteOffset(dst, offset)
+		offset += att.SizeSSZ()
+	}
+	for _, att := range atts {
+		var err error
+		if dst, err = att.MarshalSSZTo(dst); err != nil {
+			return nil, errors.Wrap(err, "could not marshal attestation")
+		}
+	}
+	return dst, nil
+}
+
+func unmarshalAttestationList(buf []byte) ([]*ethpb.Attestation, error) {
+	num, err := ssz.DecodeDynamicLength(buf, maxSnapshotAttestations)
+	if err != nil {
+		return nil, err
+	}
+	atts := make([]*ethpb.Attestation, num)
+	err = ssz.UnmarshalDynamic(buf, num, func(i int, buf []byte) error {
+		atts[i] = &ethpb.Attestation{}
+		return atts[i].UnmarshalSSZ(buf)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return atts, nil
+}
@@ -0,0 +1,66 @@
+package attestations
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestPoolSnapshot_MarshalUnmarshalSSZ(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	aggregated := testutil.NewAttestation(slotsPerEpoch+1, 0, bitfield.Bitlist{0b1101})
+	unaggregated := testutil.NewAttestation(slotsPerEpoch+2, 0, bitfield.Bitlist{0b1001})
+	block := testutil.NewAttestation(slotsPerEpoch+3, 0, bitfield.Bitlist{0b1111})
+
+	pool := NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestation(aggregated))
+	require.NoError(t, pool.SaveUnaggregatedAttestation(unaggregated))
+	require.NoError(t, pool.SaveBlockAttestation(block))
+	snapshot, err := NewPoolSnapshot(pool)
+	require.NoError(t, err)
+
+	enc, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.SizeSSZ(), len(enc))
+	decoded := &PoolSnapshot{}
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	assert.DeepEqual(t, snapshot, decoded)
+
+	restoredPool := NewPool()
+	restored, err := decoded.Restore(restoredPool, 2*slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored)
+	assert.DeepEqual(t, []*ethpb.Attestation{aggregated}, restoredPool.AggregatedAttestations())
+	assert.DeepEqual(t, []*ethpb.Attestation{block}, restoredPool.BlockAttestations())
+}
+
+func TestPoolSnapshot_MarshalUnmarshalSSZ_Empty(t *testing.T) {
+	enc, err := (&PoolSnapshot{}).MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, snapshotFixedSize, len(enc))
+	decoded := &PoolSnapshot{}
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	assert.Equal(t, 0, len(decoded.Attestations))
+	assert.Equal(t, 0, len(decoded.BlockAttestations))
+}
+
+func TestPoolSnapshot_UnmarshalSSZ_Invalid(t *testing.T) {
+	snapshot := &PoolSnapshot{
+		Attestations:      []*ethpb.Attestation{testutil.NewAttestation(1, 0, bitfield.Bitlist{0b1101})},
+		BlockAttestations: []*ethpb.Attestation{testutil.NewAttestation(2, 0, bitfield.Bitlist{0b1111})},
+	}
+	enc, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, errSnapshotTooSmall.Error(), (&PoolSnapshot{}).UnmarshalSSZ(enc[:4]))
+	assert.ErrorContains(t, "could not unmarshal block attestations", (&PoolSnapshot{}).UnmarshalSSZ(enc[:len(enc)-10]))
+	invalidOffset := append([]byte{}, enc...)
+	invalidOffset[4] = 0xff
+	invalidOffset[5] = 0xff
+	assert.ErrorContains(t, errSnapshotOffset.Error(), (&PoolSnapshot{}).UnmarshalSSZ(invalidOffset))
+}
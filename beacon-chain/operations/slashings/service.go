@@ -248,6 +248,26 @@ func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
 	numProposerSlashingsIncluded.Inc()
 }
 
+// Snapshot returns all the pending proposer and attester slashings, regardless of their validity
+// for inclusion in a block, so they can be persisted and inserted again after a restart.
+func (p *Pool) Snapshot() ([]*ethpb.ProposerSlashing, []*ethpb.AttesterSlashing) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	proposerSlashings := make([]*ethpb.ProposerSlashing, len(p.pendingProposerSlashing))
+	copy(proposerSlashings, p.pendingProposerSlashing)
+	attesterSlashings := make([]*ethpb.AttesterSlashing, 0, len(p.pendingAttesterSlashing))
+	seen := make(map[*ethpb.AttesterSlashing]bool)
+	for _, slashing := range p.pendingAttesterSlashing {
+		// An attester slashing is pending once per validator it slashes.
+		if seen[slashing.attesterSlashing] {
+			continue
+		}
+		seen[slashing.attesterSlashing] = true
+		attesterSlashings = append(attesterSlashings, slashing.attesterSlashing)
+	}
+	return proposerSlashings, attesterSlashings
+}
+
 // this function checks a few items about a validator before proceeding with inserting
 // a proposer/attester slashing into the pool. First, it checks if the validator
 // has been recently included in the pool, then it checks if the validator is slashable.
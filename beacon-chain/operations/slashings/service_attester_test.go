@@ -633,3 +633,19 @@ func TestPool_PendingAttesterSlashings_NoDuplicates(t *testing.T) {
 		t.Errorf("Unexpected return from PendingAttesterSlashings, wanted %v, received %v", want, got)
 	}
 }
+
+func TestPool_Snapshot(t *testing.T) {
+	attesterSlashing := &ethpb.AttesterSlashing{}
+	proposerSlashing := &ethpb.ProposerSlashing{}
+	p := &Pool{
+		pendingProposerSlashing: []*ethpb.ProposerSlashing{proposerSlashing},
+		// The same attester slashing slashes two validators.
+		pendingAttesterSlashing: []*PendingAttesterSlashing{
+			{attesterSlashing: attesterSlashing, validatorToSlash: 1},
+			{attesterSlashing: attesterSlashing, validatorToSlash: 2},
+		},
+	}
+	proposerSlashings, attesterSlashings := p.Snapshot()
+	assert.DeepEqual(t, []*ethpb.ProposerSlashing{proposerSlashing}, proposerSlashings)
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{attesterSlashing}, attesterSlashings)
+}
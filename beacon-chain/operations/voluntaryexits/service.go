@@ -105,6 +105,29 @@ func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
 	p.included[exit.Exit.ValidatorIndex] = true
 }
 
+// DeleteVoluntaryExit removes the pending exit of the validator index from the pool, without
+// marking it as included, so that a new exit of the validator can be inserted again.
+func (p *Pool) DeleteVoluntaryExit(validatorIndex uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	i := sort.Search(len(p.pending), func(i int) bool {
+		return p.pending[i].Exit.ValidatorIndex >= validatorIndex
+	})
+	if i != len(p.pending) && p.pending[i].Exit.ValidatorIndex == validatorIndex {
+		p.pending = append(p.pending[:i], p.pending[i+1:]...)
+	}
+}
+
+// Snapshot returns all the pending exits, regardless of their validity for inclusion in a block,
+// so they can be persisted and inserted again after a restart.
+func (p *Pool) Snapshot() []*ethpb.SignedVoluntaryExit {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	exits := make([]*ethpb.SignedVoluntaryExit, len(p.pending))
+	copy(exits, p.pending)
+	return exits
+}
+
 // HasBeenIncluded returns true if the pool has recorded that a validator index has been recorded.
 func (p *Pool) HasBeenIncluded(bIdx uint64) bool {
 	return p.included[bIdx]
@@ -456,3 +456,28 @@ func TestPool_PendingExits(t *testing.T) {
 		})
 	}
 }
+
+func TestPool_Snapshot(t *testing.T) {
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1}}
+	p := NewPool()
+	p.pending = append(p.pending, exit)
+	exits := p.Snapshot()
+	assert.DeepEqual(t, []*ethpb.SignedVoluntaryExit{exit}, exits)
+
+	// The snapshot is not affected by later changes to the pool.
+	p.MarkIncluded(exit)
+	assert.Equal(t, 1, len(exits))
+}
+
+func TestPool_DeleteVoluntaryExit(t *testing.T) {
+	p := NewPool()
+	for i := uint64(0); i < 3; i++ {
+		p.pending = append(p.pending, &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: i}})
+	}
+	p.DeleteVoluntaryExit(1)
+	p.DeleteVoluntaryExit(5)
+	require.Equal(t, 2, len(p.pending))
+	assert.Equal(t, uint64(0), p.pending[0].Exit.ValidatorIndex)
+	assert.Equal(t, uint64(2), p.pending[1].Exit.ValidatorIndex)
+	assert.Equal(t, false, p.HasBeenIncluded(1))
+}
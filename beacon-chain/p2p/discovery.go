@@ -194,7 +194,11 @@ func (s *Service) createLocalNode(
 	localNode.SetFallbackIP(ipAddr)
 	localNode.SetFallbackUDP(udpPort)
 
-	localNode, err = addForkEntry(localNode, s.genesisTime, s.genesisValidatorsRoot)
+	schedule, err := s.forkSchedule()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork schedule")
+	}
+	localNode, err = addForkEntry(localNode, schedule, s.genesisTime, s.genesisValidatorsRoot)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not add eth2 fork version entry to enr")
 	}
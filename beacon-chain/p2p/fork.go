@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/p2putils"
@@ -22,7 +23,20 @@ var eth2ENRKey = params.BeaconNetworkConfig().ETH2Key
 // ForkDigest returns the current fork digest of
 // the node.
 func (s *Service) forkDigest() ([4]byte, error) {
-	return p2putils.CreateForkDigest(s.genesisTime, s.genesisValidatorsRoot)
+	schedule, err := s.forkSchedule()
+	if err != nil {
+		return [4]byte{}, err
+	}
+	return p2putils.CreateForkDigest(schedule, s.genesisTime, s.genesisValidatorsRoot)
+}
+
+// forkSchedule returns the fork schedule the service was configured with, or the schedule of
+// the beacon config when it was not given one.
+func (s *Service) forkSchedule() (*forks.Schedule, error) {
+	if s.cfg != nil && s.cfg.ForkSchedule != nil {
+		return s.cfg.ForkSchedule, nil
+	}
+	return forks.NewBeaconSchedule(nil)
 }
 
 // Compares fork ENRs between an incoming peer's record and our node's
@@ -78,10 +92,11 @@ func (s *Service) compareForkENR(record *enr.Record) error {
 // and the next fork epoch.
 func addForkEntry(
 	node *enode.LocalNode,
+	schedule *forks.Schedule,
 	genesisTime time.Time,
 	genesisValidatorsRoot []byte,
 ) (*enode.LocalNode, error) {
-	digest, err := p2putils.CreateForkDigest(genesisTime, genesisValidatorsRoot)
+	digest, err := p2putils.CreateForkDigest(schedule, genesisTime, genesisValidatorsRoot)
 	if err != nil {
 		return nil, err
 	}
@@ -90,16 +105,17 @@ func addForkEntry(
 	if roughtime.Now().Before(genesisTime) {
 		currentSlot, currentEpoch = 0, 0
 	}
-	fork, err := p2putils.Fork(currentEpoch)
-	if err != nil {
-		return nil, err
-	}
 
 	nextForkEpoch := params.BeaconConfig().NextForkEpoch
 	nextForkVersion := params.BeaconConfig().NextForkVersion
-	// Set to the current fork version if our next fork is not planned.
+	// The next fork is taken from the fork schedule unless set explicitly, set to
+	// the current fork version if our next fork is not planned.
 	if nextForkEpoch == math.MaxUint64 {
-		nextForkVersion = fork.CurrentVersion
+		nextForkVersion = schedule.ForkAtEpoch(currentEpoch).Version
+		if nextFork := schedule.NextFork(currentEpoch); nextFork != nil {
+			nextForkEpoch = nextFork.Epoch
+			nextForkVersion = nextFork.Version
+		}
 	}
 	enrForkID := &pb.ENRForkID{
 		CurrentForkDigest: digest[:],
@@ -0,0 +1,166 @@
+package peers
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	// DefaultInvalidAttestationsThreshold defines how many invalid attestations to tolerate before
+	// peer is deemed bad.
+	DefaultInvalidAttestationsThreshold = 32
+	// DefaultDuplicateAttestationsThreshold defines how many duplicate attestations to tolerate before
+	// peer is deemed bad.
+	DefaultDuplicateAttestationsThreshold = 4096
+	// DefaultAttestationsWeight is a default weight. Since score represents penalty, it has negative weight.
+	DefaultAttestationsWeight = -1.0
+	// DefaultAttestationsDecayInterval defines how often to decay previous statistics.
+	// Every interval the invalid and duplicate attestations counters are halved.
+	DefaultAttestationsDecayInterval = time.Minute
+)
+
+// AttestationsScorer represents the scoring service of the attestations gossiped by peers, penalizing
+// the peers flooding invalid or duplicate attestations.
+type AttestationsScorer struct {
+	ctx    context.Context
+	config *AttestationsScorerConfig
+	store  *peerDataStore
+}
+
+// AttestationsScorerConfig holds configuration parameters for the attestations scoring service.
+type AttestationsScorerConfig struct {
+	// InvalidThreshold specifies number of invalid attestations tolerated, before peer is banned.
+	InvalidThreshold int
+	// DuplicateThreshold specifies number of duplicate attestations tolerated, before peer is banned.
+	DuplicateThreshold int
+	// Weight defines weight of invalid and duplicate attestations/threshold ratios on overall score.
+	Weight float64
+	// DecayInterval specifies how often attestations stats should be decayed.
+	DecayInterval time.Duration
+}
+
+// newAttestationsScorer creates new attestations scoring service.
+func newAttestationsScorer(
+	ctx context.Context, store *peerDataStore, config *AttestationsScorerConfig) *AttestationsScorer {
+	if config == nil {
+		config = &AttestationsScorerConfig{}
+	}
+	scorer := &AttestationsScorer{
+		ctx:    ctx,
+		config: config,
+		store:  store,
+	}
+	if scorer.config.InvalidThreshold <= 0 {
+		scorer.config.InvalidThreshold = DefaultInvalidAttestationsThreshold
+	}
+	if scorer.config.DuplicateThreshold <= 0 {
+		scorer.config.DuplicateThreshold = DefaultDuplicateAttestationsThreshold
+	}
+	if scorer.config.Weight == 0.0 {
+		scorer.config.Weight = DefaultAttestationsWeight
+	}
+	if scorer.config.DecayInterval == 0 {
+		scorer.config.DecayInterval = DefaultAttestationsDecayInterval
+	}
+	return scorer
+}
+
+// Score returns score (penalty) of invalid and duplicate attestations peer gossiped.
+func (s *AttestationsScorer) Score(pid peer.ID) float64 {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.score(pid)
+}
+
+// score is a lock-free version of Score.
+func (s *AttestationsScorer) score(pid peer.ID) float64 {
+	peerData, ok := s.store.peers[pid]
+	if !ok {
+		return 0
+	}
+	score := float64(peerData.invalidAttestations)/float64(s.config.InvalidThreshold) +
+		float64(peerData.duplicateAttestations)/float64(s.config.DuplicateThreshold)
+	return score * s.config.Weight
+}
+
+// Params exposes scorer's parameters.
+func (s *AttestationsScorer) Params() *AttestationsScorerConfig {
+	return s.config
+}
+
+// Counts obtains the number of invalid and of duplicate attestations we have received from the given remote peer.
+func (s *AttestationsScorer) Counts(pid peer.ID) (int, int, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	if peerData, ok := s.store.peers[pid]; ok {
+		return peerData.invalidAttestations, peerData.duplicateAttestations, nil
+	}
+	return -1, -1, ErrPeerUnknown
+}
+
+// IncrementInvalid increments the number of invalid attestations we have received from the given remote peer.
+func (s *AttestationsScorer) IncrementInvalid(pid peer.ID) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	if _, ok := s.store.peers[pid]; !ok {
+		s.store.peers[pid] = &peerData{}
+	}
+	s.store.peers[pid].invalidAttestations++
+}
+
+// IncrementDuplicate increments the number of duplicate attestations we have received from the given remote peer.
+func (s *AttestationsScorer) IncrementDuplicate(pid peer.ID) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	if _, ok := s.store.peers[pid]; !ok {
+		s.store.peers[pid] = &peerData{}
+	}
+	s.store.peers[pid].duplicateAttestations++
+}
+
+// IsBadPeer states if the peer is to be considered bad, for gossiping too many invalid or duplicate attestations.
+// If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+func (s *AttestationsScorer) IsBadPeer(pid peer.ID) bool {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.isBadPeer(pid)
+}
+
+// isBadPeer is lock-free version of IsBadPeer.
+func (s *AttestationsScorer) isBadPeer(pid peer.ID) bool {
+	if peerData, ok := s.store.peers[pid]; ok {
+		return peerData.invalidAttestations >= s.config.InvalidThreshold ||
+			peerData.duplicateAttestations >= s.config.DuplicateThreshold
+	}
+	return false
+}
+
+// BadPeers returns the peers that are bad.
+func (s *AttestationsScorer) BadPeers() []peer.ID {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	badPeers := make([]peer.ID, 0)
+	for pid := range s.store.peers {
+		if s.isBadPeer(pid) {
+			badPeers = append(badPeers, pid)
+		}
+	}
+	return badPeers
+}
+
+// Decay halves the invalid and duplicate attestations of all peers, so that only the attestations of
+// the last few intervals weigh on the score.
+func (s *AttestationsScorer) Decay() {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	for _, peerData := range s.store.peers {
+		peerData.invalidAttestations /= 2
+		peerData.duplicateAttestations /= 2
+	}
+}
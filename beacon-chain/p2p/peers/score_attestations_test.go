@@ -0,0 +1,137 @@
+package peers_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestPeerScorer_Attestations_Score(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &peers.PeerScorerConfig{
+			AttestationsScorerConfig: &peers.AttestationsScorerConfig{
+				InvalidThreshold:   4,
+				DuplicateThreshold: 8,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().AttestationsScorer()
+
+	assert.Equal(t, 0.0, scorer.Score("peer1"), "Unexpected score for unregistered peer")
+	scorer.IncrementInvalid("peer1")
+	assert.Equal(t, -0.25, scorer.Score("peer1"))
+	scorer.IncrementDuplicate("peer1")
+	scorer.IncrementDuplicate("peer1")
+	assert.Equal(t, -0.5, scorer.Score("peer1"))
+	assert.Equal(t, false, scorer.IsBadPeer("peer1"))
+	for i := 0; i < 3; i++ {
+		scorer.IncrementInvalid("peer1")
+	}
+	assert.Equal(t, -1.25, scorer.Score("peer1"))
+	assert.Equal(t, true, scorer.IsBadPeer("peer1"))
+	assert.Equal(t, true, peerStatuses.IsBad("peer1"))
+
+	for i := 0; i < 8; i++ {
+		scorer.IncrementDuplicate("peer2")
+	}
+	assert.Equal(t, true, scorer.IsBadPeer("peer2"), "Peer flooding duplicates is not bad")
+}
+
+func TestPeerScorer_Attestations_Counts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &peers.PeerScorerConfig{},
+	})
+	scorer := peerStatuses.Scorers().AttestationsScorer()
+
+	pid := peer.ID("peer1")
+	_, _, err := scorer.Counts(pid)
+	assert.ErrorContains(t, peers.ErrPeerUnknown.Error(), err)
+
+	peerStatuses.Add(nil, pid, nil, network.DirUnknown)
+	scorer.IncrementInvalid(pid)
+	scorer.IncrementDuplicate(pid)
+	scorer.IncrementDuplicate(pid)
+	invalid, duplicate, err := scorer.Counts(pid)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, invalid)
+	assert.Equal(t, 2, duplicate)
+}
+
+func TestPeerScorer_Attestations_Decay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &peers.PeerScorerConfig{
+			AttestationsScorerConfig: &peers.AttestationsScorerConfig{
+				InvalidThreshold: 4,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().AttestationsScorer()
+
+	for i := 0; i < 5; i++ {
+		scorer.IncrementInvalid("peer1")
+		scorer.IncrementDuplicate("peer1")
+	}
+	assert.Equal(t, true, scorer.IsBadPeer("peer1"))
+
+	scorer.Decay()
+	invalid, duplicate, err := scorer.Counts("peer1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, invalid)
+	assert.Equal(t, 2, duplicate)
+	assert.Equal(t, false, scorer.IsBadPeer("peer1"), "Peer is still bad after decay")
+}
+
+func TestPeerScorer_Attestations_BadPeers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &peers.PeerScorerConfig{
+			BadResponsesScorerConfig: &peers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+			AttestationsScorerConfig: &peers.AttestationsScorerConfig{
+				InvalidThreshold: 1,
+			},
+		},
+	})
+	scorers := peerStatuses.Scorers()
+	pids := []peer.ID{peer.ID("peer1"), peer.ID("peer2"), peer.ID("peer3")}
+	for _, pid := range pids {
+		peerStatuses.Add(nil, pid, nil, network.DirUnknown)
+	}
+	scorers.AttestationsScorer().IncrementInvalid("peer1")
+	scorers.AttestationsScorer().IncrementInvalid("peer3")
+	scorers.BadResponsesScorer().Increment("peer3")
+
+	want := []peer.ID{"peer1", "peer3"}
+	badPeers := scorers.AttestationsScorer().BadPeers()
+	sort.Slice(badPeers, func(i, j int) bool {
+		return badPeers[i] < badPeers[j]
+	})
+	assert.DeepEqual(t, want, badPeers)
+
+	badPeers = peerStatuses.Bad()
+	sort.Slice(badPeers, func(i, j int) bool {
+		return badPeers[i] < badPeers[j]
+	})
+	assert.DeepEqual(t, want, badPeers, "Bad peers are not deduplicated")
+}
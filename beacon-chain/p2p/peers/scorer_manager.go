@@ -19,6 +19,7 @@ type PeerScorerManager struct {
 	scorers struct {
 		badResponsesScorer  *BadResponsesScorer
 		blockProviderScorer *BlockProviderScorer
+		attestationsScorer  *AttestationsScorer
 	}
 }
 
@@ -26,6 +27,7 @@ type PeerScorerManager struct {
 type PeerScorerConfig struct {
 	BadResponsesScorerConfig  *BadResponsesScorerConfig
 	BlockProviderScorerConfig *BlockProviderScorerConfig
+	AttestationsScorerConfig  *AttestationsScorerConfig
 }
 
 // newPeerScorerManager provides fully initialized peer scoring service.
@@ -36,6 +38,7 @@ func newPeerScorerManager(ctx context.Context, store *peerDataStore, config *Pee
 	}
 	mgr.scorers.badResponsesScorer = newBadResponsesScorer(ctx, store, config.BadResponsesScorerConfig)
 	mgr.scorers.blockProviderScorer = newBlockProviderScorer(ctx, store, config.BlockProviderScorerConfig)
+	mgr.scorers.attestationsScorer = newAttestationsScorer(ctx, store, config.AttestationsScorerConfig)
 	go mgr.loop(mgr.ctx)
 
 	return mgr
@@ -51,6 +54,11 @@ func (m *PeerScorerManager) BlockProviderScorer() *BlockProviderScorer {
 	return m.scorers.blockProviderScorer
 }
 
+// AttestationsScorer exposes attestations scoring service.
+func (m *PeerScorerManager) AttestationsScorer() *AttestationsScorer {
+	return m.scorers.attestationsScorer
+}
+
 // Score returns calculated peer score across all tracked metrics.
 func (m *PeerScorerManager) Score(pid peer.ID) float64 {
 	m.store.RLock()
@@ -62,6 +70,7 @@ func (m *PeerScorerManager) Score(pid peer.ID) float64 {
 	}
 	score += m.scorers.badResponsesScorer.score(pid)
 	score += m.scorers.blockProviderScorer.score(pid)
+	score += m.scorers.attestationsScorer.score(pid)
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
@@ -71,6 +80,8 @@ func (m *PeerScorerManager) loop(ctx context.Context) {
 	defer decayBadResponsesStats.Stop()
 	decayBlockProviderStats := time.NewTicker(m.scorers.blockProviderScorer.Params().DecayInterval)
 	defer decayBlockProviderStats.Stop()
+	decayAttestationsStats := time.NewTicker(m.scorers.attestationsScorer.Params().DecayInterval)
+	defer decayAttestationsStats.Stop()
 
 	for {
 		select {
@@ -78,6 +89,8 @@ func (m *PeerScorerManager) loop(ctx context.Context) {
 			m.scorers.badResponsesScorer.Decay()
 		case <-decayBlockProviderStats.C:
 			m.scorers.blockProviderScorer.Decay()
+		case <-decayAttestationsStats.C:
+			m.scorers.attestationsScorer.Decay()
 		case <-ctx.Done():
 			return
 		}
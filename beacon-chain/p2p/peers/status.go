@@ -284,7 +284,7 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 // IsBad states if the peer is to be considered bad.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
-	return p.scorers.BadResponsesScorer().IsBadPeer(pid)
+	return p.scorers.BadResponsesScorer().IsBadPeer(pid) || p.scorers.AttestationsScorer().IsBadPeer(pid)
 }
 
 // Connecting returns the peers that are connecting.
@@ -367,7 +367,13 @@ func (p *Status) Inactive() []peer.ID {
 
 // Bad returns the peers that are bad.
 func (p *Status) Bad() []peer.ID {
-	return p.scorers.BadResponsesScorer().BadPeers()
+	badPeers := p.scorers.BadResponsesScorer().BadPeers()
+	for _, pid := range p.scorers.AttestationsScorer().BadPeers() {
+		if !p.scorers.BadResponsesScorer().IsBadPeer(pid) {
+			badPeers = append(badPeers, pid)
+		}
+	}
+	return badPeers
 }
 
 // All returns all the peers regardless of state.
@@ -23,16 +23,19 @@ const (
 	RPCPingTopic = "/eth2/beacon_chain/req/ping" + schemaVersionV1
 	// RPCMetaDataTopic defines the topic for the metadata rpc method.
 	RPCMetaDataTopic = "/eth2/beacon_chain/req/metadata" + schemaVersionV1
+	// RPCAttestationPoolTopic defines the topic for the attestation pool rpc method.
+	RPCAttestationPoolTopic = "/eth2/beacon_chain/req/attestation_pool" + schemaVersionV1
 )
 
 // RPCTopicMappings map the base message type to the rpc request.
 var RPCTopicMappings = map[string]interface{}{
-	RPCStatusTopic:        new(pb.Status),
-	RPCGoodByeTopic:       new(uint64),
-	RPCBlocksByRangeTopic: new(pb.BeaconBlocksByRangeRequest),
-	RPCBlocksByRootTopic:  [][32]byte{},
-	RPCPingTopic:          new(uint64),
-	RPCMetaDataTopic:      new(interface{}),
+	RPCStatusTopic:          new(pb.Status),
+	RPCGoodByeTopic:         new(uint64),
+	RPCBlocksByRangeTopic:   new(pb.BeaconBlocksByRangeRequest),
+	RPCBlocksByRootTopic:    [][32]byte{},
+	RPCPingTopic:            new(uint64),
+	RPCMetaDataTopic:        new(interface{}),
+	RPCAttestationPoolTopic: new(uint64),
 }
 
 // VerifyTopicMapping verifies that the topic and its accompanying
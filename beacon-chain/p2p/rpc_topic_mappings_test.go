@@ -14,6 +14,9 @@ func TestVerifyRPCMappings(t *testing.T) {
 	assert.NoError(t, VerifyTopicMapping(RPCMetaDataTopic, new(interface{})), "Failed to verify metadata rpc topic")
 	assert.NotNil(t, VerifyTopicMapping(RPCStatusTopic, new([]byte)), "Incorrect message type verified for metadata rpc topic")
 
+	assert.NoError(t, VerifyTopicMapping(RPCAttestationPoolTopic, new(uint64)), "Failed to verify attestation pool rpc topic")
+	assert.NotNil(t, VerifyTopicMapping(RPCAttestationPoolTopic, new([]byte)), "Incorrect message type verified for attestation pool rpc topic")
+
 	// TODO(#6408) Remove once issue is resolved
 	assert.NoError(t, VerifyTopicMapping(RPCBlocksByRootTopic, [][32]byte{}), "Failed to verify blocks by root rpc topic")
 	assert.NoError(t, VerifyTopicMapping(RPCBlocksByRootTopic, new(pb.BeaconBlocksByRootRequest)), "Failed to verify blocks by root rpc topic")
@@ -170,6 +170,10 @@ func NewService(cfg *Config) (*Service, error) {
 				Weight:        -100,
 				DecayInterval: time.Hour,
 			},
+			AttestationsScorerConfig: &peers.AttestationsScorerConfig{
+				InvalidThreshold:   cfg.InvalidAttestationsThreshold,
+				DuplicateThreshold: cfg.DuplicateAttestationsThreshold,
+			},
 		},
 	})
 
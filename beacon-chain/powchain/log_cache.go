@@ -0,0 +1,61 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// maxLogCacheSize is the number of log queries kept in the log cache.
+const maxLogCacheSize = 256
+
+var (
+	logCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_log_cache_miss",
+		Help: "The number of cacheable log queries that aren't present in the cache.",
+	})
+	logCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_log_cache_hit",
+		Help: "The number of cacheable log queries that are present in the cache.",
+	})
+)
+
+// filterLogs returns the logs matching the query. The logs of a block range behind the eth1
+// follow distance no longer change, so they are served from the log cache when possible.
+func (s *Service) filterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	key, cacheable := s.logCacheKey(query)
+	if cacheable {
+		if logs, ok := s.logCache.Get(key); ok {
+			logCacheHit.Inc()
+			return logs.([]gethTypes.Log), nil
+		}
+		logCacheMiss.Inc()
+	}
+	logs, err := s.logFilterer().FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if cacheable {
+		s.logCache.Add(key, logs)
+	}
+	return logs, nil
+}
+
+// logCacheKey returns the key of the query in the log cache and whether the query spans a
+// block range behind the follow distance of the latest eth1 block.
+func (s *Service) logCacheKey(query ethereum.FilterQuery) (string, bool) {
+	if query.BlockHash != nil || query.FromBlock == nil || query.ToBlock == nil {
+		return "", false
+	}
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	if s.latestEth1Data.BlockHeight < followDistance ||
+		query.ToBlock.Uint64() > s.latestEth1Data.BlockHeight-followDistance {
+		return "", false
+	}
+	return fmt.Sprintf("%d-%d-%v-%v", query.FromBlock, query.ToBlock, query.Addresses, query.Topics), true
+}
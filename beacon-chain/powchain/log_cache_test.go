@@ -0,0 +1,51 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type countingLogger struct {
+	goodLogger
+	calls int
+}
+
+func (c *countingLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	c.calls++
+	return c.goodLogger.FilterLogs(ctx, q)
+}
+
+func TestService_FilterLogs_CachesFollowedRanges(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+	logger := &countingLogger{}
+	web3Service.httpLogger = logger
+	web3Service.latestEth1Data.BlockHeight = params.BeaconConfig().Eth1FollowDistance + 100
+
+	followed := ethereum.FilterQuery{FromBlock: big.NewInt(10), ToBlock: big.NewInt(100)}
+	for i := 0; i < 2; i++ {
+		logs, err := web3Service.filterLogs(context.Background(), followed)
+		require.NoError(t, err)
+		assert.Equal(t, 3, len(logs))
+	}
+	assert.Equal(t, 1, logger.calls, "Expected logs of a followed range to be cached")
+
+	recent := ethereum.FilterQuery{FromBlock: big.NewInt(10), ToBlock: big.NewInt(101)}
+	for i := 0; i < 2; i++ {
+		_, err := web3Service.filterLogs(context.Background(), recent)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 3, logger.calls, "Expected logs of a range within the follow distance not to be cached")
+}
@@ -19,6 +19,7 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -52,6 +53,10 @@ var (
 		Name: "powchain_missed_deposit_logs",
 		Help: "The number of times a missed deposit log is detected",
 	})
+	endpointSwitchCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_endpoint_switches",
+		Help: "The number of times the eth1 endpoint in use has been switched",
+	})
 )
 
 // time to wait before trying to reconnect with the eth1 node.
@@ -60,6 +65,13 @@ var backOffPeriod = 6 * time.Second
 // Amount of times before we log the status of the eth1 dial attempt.
 var logThreshold = 20
 
+// time to wait before checking whether the primary eth1 endpoint is healthy again,
+// while connected to a fallback endpoint.
+var primaryCheckPeriod = 5 * time.Minute
+
+// time to wait for the primary eth1 endpoint to be dialed and respond when checking it.
+var primaryCheckTimeout = 10 * time.Second
+
 // ChainStartFetcher retrieves information pertaining to the chain start event
 // of the beacon chain for usage across various services.
 type ChainStartFetcher interface {
@@ -128,12 +140,15 @@ type Service struct {
 	cancel                  context.CancelFunc
 	headerChan              chan *gethTypes.Header
 	headTicker              *time.Ticker
-	httpEndpoint            string
+	httpEndpoints           []string
+	connectionLock          sync.RWMutex // Protects the current endpoint index and its clients, swapped when switching endpoints.
+	currEndpointIndex       int
 	stateNotifier           statefeed.Notifier
 	httpLogger              bind.ContractFilterer
 	eth1DataFetcher         RPCDataFetcher
 	rpcClient               RPCClient
 	blockCache              *blockCache // cache to store block hash/block height.
+	logCache                *lru.Cache  // cache to store the logs of block ranges behind the follow distance.
 	latestEth1Data          *protodb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
 	depositRoot             []byte
@@ -148,7 +163,7 @@ type Service struct {
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
 type Web3ServiceConfig struct {
-	HTTPEndPoint    string
+	HTTPEndpoints   []string // The first endpoint is the primary one, the others are fallbacks.
 	DepositContract common.Address
 	BeaconDB        db.HeadAccessDatabase
 	DepositCache    *depositcache.DepositCache
@@ -169,12 +184,21 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	if err != nil {
 		return nil, errors.Wrap(err, "could not setup genesis state")
 	}
+	if len(config.HTTPEndpoints) == 0 {
+		cancel()
+		return nil, errors.New("no eth1 endpoint provided")
+	}
+	logCache, err := lru.New(maxLogCacheSize)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not setup log cache")
+	}
 
 	s := &Service{
-		ctx:          ctx,
-		cancel:       cancel,
-		headerChan:   make(chan *gethTypes.Header),
-		httpEndpoint: config.HTTPEndPoint,
+		ctx:           ctx,
+		cancel:        cancel,
+		headerChan:    make(chan *gethTypes.Header),
+		httpEndpoints: config.HTTPEndpoints,
 		latestEth1Data: &protodb.LatestETH1Data{
 			BlockHeight:        0,
 			BlockTime:          0,
@@ -182,6 +206,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			LastRequestedBlock: 0,
 		},
 		blockCache:             newBlockCache(),
+		logCache:               logCache,
 		depositContractAddress: config.DepositContract,
 		stateNotifier:          config.StateNotifier,
 		depositTrie:            depositTrie,
@@ -305,7 +330,7 @@ func (s *Service) LatestBlockHash() common.Hash {
 func (s *Service) AreAllDepositsProcessed() (bool, error) {
 	s.processingLock.RLock()
 	defer s.processingLock.RUnlock()
-	countByte, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	countByte, err := s.contractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return false, errors.Wrap(err, "could not get deposit count")
 	}
@@ -344,13 +369,14 @@ func (s *Service) followBlockHeight(ctx context.Context) (uint64, error) {
 }
 
 func (s *Service) connectToPowChain() error {
-	httpClient, rpcClient, err := s.dialETH1Nodes()
+	httpClient, rpcClient, err := s.dialETH1Nodes(s.ctx, s.currHTTPEndpoint())
 	if err != nil {
 		return errors.Wrap(err, "could not dial eth1 nodes")
 	}
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, httpClient)
 	if err != nil {
+		httpClient.Close()
 		return errors.Wrap(err, "could not create deposit contract caller")
 	}
 
@@ -358,26 +384,30 @@ func (s *Service) connectToPowChain() error {
 	return nil
 }
 
-func (s *Service) dialETH1Nodes() (*ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(s.httpEndpoint)
+func (s *Service) dialETH1Nodes(ctx context.Context, endpoint string) (*ethclient.Client, *gethRPC.Client, error) {
+	httpRPCClient, err := gethRPC.DialContext(ctx, endpoint)
 	if err != nil {
 		return nil, nil, err
 	}
 	httpClient := ethclient.NewClient(httpRPCClient)
 
 	// Make a simple call to ensure we are actually connected to a working node.
-	cID, err := httpClient.ChainID(s.ctx)
+	cID, err := httpClient.ChainID(ctx)
 	if err != nil {
+		httpClient.Close()
 		return nil, nil, err
 	}
-	nID, err := httpClient.NetworkID(s.ctx)
+	nID, err := httpClient.NetworkID(ctx)
 	if err != nil {
+		httpClient.Close()
 		return nil, nil, err
 	}
 	if cID.Uint64() != params.BeaconNetworkConfig().ChainID {
+		httpClient.Close()
 		return nil, nil, fmt.Errorf("eth1 node using incorrect chain id, %d != %d", cID.Uint64(), params.BeaconNetworkConfig().ChainID)
 	}
 	if nID.Uint64() != params.BeaconNetworkConfig().NetworkID {
+		httpClient.Close()
 		return nil, nil, fmt.Errorf("eth1 node using incorrect network id, %d != %d", nID.Uint64(), params.BeaconNetworkConfig().NetworkID)
 	}
 
@@ -389,12 +419,57 @@ func (s *Service) initializeConnection(
 	rpcClient *gethRPC.Client,
 	contractCaller *contracts.DepositContractCaller,
 ) {
+	s.connectionLock.Lock()
+	defer s.connectionLock.Unlock()
+	s.setClients(httpClient, rpcClient, contractCaller)
+}
+
+// setClients replaces the clients of the connection to the eth1 endpoint. The caller must hold
+// the connection lock.
+func (s *Service) setClients(
+	httpClient *ethclient.Client,
+	rpcClient *gethRPC.Client,
+	contractCaller *contracts.DepositContractCaller,
+) {
+	// The http client of the previous connection wraps its rpc client, closing the rpc client
+	// closes both of them.
+	if previous, ok := s.rpcClient.(interface{ Close() }); ok && s.rpcClient != RPCClient(rpcClient) {
+		previous.Close()
+	}
 	s.httpLogger = httpClient
 	s.eth1DataFetcher = httpClient
 	s.depositContractCaller = contractCaller
 	s.rpcClient = rpcClient
 }
 
+// dataFetcher returns the eth1 data fetcher of the current endpoint.
+func (s *Service) dataFetcher() RPCDataFetcher {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.eth1DataFetcher
+}
+
+// logFilterer returns the log filterer of the current endpoint.
+func (s *Service) logFilterer() bind.ContractFilterer {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.httpLogger
+}
+
+// contractCaller returns the deposit contract caller of the current endpoint.
+func (s *Service) contractCaller() *contracts.DepositContractCaller {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.depositContractCaller
+}
+
+// batchCaller returns the rpc client of the current endpoint.
+func (s *Service) batchCaller() RPCClient {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.rpcClient
+}
+
 func (s *Service) waitForConnection() {
 	errConnect := s.connectToPowChain()
 	if errConnect == nil {
@@ -403,7 +478,7 @@ func (s *Service) waitForConnection() {
 		if synced {
 			s.connectedETH1 = true
 			log.WithFields(logrus.Fields{
-				"endpoint": s.httpEndpoint,
+				"endpoint": s.currHTTPEndpoint(),
 			}).Info("Connected to eth1 proof-of-work chain")
 			return
 		}
@@ -414,6 +489,7 @@ func (s *Service) waitForConnection() {
 	if errConnect != nil {
 		log.WithError(errConnect).Error("Could not connect to powchain endpoint")
 	}
+	s.switchEndpoint()
 	// Use a custom logger to only log errors
 	// once in  a while.
 	logCounter := 0
@@ -431,22 +507,25 @@ func (s *Service) waitForConnection() {
 			errConnect := s.connectToPowChain()
 			if errConnect != nil {
 				errorLogger(errConnect, "Could not connect to powchain endpoint")
+				s.switchEndpoint()
 				continue
 			}
 			synced, errSynced := s.isEth1NodeSynced()
 			if errSynced != nil {
 				errorLogger(errSynced, "Could not check sync status of eth1 chain")
+				s.switchEndpoint()
 				continue
 			}
 			if synced {
 				s.connectedETH1 = true
 				log.WithFields(logrus.Fields{
-					"endpoint": s.httpEndpoint,
+					"endpoint": s.currHTTPEndpoint(),
 				}).Info("Connected to eth1 proof-of-work chain")
 				ticker.Stop()
 				return
 			}
 			log.Debug("Eth1 node is currently syncing")
+			s.switchEndpoint()
 		case <-s.ctx.Done():
 			ticker.Stop()
 			log.Debug("Received cancelled context,closing existing powchain service")
@@ -458,7 +537,7 @@ func (s *Service) waitForConnection() {
 // checks if the eth1 node is healthy and ready to serve before
 // fetching data from  it.
 func (s *Service) isEth1NodeSynced() (bool, error) {
-	syncProg, err := s.eth1DataFetcher.SyncProgress(s.ctx)
+	syncProg, err := s.dataFetcher().SyncProgress(s.ctx)
 	if err != nil {
 		return false, err
 	}
@@ -469,18 +548,80 @@ func (s *Service) isEth1NodeSynced() (bool, error) {
 func (s *Service) retryETH1Node(err error) {
 	s.runError = err
 	s.connectedETH1 = false
-	// Back off for a while before
-	// resuming dialing the eth1 node.
-	time.Sleep(backOffPeriod)
+	// Fail over to the next endpoint right away if there is one, otherwise
+	// back off for a while before resuming dialing the eth1 node.
+	if len(s.httpEndpoints) > 1 {
+		s.switchEndpoint()
+	} else {
+		time.Sleep(backOffPeriod)
+	}
 	s.waitForConnection()
 	// Reset run error in the event of a successful connection.
 	s.runError = nil
 }
 
+// currHTTPEndpoint returns the eth1 endpoint in use.
+func (s *Service) currHTTPEndpoint() string {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+	return s.httpEndpoints[s.currEndpointIndex]
+}
+
+// switchEndpoint moves on to the next of the eth1 endpoints, wrapping around to the
+// primary endpoint after the last fallback.
+func (s *Service) switchEndpoint() {
+	if len(s.httpEndpoints) < 2 {
+		return
+	}
+	s.connectionLock.Lock()
+	previous := s.httpEndpoints[s.currEndpointIndex]
+	s.currEndpointIndex = (s.currEndpointIndex + 1) % len(s.httpEndpoints)
+	current := s.httpEndpoints[s.currEndpointIndex]
+	s.connectionLock.Unlock()
+	endpointSwitchCount.Inc()
+	log.WithFields(logrus.Fields{
+		"previous": previous,
+		"endpoint": current,
+	}).Warn("Switching eth1 endpoint")
+}
+
+// checkPrimaryEndpoint switches back to the primary eth1 endpoint, when connected to a
+// fallback endpoint and the primary one is reachable and synced again. The primary endpoint
+// has a limited time to respond, so an unresponsive one doesn't stall the service.
+func (s *Service) checkPrimaryEndpoint() {
+	if s.currHTTPEndpoint() == s.httpEndpoints[0] {
+		return
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, primaryCheckTimeout)
+	defer cancel()
+	httpClient, rpcClient, err := s.dialETH1Nodes(ctx, s.httpEndpoints[0])
+	if err != nil {
+		log.WithError(err).Debug("Primary eth1 endpoint is still unavailable")
+		return
+	}
+	syncProg, err := httpClient.SyncProgress(ctx)
+	if err != nil || syncProg != nil {
+		log.WithError(err).Debug("Primary eth1 endpoint is not synced yet")
+		httpClient.Close()
+		return
+	}
+	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, httpClient)
+	if err != nil {
+		log.WithError(err).Error("Could not create deposit contract caller")
+		httpClient.Close()
+		return
+	}
+	s.connectionLock.Lock()
+	s.setClients(httpClient, rpcClient, depositContractCaller)
+	s.currEndpointIndex = 0
+	s.connectionLock.Unlock()
+	log.WithField("endpoint", s.httpEndpoints[0]).Info("Switched back to primary eth1 endpoint")
+}
+
 // initDataFromContract calls the deposit contract and finds the deposit count
 // and deposit root.
 func (s *Service) initDataFromContract() error {
-	root, err := s.depositContractCaller.GetDepositRoot(&bind.CallOpts{})
+	root, err := s.contractCaller().GetDepositRoot(&bind.CallOpts{})
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve deposit root")
 	}
@@ -557,7 +698,7 @@ func (s *Service) batchRequestHeaders(startBlock uint64, endBlock uint64) ([]*ge
 		headers = append(headers, header)
 		errors = append(errors, err)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.batchCaller().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -637,7 +778,7 @@ func (s *Service) initPOWService() {
 				continue
 			}
 
-			header, err := s.eth1DataFetcher.HeaderByNumber(context.Background(), nil)
+			header, err := s.dataFetcher().HeaderByNumber(context.Background(), nil)
 			if err != nil {
 				log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
 				s.retryETH1Node(err)
@@ -665,6 +806,8 @@ func (s *Service) run(done <-chan struct{}) {
 
 	s.initPOWService()
 
+	primaryTicker := time.NewTicker(primaryCheckPeriod)
+	defer primaryTicker.Stop()
 	for {
 		select {
 		case <-done:
@@ -674,7 +817,7 @@ func (s *Service) run(done <-chan struct{}) {
 			log.Debug("Context closed, exiting goroutine")
 			return
 		case <-s.headTicker.C:
-			head, err := s.eth1DataFetcher.HeaderByNumber(s.ctx, nil)
+			head, err := s.dataFetcher().HeaderByNumber(s.ctx, nil)
 			if err != nil {
 				log.WithError(err).Debug("Could not fetch latest eth1 header")
 				s.retryETH1Node(err)
@@ -682,6 +825,8 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
+		case <-primaryTicker.C:
+			s.checkPrimaryEndpoint()
 		}
 	}
 }
@@ -14,6 +14,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
@@ -130,7 +132,7 @@ func TestStart_OK(t *testing.T) {
 	testAcc, err := contracts.Setup()
 	require.NoError(t, err, "Unable to set up simulated backend")
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: testAcc.ContractAddr,
 		BeaconDB:        beaconDB,
 	})
@@ -159,7 +161,7 @@ func TestStop_OK(t *testing.T) {
 	require.NoError(t, err, "Unable to set up simulated backend")
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: testAcc.ContractAddr,
 		BeaconDB:        beaconDB,
 	})
@@ -184,7 +186,7 @@ func TestService_Eth1Synced(t *testing.T) {
 	require.NoError(t, err, "Unable to set up simulated backend")
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: testAcc.ContractAddr,
 		BeaconDB:        beaconDB,
 	})
@@ -205,7 +207,7 @@ func TestFollowBlock_OK(t *testing.T) {
 	require.NoError(t, err, "Unable to set up simulated backend")
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: testAcc.ContractAddr,
 		BeaconDB:        beaconDB,
 	})
@@ -254,7 +256,7 @@ func TestInitDataFromContract_OK(t *testing.T) {
 	require.NoError(t, err, "Unable to set up simulated backend")
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: testAcc.ContractAddr,
 		BeaconDB:        beaconDB,
 	})
@@ -299,8 +301,8 @@ func TestHandlePanic_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
 	// nil eth1DataFetcher would panic if cached value not used
@@ -308,3 +310,63 @@ func TestHandlePanic_OK(t *testing.T) {
 	web3Service.processBlockHeader(nil)
 	require.LogsContain(t, hook, "Panicked when handling data from ETH 1.0 Chain!")
 }
+
+func TestNewService_NoEndpoints(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	_, err := NewService(context.Background(), &Web3ServiceConfig{
+		BeaconDB: beaconDB,
+	})
+	assert.ErrorContains(t, "no eth1 endpoint provided", err)
+}
+
+func TestService_SwitchEndpoint(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB, _ := dbutil.SetupDB(t)
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{"http://primary", "http://fallback"},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "http://primary", web3Service.currHTTPEndpoint())
+
+	web3Service.switchEndpoint()
+	assert.Equal(t, "http://fallback", web3Service.currHTTPEndpoint())
+	require.LogsContain(t, hook, "Switching eth1 endpoint")
+
+	web3Service.switchEndpoint()
+	assert.Equal(t, "http://primary", web3Service.currHTTPEndpoint())
+
+	// Nothing to switch to with a single endpoint.
+	web3Service.httpEndpoints = []string{"http://primary"}
+	web3Service.switchEndpoint()
+	assert.Equal(t, "http://primary", web3Service.currHTTPEndpoint())
+}
+
+type closableRPCClient struct {
+	closed bool
+}
+
+func (c *closableRPCClient) BatchCall([]gethRPC.BatchElem) error {
+	return nil
+}
+
+func (c *closableRPCClient) Close() {
+	c.closed = true
+}
+
+func TestService_InitializeConnection_ClosesPreviousClient(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{"http://primary", "http://fallback"},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+	previous := &closableRPCClient{}
+	web3Service.rpcClient = previous
+
+	rpcClient := gethRPC.DialInProc(gethRPC.NewServer())
+	defer rpcClient.Close()
+	web3Service.initializeConnection(ethclient.NewClient(rpcClient), rpcClient, nil)
+	assert.Equal(t, true, previous.closed, "Expected the previous rpc client to be closed")
+	assert.Equal(t, RPCClient(rpcClient), web3Service.rpcClient)
+}
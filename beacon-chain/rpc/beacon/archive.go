@@ -0,0 +1,76 @@
+package beacon
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+)
+
+// isArchived returns true if the node is an archival node which indexed the epoch.
+func (bs *Server) isArchived(ctx context.Context, epoch uint64) (bool, error) {
+	if !bs.ArchiveMode {
+		return false, nil
+	}
+	archived, err := bs.BeaconDB.ArchivedEpochs(ctx)
+	if err != nil {
+		return false, err
+	}
+	return epoch < archived, nil
+}
+
+// archivedAttestations returns the attestations included in the canonical blocks of the epoch
+// from the archive index, or nil if the epoch isn't archived.
+func (bs *Server) archivedAttestations(ctx context.Context, epoch uint64) ([]*ethpb.Attestation, error) {
+	archived, err := bs.isArchived(ctx, epoch)
+	if err != nil || !archived {
+		return nil, err
+	}
+	atts, err := bs.BeaconDB.ArchivedAttestations(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if atts == nil {
+		atts = make([]*ethpb.Attestation, 0)
+	}
+	return atts, nil
+}
+
+// archivedCommittees returns the beacon committees of the epoch from the archive index, or nil
+// if the epoch isn't archived.
+func (bs *Server) archivedCommittees(ctx context.Context, epoch uint64) (*ethpb.BeaconCommittees, error) {
+	archived, err := bs.isArchived(ctx, epoch)
+	if err != nil || !archived {
+		return nil, err
+	}
+	return bs.BeaconDB.ArchivedCommittees(ctx, epoch)
+}
+
+// balancesAtEpoch returns the validator balances at the start of the epoch, along with a state
+// holding the validators they belong to. The balances of archived epochs are read from the archive
+// index, with the validators of the head state as the registry only grows.
+func (bs *Server) balancesAtEpoch(ctx context.Context, epoch uint64) (*state.BeaconState, []uint64, error) {
+	archived, err := bs.isArchived(ctx, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if archived {
+		balances, err := bs.BeaconDB.ArchivedBalances(ctx, epoch)
+		if err != nil {
+			return nil, nil, err
+		}
+		headState, err := bs.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if balances != nil && headState != nil {
+			return headState, balances, nil
+		}
+	}
+	st, err := bs.StateGen.StateBySlot(ctx, helpers.StartSlot(epoch))
+	if err != nil {
+		return nil, nil, err
+	}
+	return st, st.Balances(), nil
+}
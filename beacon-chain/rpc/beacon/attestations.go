@@ -9,6 +9,7 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
@@ -61,25 +62,28 @@ func (bs *Server) ListAttestations(
 		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
 			req.PageSize, cmd.Get().MaxRPCPageSize)
 	}
-	var blocks []*ethpb.SignedBeaconBlock
-	var err error
+	var epoch uint64
 	switch q := req.QueryFilter.(type) {
 	case *ethpb.ListAttestationsRequest_GenesisEpoch:
-		blocks, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(0).SetEndEpoch(0))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not fetch attestations: %v", err)
-		}
+		epoch = 0
 	case *ethpb.ListAttestationsRequest_Epoch:
-		blocks, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(q.Epoch).SetEndEpoch(q.Epoch))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not fetch attestations: %v", err)
-		}
+		epoch = q.Epoch
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching attestations")
 	}
-	atts := make([]*ethpb.Attestation, 0, params.BeaconConfig().MaxAttestations*uint64(len(blocks)))
-	for _, block := range blocks {
-		atts = append(atts, block.Block.Body.Attestations...)
+	atts, err := bs.archivedAttestations(ctx, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch archived attestations: %v", err)
+	}
+	if atts == nil {
+		blocks, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(epoch).SetEndEpoch(epoch))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not fetch attestations: %v", err)
+		}
+		atts = make([]*ethpb.Attestation, 0, params.BeaconConfig().MaxAttestations*uint64(len(blocks)))
+		for _, block := range blocks {
+			atts = append(atts, block.Block.Body.Attestations...)
+		}
 	}
 	// We sort attestations according to the Sortable interface.
 	sort.Sort(sortableAttestations(atts))
@@ -199,8 +203,8 @@ func (bs *Server) ListIndexedAttestations(
 func (bs *Server) StreamAttestations(
 	_ *ptypes.Empty, stream ethpb.BeaconChain_StreamAttestationsServer,
 ) error {
-	attestationsChannel := make(chan *feed.Event, 1)
-	attSub := bs.AttestationNotifier.OperationFeed().Subscribe(attestationsChannel)
+	attestationsChannel := make(chan *feed.Event, streamBufferSize)
+	attSub := bs.AttestationNotifier.OperationFeed().SubscribeDropping(attestationsChannel)
 	defer attSub.Unsubscribe()
 	for {
 		select {
@@ -228,14 +232,21 @@ func (bs *Server) StreamAttestations(
 }
 
 // StreamIndexedAttestations to clients at the end of every slot. This method retrieves the
-// aggregated attestations currently in the pool, converts them into indexed form, and
-// sends them over a gRPC stream.
+// aggregated attestations currently in the pool and the attestations of the blocks received,
+// converts them into indexed form, and sends them over a gRPC stream.
 func (bs *Server) StreamIndexedAttestations(
 	_ *ptypes.Empty, stream ethpb.BeaconChain_StreamIndexedAttestationsServer,
 ) error {
-	attestationsChannel := make(chan *feed.Event, 1)
-	attSub := bs.AttestationNotifier.OperationFeed().Subscribe(attestationsChannel)
+	attestationsChannel := make(chan *feed.Event, streamBufferSize)
+	attSub := bs.AttestationNotifier.OperationFeed().SubscribeDropping(attestationsChannel)
 	defer attSub.Unsubscribe()
+	// Without a block feed, the channel stays nil and never receives.
+	var blocksChannel chan *feed.Event
+	if bs.BlockNotifier != nil {
+		blocksChannel = make(chan *feed.Event, streamBufferSize)
+		blockSub := bs.BlockNotifier.BlockFeed().SubscribeDropping(blocksChannel)
+		defer blockSub.Unsubscribe()
+	}
 	go bs.collectReceivedAttestations(stream.Context())
 	for {
 		select {
@@ -271,6 +282,8 @@ func (bs *Server) StreamIndexedAttestations(
 				}
 				bs.ReceivedAttestationsBuffer <- data.Attestation.Aggregate
 			}
+		case event := <-blocksChannel:
+			bs.bufferBlockAttestations(event)
 		case aggAtts, ok := <-bs.CollectedAttestationsBuffer:
 			if !ok {
 				log.Error("Indexed attestations stream collected attestations channel closed")
@@ -307,7 +320,7 @@ func (bs *Server) StreamIndexedAttestations(
 					continue
 				}
 				committeesForSlot, ok := committeesBySlot[att.Data.Slot]
-				if !ok || committeesForSlot.Committees == nil {
+				if !ok || att.Data.CommitteeIndex >= uint64(len(committeesForSlot.Committees)) {
 					continue
 				}
 				committee := committeesForSlot.Committees[att.Data.CommitteeIndex]
@@ -324,6 +337,29 @@ func (bs *Server) StreamIndexedAttestations(
 	}
 }
 
+// bufferBlockAttestations sends the attestations of the block of a received block event to be
+// collected with the attestations received over gossip.
+func (bs *Server) bufferBlockAttestations(event *feed.Event) {
+	if event.Type != blockfeed.ReceivedBlock {
+		return
+	}
+	data, ok := event.Data.(*blockfeed.ReceivedBlockData)
+	if !ok {
+		log.Warningf("Indexed attestations stream got data of wrong type on stream expected *ReceivedBlockData, received %T", event.Data)
+		return
+	}
+	if data.SignedBlock == nil || data.SignedBlock.Block == nil || data.SignedBlock.Block.Body == nil {
+		log.Debug("Indexed attestations stream got a nil block")
+		return
+	}
+	for _, att := range data.SignedBlock.Block.Body.Attestations {
+		if att == nil || att.Data == nil {
+			continue
+		}
+		bs.ReceivedAttestationsBuffer <- att
+	}
+}
+
 // already being done by the attestation pool in the operations service.
 func (bs *Server) collectReceivedAttestations(ctx context.Context) {
 	attsByRoot := make(map[[32]byte][]*ethpb.Attestation)
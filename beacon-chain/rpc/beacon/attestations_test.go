@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -519,6 +520,36 @@ func TestServer_ListAttestations_Pagination_DefaultPageSize(t *testing.T) {
 	assert.DeepEqual(t, atts[i:j], res.Attestations, "Incorrect attestations response")
 }
 
+func TestServer_ListAttestations_Archived(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	att := &ethpb.Attestation{
+		Signature: make([]byte, 96),
+		Data: &ethpb.AttestationData{
+			Slot:            3,
+			Target:          &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte("root"), 32)},
+			Source:          &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte("root"), 32)},
+			BeaconBlockRoot: make([]byte, 32),
+		},
+	}
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, nil, []*ethpb.Attestation{att}, nil))
+	req := &ethpb.ListAttestationsRequest{
+		QueryFilter: &ethpb.ListAttestationsRequest_Epoch{Epoch: 0},
+	}
+
+	// Pruned nodes only read the attestations of the blocks in the database.
+	bs := &Server{BeaconDB: db}
+	res, err := bs.ListAttestations(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Attestations))
+
+	bs.ArchiveMode = true
+	res, err = bs.ListAttestations(ctx, req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.Attestation{att}, res.Attestations)
+}
+
 func TestServer_mapAttestationToTargetRoot(t *testing.T) {
 	count := uint64(100)
 	atts := make([]*ethpb.Attestation, count)
@@ -1086,3 +1117,16 @@ func TestServer_StreamAttestations_OnSlotTick(t *testing.T) {
 	}
 	<-exitRoutine
 }
+
+func TestServer_BufferBlockAttestations(t *testing.T) {
+	server := &Server{ReceivedAttestationsBuffer: make(chan *ethpb.Attestation, 2)}
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}}
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Body.Attestations = []*ethpb.Attestation{att, nil}
+
+	server.bufferBlockAttestations(&feed.Event{Type: operation.UnaggregatedAttReceived, Data: &blockfeed.ReceivedBlockData{SignedBlock: blk}})
+	server.bufferBlockAttestations(&feed.Event{Type: blockfeed.ReceivedBlock, Data: &blockfeed.ReceivedBlockData{}})
+	server.bufferBlockAttestations(&feed.Event{Type: blockfeed.ReceivedBlock, Data: &blockfeed.ReceivedBlockData{SignedBlock: blk}})
+	require.Equal(t, 1, len(server.ReceivedAttestationsBuffer), "Expected only the attestation of the received block")
+	assert.Equal(t, att, <-server.ReceivedAttestationsBuffer)
+}
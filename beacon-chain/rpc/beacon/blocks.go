@@ -177,8 +177,8 @@ func (bs *Server) GetChainHead(ctx context.Context, _ *ptypes.Empty) (*ethpb.Cha
 
 // StreamBlocks to clients every single time a block is received by the beacon node.
 func (bs *Server) StreamBlocks(_ *ptypes.Empty, stream ethpb.BeaconChain_StreamBlocksServer) error {
-	blocksChannel := make(chan *feed.Event, 1)
-	blockSub := bs.BlockNotifier.BlockFeed().Subscribe(blocksChannel)
+	blocksChannel := make(chan *feed.Event, streamBufferSize)
+	blockSub := bs.BlockNotifier.BlockFeed().SubscribeDropping(blocksChannel)
 	defer blockSub.Unsubscribe()
 	for {
 		select {
@@ -219,8 +219,8 @@ func (bs *Server) StreamBlocks(_ *ptypes.Empty, stream ethpb.BeaconChain_StreamB
 
 // StreamChainHead to clients every single time the head block and state of the chain change.
 func (bs *Server) StreamChainHead(_ *ptypes.Empty, stream ethpb.BeaconChain_StreamChainHeadServer) error {
-	stateChannel := make(chan *feed.Event, 1)
-	stateSub := bs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	stateChannel := make(chan *feed.Event, streamBufferSize)
+	stateSub := bs.StateNotifier.StateFeed().SubscribeDropping(stateChannel)
 	defer stateSub.Unsubscribe()
 	for {
 		select {
@@ -43,6 +43,14 @@ func (bs *Server) ListBeaconCommittees(
 		)
 	}
 
+	archived, err := bs.archivedCommittees(ctx, requestedEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch archived committees: %v", err)
+	}
+	if archived != nil {
+		return archived, nil
+	}
+
 	committees, activeIndices, err := bs.retrieveCommitteesForEpoch(ctx, requestedEpoch)
 	if err != nil {
 		return nil, status.Errorf(
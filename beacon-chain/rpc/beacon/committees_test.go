@@ -72,6 +72,35 @@ func TestServer_ListBeaconCommittees_CurrentEpoch(t *testing.T) {
 	}
 }
 
+func TestServer_ListBeaconCommittees_Archived(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	archived := &ethpb.BeaconCommittees{
+		Epoch: 0,
+		Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+			0: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{{ValidatorIndices: []uint64{3, 1}}}},
+		},
+		ActiveValidatorCount: 2,
+	}
+	require.NoError(t, db.SaveArchivedCommittees(ctx, archived))
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, nil, nil, nil))
+
+	// The committees are read from the archive, without a state to compute them from.
+	bs := &Server{
+		BeaconDB:           db,
+		ArchiveMode:        true,
+		GenesisTimeFetcher: &mock.ChainService{},
+	}
+	res, err := bs.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Genesis{Genesis: true},
+	})
+	require.NoError(t, err)
+	if !proto.Equal(res, archived) {
+		t.Errorf("Expected %v, received %v", archived, res)
+	}
+}
+
 func TestServer_ListBeaconCommittees_PreviousEpoch(t *testing.T) {
 	params.UseMainnetConfig()
 	ctx := context.Background()
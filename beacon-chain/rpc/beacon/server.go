@@ -23,6 +23,10 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
+// streamBufferSize is the number of events buffered for each stream. Events are dropped
+// for streams too slow to keep up, so they never stall the notifications of the node.
+const streamBufferSize = 64
+
 // Server defines a server implementation of the gRPC Beacon Chain service,
 // providing RPC endpoints to access data relevant to the Ethereum 2.0 phase 0
 // beacon chain.
@@ -47,4 +51,7 @@ type Server struct {
 	CollectedAttestationsBuffer chan []*ethpb.Attestation
 	StateGen                    *stategen.State
 	SyncChecker                 sync.Checker
+	// ArchiveMode serves the balances and attestations of archived epochs from the archive
+	// indices, rather than regenerating states and reading blocks.
+	ArchiveMode bool
 }
@@ -57,13 +57,12 @@ func (bs *Server) ListValidatorBalances(
 	res := make([]*ethpb.ValidatorBalances_Balance, 0)
 	filtered := map[uint64]bool{} // Track filtered validators to prevent duplication in the response.
 
-	requestedState, err := bs.StateGen.StateBySlot(ctx, helpers.StartSlot(requestedEpoch))
+	requestedState, balances, err := bs.balancesAtEpoch(ctx, requestedEpoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get state")
 	}
 
 	validators := requestedState.Validators()
-	balances := requestedState.Balances()
 	balancesCount := len(balances)
 	for _, pubKey := range req.PublicKeys {
 		// Skip empty public key.
@@ -112,7 +112,7 @@ func (bs *Server) StreamValidatorsInfo(stream ethpb.BeaconChain_StreamValidators
 		pubKeys:             make([][]byte, 0),
 		pubKeysMutex:        &sync.RWMutex{},
 		stateChannel:        stateChannel,
-		stateSub:            bs.StateNotifier.StateFeed().Subscribe(stateChannel),
+		stateSub:            bs.StateNotifier.StateFeed().SubscribeDropping(stateChannel),
 		eth1Deposits:        cache.New(epochDuration, epochDuration*2),
 		eth1DepositsMutex:   &sync.RWMutex{},
 		eth1Blocktimes:      cache.New(epochDuration*12, epochDuration*24),
@@ -406,6 +406,35 @@ func TestServer_ListValidatorBalances_OutOfRange(t *testing.T) {
 	assert.ErrorContains(t, wanted, err)
 }
 
+func TestServer_ListValidatorBalances_Archived(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	// Validators activated after the archived epoch aren't part of its balances.
+	validators, _ := setupValidators(t, db, 3)
+	headState, err := db.HeadState(ctx)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, []uint64{10, 20}, nil, nil))
+
+	bs := &Server{
+		BeaconDB:           db,
+		ArchiveMode:        true,
+		GenesisTimeFetcher: &mock.ChainService{},
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+	}
+	res, err := bs.ListValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0},
+	})
+	require.NoError(t, err)
+	wanted := []*ethpb.ValidatorBalances_Balance{
+		{PublicKey: validators[0].PublicKey, Index: 0, Balance: 10},
+		{PublicKey: validators[1].PublicKey, Index: 1, Balance: 20},
+	}
+	assert.DeepEqual(t, wanted, res.Balances)
+}
+
 func TestServer_ListValidators_CannotRequestFutureEpoch(t *testing.T) {
 	db, _ := dbTest.SetupDB(t)
 	ctx := context.Background()
@@ -0,0 +1,142 @@
+package beaconv1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// The standard API encodes the integers as decimal strings and the bytes as 0x prefixed hex strings.
+
+type attestationJSON struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Signature       string               `json:"signature"`
+	Data            *attestationDataJSON `json:"data"`
+}
+
+type attestationDataJSON struct {
+	Slot            string          `json:"slot"`
+	Index           string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJSON `json:"source"`
+	Target          *checkpointJSON `json:"target"`
+}
+
+type checkpointJSON struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type dataResponseJSON struct {
+	Data interface{} `json:"data"`
+}
+
+type errorJSON struct {
+	Code     int                 `json:"code"`
+	Message  string              `json:"message"`
+	Failures []*indexedErrorJSON `json:"failures,omitempty"`
+}
+
+type indexedErrorJSON struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+func marshalAttestation(att *ethpb.Attestation) *attestationJSON {
+	return &attestationJSON{
+		AggregationBits: hexutil.Encode(att.AggregationBits),
+		Signature:       hexutil.Encode(att.Signature),
+		Data: &attestationDataJSON{
+			Slot:            strconv.FormatUint(att.Data.Slot, 10),
+			Index:           strconv.FormatUint(att.Data.CommitteeIndex, 10),
+			BeaconBlockRoot: hexutil.Encode(att.Data.BeaconBlockRoot),
+			Source:          marshalCheckpoint(att.Data.Source),
+			Target:          marshalCheckpoint(att.Data.Target),
+		},
+	}
+}
+
+func marshalCheckpoint(c *ethpb.Checkpoint) *checkpointJSON {
+	if c == nil {
+		return nil
+	}
+	return &checkpointJSON{
+		Epoch: strconv.FormatUint(c.Epoch, 10),
+		Root:  hexutil.Encode(c.Root),
+	}
+}
+
+func unmarshalAttestation(a *attestationJSON) (*ethpb.Attestation, error) {
+	if a == nil || a.Data == nil || a.Data.Source == nil || a.Data.Target == nil {
+		return nil, errors.New("missing attestation data")
+	}
+	bits, err := hexutil.Decode(a.AggregationBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid aggregation bits")
+	}
+	sig, err := hexutil.Decode(a.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature")
+	}
+	slot, err := strconv.ParseUint(a.Data.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slot")
+	}
+	index, err := strconv.ParseUint(a.Data.Index, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid committee index")
+	}
+	blockRoot, err := hexutil.Decode(a.Data.BeaconBlockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid beacon block root")
+	}
+	source, err := unmarshalCheckpoint(a.Data.Source)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid source")
+	}
+	target, err := unmarshalCheckpoint(a.Data.Target)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid target")
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Signature:       sig,
+		Data: &ethpb.AttestationData{
+			Slot:            slot,
+			CommitteeIndex:  index,
+			BeaconBlockRoot: blockRoot,
+			Source:          source,
+			Target:          target,
+		},
+	}, nil
+}
+
+func unmarshalCheckpoint(c *checkpointJSON) (*ethpb.Checkpoint, error) {
+	epoch, err := strconv.ParseUint(c.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid epoch")
+	}
+	root, err := hexutil.Decode(c.Root)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid root")
+	}
+	return &ethpb.Checkpoint{Epoch: epoch, Root: root}, nil
+}
+
+// writeJSON writes the value as the JSON body of a response with the status code.
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Could not write response")
+	}
+}
+
+// writeError writes an error response in the format of the standard API.
+func writeError(w http.ResponseWriter, code int, message string, failures []*indexedErrorJSON) {
+	writeJSON(w, code, &errorJSON{Code: code, Message: message, Failures: failures})
+}
@@ -0,0 +1,5 @@
+package beaconv1
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rpc/beaconv1")
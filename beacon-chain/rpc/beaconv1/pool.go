@@ -0,0 +1,160 @@
+package beaconv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+// maxPoolAttestationsBodySize is the maximum size in bytes of the request body submitting
+// attestations, which fits a few thousand JSON attestations.
+const maxPoolAttestationsBodySize = 4 << 20
+
+// PoolAttestations serves the attestation pool endpoint, GET lists the attestations of the pool
+// and POST submits attestations to the pool.
+func (bs *Server) PoolAttestations(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		bs.listPoolAttestations(w, r)
+	case http.MethodPost:
+		bs.submitPoolAttestations(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed", nil)
+	}
+}
+
+// listPoolAttestations writes the aggregated and unaggregated attestations of the pool, optionally
+// filtered by the slot and committee_index query parameters.
+func (bs *Server) listPoolAttestations(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beaconv1.listPoolAttestations")
+	defer span.End()
+
+	slot, filterSlot, err := uintQueryParam(r, "slot")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	index, filterIndex, err := uintQueryParam(r, "committee_index")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	unaggregatedAtts, err := bs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("Could not get unaggregated attestations: %v", err), nil)
+		return
+	}
+	atts := append(bs.AttestationsPool.AggregatedAttestations(), unaggregatedAtts...)
+	data := make([]*attestationJSON, 0, len(atts))
+	for _, att := range atts {
+		if att.Data == nil {
+			continue
+		}
+		if filterSlot && att.Data.Slot != slot {
+			continue
+		}
+		if filterIndex && att.Data.CommitteeIndex != index {
+			continue
+		}
+		data = append(data, marshalAttestation(att))
+	}
+	writeJSON(w, http.StatusOK, &dataResponseJSON{Data: data})
+}
+
+// submitPoolAttestations validates the unaggregated attestations of the request body, saves the
+// valid ones in the pool and broadcasts them to their subnet. The attestations failing validation
+// are reported by index of the request.
+func (bs *Server) submitPoolAttestations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beaconv1.submitPoolAttestations")
+	defer span.End()
+
+	var body []*attestationJSON
+	r.Body = http.MaxBytesReader(w, r.Body, maxPoolAttestationsBodySize)
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Could not decode request body: %v", err), nil)
+		return
+	}
+
+	var failures []*indexedErrorJSON
+	for i, a := range body {
+		att, err := unmarshalAttestation(a)
+		if err == nil {
+			err = bs.submitAttestation(ctx, att)
+		}
+		if err != nil {
+			failures = append(failures, &indexedErrorJSON{Index: i, Message: err.Error()})
+		}
+	}
+	if len(failures) > 0 {
+		writeError(w, http.StatusBadRequest, "Some attestations failed validation", failures)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// submitAttestation validates the attestation against its pre state, saves it in the unaggregated
+// attestations of the pool and broadcasts it to the subnet of its committee.
+func (bs *Server) submitAttestation(ctx context.Context, att *ethpb.Attestation) error {
+	if att.AggregationBits.Count() != 1 {
+		return errors.New("attestation is not unaggregated")
+	}
+	if err := helpers.ValidateAttestationTime(att.Data.Slot, bs.GenesisTimeFetcher.GenesisTime()); err != nil {
+		return err
+	}
+	preState, err := bs.AttestationReceiver.AttestationPreState(ctx, att)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve attestation pre state")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve attestation committee")
+	}
+	if att.AggregationBits.Len() != uint64(len(committee)) {
+		return errors.New("aggregation bits length does not match the committee size")
+	}
+	if err := blocks.VerifyAttestationSignature(ctx, preState, att); err != nil {
+		return errors.Wrap(err, "invalid attestation signature")
+	}
+
+	bs.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{
+			Attestation: att,
+		},
+	})
+	vals, err := bs.HeadFetcher.HeadValidatorsIndices(ctx, helpers.SlotToEpoch(att.Data.Slot))
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve active validators")
+	}
+	subnet := helpers.ComputeSubnetFromCommitteeAndSlot(uint64(len(vals)), att.Data.CommitteeIndex, att.Data.Slot)
+	if err := bs.Broadcaster.BroadcastAttestation(ctx, subnet, att); err != nil {
+		return errors.Wrap(err, "could not broadcast attestation")
+	}
+	return bs.AttestationsPool.SaveUnaggregatedAttestation(stateTrie.CopyAttestation(att))
+}
+
+// uintQueryParam returns the unsigned integer value of the query parameter, and if it was set.
+func uintQueryParam(r *http.Request, name string) (uint64, bool, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return 0, false, nil
+	}
+	n, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s query parameter: %v", name, err)
+	}
+	return n, true, nil
+}
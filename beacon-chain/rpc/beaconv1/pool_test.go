@@ -0,0 +1,136 @@
+package beaconv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListPoolAttestations(t *testing.T) {
+	pool := attestations.NewPool()
+	bs := &Server{AttestationsPool: pool}
+	aggregated := testutil.NewAttestation(1, 0, bitfield.Bitlist{0b1101})
+	unaggregated := testutil.NewAttestation(2, 3, bitfield.Bitlist{0b1001})
+	require.NoError(t, pool.SaveAggregatedAttestation(aggregated))
+	require.NoError(t, pool.SaveUnaggregatedAttestation(unaggregated))
+
+	tests := []struct {
+		query string
+		want  []*ethpb.Attestation
+	}{
+		{query: "", want: []*ethpb.Attestation{aggregated, unaggregated}},
+		{query: "?slot=1", want: []*ethpb.Attestation{aggregated}},
+		{query: "?slot=2&committee_index=3", want: []*ethpb.Attestation{unaggregated}},
+		{query: "?committee_index=1", want: []*ethpb.Attestation{}},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		bs.PoolAttestations(w, httptest.NewRequest(http.MethodGet, PoolAttestationsPath+tt.query, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &struct {
+			Data []*attestationJSON `json:"data"`
+		}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, len(tt.want), len(resp.Data), tt.query)
+		for i, a := range resp.Data {
+			att, err := unmarshalAttestation(a)
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want[i], att, tt.query)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	bs.PoolAttestations(w, httptest.NewRequest(http.MethodGet, PoolAttestationsPath+"?slot=a", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_SubmitPoolAttestations(t *testing.T) {
+	beaconState, keys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, beaconState.SetSlot(1))
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{
+			Genesis: time.Now().Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second),
+		},
+		HeadFetcher:         &mock.ChainService{State: beaconState},
+		AttestationReceiver: &mock.ChainService{State: beaconState},
+		AttestationsPool:    attestations.NewPool(),
+		Broadcaster:         &mockp2p.MockBroadcaster{},
+		OperationNotifier:   (&mock.ChainService{}).OperationNotifier(),
+	}
+
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, 1, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	att := testutil.NewAttestation(1, 0, bits)
+	domain, err := helpers.Domain(beaconState.Fork(), 0, params.BeaconConfig().DomainBeaconAttester, beaconState.GenesisValidatorRoot())
+	require.NoError(t, err)
+	signingRoot, err := helpers.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+	att.Signature = keys[committee[0]].Sign(signingRoot[:]).Marshal()
+
+	body, err := json.Marshal([]*attestationJSON{marshalAttestation(att)})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	bs.PoolAttestations(w, httptest.NewRequest(http.MethodPost, PoolAttestationsPath, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+	saved, err := bs.AttestationsPool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	assert.DeepEqual(t, att, saved[0])
+	assert.Equal(t, true, bs.Broadcaster.(*mockp2p.MockBroadcaster).BroadcastCalled)
+
+	badSig := testutil.NewAttestation(1, 0, bits)
+	badSig.Data.BeaconBlockRoot = bytes.Repeat([]byte{'a'}, 32)
+	aggregatedBits := bitfield.NewBitlist(uint64(len(committee)))
+	aggregatedBits.SetBitAt(0, true)
+	aggregatedBits.SetBitAt(1, true)
+	body, err = json.Marshal([]*attestationJSON{
+		marshalAttestation(badSig),
+		marshalAttestation(att),
+		marshalAttestation(testutil.NewAttestation(1, 0, aggregatedBits)),
+	})
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	bs.PoolAttestations(w, httptest.NewRequest(http.MethodPost, PoolAttestationsPath, bytes.NewReader(body)))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	resp := &errorJSON{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Failures))
+	assert.Equal(t, 0, resp.Failures[0].Index)
+	assert.Equal(t, 2, resp.Failures[1].Index)
+	saved, err = bs.AttestationsPool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(saved), "Invalid attestations were saved")
+
+	w = httptest.NewRecorder()
+	bs.PoolAttestations(w, httptest.NewRequest(http.MethodPost, PoolAttestationsPath, bytes.NewReader([]byte("{"))))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	oversized := append([]byte("["), bytes.Repeat([]byte(" "), maxPoolAttestationsBodySize)...)
+	w = httptest.NewRecorder()
+	bs.PoolAttestations(w, httptest.NewRequest(http.MethodPost, PoolAttestationsPath, bytes.NewReader(append(oversized, ']'))))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_PoolAttestations_MethodNotAllowed(t *testing.T) {
+	bs := &Server{}
+	w := httptest.NewRecorder()
+	bs.PoolAttestations(w, httptest.NewRequest(http.MethodDelete, PoolAttestationsPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "GET, POST", w.Header().Get("Allow"))
+}
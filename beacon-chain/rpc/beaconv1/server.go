@@ -0,0 +1,32 @@
+// Package beaconv1 defines the HTTP handlers of the standard Eth2 beacon node API,
+// served next to the gRPC gateway so that third-party validator clients using the
+// standard API can use a Prysm beacon node.
+package beaconv1
+
+import (
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+)
+
+// PoolAttestationsPath is the path of the standard API attestation pool endpoint.
+const PoolAttestationsPath = "/eth/v1/beacon/pool/attestations"
+
+// Server defines a server implementation of the standard Eth2 beacon node API
+// endpoints, backed by the services of the beacon node.
+type Server struct {
+	GenesisTimeFetcher  blockchain.TimeFetcher
+	HeadFetcher         blockchain.HeadFetcher
+	AttestationReceiver blockchain.AttestationReceiver
+	AttestationsPool    attestations.Pool
+	Broadcaster         p2p.Broadcaster
+	OperationNotifier   operation.Notifier
+}
+
+// RegisterHandlers registers the handlers of the standard API endpoints to the mux.
+func (bs *Server) RegisterHandlers(mux *http.ServeMux) {
+	mux.HandleFunc(PoolAttestationsPath, bs.PoolAttestations)
+}
@@ -0,0 +1,125 @@
+package debug
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAttestationPool returns the attestations of the attestation pool, filtered by the
+// slots and committee indices of the request.
+func (ds *Server) GetAttestationPool(ctx context.Context, req *pbrpc.AttestationPoolRequest) (*pbrpc.AttestationPoolResponse, error) {
+	filter := newAttestationFilter(req)
+	// Only the unaggregated attestations of the request are copied out of the pool.
+	unaggregated := make([]*ethpb.Attestation, 0)
+	if err := ds.AttestationsPool.ForEachUnaggregated(func(att *ethpb.Attestation) bool {
+		if filter.matches(att) {
+			unaggregated = append(unaggregated, stateTrie.CopyAttestation(att))
+		}
+		return true
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve unaggregated attestations: %v", err)
+	}
+	return &pbrpc.AttestationPoolResponse{
+		UnaggregatedAttestations: unaggregated,
+		AggregatedAttestations:   filter.apply(ds.AttestationsPool.AggregatedAttestations()),
+		BlockAttestations:        filter.apply(ds.AttestationsPool.BlockAttestations()),
+		ForkchoiceAttestations:   filter.apply(ds.AttestationsPool.ForkchoiceAttestations()),
+	}, nil
+}
+
+// attestationFilter matches the attestations of a set of slots and committee indices, an
+// empty set matches all of them.
+type attestationFilter struct {
+	slots            map[uint64]bool
+	committeeIndices map[uint64]bool
+}
+
+func newAttestationFilter(req *pbrpc.AttestationPoolRequest) *attestationFilter {
+	f := &attestationFilter{
+		slots:            make(map[uint64]bool, len(req.Slots)),
+		committeeIndices: make(map[uint64]bool, len(req.CommitteeIndices)),
+	}
+	for _, slot := range req.Slots {
+		f.slots[slot] = true
+	}
+	for _, idx := range req.CommitteeIndices {
+		f.committeeIndices[idx] = true
+	}
+	return f
+}
+
+func (f *attestationFilter) apply(atts []*ethpb.Attestation) []*ethpb.Attestation {
+	filtered := make([]*ethpb.Attestation, 0, len(atts))
+	for _, att := range atts {
+		if f.matches(att) {
+			filtered = append(filtered, att)
+		}
+	}
+	return filtered
+}
+
+func (f *attestationFilter) matches(att *ethpb.Attestation) bool {
+	if att.Data == nil {
+		return false
+	}
+	if len(f.slots) > 0 && !f.slots[att.Data.Slot] {
+		return false
+	}
+	return len(f.committeeIndices) == 0 || f.committeeIndices[att.Data.CommitteeIndex]
+}
+
+// ListValidatorAttestations returns the attestations of the validator included in the blocks of
+// the epoch. Archived epochs are read from the validator index of the archive, the attesting
+// indices of the other epochs are computed from the committees of the state at the epoch start.
+func (ds *Server) ListValidatorAttestations(ctx context.Context, req *pbrpc.ValidatorAttestationsRequest) (*pbrpc.ValidatorAttestationsResponse, error) {
+	archived, err := ds.BeaconDB.ArchivedEpochs(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve archived epochs: %v", err)
+	}
+	if req.Epoch < archived {
+		atts, err := ds.BeaconDB.ArchivedAttestationsByValidator(ctx, req.Epoch, req.ValidatorIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve archived attestations: %v", err)
+		}
+		if atts == nil {
+			atts = make([]*ethpb.Attestation, 0)
+		}
+		return &pbrpc.ValidatorAttestationsResponse{Attestations: atts}, nil
+	}
+
+	blocks, err := ds.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(req.Epoch).SetEndEpoch(req.Epoch))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+	}
+	atts := make([]*ethpb.Attestation, 0)
+	if len(blocks) == 0 {
+		return &pbrpc.ValidatorAttestationsResponse{Attestations: atts}, nil
+	}
+	st, err := ds.StateGen.StateBySlot(ctx, helpers.StartSlot(req.Epoch))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve state: %v", err)
+	}
+	for _, blk := range blocks {
+		for _, att := range blk.Block.Body.Attestations {
+			committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not retrieve committee: %v", err)
+			}
+			for _, idx := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+				if idx == req.ValidatorIndex {
+					atts = append(atts, att)
+					break
+				}
+			}
+		}
+	}
+	return &pbrpc.ValidatorAttestationsResponse{Attestations: atts}, nil
+}
@@ -0,0 +1,71 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetAttestationPool(t *testing.T) {
+	pool := attestations.NewPool()
+	unaggregated := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1, CommitteeIndex: 0}, AggregationBits: bitfield.Bitlist{0b1001}},
+		{Data: &ethpb.AttestationData{Slot: 1, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b1001}},
+		{Data: &ethpb.AttestationData{Slot: 2, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b1001}},
+	}
+	require.NoError(t, pool.SaveUnaggregatedAttestations(unaggregated))
+	aggregated := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b1011}}
+	require.NoError(t, pool.SaveAggregatedAttestation(aggregated))
+	block := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b1111}}
+	require.NoError(t, pool.SaveBlockAttestation(block))
+	forkchoice := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1, CommitteeIndex: 1}, AggregationBits: bitfield.Bitlist{0b1101}}
+	require.NoError(t, pool.SaveForkchoiceAttestation(forkchoice))
+	ds := &Server{AttestationsPool: pool}
+
+	res, err := ds.GetAttestationPool(context.Background(), &pbrpc.AttestationPoolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(res.UnaggregatedAttestations))
+	assert.Equal(t, 1, len(res.AggregatedAttestations))
+	assert.Equal(t, 1, len(res.BlockAttestations))
+	assert.Equal(t, 1, len(res.ForkchoiceAttestations))
+
+	res, err = ds.GetAttestationPool(context.Background(), &pbrpc.AttestationPoolRequest{
+		Slots:            []uint64{1},
+		CommitteeIndices: []uint64{1},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.Attestation{unaggregated[1]}, res.UnaggregatedAttestations)
+	assert.Equal(t, 0, len(res.AggregatedAttestations))
+	assert.DeepEqual(t, []*ethpb.Attestation{block}, res.BlockAttestations)
+	assert.DeepEqual(t, []*ethpb.Attestation{forkchoice}, res.ForkchoiceAttestations)
+}
+
+func TestServer_ListValidatorAttestations_Archived(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+	atts := []*ethpb.Attestation{
+		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}},
+		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1011}},
+	}
+	require.NoError(t, db.SaveArchivedEpoch(ctx, 0, nil, atts, [][]uint64{{4, 7}, {7, 9}}))
+	ds := &Server{BeaconDB: db}
+
+	res, err := ds.ListValidatorAttestations(ctx, &pbrpc.ValidatorAttestationsRequest{ValidatorIndex: 9, Epoch: 0})
+	require.NoError(t, err)
+	assert.DeepEqual(t, atts[1:], res.Attestations)
+	res, err = ds.ListValidatorAttestations(ctx, &pbrpc.ValidatorAttestationsRequest{ValidatorIndex: 5, Epoch: 0})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Attestations))
+
+	// Epochs without blocks have no attestations, whether or not they are archived.
+	res, err = ds.ListValidatorAttestations(ctx, &pbrpc.ValidatorAttestationsRequest{ValidatorIndex: 7, Epoch: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Attestations))
+}
@@ -0,0 +1,159 @@
+package debug
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dumpBlockTreeSlots is the number of slots before the head of the fork choice summary of a dump.
+const dumpBlockTreeSlots = 64
+
+type syncStatus struct {
+	Syncing     bool   `json:"syncing"`
+	HeadSlot    uint64 `json:"head_slot"`
+	HeadRoot    string `json:"head_root"`
+	CurrentSlot uint64 `json:"current_slot"`
+}
+
+// GetDiagnosticDump captures the goroutine stacks, heap profile, configuration, peers, sync
+// status, fork choice summary and recent logs of the beacon node into a single gzipped tar
+// archive, to be attached to bug reports. Every part of the dump is captured on a best
+// effort basis: a part which could not be captured is replaced by a file with the error.
+func (ds *Server) GetDiagnosticDump(ctx context.Context, _ *ptypes.Empty) (*pbrpc.DiagnosticDumpResponse, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	d := &dump{tw: tar.NewWriter(gz), time: time.Now()}
+
+	d.add("version.txt", func() ([]byte, error) {
+		return []byte(version.GetVersion()), nil
+	})
+	d.add("goroutines.txt", func() ([]byte, error) {
+		var b bytes.Buffer
+		err := pprof.Lookup("goroutine").WriteTo(&b, 2)
+		return b.Bytes(), err
+	})
+	d.add("heap.pprof", func() ([]byte, error) {
+		var b bytes.Buffer
+		err := pprof.Lookup("heap").WriteTo(&b, 0)
+		return b.Bytes(), err
+	})
+	d.addJSON("config.json", func() (interface{}, error) {
+		return params.BeaconConfig(), nil
+	})
+	d.addJSON("features.json", func() (interface{}, error) {
+		return featureconfig.Get(), nil
+	})
+	d.addJSON("peers.json", func() (interface{}, error) {
+		return ds.ListPeers(ctx, &ptypes.Empty{})
+	})
+	d.addJSON("sync_status.json", func() (interface{}, error) {
+		headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &syncStatus{
+			Syncing:     ds.SyncChecker.Syncing(),
+			HeadSlot:    ds.HeadFetcher.HeadSlot(),
+			HeadRoot:    fmt.Sprintf("%#x", headRoot),
+			CurrentSlot: ds.GenesisTimeFetcher.CurrentSlot(),
+		}, nil
+	})
+	d.addJSON("fork_choice.json", func() (interface{}, error) {
+		return ds.GetBlockTree(ctx, &pbrpc.BlockTreeRequest{Slots: dumpBlockTreeSlots})
+	})
+	if ds.HealthReporter != nil {
+		d.addJSON("health.json", func() (interface{}, error) {
+			return ds.GetNodeHealth(ctx, &ptypes.Empty{})
+		})
+	}
+	if ds.LogTail != nil {
+		d.add("logs.txt", func() ([]byte, error) {
+			return []byte(strings.Join(ds.LogTail.Lines(), "\n")), nil
+		})
+	}
+
+	if d.err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not write diagnostic dump: %v", d.err)
+	}
+	if err := d.tw.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not write diagnostic dump: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compress diagnostic dump: %v", err)
+	}
+	return &pbrpc.DiagnosticDumpResponse{Archive: buf.Bytes()}, nil
+}
+
+// dump writes the files of a diagnostic dump to a tar archive, keeping the first write error.
+type dump struct {
+	tw   *tar.Writer
+	time time.Time
+	err  error
+}
+
+// add the file with the captured content to the dump, or a file with the
+// capture error if it failed.
+func (d *dump) add(name string, capture func() ([]byte, error)) {
+	content, err := capturePart(capture)
+	if err != nil {
+		name += ".error"
+		content = []byte(err.Error())
+	}
+	d.write(name, content)
+}
+
+// addJSON adds the file with the captured value encoded as JSON to the dump.
+func (d *dump) addJSON(name string, capture func() (interface{}, error)) {
+	d.add(name, func() ([]byte, error) {
+		v, err := capture()
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(v, "", "  ")
+	})
+}
+
+func (d *dump) write(name string, content []byte) {
+	if d.err != nil {
+		return
+	}
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(content)),
+		ModTime: d.time,
+	}
+	if err := d.tw.WriteHeader(hdr); err != nil {
+		d.err = err
+		return
+	}
+	if _, err := d.tw.Write(content); err != nil {
+		d.err = err
+	}
+}
+
+// capturePart runs the capture, recovering from a panic such as one caused by
+// a component of the node which is not initialized yet.
+func capturePart(capture func() ([]byte, error)) (content []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			content, err = nil, fmt.Errorf("could not capture: %v", r)
+		}
+	}()
+	return capture()
+}
@@ -0,0 +1,67 @@
+package debug
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestServer_GetDiagnosticDump(t *testing.T) {
+	ctx := context.Background()
+	f := protoarray.New(0, 0, [32]byte{'a'})
+	require.NoError(t, f.ProcessBlock(ctx, 0, [32]byte{'a'}, [32]byte{}, [32]byte{}, 0, 0))
+	head := [32]byte{'a'}
+	chain := &mock.ChainService{ForkChoiceStore: f.Store(), Root: head[:]}
+	logTail := logutil.NewLogTail(10)
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	logger.AddHook(logTail)
+	logger.Info("Something happened")
+	// The peer manager is left unset, so the peers can not be captured.
+	ds := &Server{
+		HeadFetcher:        chain,
+		GenesisTimeFetcher: chain,
+		SyncChecker:        &mockSync.Sync{IsSyncing: true},
+		LogTail:            logTail,
+	}
+
+	res, err := ds.GetDiagnosticDump(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+
+	gz, err := gzip.NewReader(bytes.NewReader(res.Archive))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = content
+	}
+	for _, name := range []string{
+		"version.txt", "goroutines.txt", "heap.pprof", "config.json", "features.json",
+		"peers.json.error", "sync_status.json", "fork_choice.json", "logs.txt",
+	} {
+		_, ok := files[name]
+		assert.Equal(t, true, ok, "Missing %s from the dump", name)
+	}
+	assert.Equal(t, true, bytes.Contains(files["sync_status.json"], []byte(`"syncing": true`)), "Unexpected sync status")
+	assert.Equal(t, true, bytes.Contains(files["logs.txt"], []byte("Something happened")), "Unexpected logs")
+}
@@ -1,11 +1,17 @@
 package debug
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"fmt"
+	"strings"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetProtoArrayForkChoice returns proto array fork choice store.
@@ -42,3 +48,82 @@ func (ds *Server) GetProtoArrayForkChoice(ctx context.Context, _ *ptypes.Empty)
 		Indices:         indices,
 	}, nil
 }
+
+// GetBlockTree returns the blocks of the fork choice store within the requested number
+// of slots from the head, along with their weights and whether they are canonical.
+// The tree is also rendered in the DOT graph format so it can be visualized directly.
+func (ds *Server) GetBlockTree(ctx context.Context, req *pbrpc.BlockTreeRequest) (*pbrpc.BlockTreeResponse, error) {
+	headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	nodes := ds.HeadFetcher.ProtoArrayStore().Nodes()
+
+	// Nodes are always stored after their parent, so walking the parents from
+	// the head visits every canonical node in the store.
+	canonical := make(map[uint64]bool)
+	var headSlot uint64
+	for i := range nodes {
+		r := nodes[i].Root()
+		if !bytes.Equal(r[:], headRoot) {
+			continue
+		}
+		headSlot = nodes[i].Slot()
+		for j := uint64(i); j != protoarray.NonExistentNode && j < uint64(len(nodes)); j = nodes[j].Parent() {
+			canonical[j] = true
+		}
+		break
+	}
+
+	treeNodes := make([]*pbrpc.BlockTreeNode, 0, len(nodes))
+	for i, n := range nodes {
+		if req.Slots != 0 && n.Slot()+req.Slots < headSlot {
+			continue
+		}
+		r := n.Root()
+		var parentRoot []byte
+		if p := n.Parent(); p != protoarray.NonExistentNode && p < uint64(len(nodes)) {
+			pr := nodes[p].Root()
+			parentRoot = pr[:]
+		}
+		treeNodes = append(treeNodes, &pbrpc.BlockTreeNode{
+			Root:       r[:],
+			ParentRoot: parentRoot,
+			Slot:       n.Slot(),
+			Weight:     n.Weight(),
+			Canonical:  canonical[uint64(i)],
+		})
+	}
+
+	return &pbrpc.BlockTreeResponse{
+		Nodes: treeNodes,
+		Dot:   blockTreeDot(treeNodes),
+	}, nil
+}
+
+// blockTreeDot renders the block tree nodes as a DOT digraph, with an edge from each
+// block to its parent and the canonical chain highlighted.
+func blockTreeDot(nodes []*pbrpc.BlockTreeNode) string {
+	inTree := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		inTree[string(n.Root)] = true
+	}
+	var b strings.Builder
+	b.WriteString("digraph BlockTree {\n")
+	b.WriteString("\trankdir=RL;\n")
+	b.WriteString("\tnode [shape=box];\n")
+	for _, n := range nodes {
+		style := ""
+		if n.Canonical {
+			style = ", style=filled, fillcolor=lightblue"
+		}
+		fmt.Fprintf(&b, "\t\"%#x\" [label=\"slot %d\\n%#x\\nweight %d\"%s];\n", n.Root, n.Slot, n.Root[:4], n.Weight, style)
+	}
+	for _, n := range nodes {
+		if inTree[string(n.ParentRoot)] {
+			fmt.Fprintf(&b, "\t\"%#x\" -> \"%#x\";\n", n.Root, n.ParentRoot)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
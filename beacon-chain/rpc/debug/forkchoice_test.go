@@ -2,11 +2,13 @@ package debug
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -20,3 +22,32 @@ func TestServer_GetForkChoice(t *testing.T) {
 	assert.Equal(t, store.JustifiedEpoch(), res.JustifiedEpoch, "Did not get wanted justified epoch")
 	assert.Equal(t, store.FinalizedEpoch(), res.FinalizedEpoch, "Did not get wanted finalized epoch")
 }
+
+func TestServer_GetBlockTree(t *testing.T) {
+	ctx := context.Background()
+	f := protoarray.New(0, 0, [32]byte{'a'})
+	require.NoError(t, f.ProcessBlock(ctx, 0, [32]byte{'a'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, [32]byte{'c'}, [32]byte{'b'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, [32]byte{'d'}, [32]byte{'b'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 3, [32]byte{'e'}, [32]byte{'c'}, [32]byte{}, 0, 0))
+	head := [32]byte{'e'}
+	bs := &Server{HeadFetcher: &mock.ChainService{ForkChoiceStore: f.Store(), Root: head[:]}}
+
+	res, err := bs.GetBlockTree(ctx, &pbrpc.BlockTreeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(res.Nodes))
+	wantCanonical := map[byte]bool{'a': true, 'b': true, 'c': true, 'd': false, 'e': true}
+	for _, n := range res.Nodes {
+		assert.Equal(t, wantCanonical[n.Root[0]], n.Canonical, "Unexpected canonical flag for node at slot %d", n.Slot)
+	}
+	assert.Equal(t, 0, len(res.Nodes[0].ParentRoot), "Expected no parent for the tree root")
+	assert.DeepEqual(t, []byte{'b'}, res.Nodes[3].ParentRoot[:1])
+	assert.Equal(t, true, strings.HasPrefix(res.Dot, "digraph BlockTree {"), "Unexpected DOT output")
+	assert.Equal(t, 4, strings.Count(res.Dot, "->"), "Unexpected number of edges")
+
+	res, err = bs.GetBlockTree(ctx, &pbrpc.BlockTreeRequest{Slots: 1})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Nodes))
+	assert.Equal(t, 2, strings.Count(res.Dot, "->"), "Expected edges to parents outside the range to be left out")
+}
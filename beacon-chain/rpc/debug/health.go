@@ -0,0 +1,37 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+)
+
+// HealthReporter reports the health of the services of a node.
+type HealthReporter interface {
+	Health() []*shared.ServiceHealth
+}
+
+// GetNodeHealth returns the health of each service registered in the beacon node,
+// so a node can be diagnosed with a single call.
+func (ds *Server) GetNodeHealth(_ context.Context, _ *ptypes.Empty) (*pbrpc.NodeHealthResponse, error) {
+	res := &pbrpc.NodeHealthResponse{Healthy: true}
+	for _, h := range ds.HealthReporter.Health() {
+		svc := &pbrpc.ServiceHealth{
+			Name:          h.Type.String(),
+			Healthy:       h.Err == nil,
+			UptimeSeconds: uint64(h.Uptime.Seconds()),
+		}
+		if h.Err != nil {
+			svc.Error = h.Err.Error()
+			res.Healthy = false
+		}
+		if h.LastErr != nil {
+			svc.LastError = h.LastErr.Err.Error()
+			svc.LastErrorTime = uint64(h.LastErr.Time.Unix())
+		}
+		res.Services = append(res.Services, svc)
+	}
+	return res, nil
+}
@@ -0,0 +1,54 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockService struct {
+	status error
+}
+
+func (m *mockService) Start() {}
+
+func (m *mockService) Stop() error {
+	return nil
+}
+
+func (m *mockService) Status() error {
+	return m.status
+}
+
+func TestServer_GetNodeHealth(t *testing.T) {
+	registry := shared.NewServiceRegistry()
+	svc := &mockService{}
+	require.NoError(t, registry.RegisterService(svc))
+	registry.StartAll()
+	ds := &Server{HealthReporter: registry}
+
+	res, err := ds.GetNodeHealth(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Healthy)
+	require.Equal(t, 1, len(res.Services))
+	assert.Equal(t, "*debug.mockService", res.Services[0].Name)
+	assert.Equal(t, true, res.Services[0].Healthy)
+
+	svc.status = errors.New("not ready")
+	res, err = ds.GetNodeHealth(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Healthy)
+	assert.Equal(t, "not ready", res.Services[0].Error)
+
+	svc.status = nil
+	res, err = ds.GetNodeHealth(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Healthy)
+	assert.Equal(t, "", res.Services[0].Error)
+	assert.Equal(t, "not ready", res.Services[0].LastError)
+}
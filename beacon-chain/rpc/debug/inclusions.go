@@ -0,0 +1,42 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/inclusion"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InclusionFetcher retrieves the inclusions of the latest attestations of validators.
+type InclusionFetcher interface {
+	ValidatorInclusions(indices []uint64) map[uint64]*inclusion.Inclusion
+}
+
+// GetValidatorInclusions returns the inclusion of the latest attestation of each requested
+// validator, in the order of the request. Validators without an included attestation are
+// left out.
+func (ds *Server) GetValidatorInclusions(_ context.Context, req *pbrpc.ValidatorInclusionsRequest) (*pbrpc.ValidatorInclusionsResponse, error) {
+	if ds.InclusionFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Attestation inclusions are not tracked")
+	}
+	inclusions := ds.InclusionFetcher.ValidatorInclusions(req.ValidatorIndices)
+	res := &pbrpc.ValidatorInclusionsResponse{
+		Inclusions: make([]*pbrpc.ValidatorInclusion, 0, len(inclusions)),
+	}
+	for _, idx := range req.ValidatorIndices {
+		i, ok := inclusions[idx]
+		if !ok {
+			continue
+		}
+		res.Inclusions = append(res.Inclusions, &pbrpc.ValidatorInclusion{
+			ValidatorIndex:    idx,
+			TargetEpoch:       i.TargetEpoch,
+			AttestationSlot:   i.AttestationSlot,
+			InclusionSlot:     i.InclusionSlot,
+			InclusionDistance: i.Distance(),
+		})
+	}
+	return res, nil
+}
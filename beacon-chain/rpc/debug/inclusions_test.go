@@ -0,0 +1,38 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/inclusion"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockInclusionFetcher map[uint64]*inclusion.Inclusion
+
+func (m mockInclusionFetcher) ValidatorInclusions(indices []uint64) map[uint64]*inclusion.Inclusion {
+	inclusions := make(map[uint64]*inclusion.Inclusion)
+	for _, idx := range indices {
+		if i, ok := m[idx]; ok {
+			inclusions[idx] = i
+		}
+	}
+	return inclusions
+}
+
+func TestServer_GetValidatorInclusions(t *testing.T) {
+	ds := &Server{InclusionFetcher: mockInclusionFetcher{
+		1: {TargetEpoch: 1, AttestationSlot: 33, InclusionSlot: 34},
+		2: {TargetEpoch: 1, AttestationSlot: 35, InclusionSlot: 38},
+	}}
+	res, err := ds.GetValidatorInclusions(context.Background(), &pbrpc.ValidatorInclusionsRequest{
+		ValidatorIndices: []uint64{2, 3, 1},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*pbrpc.ValidatorInclusion{
+		{ValidatorIndex: 2, TargetEpoch: 1, AttestationSlot: 35, InclusionSlot: 38, InclusionDistance: 3},
+		{ValidatorIndex: 1, TargetEpoch: 1, AttestationSlot: 33, InclusionSlot: 34, InclusionDistance: 1},
+	}, res.Inclusions)
+}
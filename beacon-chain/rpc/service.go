@@ -6,12 +6,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
 	"sync"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
@@ -19,6 +21,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
@@ -35,6 +38,7 @@ import (
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
@@ -70,12 +74,14 @@ type Service struct {
 	chainStartFetcher       powchain.ChainStartFetcher
 	mockEth1Votes           bool
 	enableDebugRPCEndpoints bool
+	archiveMode             bool
 	attestationsPool        attestations.Pool
 	exitPool                *voluntaryexits.Pool
 	slashingsPool           *slashings.Pool
 	syncService             chainSync.Checker
 	host                    string
 	port                    string
+	unixSocket              string
 	listener                net.Listener
 	withCert                string
 	withKey                 string
@@ -97,6 +103,10 @@ type Service struct {
 	slasherCredentialError  error
 	slasherClient           slashpb.SlasherClient
 	stateGen                *stategen.State
+	forkSchedule            *forks.Schedule
+	healthReporter          debug.HealthReporter
+	inclusionFetcher        debug.InclusionFetcher
+	logTail                 *logutil.LogTail
 	connectedRPCClients     map[net.Addr]bool
 	clientConnectionLock    sync.Mutex
 }
@@ -105,6 +115,7 @@ type Service struct {
 type Config struct {
 	Host                    string
 	Port                    string
+	UnixSocket              string
 	CertFlag                string
 	KeyFlag                 string
 	BeaconDB                db.HeadAccessDatabase
@@ -118,6 +129,7 @@ type Config struct {
 	GenesisTimeFetcher      blockchain.TimeFetcher
 	GenesisFetcher          blockchain.GenesisFetcher
 	EnableDebugRPCEndpoints bool
+	ArchiveMode             bool
 	MockEth1Votes           bool
 	AttestationsPool        attestations.Pool
 	ExitPool                *voluntaryexits.Pool
@@ -134,6 +146,10 @@ type Config struct {
 	BlockNotifier           blockfeed.Notifier
 	OperationNotifier       opfeed.Notifier
 	StateGen                *stategen.State
+	ForkSchedule            *forks.Schedule
+	HealthReporter          debug.HealthReporter
+	InclusionFetcher        debug.InclusionFetcher
+	LogTail                 *logutil.LogTail
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -163,6 +179,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		syncService:             cfg.SyncService,
 		host:                    cfg.Host,
 		port:                    cfg.Port,
+		unixSocket:              cfg.UnixSocket,
 		withCert:                cfg.CertFlag,
 		withKey:                 cfg.KeyFlag,
 		depositFetcher:          cfg.DepositFetcher,
@@ -175,20 +192,34 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		slasherProvider:         cfg.SlasherProvider,
 		slasherCert:             cfg.SlasherCert,
 		stateGen:                cfg.StateGen,
+		forkSchedule:            cfg.ForkSchedule,
+		healthReporter:          cfg.HealthReporter,
+		inclusionFetcher:        cfg.InclusionFetcher,
+		logTail:                 cfg.LogTail,
 		enableDebugRPCEndpoints: cfg.EnableDebugRPCEndpoints,
+		archiveMode:             cfg.ArchiveMode,
 		connectedRPCClients:     make(map[net.Addr]bool),
 	}
 }
 
 // Start the gRPC server.
 func (s *Service) Start() {
-	address := fmt.Sprintf("%s:%s", s.host, s.port)
-	lis, err := net.Listen("tcp", address)
-	if err != nil {
-		log.Errorf("Could not listen to port in Start() %s: %v", address, err)
+	if s.unixSocket != "" {
+		lis, err := listenUnixSocket(s.unixSocket)
+		if err != nil {
+			log.Errorf("Could not listen to unix socket in Start() %s: %v", s.unixSocket, err)
+		}
+		s.listener = lis
+		log.WithField("socket", s.unixSocket).Info("RPC-API listening on unix socket")
+	} else {
+		address := fmt.Sprintf("%s:%s", s.host, s.port)
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			log.Errorf("Could not listen to port in Start() %s: %v", address, err)
+		}
+		s.listener = lis
+		log.WithField("address", address).Info("RPC-API listening on port")
 	}
-	s.listener = lis
-	log.WithField("address", address).Info("RPC-API listening on port")
 
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
@@ -250,6 +281,7 @@ func (s *Service) Start() {
 		PendingDepositsFetcher: s.pendingDepositFetcher,
 		SlashingsPool:          s.slashingsPool,
 		StateGen:               s.stateGen,
+		ForkSchedule:           s.forkSchedule,
 	}
 	nodeServer := &node.Server{
 		BeaconDB:           s.beaconDB,
@@ -278,6 +310,7 @@ func (s *Service) Start() {
 		Broadcaster:                 s.p2p,
 		StateGen:                    s.stateGen,
 		SyncChecker:                 s.syncService,
+		ArchiveMode:                 s.archiveMode,
 		ReceivedAttestationsBuffer:  make(chan *ethpb.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, attestationBufferSize),
 	}
@@ -292,6 +325,11 @@ func (s *Service) Start() {
 			HeadFetcher:        s.headFetcher,
 			PeerManager:        s.peerManager,
 			PeersFetcher:       s.peersFetcher,
+			HealthReporter:     s.healthReporter,
+			SyncChecker:        s.syncService,
+			LogTail:            s.logTail,
+			AttestationsPool:   s.attestationsPool,
+			InclusionFetcher:   s.inclusionFetcher,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
@@ -312,6 +350,26 @@ func (s *Service) Start() {
 	}
 }
 
+// listenUnixSocket listens on a unix domain socket at the path, replacing the socket left
+// behind by an unclean shutdown. The socket is only accessible by the user running the beacon
+// node, access can be granted to other users through filesystem ACLs.
+func listenUnixSocket(path string) (net.Listener, error) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "could not remove existing socket")
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		if closeErr := lis.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close unix socket")
+		}
+		return nil, errors.Wrap(err, "could not set socket permissions")
+	}
+	return lis, nil
+}
+
 func (s *Service) startSlasherClient() {
 	var dialOpt grpc.DialOption
 	if s.slasherCert != "" {
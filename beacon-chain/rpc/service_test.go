@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -46,6 +48,35 @@ func TestLifecycle_OK(t *testing.T) {
 	assert.NoError(t, rpcService.Stop())
 }
 
+func TestLifecycle_UnixSocket(t *testing.T) {
+	hook := logTest.NewGlobal()
+	dir, err := ioutil.TempDir("", "rpc")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(dir))
+	}()
+	socket := filepath.Join(dir, "beacon.sock")
+	chainService := &mock.ChainService{Genesis: time.Now()}
+	rpcService := NewService(context.Background(), &Config{
+		UnixSocket:          socket,
+		SyncService:         &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:       chainService,
+		AttestationReceiver: chainService,
+		HeadFetcher:         chainService,
+		GenesisTimeFetcher:  chainService,
+		POWChainService:     &mockPOW.POWChain{},
+		StateNotifier:       chainService.StateNotifier(),
+	})
+
+	rpcService.Start()
+
+	require.LogsContain(t, hook, "listening on unix socket")
+	info, err := os.Stat(socket)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	assert.NoError(t, rpcService.Stop())
+}
+
 func TestStatus_CredentialError(t *testing.T) {
 	credentialErr := errors.New("credentialError")
 	s := &Service{credentialError: credentialErr}
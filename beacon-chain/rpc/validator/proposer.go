@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
-	"sort"
 	"time"
 
 	fastssz "github.com/ferranbt/fastssz"
@@ -51,21 +50,6 @@ type eth1DataAggregatedVote struct {
 	votes int
 }
 
-// profitableAtts implements the Sort interface to sort attestations
-// by highest slot and by highest aggregation bit count.
-type profitableAtts struct {
-	atts []*ethpb.Attestation
-}
-
-func (p profitableAtts) Len() int      { return len(p.atts) }
-func (p profitableAtts) Swap(i, j int) { p.atts[i], p.atts[j] = p.atts[j], p.atts[i] }
-func (p profitableAtts) Less(i, j int) bool {
-	if p.atts[i].Data.Slot == p.atts[j].Data.Slot {
-		return p.atts[i].AggregationBits.Count() > p.atts[j].AggregationBits.Count()
-	}
-	return p.atts[i].Data.Slot > p.atts[j].Data.Slot
-}
-
 // GetBlock is called by a proposer during its assigned slot to request a block to sign
 // by passing in the slot and the signed randao reveal of the slot.
 func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
@@ -178,6 +162,11 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 		"blockRoot": hex.EncodeToString(root[:]),
 	}).Debug("Broadcasting block")
 
+	// The attestations of the published block are claimed, so they are not packed into another block.
+	if err := vs.AttPool.ClaimBlockAttestations(blk.Block.Body.Attestations); err != nil {
+		log.WithError(err).Error("Could not claim the attestations of the proposed block")
+	}
+
 	if err := vs.BlockReceiver.ReceiveBlock(ctx, blk, root); err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not process beacon block: %v", err)
 	}
@@ -669,20 +658,33 @@ func (vs *Server) packAttestations(ctx context.Context, latestState *stateTrie.B
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.packAttestations")
 	defer span.End()
 
-	atts := vs.AttPool.AggregatedAttestations()
+	// The most profitable aggregates come first, so they are the ones kept if the pool has more
+	// attestations than fit in the block. They stay in the pool until the block is proposed.
+	atts := vs.AttPool.ProfitableAttestations(latestState)
 	atts, err := vs.filterAttestationsForBlockInclusion(ctx, latestState, atts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not filter attestations")
 	}
 
-	// If there is any room left in the block, consider unaggregated attestations as well.
+	// If there is any room left in the block, consider unaggregated attestations as well. They are
+	// visited in the pool without copying it, only the ones which can be included are kept.
 	numAtts := uint64(len(atts))
 	if numAtts < params.BeaconConfig().MaxAttestations {
-		uAtts, err := vs.AttPool.UnaggregatedAttestations()
-		if err != nil {
+		stateSlot := latestState.Slot()
+		uAtts := make([]*ethpb.Attestation, 0)
+		if err := vs.AttPool.ForEachUnaggregated(func(att *ethpb.Attestation) bool {
+			if att.Data != nil && att.Data.Slot+params.BeaconConfig().MinAttestationInclusionDelay <= stateSlot &&
+				stateSlot <= att.Data.Slot+params.BeaconConfig().SlotsPerEpoch {
+				uAtts = append(uAtts, att)
+			}
+			return true
+		}); err != nil {
 			return nil, errors.Wrap(err, "could not get unaggregated attestations")
 		}
 		uAtts, err = vs.filterAttestationsForBlockInclusion(ctx, latestState, uAtts)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not filter unaggregated attestations")
+		}
 		atts = append(atts, uAtts...)
 
 		attsByDataRoot := make(map[[32]byte][]*ethpb.Attestation, len(atts))
@@ -695,18 +697,34 @@ func (vs *Server) packAttestations(ctx context.Context, latestState *stateTrie.B
 		}
 
 		attsForInclusion := make([]*ethpb.Attestation, 0)
+		redundantAtts := make([]*ethpb.Attestation, 0)
 		for _, as := range attsByDataRoot {
 			as, err := attaggregation.Aggregate(as)
 			if err != nil {
 				return nil, err
 			}
-			attsForInclusion = append(attsForInclusion, as...)
+			selected, redundant, err := attaggregation.SelectMaxCover(as)
+			if err != nil {
+				return nil, err
+			}
+			attsForInclusion = append(attsForInclusion, selected...)
+			redundantAtts = append(redundantAtts, redundant...)
 		}
 
+		// The aggregates covering the most unique attesters of their data are packed first, the
+		// remaining room is filled with the most profitable of the redundant ones. Both are sorted
+		// by the validators they reward which the state doesn't include yet, like the aggregates.
+		attsForInclusion = kv.SortByProfitability(latestState, attsForInclusion)
+		attsForInclusion = append(attsForInclusion, kv.SortByProfitability(latestState, redundantAtts)...)
 		if uint64(len(attsForInclusion)) > params.BeaconConfig().MaxAttestations {
-			sort.Sort(profitableAtts{atts: attsForInclusion})
 			attsForInclusion = attsForInclusion[:params.BeaconConfig().MaxAttestations]
 		}
+		// The unaggregated attestations not aggregated with others are still the pool's.
+		for i, att := range attsForInclusion {
+			if !helpers.IsAggregated(att) {
+				attsForInclusion[i] = stateTrie.CopyAttestation(att)
+			}
+		}
 
 		atts = attsForInclusion
 	}
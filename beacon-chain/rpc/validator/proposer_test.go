@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"math/big"
-	"sort"
 	"testing"
 
 	fastssz "github.com/ferranbt/fastssz"
@@ -213,6 +212,11 @@ func TestGetBlock_AddsUnaggregatedAtts(t *testing.T) {
 		}
 	}
 	assert.Equal(t, false, hasUnaggregatedAtt, "Expected block to not have unaggregated attestation")
+
+	// The aggregates stay in the pool until the block is proposed, so a retried proposal packs them again.
+	block, err = proposerServer.GetBlock(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().MaxAttestations, uint64(len(block.Body.Attestations)), "Expected a retried proposal to pack the same attestations")
 }
 
 func TestProposeBlock_OK(t *testing.T) {
@@ -242,13 +246,28 @@ func TestProposeBlock_OK(t *testing.T) {
 		HeadFetcher:       c,
 		BlockNotifier:     c.BlockNotifier(),
 		P2P:               mockp2p.NewTestP2P(t),
+		AttPool:           attestations.NewPool(),
 	}
+	att := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Slot:            4,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		AggregationBits: bitfield.Bitlist{0b1111},
+		Signature:       make([]byte, 96),
+	}
+	require.NoError(t, proposerServer.AttPool.SaveAggregatedAttestation(att))
 	req := testutil.NewBeaconBlock()
 	req.Block.Slot = 5
 	req.Block.ParentRoot = bsRoot[:]
+	req.Block.Body.Attestations = []*ethpb.Attestation{att}
 	require.NoError(t, db.SaveBlock(ctx, req))
 	_, err = proposerServer.ProposeBlock(context.Background(), req)
 	assert.NoError(t, err, "Could not propose block correctly")
+	assert.Equal(t, 0, proposerServer.AttPool.AggregatedAttestationCount(), "Expected the block attestations to be claimed")
+	assert.Equal(t, 1, len(proposerServer.AttPool.BlockAttestations()), "Expected the block attestations in the block pool")
 }
 
 func TestComputeStateRoot_OK(t *testing.T) {
@@ -1945,24 +1964,3 @@ func TestDeleteAttsInPool_Aggregated(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(atts), "Did not delete unaggregated attestation")
 }
-
-func TestSortProfitableAtts(t *testing.T) {
-	atts := []*ethpb.Attestation{
-		{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b11100000}},
-		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b11000000}},
-		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b11100000}},
-		{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b11110000}},
-		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b11100000}},
-		{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b11000000}},
-	}
-	sort.Sort(profitableAtts{atts: atts})
-	want := []*ethpb.Attestation{
-		{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b11110000}},
-		{Data: &ethpb.AttestationData{Slot: 4}, AggregationBits: bitfield.Bitlist{0b11100000}},
-		{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b11000000}},
-		{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b11100000}},
-		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b11100000}},
-		{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b11000000}},
-	}
-	require.DeepEqual(t, want, atts)
-}
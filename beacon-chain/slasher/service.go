@@ -0,0 +1,321 @@
+// Package slasher defines a lightweight slasher running as a beacon node service. It
+// runs slashing detection on the blocks and attestations received by the node and
+// inserts the slashings it finds into the node's slashing pool, without requiring
+// a separate slasher process.
+package slasher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	slasherDB "github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var log = logrus.WithField("prefix", "slasher")
+
+// attestationChannelSize is the number of operation feed events buffered for the slasher. The
+// events are only queued on receipt, so the feed senders, including the gossip validation, are
+// not held up by detection.
+const attestationChannelSize = 256
+
+// Config options for the embedded slasher service.
+type Config struct {
+	// BeaconDB is the beacon node database the slasher keeps its buckets in.
+	BeaconDB            db.Database
+	HeadFetcher         blockchain.HeadFetcher
+	BlockNotifier       blockfeed.Notifier
+	AttestationNotifier operation.Notifier
+	SlashingPool        *slashings.Pool
+}
+
+// Service runs slashing detection on the blocks and attestations received by the beacon node.
+type Service struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	slasherDB           slasherDB.Database
+	detector            *detection.Service
+	headFetcher         blockchain.HeadFetcher
+	blockNotifier       blockfeed.Notifier
+	attestationNotifier operation.Notifier
+	slashingPool        *slashings.Pool
+	queueLock           sync.Mutex
+	queuedAtts          []*ethpb.Attestation
+	queuedSlashings     []*ethpb.AttesterSlashing
+}
+
+// NewService initializes the slasher buckets in the beacon node database and the slashing
+// detection for the beacon node.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	d, err := kv.NewKVStoreWithDB(cfg.BeaconDB.BoltDB(), &kv.Config{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open slasher database")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:                 ctx,
+		cancel:              cancel,
+		slasherDB:           d,
+		headFetcher:         cfg.HeadFetcher,
+		blockNotifier:       cfg.BlockNotifier,
+		attestationNotifier: cfg.AttestationNotifier,
+		slashingPool:        cfg.SlashingPool,
+	}
+	// The detection service is only used for its detection methods and history pruning, so
+	// nothing subscribes to its slashing feeds. Slashings are inserted into the pool directly.
+	s.detector = detection.NewDetectionService(ctx, &detection.Config{
+		ChainFetcher:          s,
+		SlasherDB:             d,
+		AttesterSlashingsFeed: new(event.Feed),
+		ProposerSlashingsFeed: new(event.Feed),
+	})
+	return s, nil
+}
+
+// Start the slasher service, listening for incoming blocks and attestations and pruning
+// the slasher history older than the pruning epoch age.
+func (s *Service) Start() {
+	log.Info("Starting embedded slasher")
+	s.detector.StartPruning()
+	go s.receiveBlocks(s.ctx)
+	go s.receiveAttestations(s.ctx)
+	go s.processQueuedAttestations(s.ctx)
+}
+
+// Stop the slasher service and close its database, leaving the shared beacon node database open.
+func (s *Service) Stop() error {
+	s.cancel()
+	return s.slasherDB.Close()
+}
+
+// Status of the slasher service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// ChainHead returns the head epoch of the beacon node, which the slasher history is pruned from.
+func (s *Service) ChainHead(_ context.Context) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadEpoch: helpers.SlotToEpoch(s.headFetcher.HeadSlot())}, nil
+}
+
+func (s *Service) receiveBlocks(ctx context.Context) {
+	blocksChannel := make(chan *feed.Event, 1)
+	sub := s.blockNotifier.BlockFeed().Subscribe(blocksChannel)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case event := <-blocksChannel:
+			if event.Type != blockfeed.ReceivedBlock {
+				continue
+			}
+			data, ok := event.Data.(*blockfeed.ReceivedBlockData)
+			if !ok || data.SignedBlock == nil {
+				continue
+			}
+			header, err := blockutil.SignedBeaconBlockHeaderFromBlock(data.SignedBlock)
+			if err != nil {
+				log.WithError(err).Error("Could not get block header from block")
+				continue
+			}
+			if err := s.detectDoubleProposal(ctx, header); err != nil {
+				log.WithError(err).Error("Could not detect double proposals")
+			}
+		case <-sub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// receiveAttestations queues the attestations and slashable attestations received by the node,
+// leaving their detection to processQueuedAttestations.
+func (s *Service) receiveAttestations(ctx context.Context) {
+	attsChannel := make(chan *feed.Event, attestationChannelSize)
+	sub := s.attestationNotifier.OperationFeed().Subscribe(attsChannel)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case event := <-attsChannel:
+			s.queueAttestationEvent(event)
+		case <-sub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) queueAttestationEvent(event *feed.Event) {
+	s.queueLock.Lock()
+	defer s.queueLock.Unlock()
+	switch data := event.Data.(type) {
+	case *operation.UnAggregatedAttReceivedData:
+		if data.Attestation != nil && data.Attestation.Data != nil {
+			s.queuedAtts = append(s.queuedAtts, data.Attestation)
+		}
+	case *operation.AggregatedAttReceivedData:
+		if data.Attestation != nil && data.Attestation.Aggregate != nil && data.Attestation.Aggregate.Data != nil {
+			s.queuedAtts = append(s.queuedAtts, data.Attestation.Aggregate)
+		}
+	case *operation.SlashableAttReceivedData:
+		s.queuedSlashings = append(s.queuedSlashings, data.Slashings...)
+	}
+}
+
+// processQueuedAttestations runs detection on the queued attestations once per slot, so the
+// head state is only retrieved once per batch.
+func (s *Service) processQueuedAttestations(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.processQueue(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) processQueue(ctx context.Context) {
+	s.queueLock.Lock()
+	atts, attSlashings := s.queuedAtts, s.queuedSlashings
+	s.queuedAtts, s.queuedSlashings = nil, nil
+	s.queueLock.Unlock()
+
+	if err := s.insertAttesterSlashings(ctx, attSlashings); err != nil {
+		log.WithError(err).Error("Could not insert attester slashings of slashable attestations")
+	}
+	if err := s.processAttestations(ctx, atts); err != nil {
+		log.WithError(err).Error("Could not detect attester slashings")
+	}
+}
+
+// processAttestations converts the attestations into their indexed form using the
+// committees of the head state and runs attester slashing detection on them.
+func (s *Service) processAttestations(ctx context.Context, atts []*ethpb.Attestation) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.processAttestations")
+	defer span.End()
+	if len(atts) == 0 {
+		return nil
+	}
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state")
+	}
+	indexedAtts := make([]*ethpb.IndexedAttestation, 0, len(atts))
+	for _, att := range atts {
+		committee, err := helpers.BeaconCommitteeFromState(headState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve attestation committee")
+			continue
+		}
+		indexedAtts = append(indexedAtts, attestationutil.ConvertToIndexed(ctx, att, committee))
+	}
+	return s.detectAttesterSlashings(ctx, headState, indexedAtts)
+}
+
+func (s *Service) detectAttesterSlashings(
+	ctx context.Context,
+	headState *stateTrie.BeaconState,
+	indexedAtts []*ethpb.IndexedAttestation,
+) error {
+	// Detection looks up the conflicting attestations in the DB, so they need to be saved first.
+	if err := s.slasherDB.SaveIndexedAttestations(ctx, indexedAtts); err != nil {
+		return errors.Wrap(err, "could not save indexed attestations")
+	}
+	found, err := s.detector.DetectAttesterSlashingsBatch(ctx, indexedAtts)
+	if err != nil {
+		return err
+	}
+	s.insertAttesterSlashingsWithState(ctx, headState, found)
+	return nil
+}
+
+// CheckAttestation returns the attester slashings the attestation makes with the attestations
+// already processed by the slasher, so the attestation pool can reject slashable attestations.
+// It runs for every attestation saved in the pool, so the head state is only read, not copied.
+func (s *Service) CheckAttestation(att *ethpb.Attestation) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(s.ctx, "slasher.CheckAttestation")
+	defer span.End()
+	if att.Data == nil {
+		return nil, nil
+	}
+	headState, err := s.headFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(headState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attestation committee")
+	}
+	return s.detector.DetectAttesterSlashings(ctx, attestationutil.ConvertToIndexed(ctx, att, committee))
+}
+
+func (s *Service) insertAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
+	if len(slashings) == 0 {
+		return nil
+	}
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state")
+	}
+	s.insertAttesterSlashingsWithState(ctx, headState, slashings)
+	return nil
+}
+
+func (s *Service) insertAttesterSlashingsWithState(
+	ctx context.Context,
+	headState *stateTrie.BeaconState,
+	slashings []*ethpb.AttesterSlashing,
+) {
+	for _, slashing := range slashings {
+		log.WithFields(logrus.Fields{
+			"sourceEpoch": slashing.Attestation_1.Data.Source.Epoch,
+			"targetEpoch": slashing.Attestation_1.Data.Target.Epoch,
+		}).Info("Found an attester slashing! Inserting into the slashing pool")
+		if err := s.slashingPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Error("Could not insert attester slashing into the pool")
+		}
+	}
+}
+
+func (s *Service) detectDoubleProposal(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) error {
+	slashing, err := s.detector.DetectDoubleProposals(ctx, header)
+	if err != nil {
+		return err
+	}
+	if slashing == nil {
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"proposerIndex": header.Header.ProposerIndex,
+		"slot":          header.Header.Slot,
+	}).Info("Found a proposer slashing! Inserting into the slashing pool")
+	headState, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head state")
+	}
+	return s.slashingPool.InsertProposerSlashing(ctx, headState, slashing)
+}
@@ -0,0 +1,133 @@
+package slasher
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupService(t *testing.T, beaconState *stateTrie.BeaconState, pool *slashings.Pool) *Service {
+	beaconDB, _ := dbTest.SetupDB(t)
+	s, err := NewService(context.Background(), &Config{
+		BeaconDB:     beaconDB,
+		HeadFetcher:  &mock.ChainService{State: beaconState},
+		SlashingPool: pool,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	return s
+}
+
+func signedAttestation(
+	t *testing.T,
+	beaconState *stateTrie.BeaconState,
+	priv bls.SecretKey,
+	idx uint64,
+	targetRoot []byte,
+) *ethpb.IndexedAttestation {
+	att := &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source: &ethpb.Checkpoint{
+				Root: make([]byte, 32),
+			},
+			Target: &ethpb.Checkpoint{
+				Root: bytesutil.PadTo(targetRoot, 32),
+			},
+		},
+		AttestingIndices: []uint64{idx},
+	}
+	var err error
+	att.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, att.Data, params.BeaconConfig().DomainBeaconAttester, priv)
+	require.NoError(t, err)
+	return att
+}
+
+func TestService_DetectDoubleProposal(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	pool := slashings.NewPool()
+	s := setupService(t, beaconState, pool)
+
+	slashing, err := testutil.GenerateProposerSlashingForValidator(beaconState, privKeys[1], 1)
+	require.NoError(t, err)
+	require.NoError(t, s.detectDoubleProposal(ctx, slashing.Header_1))
+	assert.Equal(t, 0, len(pool.PendingProposerSlashings(ctx, beaconState)))
+	require.NoError(t, s.detectDoubleProposal(ctx, slashing.Header_2))
+	assert.Equal(t, 1, len(pool.PendingProposerSlashings(ctx, beaconState)))
+}
+
+func TestService_DetectAttesterSlashings(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	pool := slashings.NewPool()
+	s := setupService(t, beaconState, pool)
+
+	att1 := signedAttestation(t, beaconState, privKeys[2], 2, []byte("first target"))
+	require.NoError(t, s.detectAttesterSlashings(ctx, beaconState, []*ethpb.IndexedAttestation{att1}))
+	assert.Equal(t, 0, len(pool.PendingAttesterSlashings(ctx, beaconState)))
+
+	att2 := signedAttestation(t, beaconState, privKeys[2], 2, []byte("second target"))
+	require.NoError(t, s.detectAttesterSlashings(ctx, beaconState, []*ethpb.IndexedAttestation{att2}))
+	assert.Equal(t, 1, len(pool.PendingAttesterSlashings(ctx, beaconState)))
+}
+
+func TestService_ChainHead(t *testing.T) {
+	beaconState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, beaconState.SetSlot(3*params.BeaconConfig().SlotsPerEpoch+1))
+	s := setupService(t, beaconState, slashings.NewPool())
+
+	// The slasher history is pruned from the head epoch of the beacon node.
+	head, err := s.ChainHead(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), head.HeadEpoch)
+}
+
+func TestService_ProcessQueue(t *testing.T) {
+	ctx := context.Background()
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	pool := slashings.NewPool()
+	s := setupService(t, beaconState, pool)
+
+	// Slashable attestations are only queued on receipt, their slashings are inserted into the
+	// pool when the queue is processed.
+	slashing, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[3], 3)
+	require.NoError(t, err)
+	s.queueAttestationEvent(&feed.Event{
+		Type: operation.SlashableAttReceived,
+		Data: &operation.SlashableAttReceivedData{Slashings: []*ethpb.AttesterSlashing{slashing}},
+	})
+	assert.Equal(t, 0, len(pool.PendingAttesterSlashings(ctx, beaconState)))
+	s.processQueue(ctx)
+	assert.Equal(t, 1, len(pool.PendingAttesterSlashings(ctx, beaconState)))
+	assert.Equal(t, 0, len(s.queuedSlashings))
+}
+
+func TestService_SharesBeaconDB(t *testing.T) {
+	beaconDB, _ := dbTest.SetupDB(t)
+	s, err := NewService(context.Background(), &Config{BeaconDB: beaconDB})
+	require.NoError(t, err)
+
+	// Stopping the slasher leaves the beacon node database open.
+	assert.Equal(t, beaconDB.DatabasePath(), path.Dir(s.slasherDB.DatabasePath()))
+	require.NoError(t, s.Stop())
+	_, err = beaconDB.HeadBlock(context.Background())
+	require.NoError(t, err)
+}
@@ -0,0 +1,192 @@
+package sync
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+)
+
+const (
+	// defaultAttestationQueueSize is the number of attestations each tier of the queue holds by default.
+	defaultAttestationQueueSize = 4096
+	// defaultAttestationQueuePriority is the number of own committee attestations validated for
+	// each other attestation by default, while both tiers have attestations queued.
+	defaultAttestationQueuePriority = 4
+	// attestationQueueWorkers is the number of queued attestations validated at the same time. Validations
+	// wait for their signature batch, so several batches worth of attestations are validated at once.
+	attestationQueueWorkers = 4 * verifierLimit
+)
+
+var errAttestationQueueFull = errors.New("attestation queue is full")
+
+// attestationValidation is a gossip validation of an unaggregated attestation waiting in the queue.
+type attestationValidation struct {
+	ctx    context.Context
+	pid    peer.ID
+	msg    *pubsub.Message
+	result chan pubsub.ValidationResult
+}
+
+// attestationQueue queues the validations of the unaggregated attestations received on committee
+// subnets in two tiers: the attestations of the subnets of the node's own validators, validated
+// first, and the other attestations. The validation workers pop the queue.
+type attestationQueue struct {
+	own      chan *attestationValidation
+	other    chan *attestationValidation
+	priority int
+	ownTaken int // Own committee attestations popped since the last other attestation.
+	lock     chan struct{}
+}
+
+func newAttestationQueue(size int, priority int) *attestationQueue {
+	if size <= 0 {
+		size = defaultAttestationQueueSize
+	}
+	if priority <= 0 {
+		priority = defaultAttestationQueuePriority
+	}
+	return &attestationQueue{
+		own:      make(chan *attestationValidation, size),
+		other:    make(chan *attestationValidation, size),
+		priority: priority,
+		lock:     make(chan struct{}, 1),
+	}
+}
+
+// push queues the validation in its tier, it returns an error without blocking if the tier is full.
+func (q *attestationQueue) push(v *attestationValidation, own bool) error {
+	tier, label := q.other, "other"
+	if own {
+		tier, label = q.own, "own"
+	}
+	select {
+	case tier <- v:
+		return nil
+	default:
+		attestationQueueDroppedCounter.WithLabelValues(label).Inc()
+		return errAttestationQueueFull
+	}
+}
+
+// pop returns the next validation to run, waiting for one to be queued until the context is
+// done. Own committee attestations come first, but an other attestation is popped after every
+// priority own ones so that the other tier is never starved. Workers pop the queue one at a time.
+func (q *attestationQueue) pop(ctx context.Context) (*attestationValidation, bool) {
+	select {
+	case q.lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, false
+	}
+	defer func() { <-q.lock }()
+
+	if q.ownTaken < q.priority {
+		select {
+		case v := <-q.own:
+			q.ownTaken++
+			return v, true
+		default:
+		}
+	}
+	select {
+	case v := <-q.other:
+		q.ownTaken = 0
+		return v, true
+	default:
+	}
+	select {
+	case v := <-q.own:
+		if q.ownTaken < q.priority {
+			q.ownTaken++
+		}
+		return v, true
+	case v := <-q.other:
+		q.ownTaken = 0
+		return v, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+// validateCommitteeIndexBeaconAttestationQueued is the gossip validator of committee subnet attestations.
+// The validations wait in the attestation queue, so the attestations of the node's own validator
+// committees are validated ahead of the other subnet traffic. Attestations are ignored when their
+// tier of the queue is full.
+func (s *Service) validateCommitteeIndexBeaconAttestationQueued(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	if s.attQueue == nil {
+		return s.validateCommitteeIndexBeaconAttestation(ctx, pid, msg)
+	}
+	// Buffered so the worker never blocks on a validation whose caller gave up waiting.
+	v := &attestationValidation{ctx: ctx, pid: pid, msg: msg, result: make(chan pubsub.ValidationResult, 1)}
+	if err := s.attQueue.push(v, s.isOwnCommitteeAttestation(msg)); err != nil {
+		return pubsub.ValidationIgnore
+	}
+	select {
+	case res := <-v.result:
+		return res
+	case <-ctx.Done():
+		return pubsub.ValidationIgnore
+	}
+}
+
+// attestationQueueRoutine runs the queued validations until the service is stopped.
+func (s *Service) attestationQueueRoutine() {
+	for {
+		v, ok := s.attQueue.pop(s.ctx)
+		if !ok {
+			return
+		}
+		if v.ctx.Err() != nil {
+			v.result <- pubsub.ValidationIgnore
+			continue
+		}
+		v.result <- s.validateCommitteeIndexBeaconAttestation(v.ctx, v.pid, v.msg)
+	}
+}
+
+// isOwnCommitteeAttestation returns true if the attestation was received on the subnet of the
+// committee of one of the node's validators at the current or previous slot. The subnet is read
+// from the topic, so the attestation does not need to be decoded.
+func (s *Service) isOwnCommitteeAttestation(msg *pubsub.Message) bool {
+	if len(msg.TopicIDs) == 0 {
+		return false
+	}
+	subnet, ok := attestationTopicSubnet(msg.TopicIDs[0])
+	if !ok {
+		return false
+	}
+	currentSlot := s.chain.CurrentSlot()
+	subnets := cache.SubnetIDs.GetAttesterSubnetIDs(currentSlot)
+	if currentSlot > 0 {
+		subnets = append(subnets, cache.SubnetIDs.GetAttesterSubnetIDs(currentSlot-1)...)
+	}
+	for _, id := range subnets {
+		if id == subnet {
+			return true
+		}
+	}
+	return false
+}
+
+// attestationTopicSubnet returns the subnet of a committee subnet attestation topic, such as
+// /eth2/<digest>/beacon_attestation_<subnet>/<encoding>.
+func attestationTopicSubnet(topic string) (uint64, bool) {
+	const prefix = "beacon_attestation_"
+	i := strings.LastIndex(topic, prefix)
+	if i < 0 {
+		return 0, false
+	}
+	rest := topic[i+len(prefix):]
+	if j := strings.Index(rest, "/"); j >= 0 {
+		rest = rest[:j]
+	}
+	subnet, err := strconv.ParseUint(rest, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return subnet, true
+}
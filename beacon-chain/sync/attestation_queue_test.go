@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAttestationQueue_PopsOwnAttestationsFirst(t *testing.T) {
+	ctx := context.Background()
+	q := newAttestationQueue(10, 2)
+	newValidation := func(id string) *attestationValidation {
+		return &attestationValidation{ctx: ctx, pid: peer.ID(id)}
+	}
+	for _, id := range []string{"other1", "other2", "other3"} {
+		require.NoError(t, q.push(newValidation(id), false))
+	}
+	for _, id := range []string{"own1", "own2", "own3", "own4", "own5"} {
+		require.NoError(t, q.push(newValidation(id), true))
+	}
+
+	// Two own attestations are popped for each other attestation until the own tier is empty.
+	var ids []string
+	for i := 0; i < 8; i++ {
+		v, ok := q.pop(ctx)
+		require.Equal(t, true, ok)
+		ids = append(ids, string(v.pid))
+	}
+	assert.DeepEqual(t, []string{"own1", "own2", "other1", "own3", "own4", "other2", "own5", "other3"}, ids)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, ok := q.pop(cancelledCtx)
+	assert.Equal(t, false, ok, "Expected no attestation from an empty queue")
+}
+
+func TestAttestationQueue_PushFullTier(t *testing.T) {
+	q := newAttestationQueue(1, 0)
+	v := &attestationValidation{ctx: context.Background()}
+	require.NoError(t, q.push(v, true))
+	assert.ErrorContains(t, errAttestationQueueFull.Error(), q.push(v, true))
+	assert.NoError(t, q.push(v, false), "Expected the other tier not to be full")
+	assert.Equal(t, defaultAttestationQueuePriority, q.priority)
+}
+
+func TestAttestationTopicSubnet(t *testing.T) {
+	subnet, ok := attestationTopicSubnet("/eth2/e7a75d5a/beacon_attestation_12/ssz_snappy")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, uint64(12), subnet)
+	subnet, ok = attestationTopicSubnet("/eth2/e7a75d5a/beacon_attestation_3")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, uint64(3), subnet)
+	_, ok = attestationTopicSubnet("/eth2/e7a75d5a/beacon_aggregate_and_proof/ssz_snappy")
+	assert.Equal(t, false, ok)
+}
@@ -0,0 +1,105 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// signatureVerificationInterval is the longest a signature waits in the batch verification queue.
+	signatureVerificationInterval = 50 * time.Millisecond
+	// verifierLimit is the number of signature sets verified together at most.
+	verifierLimit = 50
+)
+
+var errInvalidSignature = errors.New("invalid signature")
+
+type signatureVerifier struct {
+	set     *bls.SignatureSet
+	resChan chan error
+}
+
+// verifierRoutine accumulates the signature sets sent to the batch verification queue and
+// verifies them together once the batch is full or the verification interval elapsed.
+func (s *Service) verifierRoutine() {
+	ticker := time.NewTicker(signatureVerificationInterval)
+	defer ticker.Stop()
+	var verifierBatch []*signatureVerifier
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case sig := <-s.signatureChan:
+			verifierBatch = append(verifierBatch, sig)
+			if len(verifierBatch) >= verifierLimit {
+				verifyBatch(verifierBatch)
+				verifierBatch = nil
+			}
+		case <-ticker.C:
+			if len(verifierBatch) > 0 {
+				verifyBatch(verifierBatch)
+				verifierBatch = nil
+			}
+		}
+	}
+}
+
+// verifySignatureSet sends the signature set to the batch verification queue and waits for its
+// result. The set is verified on its own if the service has no verification queue.
+func (s *Service) verifySignatureSet(ctx context.Context, set *bls.SignatureSet) error {
+	ctx, span := trace.StartSpan(ctx, "sync.verifySignatureSet")
+	defer span.End()
+
+	if s.signatureChan == nil {
+		return verifySet(set)
+	}
+	// Buffered so the verifier routine never blocks on a validator which gave up waiting.
+	resChan := make(chan error, 1)
+	select {
+	case s.signatureChan <- &signatureVerifier{set: set, resChan: resChan}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-resChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// verifyBatch verifies the signature sets of the batch with a single batch verification. If it
+// fails, the sets are verified one by one to find the invalid ones.
+func verifyBatch(verifierBatch []*signatureVerifier) {
+	aggSet := bls.NewSet()
+	for _, v := range verifierBatch {
+		aggSet.Join(v.set)
+	}
+	verified, err := aggSet.Verify()
+	if err == nil && verified {
+		batchVerifiedSignatures.Add(float64(len(verifierBatch)))
+		for _, v := range verifierBatch {
+			v.resChan <- nil
+		}
+		return
+	}
+	failedBatchVerifications.Inc()
+	for _, v := range verifierBatch {
+		v.resChan <- verifySet(v.set)
+	}
+}
+
+func verifySet(set *bls.SignatureSet) error {
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify signature set")
+	}
+	if !verified {
+		return errInvalidSignature
+	}
+	return nil
+}
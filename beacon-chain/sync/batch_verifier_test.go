@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func signatureSet(t *testing.T, msg [32]byte, valid bool) *bls.SignatureSet {
+	key := bls.RandKey()
+	signed := msg
+	if !valid {
+		signed[0]++
+	}
+	return &bls.SignatureSet{
+		Signatures: []bls.Signature{key.Sign(signed[:])},
+		PublicKeys: []bls.PublicKey{key.PublicKey()},
+		Messages:   [][32]byte{msg},
+	}
+}
+
+func TestVerifyBatch_FallsBackToIndividualVerification(t *testing.T) {
+	batch := []*signatureVerifier{
+		{set: signatureSet(t, [32]byte{1}, true), resChan: make(chan error, 1)},
+		{set: signatureSet(t, [32]byte{2}, false), resChan: make(chan error, 1)},
+		{set: signatureSet(t, [32]byte{3}, true), resChan: make(chan error, 1)},
+	}
+	verifyBatch(batch)
+	assert.NoError(t, <-batch[0].resChan)
+	assert.ErrorContains(t, errInvalidSignature.Error(), <-batch[1].resChan)
+	assert.NoError(t, <-batch[2].resChan)
+}
+
+func TestService_VerifySignatureSet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{ctx: ctx, signatureChan: make(chan *signatureVerifier, verifierLimit)}
+	go s.verifierRoutine()
+
+	assert.NoError(t, s.verifySignatureSet(ctx, signatureSet(t, [32]byte{1}, true)))
+	assert.ErrorContains(t, errInvalidSignature.Error(), s.verifySignatureSet(ctx, signatureSet(t, [32]byte{2}, false)))
+
+	// Sets are verified on their own without a verification queue.
+	s = &Service{}
+	assert.NoError(t, s.verifySignatureSet(ctx, signatureSet(t, [32]byte{1}, true)))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -41,6 +42,20 @@ var (
 		},
 		[]string{"topic"},
 	)
+	attestationRejectedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_attestation_rejected_total",
+			Help: "Count of gossiped attestations rejected, or ignored as duplicates, by reason.",
+		},
+		[]string{"reason"},
+	)
+	attestationQueueDroppedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "attestation_queue_dropped_total",
+			Help: "Count of unaggregated attestations dropped because their tier of the attestation queue was full.",
+		},
+		[]string{"tier"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -78,6 +93,42 @@ var (
 			Buckets: []float64{1000, 2000, 3000, 4000, 5000, 6000},
 		},
 	)
+	arrivalAttestationPropagationHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "attestation_arrival_latency_milliseconds",
+			Help:    "Captures attestations propagation time. Attestations arrival in milliseconds since their slot start distribution",
+			Buckets: []float64{1000, 2000, 3000, 4000, 5000, 6000, 8000, 10000, 12000},
+		},
+	)
+	arrivalAggregatePropagationHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "aggregate_arrival_latency_milliseconds",
+			Help:    "Captures aggregates propagation time. Aggregates arrival in milliseconds since their slot start distribution",
+			Buckets: []float64{4000, 6000, 8000, 9000, 10000, 11000, 12000, 18000, 24000},
+		},
+	)
+	batchVerifiedSignatures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "p2p_batch_verified_signatures_total",
+			Help: "Count the number of gossip signatures verified in a successful batch verification.",
+		},
+	)
+	failedBatchVerifications = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "p2p_failed_batch_verifications_total",
+			Help: "Count the number of batch verifications which failed and fell back to verifying signatures one by one.",
+		},
+	)
+)
+
+// Metrics of the caches of the objects seen on the wire, reported with the other cache metrics.
+var (
+	seenBlockCacheMetrics            = cache.NewMetrics("seen_block")
+	seenAttestationCacheMetrics      = cache.NewMetrics("seen_attestation")
+	seenExitCacheMetrics             = cache.NewMetrics("seen_exit")
+	seenAttesterSlashingCacheMetrics = cache.NewMetrics("seen_attester_slashing")
+	seenProposerSlashingCacheMetrics = cache.NewMetrics("seen_proposer_slashing")
+	badBlockCacheMetrics             = cache.NewMetrics("bad_block")
 )
 
 func (s *Service) updateMetrics() {
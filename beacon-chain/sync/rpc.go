@@ -55,6 +55,10 @@ func (s *Service) registerRPCHandlers() {
 		p2p.RPCMetaDataTopic,
 		s.metaDataHandler,
 	)
+	s.registerRPC(
+		p2p.RPCAttestationPoolTopic,
+		s.attestationPoolRPCHandler,
+	)
 }
 
 // registerRPC for a given topic with an expected protobuf message type.
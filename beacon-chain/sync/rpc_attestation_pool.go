@@ -0,0 +1,209 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	streamhelpers "github.com/libp2p/go-libp2p-core/helpers"
+	"github.com/libp2p/go-libp2p-core/mux"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxRequestPoolAttestations is the maximum number of attestations sent in response to an
+	// attestation pool request.
+	maxRequestPoolAttestations = 4096
+	// attestationPoolSyncPeers is the number of peers the attestations of the pool are requested from.
+	attestationPoolSyncPeers = 3
+)
+
+// attestationPoolRPCHandler responds to an attestation pool request with the aggregated, then the
+// unaggregated, attestations of the pool since the requested slot.
+func (s *Service) attestationPoolRPCHandler(_ context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Failed to close stream")
+		}
+	}()
+	SetRPCStreamDeadlines(stream)
+
+	startSlot, ok := msg.(*uint64)
+	if !ok {
+		return fmt.Errorf("wrong message type for attestation pool request, got %T, wanted *uint64", msg)
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	sent := 0
+	for _, att := range s.attPool.AggregatedAttestations() {
+		if sent >= maxRequestPoolAttestations {
+			return nil
+		}
+		if att.Data == nil || att.Data.Slot < *startSlot {
+			continue
+		}
+		if err := s.chunkWriter(stream, att); err != nil {
+			return err
+		}
+		sent++
+	}
+	// The unaggregated attestations are visited without copying the whole pool, stopping once
+	// the response is full.
+	var writeErr error
+	if err := s.attPool.ForEachUnaggregated(func(att *ethpb.Attestation) bool {
+		if sent >= maxRequestPoolAttestations {
+			return false
+		}
+		if att.Data == nil || att.Data.Slot < *startSlot {
+			return true
+		}
+		if writeErr = s.chunkWriter(stream, att); writeErr != nil {
+			return false
+		}
+		sent++
+		return true
+	}); err != nil {
+		return err
+	}
+	return writeErr
+}
+
+// sendAttestationPoolRequest requests the attestations of the pool of the peer since the start
+// slot and saves the valid ones in the pool, returning the number of attestations saved. The peer
+// is penalized for invalid attestations.
+func (s *Service) sendAttestationPoolRequest(ctx context.Context, startSlot uint64, id peer.ID) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+
+	stream, err := s.p2p.Send(ctx, &startSlot, p2p.RPCAttestationPoolTopic, id)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := streamhelpers.FullClose(stream); err != nil && err.Error() != mux.ErrReset.Error() {
+			log.WithError(err).Debugf("Failed to reset stream with protocol %s", stream.Protocol())
+		}
+	}()
+
+	saved := 0
+	for i := 0; i < maxRequestPoolAttestations; i++ {
+		att := &ethpb.Attestation{}
+		if err := readResponseChunk(stream, s.p2p, att); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return saved, err
+		}
+		valid, err := s.validatePoolAttestation(ctx, att)
+		if err != nil {
+			s.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+			return saved, errors.Wrap(err, "invalid attestation in attestation pool response")
+		}
+		if !valid {
+			continue
+		}
+		if helpers.IsAggregated(att) {
+			err = s.attPool.SaveAggregatedAttestation(att)
+		} else {
+			err = s.attPool.SaveUnaggregatedAttestation(att)
+		}
+		if err != nil {
+			return saved, err
+		}
+		saved++
+	}
+	return saved, nil
+}
+
+// validatePoolAttestation verifies an attestation received in response to an attestation pool
+// request. It returns false if the attestation expired or can't be verified yet, because its
+// block is unknown, and an error if the attestation is invalid.
+func (s *Service) validatePoolAttestation(ctx context.Context, att *ethpb.Attestation) (bool, error) {
+	if att.Data == nil || att.Data.Source == nil || att.Data.Target == nil || att.AggregationBits.Count() == 0 {
+		return false, errors.New("attestation is missing data or aggregation bits")
+	}
+	currentSlot := s.chain.CurrentSlot()
+	if att.Data.Slot > currentSlot {
+		return false, fmt.Errorf("attestation slot %d is after the current slot %d", att.Data.Slot, currentSlot)
+	}
+	if att.Data.Slot+params.BeaconConfig().SlotsPerEpoch <= currentSlot {
+		return false, nil
+	}
+	if s.hasBadBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) ||
+		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Target.Root)) ||
+		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Source.Root)) {
+		return false, errors.New("attestation references a bad block")
+	}
+	blockRoot := bytesutil.ToBytes32(att.Data.BeaconBlockRoot)
+	if !s.db.HasBlock(ctx, blockRoot) && !s.chain.HasInitSyncBlock(blockRoot) {
+		return false, nil
+	}
+	preState, err := s.chain.AttestationPreState(ctx, att)
+	if err != nil {
+		log.WithError(err).Debug("Could not retrieve attestation pre state")
+		return false, nil
+	}
+	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return false, err
+	}
+	if att.AggregationBits.Len() != uint64(len(committee)) {
+		return false, fmt.Errorf("attestation has %d aggregation bits for a committee of %d", att.AggregationBits.Len(), len(committee))
+	}
+	if err := blocks.VerifyAttestationSignature(ctx, preState, att); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// requestAttestationPools requests the recent attestations of the pools of a few peers, so that
+// the blocks proposed right after a start or a resync include them.
+func (s *Service) requestAttestationPools() {
+	startSlot := uint64(0)
+	if currentSlot := s.chain.CurrentSlot(); currentSlot > params.BeaconConfig().SlotsPerEpoch {
+		startSlot = currentSlot - params.BeaconConfig().SlotsPerEpoch
+	}
+	_, pids := s.p2p.Peers().BestFinalized(attestationPoolSyncPeers, s.chain.FinalizedCheckpt().Epoch)
+	for _, pid := range pids {
+		saved, err := s.sendAttestationPoolRequest(s.ctx, startSlot, pid)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not request attestation pool")
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"peer":         pid,
+			"attestations": saved,
+		}).Debug("Saved attestations of peer attestation pool")
+	}
+}
+
+// attestationPoolSyncRoutine requests the attestation pools of peers once the chain started and
+// the initial sync is done.
+func (s *Service) attestationPoolSyncRoutine() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if s.chainStarted && s.initialSync != nil && !s.initialSync.Syncing() {
+				s.requestAttestationPools()
+				return
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
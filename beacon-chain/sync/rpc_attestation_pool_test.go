@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAttestationPoolRPCHandler_SendsAttestationsSinceSlot(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	aggregated := testutil.NewAttestation(5, 0, bitfield.Bitlist{0b1101})
+	unaggregated := testutil.NewAttestation(6, 0, bitfield.Bitlist{0b1001})
+	old := testutil.NewAttestation(1, 0, bitfield.Bitlist{0b1010})
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestation(aggregated))
+	require.NoError(t, pool.SaveUnaggregatedAttestations([]*ethpb.Attestation{unaggregated, old}))
+	r := &Service{
+		p2p:         p1,
+		attPool:     pool,
+		rateLimiter: newRateLimiter(p1),
+	}
+
+	pcl := protocol.ID("/testing")
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(1, 1, false)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		var received []*ethpb.Attestation
+		for {
+			att := &ethpb.Attestation{}
+			if err := readResponseChunk(stream, p2, att); err != nil {
+				assert.Equal(t, io.EOF, err)
+				break
+			}
+			received = append(received, att)
+		}
+		assert.DeepEqual(t, []*ethpb.Attestation{aggregated, unaggregated}, received)
+	})
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+
+	startSlot := uint64(3)
+	assert.NoError(t, r.attestationPoolRPCHandler(context.Background(), &startSlot, stream1))
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestValidatePoolAttestation(t *testing.T) {
+	d, _ := db.SetupDB(t)
+	badBlockCache, err := lru.New(10)
+	require.NoError(t, err)
+	currentSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	genesis := time.Now().Add(-time.Duration(currentSlot*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	r := &Service{
+		db:            d,
+		chain:         &mock.ChainService{Genesis: genesis},
+		badBlockCache: badBlockCache,
+	}
+	badRoot := [32]byte{'b'}
+	r.setBadBlock(context.Background(), badRoot)
+	badBlock := testutil.NewAttestation(currentSlot-1, 0, bitfield.Bitlist{0b1101})
+	badBlock.Data.BeaconBlockRoot = badRoot[:]
+
+	tests := []struct {
+		name    string
+		att     *ethpb.Attestation
+		valid   bool
+		wantErr string
+	}{
+		{
+			name:    "missing data",
+			att:     &ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}},
+			wantErr: "missing data",
+		},
+		{
+			name:    "no aggregation bits",
+			att:     testutil.NewAttestation(currentSlot-1, 0, bitfield.Bitlist{0b1000}),
+			wantErr: "missing data or aggregation bits",
+		},
+		{
+			name:    "future slot",
+			att:     testutil.NewAttestation(currentSlot+1, 0, bitfield.Bitlist{0b1101}),
+			wantErr: "after the current slot",
+		},
+		{
+			name: "expired",
+			att:  testutil.NewAttestation(1, 0, bitfield.Bitlist{0b1101}),
+		},
+		{
+			name:    "bad block",
+			att:     badBlock,
+			wantErr: "bad block",
+		},
+		{
+			name: "unknown block",
+			att:  testutil.NewAttestation(currentSlot-1, 0, bitfield.Bitlist{0b1101}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := r.validatePoolAttestation(context.Background(), tt.att)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+}
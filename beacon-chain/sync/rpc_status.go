@@ -84,6 +84,8 @@ func (s *Service) resyncIfBehind() {
 				s.clearPendingSlots()
 				if err := s.initialSync.Resync(); err != nil {
 					log.Errorf("Could not resync chain: %v", err)
+				} else {
+					go s.requestAttestationPools()
 				}
 			}
 		}
@@ -0,0 +1,34 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// Reasons of the gossiped attestations rejections, used as labels of the rejected attestations metric.
+const (
+	rejectedAttDecode           = "decode"
+	rejectedAttMissingData      = "missing data"
+	rejectedAttBadBlock         = "bad block"
+	rejectedAttWrongSubnet      = "wrong subnet"
+	rejectedAttInvalidBits      = "invalid bits"
+	rejectedAttInvalidSignature = "invalid signature"
+	rejectedAttInvalidAggregate = "invalid aggregate"
+	rejectedAttDuplicate        = "duplicate"
+)
+
+// rejectAttestation counts the invalid attestation against the peer which gossiped it, so that the
+// peers flooding invalid attestations are eventually disconnected, and rejects the message.
+func (s *Service) rejectAttestation(pid peer.ID, reason string) pubsub.ValidationResult {
+	attestationRejectedCounter.WithLabelValues(reason).Inc()
+	s.p2p.Peers().Scorers().AttestationsScorer().IncrementInvalid(pid)
+	return pubsub.ValidationReject
+}
+
+// ignoreDuplicateAttestation counts the already seen attestation against the peer which gossiped it,
+// so that the peers flooding duplicate attestations are eventually disconnected, and ignores the message.
+func (s *Service) ignoreDuplicateAttestation(pid peer.ID) pubsub.ValidationResult {
+	attestationRejectedCounter.WithLabelValues(rejectedAttDuplicate).Inc()
+	s.p2p.Peers().Scorers().AttestationsScorer().IncrementDuplicate(pid)
+	return pubsub.ValidationIgnore
+}
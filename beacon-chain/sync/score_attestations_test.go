@@ -0,0 +1,35 @@
+package sync
+
+import (
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_RejectAttestation_BadPeer(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{p2p: p}
+	scorer := p.Peers().Scorers().AttestationsScorer()
+
+	for i := 0; i < scorer.Params().InvalidThreshold-1; i++ {
+		assert.Equal(t, pubsub.ValidationReject, s.rejectAttestation("peer1", rejectedAttInvalidSignature))
+	}
+	assert.Equal(t, false, p.Peers().IsBad("peer1"), "Peer is bad before reaching the threshold")
+	s.rejectAttestation("peer1", rejectedAttInvalidSignature)
+	assert.Equal(t, true, p.Peers().IsBad("peer1"), "Peer flooding invalid attestations is not bad")
+}
+
+func TestService_IgnoreDuplicateAttestation(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{p2p: p}
+
+	assert.Equal(t, pubsub.ValidationIgnore, s.ignoreDuplicateAttestation("peer1"))
+	assert.Equal(t, pubsub.ValidationIgnore, s.ignoreDuplicateAttestation("peer1"))
+	invalid, duplicate, err := p.Peers().Scorers().AttestationsScorer().Counts("peer1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, invalid)
+	assert.Equal(t, 2, duplicate)
+}
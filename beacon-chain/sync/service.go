@@ -19,6 +19,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
@@ -57,6 +58,12 @@ type Config struct {
 	AttestationNotifier operation.Notifier
 	StateSummaryCache   *cache.StateSummaryCache
 	StateGen            *stategen.State
+	ForkSchedule        *forks.Schedule
+	// AttestationQueueSize is the number of attestations each tier of the attestation queue holds.
+	AttestationQueueSize int
+	// AttestationQueuePriority is the number of own committee attestations validated for each
+	// other attestation.
+	AttestationQueuePriority int
 }
 
 // This defines the interface for interacting with block chain service
@@ -108,6 +115,9 @@ type Service struct {
 	badBlockLock              sync.RWMutex
 	stateSummaryCache         *cache.StateSummaryCache
 	stateGen                  *stategen.State
+	signatureChan             chan *signatureVerifier
+	attQueue                  *attestationQueue
+	forkSchedule              *forks.Schedule
 }
 
 // NewRegularSync service.
@@ -133,9 +143,16 @@ func NewRegularSync(cfg *Config) *Service {
 		stateSummaryCache:    cfg.StateSummaryCache,
 		stateGen:             cfg.StateGen,
 		rateLimiter:          rLimiter,
+		signatureChan:        make(chan *signatureVerifier, verifierLimit),
+		attQueue:             newAttestationQueue(cfg.AttestationQueueSize, cfg.AttestationQueuePriority),
+		forkSchedule:         cfg.ForkSchedule,
 	}
 
 	go r.registerHandlers()
+	go r.verifierRoutine()
+	for i := 0; i < attestationQueueWorkers; i++ {
+		go r.attestationQueueRoutine()
+	}
 
 	return r
 }
@@ -156,6 +173,7 @@ func (s *Service) Start() {
 	s.processPendingAttsQueue()
 	s.maintainPeerStatuses()
 	s.resyncIfBehind()
+	go s.attestationPoolSyncRoutine()
 
 	// Update sync metrics.
 	runutil.RunEvery(s.ctx, syncMetricsInterval, s.updateMetrics)
@@ -192,27 +210,27 @@ func (s *Service) Status() error {
 // This initializes the caches to update seen beacon objects coming in from the wire
 // and prevent DoS.
 func (s *Service) initCaches() error {
-	blkCache, err := lru.New(seenBlockSize)
+	blkCache, err := lru.NewWithEvict(seenBlockSize, seenBlockCacheMetrics.Evicted)
 	if err != nil {
 		return err
 	}
-	attCache, err := lru.New(seenAttSize)
+	attCache, err := lru.NewWithEvict(seenAttSize, seenAttestationCacheMetrics.Evicted)
 	if err != nil {
 		return err
 	}
-	exitCache, err := lru.New(seenExitSize)
+	exitCache, err := lru.NewWithEvict(seenExitSize, seenExitCacheMetrics.Evicted)
 	if err != nil {
 		return err
 	}
-	attesterSlashingCache, err := lru.New(seenAttesterSlashingSize)
+	attesterSlashingCache, err := lru.NewWithEvict(seenAttesterSlashingSize, seenAttesterSlashingCacheMetrics.Evicted)
 	if err != nil {
 		return err
 	}
-	proposerSlashingCache, err := lru.New(seenProposerSlashingSize)
+	proposerSlashingCache, err := lru.NewWithEvict(seenProposerSlashingSize, seenProposerSlashingCacheMetrics.Evicted)
 	if err != nil {
 		return err
 	}
-	badBlockCache, err := lru.New(badBlockSize)
+	badBlockCache, err := lru.NewWithEvict(badBlockSize, badBlockCacheMetrics.Evicted)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/messagehandler"
@@ -20,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -41,46 +43,117 @@ func (s *Service) noopValidator(ctx context.Context, _ peer.ID, msg *pubsub.Mess
 
 // Register PubSub subscribers
 func (s *Service) registerSubscribers() {
-	s.subscribe(
-		p2p.BlockSubnetTopicFormat,
-		s.validateBeaconBlockPubSub,
-		s.beaconBlockSubscriber,
-	)
-	s.subscribe(
-		p2p.AggregateAndProofSubnetTopicFormat,
-		s.validateAggregateAndProof,
-		s.beaconAggregateProofSubscriber,
-	)
-	s.subscribe(
-		p2p.ExitSubnetTopicFormat,
-		s.validateVoluntaryExit,
-		s.voluntaryExitSubscriber,
-	)
-	s.subscribe(
-		p2p.ProposerSlashingSubnetTopicFormat,
-		s.validateProposerSlashing,
-		s.proposerSlashingSubscriber,
-	)
-	s.subscribe(
-		p2p.AttesterSlashingSubnetTopicFormat,
-		s.validateAttesterSlashing,
-		s.attesterSlashingSubscriber,
-	)
+	go s.resubscribeOnForks(s.subscribeTopics())
 	if featureconfig.Get().DisableDynamicCommitteeSubnets {
 		s.subscribeStaticWithSubnets(
 			"/eth2/%x/beacon_attestation_%d",
-			s.validateCommitteeIndexBeaconAttestation,   /* validator */
-			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
+			s.validateCommitteeIndexBeaconAttestationQueued, /* validator */
+			s.committeeIndexBeaconAttestationSubscriber,     /* message handler */
 		)
 	} else {
 		s.subscribeDynamicWithSubnets(
 			"/eth2/%x/beacon_attestation_%d",
-			s.validateCommitteeIndexBeaconAttestation,   /* validator */
-			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
+			s.validateCommitteeIndexBeaconAttestationQueued, /* validator */
+			s.committeeIndexBeaconAttestationSubscriber,     /* message handler */
 		)
 	}
 }
 
+// subscribeTopics subscribes to the gossip topics other than the attestation subnets, on the
+// digest of the current fork.
+func (s *Service) subscribeTopics() []*pubsub.Subscription {
+	return []*pubsub.Subscription{
+		s.subscribe(
+			p2p.BlockSubnetTopicFormat,
+			s.validateBeaconBlockPubSub,
+			s.beaconBlockSubscriber,
+		),
+		s.subscribe(
+			p2p.AggregateAndProofSubnetTopicFormat,
+			s.validateAggregateAndProof,
+			s.beaconAggregateProofSubscriber,
+		),
+		s.subscribe(
+			p2p.ExitSubnetTopicFormat,
+			s.validateVoluntaryExit,
+			s.voluntaryExitSubscriber,
+		),
+		s.subscribe(
+			p2p.ProposerSlashingSubnetTopicFormat,
+			s.validateProposerSlashing,
+			s.proposerSlashingSubscriber,
+		),
+		s.subscribe(
+			p2p.AttesterSlashingSubnetTopicFormat,
+			s.validateAttesterSlashing,
+			s.attesterSlashingSubscriber,
+		),
+	}
+}
+
+// resubscribeOnForks checks every slot whether a new fork is active, in which case it subscribes
+// to the gossip topics of the digest of the new fork and cancels the subscriptions to the topics
+// of the previous one.
+func (s *Service) resubscribeOnForks(subs []*pubsub.Subscription) {
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Error("Could not compute fork digest")
+		return
+	}
+	ticker := slotutil.GetSlotTicker(s.chain.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	for {
+		select {
+		case <-s.ctx.Done():
+			ticker.Done()
+			return
+		case <-ticker.C():
+			newDigest, err := s.forkDigest()
+			if err != nil {
+				log.WithError(err).Error("Could not compute fork digest")
+				continue
+			}
+			if newDigest == digest {
+				continue
+			}
+			s.logForkActivation(newDigest)
+			for _, sub := range subs {
+				s.unsubscribe(sub)
+			}
+			subs = s.subscribeTopics()
+			digest = newDigest
+		}
+	}
+}
+
+// logForkActivation logs the fork of the digest the gossip topics switch to.
+func (s *Service) logForkActivation(digest [4]byte) {
+	schedule, err := s.schedule()
+	if err != nil {
+		log.WithError(err).Error("Could not get fork schedule")
+		return
+	}
+	genRoot := s.chain.GenesisValidatorRoot()
+	digests, err := schedule.ForkDigests(genRoot[:])
+	if err != nil {
+		log.WithError(err).Error("Could not compute fork digests")
+		return
+	}
+	fields := logrus.Fields{"digest": fmt.Sprintf("%#x", digest)}
+	if f, ok := digests[digest]; ok {
+		fields["epoch"] = f.Epoch
+		fields["version"] = fmt.Sprintf("%#x", f.Version)
+	}
+	log.WithFields(fields).Info("Switching the gossip topics to the new fork")
+}
+
+// unsubscribe cancels the subscription and unregisters the validator of its topic.
+func (s *Service) unsubscribe(sub *pubsub.Subscription) {
+	sub.Cancel()
+	if err := s.p2p.PubSub().UnregisterTopicValidator(sub.Topic()); err != nil {
+		log.WithError(err).Error("Failed to unregister topic validator")
+	}
+}
+
 // subscribe to a given topic with a given validator and subscription handler.
 // The base protobuf message is used to initialize new messages for decoding.
 func (s *Service) subscribe(topic string, validator pubsub.ValidatorEx, handle subHandler) *pubsub.Subscription {
@@ -170,11 +243,15 @@ func wrapAndReportValidation(topic string, v pubsub.ValidatorEx) (string, pubsub
 		defer messagehandler.HandlePanic(ctx, msg)
 		ctx, cancel := context.WithTimeout(ctx, pubsubMessageTimeout)
 		defer cancel()
+		ctx, span := trace.StartSpan(ctx, "sync.pubsubValidation")
+		defer span.End()
+		span.AddAttributes(trace.StringAttribute("topic", topic), trace.StringAttribute("peer", pid.String()))
 		messageReceivedCounter.WithLabelValues(topic).Inc()
 		b := v(ctx, pid, msg)
 		if b == pubsub.ValidationReject {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
 		}
+		span.AddAttributes(trace.BoolAttribute("accepted", b == pubsub.ValidationAccept))
 		return b
 	}
 }
@@ -186,8 +263,13 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator pubsub.Vali
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
 	}
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Fatal("Could not compute fork digest")
+	}
+	subnetSubs := make([]*pubsub.Subscription, 0, params.BeaconNetworkConfig().AttestationSubnetCount)
 	for i := uint64(0); i < params.BeaconNetworkConfig().AttestationSubnetCount; i++ {
-		s.subscribeWithBase(base, s.addDigestAndIndexToTopic(topic, i), validator, handle)
+		subnetSubs = append(subnetSubs, s.subscribeWithBase(base, fmt.Sprintf(topic, digest, i), validator, handle))
 	}
 	genesis := s.chain.GenesisTime()
 	ticker := slotutil.GetSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
@@ -199,6 +281,17 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator pubsub.Vali
 				ticker.Done()
 				return
 			case <-ticker.C():
+				// The subnets of a new fork are subscribed to in place of the previous ones.
+				if newDigest, err := s.forkDigest(); err == nil && newDigest != digest {
+					for _, sub := range subnetSubs {
+						s.unsubscribe(sub)
+					}
+					subnetSubs = subnetSubs[:0]
+					for i := uint64(0); i < params.BeaconNetworkConfig().AttestationSubnetCount; i++ {
+						subnetSubs = append(subnetSubs, s.subscribeWithBase(base, fmt.Sprintf(topic, newDigest, i), validator, handle))
+					}
+					digest = newDigest
+				}
 				if s.chainStarted && s.initialSync.Syncing() {
 					continue
 				}
@@ -249,6 +342,12 @@ func (s *Service) subscribeDynamicWithSubnets(
 				ticker.Done()
 				return
 			case currentSlot := <-ticker.C():
+				// The subnets of the previous fork are dropped once a new fork is active, and
+				// subscribed to again below on the digest of the new fork.
+				if newDigest, err := s.forkDigest(); err == nil && newDigest != digest {
+					s.reValidateSubscriptions(subscriptions, nil, topicFormat, digest)
+					digest = newDigest
+				}
 				if s.chainStarted && s.initialSync.Syncing() {
 					continue
 				}
@@ -360,19 +459,20 @@ func (s *Service) addDigestToTopic(topic string) string {
 	return fmt.Sprintf(topic, digest)
 }
 
-// Add the digest and index to subnet topic.
-func (s *Service) addDigestAndIndexToTopic(topic string, idx uint64) string {
-	if !strings.Contains(topic, "%x") {
-		log.Fatal("Topic does not have appropriate formatter for digest")
-	}
-	digest, err := s.forkDigest()
+func (s *Service) forkDigest() ([4]byte, error) {
+	schedule, err := s.schedule()
 	if err != nil {
-		log.WithError(err).Fatal("Could not compute fork digest")
+		return [4]byte{}, err
 	}
-	return fmt.Sprintf(topic, digest, idx)
+	genRoot := s.chain.GenesisValidatorRoot()
+	return p2putils.CreateForkDigest(schedule, s.chain.GenesisTime(), genRoot[:])
 }
 
-func (s *Service) forkDigest() ([4]byte, error) {
-	genRoot := s.chain.GenesisValidatorRoot()
-	return p2putils.CreateForkDigest(s.chain.GenesisTime(), genRoot[:])
+// schedule returns the fork schedule the service was configured with, or the schedule of the
+// beacon config when it was not given one.
+func (s *Service) schedule() (*forks.Schedule, error) {
+	if s.forkSchedule != nil {
+		return s.forkSchedule, nil
+	}
+	return forks.NewBeaconSchedule(nil)
 }
@@ -15,27 +15,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
-func TestDeleteAttsInPool(t *testing.T) {
-	r := &Service{
-		attPool: attestations.NewPool(),
-	}
-	data := &ethpb.AttestationData{}
-	att1 := &ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}, Data: data}
-	att2 := &ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1110}, Data: data}
-	att3 := &ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1011}, Data: data}
-	att4 := &ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1001}, Data: data}
-	require.NoError(t, r.attPool.SaveAggregatedAttestation(att1))
-	require.NoError(t, r.attPool.SaveAggregatedAttestation(att2))
-	require.NoError(t, r.attPool.SaveAggregatedAttestation(att3))
-	require.NoError(t, r.attPool.SaveUnaggregatedAttestation(att4))
-
-	// Seen 1, 3 and 4 in block.
-	require.NoError(t, r.deleteAttsInPool([]*ethpb.Attestation{att1, att3, att4}))
-
-	// Only 2 should remain.
-	assert.DeepEqual(t, []*ethpb.Attestation{att2}, r.attPool.AggregatedAttestations(), "Did not get wanted attestations")
-}
-
 func TestService_beaconBlockSubscriber(t *testing.T) {
 	pooledAttestations := []*ethpb.Attestation{
 		// Aggregated.
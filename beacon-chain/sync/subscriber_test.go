@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -247,3 +248,25 @@ func TestStaticSubnets(t *testing.T) {
 	}
 	cancel()
 }
+
+func TestSubscribeTopics(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := Service{
+		ctx: ctx,
+		chain: &mockChain.ChainService{
+			Genesis:        time.Now(),
+			ValidatorsRoot: [32]byte{'A'},
+		},
+		p2p: p,
+	}
+	digest, err := r.forkDigest()
+	require.NoError(t, err)
+	subs := r.subscribeTopics()
+	require.Equal(t, 5, len(subs))
+	for _, sub := range subs {
+		assert.Equal(t, true, strings.Contains(sub.Topic(), fmt.Sprintf("%x", digest)), "Topic is not on the current fork digest")
+		r.unsubscribe(sub)
+	}
+}
@@ -39,32 +39,39 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	if err != nil {
 		log.WithError(err).Debug("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttDecode)
 	}
 	m, ok := raw.(*ethpb.SignedAggregateAttestationAndProof)
 	if !ok {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttDecode)
+	}
+	if m.Message == nil || m.Message.Aggregate == nil || m.Message.Aggregate.Data == nil {
+		return s.rejectAttestation(pid, rejectedAttMissingData)
 	}
 	if err := helpers.ValidateAttestationTime(m.Message.Aggregate.Data.Slot, s.chain.GenesisTime()); err != nil {
 		traceutil.AnnotateError(span, err)
 		return pubsub.ValidationIgnore
 	}
 
-	if m.Message == nil || m.Message.Aggregate == nil || m.Message.Aggregate.Data == nil {
-		return pubsub.ValidationReject
+	// Add metrics for aggregate arrival time subtracts slot start time.
+	if captureArrivalTimeMetric(arrivalAggregatePropagationHistogram, uint64(s.chain.GenesisTime().Unix()), m.Message.Aggregate.Data.Slot) != nil {
+		return pubsub.ValidationIgnore
 	}
+
 	// Verify this is the first aggregate received from the aggregator with index and slot.
 	if s.hasSeenAggregatorIndexEpoch(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex) {
-		return pubsub.ValidationIgnore
+		return s.ignoreDuplicateAttestation(pid)
 	}
 	// Check that the block being voted on isn't invalid.
 	if s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.BeaconBlockRoot)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Target.Root)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Source.Root)) {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttBadBlock)
 	}
 
 	// Verify aggregate attestation has not already been seen via aggregate gossip, within a block, or through the creation locally.
+	// Several aggregators of a committee honestly gossip aggregates which are subsets of each other, so these are not
+	// counted against the peer as duplicates.
 	seen, err := s.attPool.HasAggregatedAttestation(m.Message.Aggregate)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
@@ -78,6 +85,9 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	}
 
 	validationRes := s.validateAggregatedAtt(ctx, m)
+	if validationRes == pubsub.ValidationReject {
+		return s.rejectAttestation(pid, rejectedAttInvalidAggregate)
+	}
 	if validationRes != pubsub.ValidationAccept {
 		return validationRes
 	}
@@ -160,6 +170,7 @@ func (s *Service) hasSeenAggregatorIndexEpoch(epoch uint64, aggregatorIndex uint
 	defer s.seenAttestationLock.RUnlock()
 	b := append(bytesutil.Bytes32(epoch), bytesutil.Bytes32(aggregatorIndex)...)
 	_, seen := s.seenAttestationCache.Get(string(b))
+	seenAttestationCacheMetrics.Lookup(seen)
 	return seen
 }
 
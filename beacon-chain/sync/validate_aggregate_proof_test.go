@@ -287,7 +287,7 @@ func TestValidateAggregateAndProof_ExistedInPool(t *testing.T) {
 		p2p:         p,
 		db:          db,
 		initialSync: &mockSync.Sync{IsSyncing: false},
-		chain: &mock.ChainService{Genesis: time.Now(),
+		chain: &mock.ChainService{Genesis: time.Now().Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second),
 			State: beaconState},
 		seenAttestationCache: c,
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
@@ -309,9 +309,12 @@ func TestValidateAggregateAndProof_ExistedInPool(t *testing.T) {
 	}
 
 	require.NoError(t, r.attPool.SaveBlockAttestation(att))
-	if r.validateAggregateAndProof(context.Background(), "", msg) == pubsub.ValidationAccept {
+	if r.validateAggregateAndProof(context.Background(), "peer1", msg) == pubsub.ValidationAccept {
 		t.Error("Expected validate to fail")
 	}
+	// An aggregate already covered by the pool is not counted as a duplicate of the peer.
+	_, _, err = p.Peers().Scorers().AttestationsScorer().Counts("peer1")
+	assert.ErrorContains(t, "peer unknown", err)
 }
 
 func TestValidateAggregateAndProofWithNewStateMgmt_CanValidate(t *testing.T) {
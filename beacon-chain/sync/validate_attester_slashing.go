@@ -93,6 +93,7 @@ func (s *Service) hasSeenAttesterSlashingIndices(indices1 []uint64, indices2 []u
 	b := hashutil.FastSum256(IndicesInBytes)
 
 	_, seen := s.seenAttesterSlashingCache.Get(b)
+	seenAttesterSlashingCacheMetrics.Lookup(seen)
 	return seen
 }
 
@@ -45,22 +45,22 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttDecode)
 	}
 	// Restore topic.
 	msg.TopicIDs[0] = originalTopic
 
 	att, ok := m.(*eth.Attestation)
 	if !ok {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttDecode)
 	}
 
 	if att.Data == nil {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttMissingData)
 	}
 	// Attestation aggregation bits must exist.
 	if att.AggregationBits == nil {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttMissingData)
 	}
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE.
@@ -69,15 +69,20 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationIgnore
 	}
 
+	// Add metrics for attestation arrival time subtracts slot start time.
+	if captureArrivalTimeMetric(arrivalAttestationPropagationHistogram, uint64(s.chain.GenesisTime().Unix()), att.Data.Slot) != nil {
+		return pubsub.ValidationIgnore
+	}
+
 	// Verify this the first attestation received for the participating validator for the slot.
 	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
-		return pubsub.ValidationIgnore
+		return s.ignoreDuplicateAttestation(pid)
 	}
 	// Reject an attestation if it references an invalid block.
 	if s.hasBadBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Target.Root)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Source.Root)) {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttBadBlock)
 	}
 
 	// Verify the block being voted and the processed state is in DB and. The block should have passed validation if it's in the DB.
@@ -113,7 +118,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	subnet := helpers.ComputeSubnetForAttestation(valCount, att)
 
 	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, digest, subnet)) {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttWrongSubnet)
 	}
 
 	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
@@ -126,15 +131,26 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	// Note: eth2 spec suggests (len(get_attesting_indices(state, attestation.data, attestation.aggregation_bits)) == 1)
 	// however this validation can be achieved without use of get_attesting_indices which is an O(n) lookup.
 	if att.AggregationBits.Count() != 1 || att.AggregationBits.BitIndices()[0] >= len(committee) {
-		return pubsub.ValidationReject
+		return s.rejectAttestation(pid, rejectedAttInvalidBits)
 	}
 
 	// Attestation's signature is a valid BLS signature and belongs to correct public key..
+	// The signatures of the attestations received in a short window are batch verified.
 	if !featureconfig.Get().DisableStrictAttestationPubsubVerification {
-		if err := blocks.VerifyAttestationSignature(ctx, preState, att); err != nil {
+		set, err := blocks.AttestationSignatureSet(ctx, preState, []*eth.Attestation{att})
+		if err != nil {
+			// The set could not be built from the local state, which is not the fault of the peer.
+			log.WithError(err).Error("Could not retrieve attestation signature set")
+			traceutil.AnnotateError(span, err)
+			return pubsub.ValidationIgnore
+		}
+		if err := s.verifySignatureSet(ctx, set); err != nil {
+			if ctx.Err() != nil {
+				return pubsub.ValidationIgnore
+			}
 			log.WithError(err).Error("Could not verify attestation")
 			traceutil.AnnotateError(span, err)
-			return pubsub.ValidationReject
+			return s.rejectAttestation(pid, rejectedAttInvalidSignature)
 		}
 	}
 
@@ -152,6 +168,7 @@ func (s *Service) hasSeenCommitteeIndicesSlot(slot uint64, committeeID uint64, a
 	b := append(bytesutil.Bytes32(slot), bytesutil.Bytes32(committeeID)...)
 	b = append(b, aggregateBits...)
 	_, seen := s.seenAttestationCache.Get(string(b))
+	seenAttestationCacheMetrics.Lookup(seen)
 	return seen
 }
 
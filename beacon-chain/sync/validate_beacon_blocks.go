@@ -6,6 +6,7 @@ import (
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prometheus/client_golang/prometheus"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
@@ -56,6 +57,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	if blk.Block == nil {
 		return pubsub.ValidationReject
 	}
+	span.AddAttributes(trace.Int64Attribute("slot", int64(blk.Block.Slot)))
 
 	// Broadcast the block on a feed to notify other services in the beacon node
 	// of a received block (even if it does not process correctly through a state transition).
@@ -93,7 +95,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	s.pendingQueueLock.RUnlock()
 
 	// Add metrics for block arrival time subtracts slot start time.
-	if captureArrivalTimeMetric(uint64(s.chain.GenesisTime().Unix()), blk.Block.Slot) != nil {
+	if captureArrivalTimeMetric(arrivalBlockPropagationHistogram, uint64(s.chain.GenesisTime().Unix()), blk.Block.Slot) != nil {
 		return pubsub.ValidationIgnore
 	}
 
@@ -165,6 +167,7 @@ func (s *Service) hasSeenBlockIndexSlot(slot uint64, proposerIdx uint64) bool {
 	defer s.seenBlockLock.RUnlock()
 	b := append(bytesutil.Bytes32(slot), bytesutil.Bytes32(proposerIdx)...)
 	_, seen := s.seenBlockCache.Get(string(b))
+	seenBlockCacheMetrics.Lookup(seen)
 	return seen
 }
 
@@ -181,6 +184,7 @@ func (s *Service) hasBadBlock(root [32]byte) bool {
 	s.badBlockLock.RLock()
 	defer s.badBlockLock.RUnlock()
 	_, seen := s.badBlockCache.Get(string(root[:]))
+	badBlockCacheMetrics.Lookup(seen)
 	return seen
 }
 
@@ -194,14 +198,14 @@ func (s *Service) setBadBlock(ctx context.Context, root [32]byte) {
 	s.badBlockCache.Add(string(root[:]), true)
 }
 
-// This captures metrics for block arrival time by subtracts slot start time.
-func captureArrivalTimeMetric(genesisTime uint64, currentSlot uint64) error {
+// This captures metrics for gossip message arrival time by subtracts slot start time.
+func captureArrivalTimeMetric(histogram prometheus.Histogram, genesisTime uint64, currentSlot uint64) error {
 	startTime, err := helpers.SlotToTime(genesisTime, currentSlot)
 	if err != nil {
 		return err
 	}
 	diffMs := roughtime.Now().Sub(startTime) / time.Millisecond
-	arrivalBlockPropagationHistogram.Observe(float64(diffMs))
+	histogram.Observe(float64(diffMs))
 
 	return nil
 }
@@ -78,6 +78,7 @@ func (s *Service) hasSeenProposerSlashingIndex(i uint64) bool {
 	s.seenProposerSlashingLock.RLock()
 	defer s.seenProposerSlashingLock.RUnlock()
 	_, seen := s.seenProposerSlashingCache.Get(i)
+	seenProposerSlashingCacheMetrics.Lookup(seen)
 	return seen
 }
 
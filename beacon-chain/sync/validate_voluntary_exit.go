@@ -75,6 +75,7 @@ func (s *Service) hasSeenExitIndex(i uint64) bool {
 	s.seenExitLock.RLock()
 	defer s.seenExitLock.RUnlock()
 	_, seen := s.seenExitCache.Get(i)
+	seenExitCacheMetrics.Lookup(seen)
 	return seen
 }
 
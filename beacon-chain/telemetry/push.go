@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pushTimeout = 10 * time.Second
+	// signatureHeader holds the hex encoded HMAC-SHA256 of the pushed body keyed with the secret,
+	// which the endpoint verifies to only accept statistics of consenting nodes.
+	signatureHeader = "X-Prysm-Signature"
+)
+
+type pusher interface {
+	push(ctx context.Context, st *stats) error
+}
+
+// httpPusher posts the statistics as JSON to an endpoint.
+type httpPusher struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+func newHTTPPusher(url string, secret []byte) *httpPusher {
+	return &httpPusher{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: pushTimeout},
+	}
+}
+
+func (h *httpPusher) push(ctx context.Context, st *stats) error {
+	body, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create telemetry request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(h.secret) > 0 {
+		req.Header.Set(signatureHeader, sign(h.secret, body))
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not post telemetry")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close telemetry response body")
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("telemetry endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// sign returns the hex encoded HMAC-SHA256 of the body keyed with the secret.
+func sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	// Writing to a hash never returns an error.
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,127 @@
+// Package telemetry defines an opt-in beacon node service periodically pushing anonymized node
+// statistics to a remote endpoint, from which testnet coordinators build network-wide dashboards.
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"runtime"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "telemetry")
+
+// defaultPushInterval is how often the statistics are pushed when the configured interval is
+// not positive.
+const defaultPushInterval = time.Minute
+
+// Config options for the telemetry service.
+type Config struct {
+	// Endpoint is the URL the statistics are pushed to.
+	Endpoint string
+	// Secret is the key the pushed statistics are signed with, they are not signed if empty.
+	Secret        []byte
+	HeadFetcher   blockchain.HeadFetcher
+	TimeFetcher   blockchain.TimeFetcher
+	PeersProvider p2p.PeersProvider
+	// PushInterval is how often the statistics are pushed, every minute if not positive.
+	PushInterval time.Duration
+}
+
+// Service periodically pushes the statistics of the node to the configured endpoint.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	pusher pusher
+	// sessionID identifies the pushes of the node until it restarts, in place of any
+	// identifying information such as its peer ID or address.
+	sessionID string
+}
+
+// stats are the anonymized statistics of the node, pushed as JSON.
+type stats struct {
+	SessionID    string `json:"session_id"`
+	Version      string `json:"version"`
+	Timestamp    int64  `json:"timestamp"`
+	HeadSlot     uint64 `json:"head_slot"`
+	SyncDistance uint64 `json:"sync_distance"`
+	PeerCount    int    `json:"peer_count"`
+	MemoryBytes  uint64 `json:"memory_bytes"`
+}
+
+// NewService creates a telemetry service pushing to the configured endpoint.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		cfg:       cfg,
+		pusher:    newHTTPPusher(cfg.Endpoint, cfg.Secret),
+		sessionID: randomSessionID(),
+	}
+}
+
+// Start pushing the statistics of the node.
+func (s *Service) Start() {
+	interval := s.cfg.PushInterval
+	if interval <= 0 {
+		log.WithField("interval", interval).Warnf("Invalid telemetry interval, pushing every %v", defaultPushInterval)
+		interval = defaultPushInterval
+	}
+	log.WithField("endpoint", s.cfg.Endpoint).Info("Starting telemetry service")
+	runutil.RunEvery(s.ctx, interval, s.push)
+}
+
+// Stop pushing the statistics of the node.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the telemetry service.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) push() {
+	if err := s.pusher.push(s.ctx, s.stats()); err != nil {
+		log.WithError(err).Debug("Could not push telemetry")
+	}
+}
+
+// stats collects the current statistics of the node.
+func (s *Service) stats() *stats {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	st := &stats{
+		SessionID:   s.sessionID,
+		Version:     version.GetVersion(),
+		Timestamp:   roughtime.Now().Unix(),
+		HeadSlot:    s.cfg.HeadFetcher.HeadSlot(),
+		MemoryBytes: mem.Sys,
+	}
+	if currentSlot := s.cfg.TimeFetcher.CurrentSlot(); currentSlot > st.HeadSlot {
+		st.SyncDistance = currentSlot - st.HeadSlot
+	}
+	if s.cfg.PeersProvider != nil {
+		st.PeerCount = len(s.cfg.PeersProvider.Peers().Connected())
+	}
+	return st
+}
+
+func randomSessionID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		log.WithError(err).Error("Could not generate telemetry session ID")
+	}
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,67 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_Push(t *testing.T) {
+	secret := []byte("secret")
+	received := make(chan *stats, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, sign(secret, body), r.Header.Get(signatureHeader))
+		st := &stats{}
+		require.NoError(t, json.Unmarshal(body, st))
+		received <- st
+	}))
+	defer srv.Close()
+
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	chain := &mock.ChainService{
+		Genesis: time.Now().Add(-10*slotDuration - slotDuration/2),
+	}
+	s := NewService(context.Background(), &Config{
+		Endpoint:      srv.URL,
+		Secret:        secret,
+		HeadFetcher:   chain,
+		TimeFetcher:   chain,
+		PeersProvider: &p2ptest.MockPeersProvider{},
+	})
+	s.push()
+
+	st := <-received
+	assert.Equal(t, s.sessionID, st.SessionID)
+	assert.Equal(t, uint64(0), st.HeadSlot)
+	assert.Equal(t, chain.CurrentSlot(), st.SyncDistance)
+	assert.NotEqual(t, uint64(0), st.MemoryBytes)
+}
+
+func TestService_Start_InvalidInterval(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := NewService(context.Background(), &Config{Endpoint: "http://localhost", PushInterval: -time.Second})
+	s.Start()
+	require.NoError(t, s.Stop())
+	require.LogsContain(t, hook, "Invalid telemetry interval")
+}
+
+func TestSign(t *testing.T) {
+	// HMAC-SHA256 test case 2 of RFC 4231.
+	assert.Equal(t,
+		"5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		sign([]byte("Jefe"), []byte("what do ya want for nothing?")),
+	)
+}
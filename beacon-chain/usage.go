@@ -54,12 +54,16 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PTCPPort,
 			cmd.DataDirFlag,
 			cmd.VerbosityFlag,
+			cmd.LogModuleVerbosityFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingExporterFlag,
 			cmd.TraceSampleFractionFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
+			cmd.MonitoringDebugEndpointsFlag,
+			cmd.MonitoringDebugAuthTokenFlag,
 			cmd.DisableMonitoringFlag,
 			cmd.MaxGoroutines,
 			cmd.ForceClearDB,
@@ -89,6 +93,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ContractDeploymentBlock,
 			flags.RPCHost,
 			flags.RPCPort,
+			flags.RPCUnixSocket,
 			flags.CertFlag,
 			flags.KeyFlag,
 			flags.DisableGRPCGateway,
@@ -101,11 +106,37 @@ var appHelpFlagGroups = []flagGroup{
 			flags.SlasherProviderFlag,
 			flags.SlotsPerArchivedPoint,
 			flags.DisableDiscv5,
+			flags.InvalidAttestationsThresholdFlag,
+			flags.DuplicateAttestationsThresholdFlag,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SlotsPerArchivedPoint,
+			flags.ArchiveFlag,
+			flags.DisableAttestationPoolPersistenceFlag,
+			flags.AttestationPoolSizeFlag,
+			flags.AttestationPoolShardsFlag,
+			flags.AttestationAggregationModeFlag,
+			flags.AttestationRetentionSlotsFlag,
+			flags.AttestationAggregationIntervalFlag,
+			flags.AttestationAggregationBatchSizeFlag,
+			flags.AttestationAggregationDryRunStrategyFlag,
+			flags.AttestationQueueSizeFlag,
+			flags.AttestationQueuePriorityFlag,
 			flags.HistoricalSlasherNode,
+			flags.SlasherFlag,
+			flags.MonitorIndicesFlag,
+			flags.FreeDiskWarningThresholdFlag,
+			flags.AlertWebhookURLFlag,
+			flags.AlertFinalityEpochsFlag,
+			flags.AlertMinPeersFlag,
+			flags.TelemetryEndpointFlag,
+			flags.TelemetrySecretFlag,
+			flags.TelemetryIntervalFlag,
+			flags.EnableClockDriftCheckFlag,
+			flags.NTPServersFlag,
+			flags.ClockDriftThresholdFlag,
+			flags.WeakSubjectivityCheckpt,
 			flags.ChainID,
 			flags.NetworkID,
 		},
@@ -131,6 +162,10 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogFileMaxSizeFlag,
+			cmd.LogFileRotationIntervalFlag,
+			cmd.LogFileMaxBackupsFlag,
+			cmd.LogFileCompressFlag,
 		},
 	},
 	{
@@ -143,6 +178,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.InteropGenesisStateFlag,
 			flags.InteropGenesisTimeFlag,
 			flags.InteropNumValidatorsFlag,
+			flags.InteropMnemonicFlag,
 		},
 	},
 }
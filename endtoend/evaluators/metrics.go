@@ -12,6 +12,7 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	e2e "github.com/prysmaticlabs/prysm/endtoend/params"
 	"github.com/prysmaticlabs/prysm/endtoend/types"
@@ -93,7 +94,11 @@ func metricsTest(conns ...*grpc.ClientConn) error {
 	if err != nil {
 		return err
 	}
-	forkDigest, err := p2putils.CreateForkDigest(time.Unix(genesis.GenesisTime.Seconds, 0), genesis.GenesisValidatorsRoot)
+	schedule, err := forks.NewBeaconSchedule(nil)
+	if err != nil {
+		return err
+	}
+	forkDigest, err := p2putils.CreateForkDigest(schedule, time.Unix(genesis.GenesisTime.Seconds, 0), genesis.GenesisValidatorsRoot)
 	if err != nil {
 		return err
 	}
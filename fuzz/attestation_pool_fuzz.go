@@ -0,0 +1,113 @@
+package fuzz
+
+import (
+	"encoding/binary"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+)
+
+// BeaconFuzzAttestationPool inserts the attestations of the input in a new attestation pool,
+// aggregates them and reads them back, the same way the pool is used when attestations are
+// received from peers. The input is a sequence of SSZ encoded attestations, each prefixed by
+// its length as a 4 byte little endian integer. It panics if an aggregate of the pool is eclipsed
+// by another one, or if a deleted aggregate is still listed.
+func BeaconFuzzAttestationPool(b []byte) {
+	pool := attestations.NewPool()
+	var saved []*ethpb.Attestation
+	for len(b) >= 4 {
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		b = b[4:]
+		if size > len(b) {
+			return
+		}
+		att := &ethpb.Attestation{}
+		if err := att.UnmarshalSSZ(b[:size]); err != nil {
+			return
+		}
+		b = b[size:]
+		if att.Data == nil || att.AggregationBits == nil {
+			continue
+		}
+		var err error
+		if helpers.IsAggregated(att) {
+			err = pool.SaveAggregatedAttestation(att)
+		} else {
+			err = pool.SaveUnaggregatedAttestation(att)
+		}
+		if err == nil {
+			saved = append(saved, att)
+		}
+	}
+	if err := pool.AggregateUnaggregatedAttestations(); err != nil {
+		return
+	}
+	if _, err := pool.UnaggregatedAttestations(); err != nil {
+		return
+	}
+	for _, att := range pool.AggregatedAttestations() {
+		if att.Data == nil {
+			panic("aggregated attestation without data in the pool")
+		}
+		pool.AggregatedAttestationsBySlotIndex(att.Data.Slot, att.Data.CommitteeIndex)
+	}
+	for _, att := range saved {
+		if !helpers.IsAggregated(att) {
+			continue
+		}
+		has, err := pool.HasAggregatedAttestation(att)
+		if err != nil {
+			continue
+		}
+		if !has {
+			panic("saved aggregated attestation is not in the pool")
+		}
+	}
+	checkNoEclipsedAttestations(pool)
+
+	// A deleted aggregate, and any aggregate it contains, is never listed again.
+	aggregated := pool.AggregatedAttestations()
+	if len(aggregated) == 0 {
+		return
+	}
+	deleted := aggregated[0]
+	if err := pool.DeleteAggregatedAttestation(deleted); err != nil {
+		return
+	}
+	for _, a := range pool.AggregatedAttestationsBySlotIndex(deleted.Data.Slot, deleted.Data.CommitteeIndex) {
+		if sameData(a, deleted) && a.AggregationBits.Len() == deleted.AggregationBits.Len() && deleted.AggregationBits.Contains(a.AggregationBits) {
+			panic("deleted aggregated attestation is still in the pool")
+		}
+	}
+	checkNoEclipsedAttestations(pool)
+}
+
+// checkNoEclipsedAttestations panics if an aggregated attestation of the pool is contained by
+// another one of the same data, which should have replaced it.
+func checkNoEclipsedAttestations(pool attestations.Pool) {
+	atts := pool.AggregatedAttestations()
+	for i, a := range atts {
+		for j, b := range atts {
+			if i == j || !sameData(a, b) || a.AggregationBits.Len() != b.AggregationBits.Len() {
+				continue
+			}
+			if a.AggregationBits.Contains(b.AggregationBits) {
+				panic("aggregated attestation eclipsed by another one in the pool")
+			}
+		}
+	}
+}
+
+func sameData(a, b *ethpb.Attestation) bool {
+	ra, err := ssz.HashTreeRoot(a.Data)
+	if err != nil {
+		return false
+	}
+	rb, err := ssz.HashTreeRoot(b.Data)
+	if err != nil {
+		return false
+	}
+	return ra == rb
+}
@@ -0,0 +1,40 @@
+package fuzz
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+)
+
+// BeaconFuzzGossipDecode decodes the input as the message of every gossip topic, as is done
+// with the messages received from peers. Messages which decode are encoded again, and must
+// decode to the same message.
+func BeaconFuzzGossipDecode(b []byte) {
+	e := encoder.SszNetworkEncoder{}
+	topics := make([]string, 0, len(p2p.GossipTopicMappings))
+	for topic := range p2p.GossipTopicMappings {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	for _, topic := range topics {
+		msg := proto.Clone(p2p.GossipTopicMappings[topic])
+		if err := e.DecodeGossip(b, msg); err != nil {
+			continue
+		}
+		buf := new(bytes.Buffer)
+		if _, err := e.EncodeGossip(buf, msg); err != nil {
+			panic(err)
+		}
+		decoded := proto.Clone(p2p.GossipTopicMappings[topic])
+		if err := e.DecodeGossip(buf.Bytes(), decoded); err != nil {
+			panic(err)
+		}
+		if !ssz.DeepEqual(msg, decoded) {
+			panic("gossip message changed after an encoding round trip for topic " + topic)
+		}
+	}
+}
@@ -718,6 +718,673 @@ func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
 	return 0
 }
 
+type BlockTreeRequest struct {
+	Slots                uint64   `protobuf:"varint,1,opt,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTreeRequest) Reset()         { *m = BlockTreeRequest{} }
+func (m *BlockTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeRequest) ProtoMessage()    {}
+func (*BlockTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+}
+
+func (m *BlockTreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTreeRequest.Unmarshal(m, b)
+}
+func (m *BlockTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTreeRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeRequest.Merge(m, src)
+}
+func (m *BlockTreeRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockTreeRequest.Size(m)
+}
+func (m *BlockTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTreeRequest proto.InternalMessageInfo
+
+func (m *BlockTreeRequest) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+type BlockTreeResponse struct {
+	Nodes                []*BlockTreeNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Dot                  string           `protobuf:"bytes,2,opt,name=dot,proto3" json:"dot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
+func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeResponse) ProtoMessage()    {}
+func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
+}
+
+func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTreeResponse.Unmarshal(m, b)
+}
+func (m *BlockTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTreeResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeResponse.Merge(m, src)
+}
+func (m *BlockTreeResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockTreeResponse.Size(m)
+}
+func (m *BlockTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTreeResponse proto.InternalMessageInfo
+
+func (m *BlockTreeResponse) GetNodes() []*BlockTreeNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *BlockTreeResponse) GetDot() string {
+	if m != nil {
+		return m.Dot
+	}
+	return ""
+}
+
+type BlockTreeNode struct {
+	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Weight               uint64   `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Canonical            bool     `protobuf:"varint,5,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTreeNode) Reset()         { *m = BlockTreeNode{} }
+func (m *BlockTreeNode) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeNode) ProtoMessage()    {}
+func (*BlockTreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
+}
+
+func (m *BlockTreeNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTreeNode.Unmarshal(m, b)
+}
+func (m *BlockTreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTreeNode.Marshal(b, m, deterministic)
+}
+func (m *BlockTreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeNode.Merge(m, src)
+}
+func (m *BlockTreeNode) XXX_Size() int {
+	return xxx_messageInfo_BlockTreeNode.Size(m)
+}
+func (m *BlockTreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTreeNode proto.InternalMessageInfo
+
+func (m *BlockTreeNode) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *BlockTreeNode) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *BlockTreeNode) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BlockTreeNode) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *BlockTreeNode) GetCanonical() bool {
+	if m != nil {
+		return m.Canonical
+	}
+	return false
+}
+
+type NodeHealthResponse struct {
+	Healthy              bool             `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Services             []*ServiceHealth `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NodeHealthResponse) Reset()         { *m = NodeHealthResponse{} }
+func (m *NodeHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeHealthResponse) ProtoMessage()    {}
+func (*NodeHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
+}
+
+func (m *NodeHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeHealthResponse.Unmarshal(m, b)
+}
+func (m *NodeHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeHealthResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeHealthResponse.Merge(m, src)
+}
+func (m *NodeHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeHealthResponse.Size(m)
+}
+func (m *NodeHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeHealthResponse proto.InternalMessageInfo
+
+func (m *NodeHealthResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *NodeHealthResponse) GetServices() []*ServiceHealth {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceHealth struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy              bool     `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	LastError            string   `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime        uint64   `protobuf:"varint,5,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	UptimeSeconds        uint64   `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceHealth) Reset()         { *m = ServiceHealth{} }
+func (m *ServiceHealth) String() string { return proto.CompactTextString(m) }
+func (*ServiceHealth) ProtoMessage()    {}
+func (*ServiceHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{14}
+}
+
+func (m *ServiceHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceHealth.Unmarshal(m, b)
+}
+func (m *ServiceHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceHealth.Marshal(b, m, deterministic)
+}
+func (m *ServiceHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceHealth.Merge(m, src)
+}
+func (m *ServiceHealth) XXX_Size() int {
+	return xxx_messageInfo_ServiceHealth.Size(m)
+}
+func (m *ServiceHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceHealth proto.InternalMessageInfo
+
+func (m *ServiceHealth) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceHealth) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *ServiceHealth) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ServiceHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ServiceHealth) GetLastErrorTime() uint64 {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return 0
+}
+
+func (m *ServiceHealth) GetUptimeSeconds() uint64 {
+	if m != nil {
+		return m.UptimeSeconds
+	}
+	return 0
+}
+
+type DiagnosticDumpResponse struct {
+	Archive              []byte   `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiagnosticDumpResponse) Reset()         { *m = DiagnosticDumpResponse{} }
+func (m *DiagnosticDumpResponse) String() string { return proto.CompactTextString(m) }
+func (*DiagnosticDumpResponse) ProtoMessage()    {}
+func (*DiagnosticDumpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{15}
+}
+
+func (m *DiagnosticDumpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiagnosticDumpResponse.Unmarshal(m, b)
+}
+func (m *DiagnosticDumpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiagnosticDumpResponse.Marshal(b, m, deterministic)
+}
+func (m *DiagnosticDumpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiagnosticDumpResponse.Merge(m, src)
+}
+func (m *DiagnosticDumpResponse) XXX_Size() int {
+	return xxx_messageInfo_DiagnosticDumpResponse.Size(m)
+}
+func (m *DiagnosticDumpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiagnosticDumpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiagnosticDumpResponse proto.InternalMessageInfo
+
+func (m *DiagnosticDumpResponse) GetArchive() []byte {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
+type AttestationPoolRequest struct {
+	Slots                []uint64 `protobuf:"varint,1,rep,packed,name=slots,proto3" json:"slots,omitempty"`
+	CommitteeIndices     []uint64 `protobuf:"varint,2,rep,packed,name=committee_indices,json=committeeIndices,proto3" json:"committee_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationPoolRequest) Reset()         { *m = AttestationPoolRequest{} }
+func (m *AttestationPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationPoolRequest) ProtoMessage()    {}
+func (*AttestationPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{16}
+}
+
+func (m *AttestationPoolRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationPoolRequest.Unmarshal(m, b)
+}
+func (m *AttestationPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationPoolRequest.Marshal(b, m, deterministic)
+}
+func (m *AttestationPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPoolRequest.Merge(m, src)
+}
+func (m *AttestationPoolRequest) XXX_Size() int {
+	return xxx_messageInfo_AttestationPoolRequest.Size(m)
+}
+func (m *AttestationPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPoolRequest proto.InternalMessageInfo
+
+func (m *AttestationPoolRequest) GetSlots() []uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+func (m *AttestationPoolRequest) GetCommitteeIndices() []uint64 {
+	if m != nil {
+		return m.CommitteeIndices
+	}
+	return nil
+}
+
+type AttestationPoolResponse struct {
+	UnaggregatedAttestations []*v1alpha1.Attestation `protobuf:"bytes,1,rep,name=unaggregated_attestations,json=unaggregatedAttestations,proto3" json:"unaggregated_attestations,omitempty"`
+	AggregatedAttestations   []*v1alpha1.Attestation `protobuf:"bytes,2,rep,name=aggregated_attestations,json=aggregatedAttestations,proto3" json:"aggregated_attestations,omitempty"`
+	BlockAttestations        []*v1alpha1.Attestation `protobuf:"bytes,3,rep,name=block_attestations,json=blockAttestations,proto3" json:"block_attestations,omitempty"`
+	ForkchoiceAttestations   []*v1alpha1.Attestation `protobuf:"bytes,4,rep,name=forkchoice_attestations,json=forkchoiceAttestations,proto3" json:"forkchoice_attestations,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                `json:"-"`
+	XXX_unrecognized         []byte                  `json:"-"`
+	XXX_sizecache            int32                   `json:"-"`
+}
+
+func (m *AttestationPoolResponse) Reset()         { *m = AttestationPoolResponse{} }
+func (m *AttestationPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationPoolResponse) ProtoMessage()    {}
+func (*AttestationPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{17}
+}
+
+func (m *AttestationPoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationPoolResponse.Unmarshal(m, b)
+}
+func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationPoolResponse.Marshal(b, m, deterministic)
+}
+func (m *AttestationPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPoolResponse.Merge(m, src)
+}
+func (m *AttestationPoolResponse) XXX_Size() int {
+	return xxx_messageInfo_AttestationPoolResponse.Size(m)
+}
+func (m *AttestationPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPoolResponse proto.InternalMessageInfo
+
+func (m *AttestationPoolResponse) GetUnaggregatedAttestations() []*v1alpha1.Attestation {
+	if m != nil {
+		return m.UnaggregatedAttestations
+	}
+	return nil
+}
+
+func (m *AttestationPoolResponse) GetAggregatedAttestations() []*v1alpha1.Attestation {
+	if m != nil {
+		return m.AggregatedAttestations
+	}
+	return nil
+}
+
+func (m *AttestationPoolResponse) GetBlockAttestations() []*v1alpha1.Attestation {
+	if m != nil {
+		return m.BlockAttestations
+	}
+	return nil
+}
+
+func (m *AttestationPoolResponse) GetForkchoiceAttestations() []*v1alpha1.Attestation {
+	if m != nil {
+		return m.ForkchoiceAttestations
+	}
+	return nil
+}
+
+type ValidatorInclusionsRequest struct {
+	ValidatorIndices     []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorInclusionsRequest) Reset()         { *m = ValidatorInclusionsRequest{} }
+func (m *ValidatorInclusionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorInclusionsRequest) ProtoMessage()    {}
+func (*ValidatorInclusionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{18}
+}
+
+func (m *ValidatorInclusionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorInclusionsRequest.Unmarshal(m, b)
+}
+func (m *ValidatorInclusionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorInclusionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorInclusionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorInclusionsRequest.Merge(m, src)
+}
+func (m *ValidatorInclusionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorInclusionsRequest.Size(m)
+}
+func (m *ValidatorInclusionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorInclusionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorInclusionsRequest proto.InternalMessageInfo
+
+func (m *ValidatorInclusionsRequest) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+type ValidatorInclusionsResponse struct {
+	Inclusions           []*ValidatorInclusion `protobuf:"bytes,1,rep,name=inclusions,proto3" json:"inclusions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ValidatorInclusionsResponse) Reset()         { *m = ValidatorInclusionsResponse{} }
+func (m *ValidatorInclusionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorInclusionsResponse) ProtoMessage()    {}
+func (*ValidatorInclusionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{19}
+}
+
+func (m *ValidatorInclusionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorInclusionsResponse.Unmarshal(m, b)
+}
+func (m *ValidatorInclusionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorInclusionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorInclusionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorInclusionsResponse.Merge(m, src)
+}
+func (m *ValidatorInclusionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorInclusionsResponse.Size(m)
+}
+func (m *ValidatorInclusionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorInclusionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorInclusionsResponse proto.InternalMessageInfo
+
+func (m *ValidatorInclusionsResponse) GetInclusions() []*ValidatorInclusion {
+	if m != nil {
+		return m.Inclusions
+	}
+	return nil
+}
+
+type ValidatorInclusion struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	TargetEpoch          uint64   `protobuf:"varint,2,opt,name=target_epoch,json=targetEpoch,proto3" json:"target_epoch,omitempty"`
+	AttestationSlot      uint64   `protobuf:"varint,3,opt,name=attestation_slot,json=attestationSlot,proto3" json:"attestation_slot,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,4,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,5,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorInclusion) Reset()         { *m = ValidatorInclusion{} }
+func (m *ValidatorInclusion) String() string { return proto.CompactTextString(m) }
+func (*ValidatorInclusion) ProtoMessage()    {}
+func (*ValidatorInclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20}
+}
+
+func (m *ValidatorInclusion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorInclusion.Unmarshal(m, b)
+}
+func (m *ValidatorInclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorInclusion.Marshal(b, m, deterministic)
+}
+func (m *ValidatorInclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorInclusion.Merge(m, src)
+}
+func (m *ValidatorInclusion) XXX_Size() int {
+	return xxx_messageInfo_ValidatorInclusion.Size(m)
+}
+func (m *ValidatorInclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorInclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorInclusion proto.InternalMessageInfo
+
+func (m *ValidatorInclusion) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorInclusion) GetTargetEpoch() uint64 {
+	if m != nil {
+		return m.TargetEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorInclusion) GetAttestationSlot() uint64 {
+	if m != nil {
+		return m.AttestationSlot
+	}
+	return 0
+}
+
+func (m *ValidatorInclusion) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
+	}
+	return 0
+}
+
+func (m *ValidatorInclusion) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+type ValidatorAttestationsRequest struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorAttestationsRequest) Reset()         { *m = ValidatorAttestationsRequest{} }
+func (m *ValidatorAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestationsRequest) ProtoMessage()    {}
+func (*ValidatorAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{21}
+}
+
+func (m *ValidatorAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAttestationsRequest.Unmarshal(m, b)
+}
+func (m *ValidatorAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAttestationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestationsRequest.Merge(m, src)
+}
+func (m *ValidatorAttestationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAttestationsRequest.Size(m)
+}
+func (m *ValidatorAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestationsRequest proto.InternalMessageInfo
+
+func (m *ValidatorAttestationsRequest) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorAttestationsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ValidatorAttestationsResponse struct {
+	Attestations         []*v1alpha1.Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ValidatorAttestationsResponse) Reset()         { *m = ValidatorAttestationsResponse{} }
+func (m *ValidatorAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestationsResponse) ProtoMessage()    {}
+func (*ValidatorAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{22}
+}
+
+func (m *ValidatorAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAttestationsResponse.Unmarshal(m, b)
+}
+func (m *ValidatorAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAttestationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestationsResponse.Merge(m, src)
+}
+func (m *ValidatorAttestationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAttestationsResponse.Size(m)
+}
+func (m *ValidatorAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestationsResponse proto.InternalMessageInfo
+
+func (m *ValidatorAttestationsResponse) GetAttestations() []*v1alpha1.Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
 	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
@@ -732,87 +1399,133 @@ func init() {
 	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
 	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
 	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+	proto.RegisterType((*BlockTreeRequest)(nil), "ethereum.beacon.rpc.v1.BlockTreeRequest")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeNode")
+	proto.RegisterType((*NodeHealthResponse)(nil), "ethereum.beacon.rpc.v1.NodeHealthResponse")
+	proto.RegisterType((*ServiceHealth)(nil), "ethereum.beacon.rpc.v1.ServiceHealth")
+	proto.RegisterType((*DiagnosticDumpResponse)(nil), "ethereum.beacon.rpc.v1.DiagnosticDumpResponse")
+	proto.RegisterType((*AttestationPoolRequest)(nil), "ethereum.beacon.rpc.v1.AttestationPoolRequest")
+	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.beacon.rpc.v1.AttestationPoolResponse")
+	proto.RegisterType((*ValidatorInclusionsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorInclusionsRequest")
+	proto.RegisterType((*ValidatorInclusionsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorInclusionsResponse")
+	proto.RegisterType((*ValidatorInclusion)(nil), "ethereum.beacon.rpc.v1.ValidatorInclusion")
+	proto.RegisterType((*ValidatorAttestationsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestationsRequest")
+	proto.RegisterType((*ValidatorAttestationsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestationsResponse")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
 
 var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xae, 0x9d, 0x38, 0x89, 0x8f, 0x8d, 0xe3, 0x4e, 0x4b, 0x6a, 0xdc, 0x9f, 0xa4, 0xdb, 0xd2,
-	0x5f, 0xba, 0x56, 0x0c, 0x17, 0xa8, 0x42, 0x42, 0xf9, 0x6b, 0x1a, 0x29, 0xb4, 0x65, 0xd3, 0x72,
-	0x41, 0x85, 0x56, 0x93, 0xdd, 0x63, 0x7b, 0xc9, 0x66, 0x66, 0x3b, 0x33, 0x1b, 0x70, 0xb9, 0xab,
-	0x10, 0x5c, 0x72, 0x81, 0xc4, 0x35, 0x8f, 0xc1, 0x7b, 0xf0, 0x0a, 0x3c, 0x05, 0x57, 0x68, 0x66,
-	0x76, 0xd7, 0x36, 0xb1, 0x4b, 0x40, 0xdc, 0xcd, 0xf9, 0xe6, 0x3b, 0x3f, 0x73, 0xce, 0x99, 0x39,
-	0x03, 0xab, 0x89, 0xe0, 0x8a, 0x77, 0x0e, 0x91, 0x06, 0x9c, 0x75, 0x44, 0x12, 0x74, 0x4e, 0xd6,
-	0x3b, 0x21, 0x1e, 0xa6, 0x7d, 0xd7, 0xec, 0x90, 0x15, 0x54, 0x03, 0x14, 0x98, 0x1e, 0xbb, 0x96,
-	0xe3, 0x8a, 0x24, 0x70, 0x4f, 0xd6, 0xdb, 0x97, 0x50, 0x0d, 0x3a, 0x27, 0xeb, 0x34, 0x4e, 0x06,
-	0x74, 0xbd, 0xc3, 0x78, 0x88, 0x56, 0xa1, 0xed, 0x4c, 0x58, 0x4c, 0xba, 0x89, 0xb6, 0x78, 0x8c,
-	0x52, 0xd2, 0x3e, 0xca, 0x8c, 0x73, 0xa5, 0xcf, 0x79, 0x3f, 0xc6, 0x0e, 0x4d, 0xa2, 0x0e, 0x65,
-	0x8c, 0x2b, 0xaa, 0x22, 0xce, 0xf2, 0xdd, 0xcb, 0xd9, 0xae, 0x91, 0x0e, 0xd3, 0x5e, 0x07, 0x8f,
-	0x13, 0x35, 0xb4, 0x9b, 0xce, 0x43, 0xb8, 0xb8, 0xc7, 0x82, 0x38, 0x95, 0x11, 0x67, 0x07, 0x31,
-	0x57, 0x1e, 0xbe, 0x4a, 0x51, 0x2a, 0xd2, 0x80, 0x72, 0x14, 0xb6, 0x4a, 0x6b, 0xa5, 0x3b, 0xf3,
-	0x5e, 0x39, 0x0a, 0x09, 0x81, 0x79, 0x19, 0x73, 0xd5, 0x2a, 0x1b, 0xc4, 0xac, 0x9d, 0xfb, 0xf0,
-	0xee, 0xdf, 0x74, 0x65, 0xc2, 0x99, 0xc4, 0xa9, 0xe4, 0x97, 0x40, 0x36, 0xcd, 0x19, 0x0e, 0x14,
-	0x55, 0x98, 0xbb, 0xb9, 0x98, 0x31, 0x8d, 0xa3, 0xc7, 0xe7, 0x2c, 0x97, 0xac, 0x02, 0x1c, 0xc6,
-	0x3c, 0x38, 0xf2, 0x05, 0xcf, 0xac, 0xd4, 0x1f, 0x9f, 0xf3, 0xaa, 0x06, 0xf3, 0x38, 0x57, 0x9b,
-	0x0d, 0xa8, 0xbf, 0x4a, 0x51, 0x0c, 0xfd, 0x5e, 0x14, 0x2b, 0x14, 0xce, 0x03, 0xa8, 0x6f, 0x9a,
-	0xcd, 0xcc, 0xec, 0xd5, 0x09, 0x03, 0xda, 0x78, 0x7d, 0x4c, 0xdd, 0xb9, 0x0d, 0xb5, 0x83, 0x83,
-	0x2f, 0x8b, 0x70, 0x5b, 0xb0, 0x88, 0x2c, 0xe0, 0x21, 0x86, 0x19, 0x35, 0x17, 0x9d, 0x1f, 0x4b,
-	0x70, 0x61, 0x9f, 0xf7, 0xfb, 0x11, 0xeb, 0xef, 0xe3, 0x09, 0xc6, 0xb9, 0xfd, 0x5d, 0xa8, 0xc4,
-	0x5a, 0x36, 0xfc, 0x46, 0x77, 0xdd, 0x9d, 0x5e, 0x55, 0x77, 0x8a, 0xae, 0x6b, 0x05, 0xab, 0xef,
-	0xdc, 0x86, 0x8a, 0x91, 0xc9, 0x12, 0xcc, 0xef, 0x3d, 0x79, 0xf4, 0xb4, 0x79, 0x8e, 0x54, 0xa1,
-	0xb2, 0xbd, 0xb3, 0xf9, 0x62, 0xb7, 0x59, 0xd2, 0xcb, 0xe7, 0xde, 0xc6, 0xd6, 0x4e, 0xb3, 0xec,
-	0xfc, 0x30, 0x07, 0x57, 0x9e, 0xe9, 0x8a, 0x6d, 0x08, 0x41, 0x87, 0x8f, 0xb8, 0x38, 0xda, 0x1a,
-	0xf0, 0x28, 0xc0, 0xe2, 0x10, 0xb7, 0x61, 0x39, 0x11, 0x29, 0x43, 0x5f, 0x0d, 0x04, 0xca, 0x01,
-	0x8f, 0xf3, 0xea, 0x35, 0x0c, 0xfc, 0x3c, 0x47, 0x35, 0xf1, 0xeb, 0x54, 0xaa, 0xa8, 0x17, 0x61,
-	0xe8, 0x63, 0xc2, 0x83, 0x41, 0x56, 0xa7, 0x46, 0x01, 0xef, 0x68, 0x54, 0x13, 0x7b, 0x11, 0xa3,
-	0x71, 0xf4, 0xba, 0x20, 0xce, 0x59, 0x62, 0x01, 0x5b, 0xa2, 0x07, 0xe7, 0x4d, 0x33, 0xf9, 0x54,
-	0xc7, 0xe6, 0xeb, 0xe6, 0x95, 0xad, 0xf9, 0xb5, 0xb9, 0x3b, 0xb5, 0xee, 0xad, 0x59, 0x99, 0x19,
-	0x9d, 0xe5, 0x09, 0x0f, 0xd1, 0x5b, 0x4e, 0x26, 0x64, 0x49, 0x5e, 0xc2, 0x62, 0xc4, 0xc2, 0x28,
-	0x40, 0xd9, 0xaa, 0x18, 0x4b, 0x1b, 0xff, 0x6c, 0xe9, 0x74, 0x56, 0xdc, 0x3d, 0x6b, 0x63, 0x87,
-	0x29, 0x31, 0xf4, 0x72, 0x8b, 0xed, 0x87, 0x50, 0x1f, 0xdf, 0x20, 0x4d, 0x98, 0x3b, 0xc2, 0xa1,
-	0xc9, 0x57, 0xd5, 0xd3, 0x4b, 0x72, 0x11, 0x2a, 0x27, 0x34, 0x4e, 0x31, 0x4b, 0x8d, 0x15, 0x1e,
-	0x96, 0x3f, 0x2e, 0x39, 0x6f, 0xca, 0xd0, 0x98, 0x0c, 0xbe, 0x68, 0xf7, 0xd2, 0xa8, 0xdd, 0x35,
-	0x36, 0x6a, 0x5e, 0xcf, 0xac, 0xc9, 0x0a, 0x2c, 0x24, 0x54, 0x20, 0x53, 0x59, 0x1e, 0x33, 0x69,
-	0x5a, 0x45, 0xe6, 0xcf, 0x5a, 0x91, 0xca, 0xd4, 0x8a, 0xac, 0xc0, 0xc2, 0x37, 0x18, 0xf5, 0x07,
-	0xaa, 0xb5, 0x60, 0x3d, 0x59, 0xc9, 0xdc, 0x0b, 0x94, 0xca, 0x0f, 0x06, 0x51, 0x1c, 0xb6, 0x16,
-	0xcd, 0x5e, 0x55, 0x23, 0x5b, 0x1a, 0xd0, 0xf6, 0xcd, 0x76, 0x88, 0x32, 0x40, 0x16, 0x52, 0xa6,
-	0x5a, 0x4b, 0xd6, 0xbe, 0x86, 0xb7, 0x0b, 0xd4, 0xf9, 0x0a, 0xc8, 0xb6, 0x7e, 0xd4, 0x9e, 0x21,
-	0x8a, 0x3c, 0xd7, 0x92, 0xec, 0x42, 0x55, 0xe4, 0x42, 0xab, 0x64, 0xaa, 0x76, 0x77, 0x56, 0xd5,
-	0x4e, 0xa9, 0x7b, 0x23, 0x5d, 0xe7, 0xb7, 0x0a, 0x9c, 0x3f, 0x45, 0x20, 0x1d, 0xb8, 0x10, 0x47,
-	0x52, 0x21, 0x8b, 0x58, 0xdf, 0xa7, 0x61, 0x28, 0x50, 0xe6, 0x8e, 0xaa, 0x1e, 0x29, 0xb6, 0x36,
-	0xf2, 0x1d, 0xb2, 0x09, 0xd5, 0x30, 0x12, 0x18, 0xe8, 0xc7, 0xd0, 0x14, 0xa2, 0xd1, 0xbd, 0x39,
-	0x8a, 0x07, 0xd5, 0xc0, 0xcd, 0x1f, 0x5c, 0x57, 0x3b, 0xda, 0xce, 0xb9, 0xde, 0x48, 0x8d, 0x7c,
-	0x0e, 0xcd, 0x80, 0x33, 0x66, 0x25, 0x5f, 0xea, 0xb7, 0xcb, 0x54, 0xaf, 0x31, 0xde, 0xda, 0x13,
-	0xa6, 0xb6, 0x0a, 0xba, 0x7d, 0xe9, 0x96, 0x83, 0x49, 0x80, 0x5c, 0x82, 0xc5, 0x04, 0x51, 0xf8,
-	0x51, 0x68, 0xca, 0x5c, 0xf5, 0x16, 0xb4, 0xb8, 0x17, 0xea, 0x36, 0x44, 0x26, 0x4c, 0x49, 0xab,
-	0x9e, 0x5e, 0x92, 0xa7, 0x50, 0xb5, 0x54, 0xd6, 0xe3, 0xa6, 0x94, 0xb5, 0x6e, 0xf7, 0xcc, 0x19,
-	0x35, 0x87, 0xda, 0x63, 0x3d, 0xee, 0x2d, 0x25, 0xd9, 0x8a, 0x7c, 0x0a, 0x35, 0x63, 0x50, 0x1f,
-	0x24, 0x95, 0xa6, 0x03, 0x6a, 0xdd, 0x6b, 0xa7, 0x4c, 0x26, 0xdd, 0x44, 0x9b, 0x3c, 0x30, 0x2c,
-	0x0f, 0xb4, 0x8a, 0x5d, 0x93, 0xeb, 0x50, 0x8f, 0xa9, 0x54, 0x7e, 0x9a, 0x84, 0x54, 0x61, 0x98,
-	0xf5, 0x47, 0x4d, 0x63, 0x2f, 0x2c, 0xd4, 0xfe, 0xb3, 0x04, 0x4b, 0xb9, 0x6b, 0xf2, 0x09, 0x2c,
-	0x1d, 0xa3, 0xa2, 0x21, 0x55, 0xd4, 0xdc, 0x8f, 0x5a, 0x77, 0x6d, 0x96, 0xb7, 0xcf, 0x50, 0xd1,
-	0x6d, 0xaa, 0xa8, 0x57, 0x68, 0x90, 0x2b, 0x50, 0x35, 0x0f, 0x43, 0xc0, 0x63, 0xd9, 0x2a, 0x9b,
-	0x42, 0x8f, 0x00, 0xb2, 0x0a, 0xb5, 0x1e, 0x4d, 0x63, 0xe5, 0x07, 0x3c, 0x2d, 0x2e, 0x15, 0x18,
-	0x68, 0x4b, 0x23, 0xe4, 0x2e, 0x34, 0x73, 0xb6, 0x7f, 0x82, 0x42, 0xcf, 0xa9, 0x2c, 0xe5, 0xcb,
-	0x39, 0xfe, 0x85, 0x85, 0xc9, 0x0d, 0x78, 0x87, 0xf6, 0x91, 0xa9, 0x82, 0x67, 0xab, 0x50, 0x37,
-	0x60, 0x4e, 0xba, 0x0e, 0x75, 0x93, 0xbd, 0x98, 0x2a, 0x64, 0xc1, 0x30, 0xbb, 0x5c, 0x26, 0xa3,
-	0xfb, 0x16, 0xea, 0xfe, 0xba, 0x08, 0x15, 0x53, 0x09, 0xf2, 0x7d, 0x09, 0x1a, 0xbb, 0xa8, 0xc6,
-	0x86, 0x1e, 0xb9, 0x37, 0xab, 0x76, 0xa7, 0x27, 0x63, 0xfb, 0xc6, 0x2c, 0xee, 0xd8, 0xe4, 0x72,
-	0xae, 0xbf, 0xf9, 0xfd, 0x8f, 0x9f, 0xcb, 0x97, 0xc9, 0x7b, 0x9d, 0x89, 0xef, 0x83, 0xf9, 0x70,
-	0x74, 0x4c, 0xb3, 0x92, 0x6f, 0x61, 0x49, 0x47, 0xa1, 0x67, 0x1f, 0xb9, 0x39, 0xd3, 0xff, 0xd8,
-	0xf0, 0xfc, 0x1f, 0x3c, 0x9b, 0x49, 0x4b, 0xbe, 0x83, 0xe5, 0x03, 0x54, 0xe3, 0x23, 0x90, 0xdc,
-	0xff, 0x17, 0x83, 0xb2, 0xbd, 0xe2, 0xda, 0x8f, 0x8b, 0x9b, 0x7f, 0x5c, 0xdc, 0x1d, 0xfd, 0x71,
-	0x71, 0x6e, 0x18, 0xd7, 0x57, 0x9d, 0xcb, 0xd3, 0x5c, 0xc7, 0xd6, 0x10, 0xf9, 0xa9, 0x04, 0x97,
-	0x76, 0x51, 0x4d, 0x1b, 0x0e, 0x64, 0x86, 0xe1, 0xf6, 0x47, 0xff, 0x65, 0xc4, 0x38, 0xb7, 0x4c,
-	0x38, 0x6b, 0xe4, 0xda, 0xb4, 0x70, 0x7a, 0x5c, 0x1c, 0x05, 0xd6, 0xab, 0x80, 0xea, 0x7e, 0x24,
-	0x95, 0xbe, 0x19, 0x72, 0x66, 0x08, 0xf7, 0xce, 0x7c, 0xbb, 0xe5, 0xdb, 0x4b, 0x90, 0x18, 0x37,
-	0xaf, 0x61, 0x51, 0x27, 0x01, 0x51, 0x10, 0xe7, 0x2d, 0x2f, 0x5f, 0x9e, 0xf1, 0xb3, 0xbf, 0xd6,
-	0xce, 0x9a, 0x71, 0xde, 0x26, 0xad, 0x59, 0xce, 0xc9, 0x2f, 0x25, 0x68, 0xee, 0xa2, 0x9a, 0xf8,
-	0x21, 0x92, 0x0f, 0x66, 0x79, 0x98, 0xf6, 0x09, 0x6d, 0x3f, 0x38, 0x23, 0x3b, 0x8b, 0xe9, 0x7d,
-	0x13, 0xd3, 0x2a, 0xb9, 0x3a, 0x2d, 0xa6, 0x28, 0x57, 0x39, 0x5c, 0x30, 0x39, 0xff, 0xf0, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x35, 0xa6, 0x9e, 0xac, 0x0b, 0x00, 0x00,
+	// 1720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x8e, 0x5e, 0xb6, 0x34, 0x92, 0x25, 0x79, 0x93, 0xda, 0x8a, 0x6c, 0x27, 0x0e, 0xf3, 0x4e,
+	0x1a, 0x09, 0x56, 0x7b, 0x28, 0xd2, 0x02, 0x85, 0x6d, 0x39, 0x89, 0x0b, 0x37, 0x0f, 0xca, 0xc9,
+	0xa1, 0x41, 0x41, 0xd0, 0xe4, 0x5a, 0x62, 0x43, 0x91, 0x0c, 0x49, 0xa9, 0x71, 0x7a, 0x0b, 0x8a,
+	0xe6, 0xd6, 0x1e, 0x0a, 0xf4, 0xdc, 0x9f, 0xd1, 0x6b, 0x7f, 0x43, 0x7f, 0x41, 0x81, 0xfe, 0x8a,
+	0x9e, 0x3a, 0xbb, 0xcb, 0x67, 0x24, 0xb9, 0x6a, 0xd0, 0x1b, 0x77, 0xe6, 0x9b, 0xc7, 0xce, 0xcc,
+	0xce, 0xce, 0x12, 0x2e, 0x3a, 0xae, 0xed, 0xdb, 0xed, 0x23, 0xaa, 0x6a, 0xb6, 0xd5, 0x76, 0x1d,
+	0xad, 0x3d, 0xde, 0x6a, 0xeb, 0xf4, 0x68, 0xd4, 0x6f, 0x71, 0x0e, 0x59, 0xa1, 0xfe, 0x80, 0xba,
+	0x74, 0x34, 0x6c, 0x09, 0x4c, 0x0b, 0x31, 0xad, 0xf1, 0x56, 0xf3, 0x02, 0xd2, 0x11, 0xab, 0x9a,
+	0xce, 0x40, 0xdd, 0x6a, 0xab, 0xbe, 0x4f, 0x3d, 0x5f, 0xf5, 0x0d, 0x04, 0x70, 0xb9, 0xe6, 0x6a,
+	0x8a, 0x6f, 0xd9, 0x3a, 0x0d, 0x18, 0x52, 0xca, 0xa2, 0xd3, 0x71, 0x98, 0xc5, 0x21, 0xf5, 0x3c,
+	0xb5, 0x4f, 0xbd, 0x00, 0xb3, 0xde, 0xb7, 0xed, 0xbe, 0x49, 0xdb, 0xaa, 0x63, 0xb4, 0x55, 0xcb,
+	0xb2, 0x85, 0xe6, 0x90, 0xbb, 0x16, 0x70, 0xf9, 0xea, 0x68, 0x74, 0xdc, 0xa6, 0x43, 0xc7, 0x3f,
+	0x11, 0x4c, 0xe9, 0x2e, 0x9c, 0xdb, 0xb7, 0x34, 0x73, 0xe4, 0xa1, 0x40, 0xcf, 0xb4, 0x7d, 0x99,
+	0xbe, 0x1c, 0xa1, 0x6b, 0xa4, 0x0a, 0x59, 0x43, 0x6f, 0x64, 0x36, 0x33, 0x37, 0xf2, 0x32, 0x7e,
+	0x11, 0x02, 0x79, 0x0f, 0xd9, 0x8d, 0x2c, 0xa7, 0xf0, 0x6f, 0xe9, 0x36, 0x7c, 0xf0, 0x8e, 0xac,
+	0xe7, 0xa0, 0x59, 0x3a, 0x15, 0xfc, 0x1c, 0xc8, 0x0e, 0xdf, 0x43, 0x0f, 0xbd, 0xa3, 0xa1, 0x99,
+	0x73, 0x01, 0x92, 0x1b, 0x7a, 0x70, 0x46, 0x60, 0xc9, 0x45, 0x80, 0x23, 0xd3, 0xd6, 0x5e, 0x28,
+	0xae, 0x1d, 0x68, 0xa9, 0x20, 0xaf, 0xc4, 0x69, 0x32, 0x92, 0x76, 0xaa, 0x50, 0x41, 0x79, 0xf7,
+	0x44, 0x39, 0x36, 0x4c, 0x9f, 0xba, 0xd2, 0x1d, 0xa8, 0xec, 0x70, 0x66, 0xa0, 0x76, 0x23, 0xa5,
+	0x80, 0x29, 0xaf, 0x24, 0xc4, 0xa5, 0xeb, 0x50, 0xee, 0xf5, 0xbe, 0x8a, 0xdc, 0x6d, 0xc0, 0x22,
+	0xb5, 0x34, 0x0c, 0xb9, 0x1e, 0x40, 0xc3, 0xa5, 0xf4, 0x36, 0x03, 0x67, 0x0f, 0xec, 0x7e, 0xdf,
+	0xb0, 0xfa, 0x07, 0x74, 0x4c, 0xcd, 0x50, 0xff, 0x7d, 0x28, 0x98, 0x6c, 0xcd, 0xf1, 0xd5, 0xce,
+	0x56, 0x6b, 0x7a, 0xd6, 0x5b, 0x53, 0x64, 0x5b, 0x62, 0x21, 0xe4, 0xd1, 0x93, 0x02, 0x5f, 0x93,
+	0x22, 0xe4, 0xf7, 0x1f, 0xde, 0x7b, 0x54, 0x3f, 0x43, 0x4a, 0x50, 0xe8, 0xee, 0xed, 0x3c, 0xbd,
+	0x5f, 0xcf, 0xb0, 0xcf, 0x43, 0x79, 0x7b, 0x77, 0xaf, 0x9e, 0x95, 0x7e, 0xc8, 0xc1, 0xfa, 0x63,
+	0x96, 0xb1, 0x6d, 0xd7, 0x55, 0x4f, 0xee, 0xd9, 0xee, 0x8b, 0xdd, 0x81, 0x6d, 0x68, 0x34, 0xda,
+	0xc4, 0x75, 0xa8, 0x39, 0xee, 0xc8, 0xa2, 0x8a, 0x3f, 0x70, 0xa9, 0x37, 0xb0, 0xcd, 0x30, 0x7b,
+	0x55, 0x4e, 0x3e, 0x0c, 0xa9, 0x0c, 0xf8, 0xcd, 0xc8, 0xf3, 0x8d, 0x63, 0x83, 0xea, 0x0a, 0x75,
+	0x6c, 0x6d, 0x10, 0xe4, 0xa9, 0x1a, 0x91, 0xf7, 0x18, 0x95, 0x01, 0x8f, 0x0d, 0x4b, 0x35, 0x8d,
+	0xd7, 0x11, 0x30, 0x27, 0x80, 0x11, 0x59, 0x00, 0x65, 0x58, 0xe6, 0xc5, 0xa4, 0xa8, 0xcc, 0x37,
+	0x85, 0x15, 0xaf, 0xd7, 0xc8, 0x6f, 0xe6, 0x6e, 0x94, 0x3b, 0xd7, 0x66, 0x45, 0x26, 0xde, 0xcb,
+	0x43, 0x84, 0xcb, 0x35, 0x27, 0xb5, 0xf6, 0xc8, 0x73, 0x58, 0x34, 0x2c, 0x1d, 0x37, 0xe8, 0x35,
+	0x0a, 0x5c, 0xd3, 0xf6, 0xbf, 0x6b, 0x9a, 0x8c, 0x4a, 0x6b, 0x5f, 0xe8, 0xd8, 0xb3, 0x7c, 0xf7,
+	0x44, 0x0e, 0x35, 0x36, 0xef, 0x42, 0x25, 0xc9, 0x20, 0x75, 0xc8, 0xbd, 0xa0, 0x27, 0x3c, 0x5e,
+	0x25, 0x99, 0x7d, 0x62, 0x5d, 0x16, 0xc6, 0xaa, 0x39, 0xa2, 0x41, 0x68, 0xc4, 0xe2, 0x6e, 0xf6,
+	0x93, 0x8c, 0xf4, 0x26, 0x0b, 0xd5, 0xb4, 0xf3, 0x51, 0xb9, 0x67, 0xe2, 0x72, 0x67, 0xb4, 0xb8,
+	0x78, 0x65, 0xfe, 0x4d, 0x56, 0x60, 0xc1, 0x51, 0x5d, 0x6a, 0xf9, 0x41, 0x1c, 0x83, 0xd5, 0xb4,
+	0x8c, 0xe4, 0xe7, 0xcd, 0x48, 0x61, 0x6a, 0x46, 0xd0, 0xd2, 0xb7, 0xd4, 0xe8, 0x0f, 0xfc, 0xc6,
+	0x82, 0xb0, 0x24, 0x56, 0xfc, 0x5c, 0x60, 0x0d, 0x2a, 0xda, 0xc0, 0xc0, 0xfa, 0x58, 0xe4, 0xbc,
+	0x12, 0xa3, 0xec, 0x32, 0x02, 0xd3, 0xcf, 0xd9, 0x98, 0x00, 0x8d, 0x5a, 0xba, 0x8a, 0x9e, 0x16,
+	0x85, 0x7e, 0x46, 0xee, 0x46, 0x54, 0xe9, 0x6b, 0x20, 0x5d, 0xd6, 0xf4, 0x1e, 0x53, 0xea, 0x86,
+	0xb1, 0xf6, 0xf0, 0x54, 0x94, 0xdc, 0x70, 0x81, 0xc1, 0x60, 0x59, 0xbb, 0x39, 0x2b, 0x6b, 0x13,
+	0xe2, 0x72, 0x2c, 0x2b, 0xfd, 0x56, 0x80, 0xe5, 0x09, 0x00, 0x69, 0xc3, 0x59, 0xd3, 0xf0, 0x7c,
+	0x6a, 0xe1, 0x89, 0x52, 0x54, 0x5d, 0x47, 0x7c, 0x68, 0xa8, 0x24, 0x93, 0x88, 0xb5, 0x1d, 0x72,
+	0xc8, 0x0e, 0x94, 0x74, 0xc3, 0xa5, 0x1a, 0x6b, 0x86, 0x3c, 0x11, 0xd5, 0xce, 0x95, 0xd8, 0x1f,
+	0xfc, 0x68, 0x85, 0x0d, 0xb7, 0xc5, 0x0c, 0x75, 0x43, 0xac, 0x1c, 0x8b, 0x91, 0x27, 0x50, 0x47,
+	0xaf, 0x2d, 0xb1, 0x52, 0x58, 0xcf, 0xa6, 0x3c, 0x7b, 0xd5, 0x64, 0x69, 0xa7, 0x54, 0xed, 0x46,
+	0x70, 0xd1, 0xe9, 0x6a, 0x5a, 0x9a, 0x40, 0x56, 0x61, 0xd1, 0x41, 0x73, 0x0a, 0xf6, 0xd7, 0x3c,
+	0xaf, 0xb8, 0x05, 0xb6, 0xdc, 0xd7, 0x59, 0x19, 0x52, 0xcb, 0xe5, 0x29, 0xc5, 0x32, 0xc4, 0x4f,
+	0xf2, 0x08, 0x4a, 0x02, 0x6a, 0x1d, 0xdb, 0x3c, 0x95, 0xe5, 0x4e, 0x67, 0xee, 0x88, 0xf2, 0x4d,
+	0xed, 0xa3, 0xa4, 0x5c, 0x74, 0x82, 0x2f, 0xf2, 0x39, 0x94, 0xb9, 0x42, 0xb6, 0x91, 0x91, 0xc7,
+	0x2b, 0xa0, 0xdc, 0xb9, 0x30, 0xa1, 0x12, 0xaf, 0x19, 0xa6, 0xb2, 0xc7, 0x51, 0x32, 0x30, 0x11,
+	0xf1, 0x4d, 0x2e, 0x41, 0xc5, 0x54, 0xb1, 0x44, 0x46, 0x8e, 0x8e, 0x7b, 0xd1, 0x83, 0xfa, 0x28,
+	0x33, 0xda, 0x53, 0x41, 0x6a, 0xfe, 0x9d, 0x81, 0x62, 0x68, 0x9a, 0x7c, 0x06, 0xc5, 0x21, 0xf5,
+	0x55, 0xe4, 0xa8, 0xfc, 0x7c, 0x94, 0x3b, 0x9b, 0xb3, 0xac, 0x7d, 0x89, 0xb8, 0x2e, 0xe2, 0xe4,
+	0x48, 0x82, 0xac, 0xe3, 0xfe, 0xd9, 0x59, 0xd3, 0x6c, 0xd3, 0xc3, 0x0c, 0xb2, 0x44, 0xc7, 0x04,
+	0xbc, 0x26, 0xca, 0xc7, 0xea, 0xc8, 0xc4, 0x72, 0xb6, 0x47, 0xd1, 0xa1, 0x02, 0x4e, 0xda, 0x65,
+	0x14, 0x72, 0x13, 0xea, 0x21, 0x5a, 0x19, 0x53, 0x97, 0xdd, 0x53, 0x41, 0xc8, 0x6b, 0x21, 0xfd,
+	0x99, 0x20, 0x93, 0xcb, 0xb0, 0x84, 0x17, 0xaa, 0xe5, 0x47, 0x38, 0x91, 0x85, 0x0a, 0x27, 0x86,
+	0x20, 0xdc, 0x3c, 0x8f, 0x9e, 0x89, 0xfb, 0xb4, 0xb4, 0x93, 0xe0, 0x70, 0xf1, 0x88, 0x1e, 0x08,
+	0x92, 0x74, 0x03, 0xea, 0xfc, 0x26, 0x3a, 0x74, 0x69, 0xe2, 0x92, 0x2b, 0xb0, 0x9e, 0xe0, 0x05,
+	0x0d, 0x42, 0x2c, 0xa4, 0x23, 0x58, 0x4e, 0x20, 0x83, 0x1a, 0xff, 0x14, 0x0a, 0xa2, 0x7d, 0x8a,
+	0xe3, 0x73, 0x75, 0x56, 0xb2, 0x23, 0x49, 0xde, 0x3d, 0x85, 0x0c, 0xab, 0x1f, 0x3d, 0x68, 0x39,
+	0x58, 0x3f, 0xf8, 0x29, 0xfd, 0x98, 0x81, 0xa5, 0x14, 0x34, 0xea, 0x4b, 0x99, 0x44, 0x5f, 0xc2,
+	0x38, 0x8a, 0x4e, 0x94, 0xb8, 0x6f, 0x31, 0xe9, 0x9c, 0xc4, 0xee, 0xcb, 0xa8, 0xc1, 0xe5, 0x12,
+	0x0d, 0x2e, 0x6e, 0x31, 0xf9, 0x54, 0x8b, 0xc1, 0x94, 0x69, 0xaa, 0x65, 0x5b, 0x86, 0xa6, 0x9a,
+	0x3c, 0x88, 0x45, 0x39, 0x26, 0x48, 0x2f, 0x81, 0x30, 0x37, 0x1e, 0x50, 0xd5, 0xf4, 0x07, 0xc9,
+	0x0b, 0x78, 0xc0, 0x29, 0xa2, 0x07, 0x17, 0xe5, 0x70, 0x49, 0xb6, 0xa1, 0xe8, 0x51, 0x77, 0xcc,
+	0xef, 0x81, 0xec, 0xe9, 0x21, 0xe9, 0x09, 0x5c, 0xa0, 0x3a, 0x12, 0x93, 0x7e, 0xc7, 0x18, 0xa4,
+	0x78, 0x6c, 0x3b, 0x96, 0x3a, 0xa4, 0x41, 0xbf, 0xe7, 0xdf, 0x49, 0x17, 0xb2, 0x69, 0x17, 0x30,
+	0x7b, 0xd4, 0x75, 0x6d, 0x97, 0xef, 0xbe, 0x24, 0x8b, 0x05, 0xeb, 0xa4, 0xfc, 0x1c, 0x08, 0x96,
+	0x28, 0xaa, 0x12, 0xa3, 0xec, 0x71, 0xf6, 0x35, 0xa8, 0xc5, 0x6c, 0xc5, 0x37, 0xd0, 0x9a, 0xe8,
+	0xd4, 0x4b, 0x11, 0xe6, 0x10, 0x89, 0xe4, 0x2a, 0x54, 0x47, 0x0e, 0x63, 0x2b, 0x1e, 0xc5, 0xbd,
+	0xe8, 0x5e, 0x50, 0x53, 0x4b, 0x82, 0xda, 0x13, 0x44, 0xa9, 0x03, 0x2b, 0x5d, 0x43, 0xed, 0x5b,
+	0x36, 0x5e, 0x07, 0x5a, 0x77, 0x34, 0x74, 0x92, 0xa1, 0x53, 0x5d, 0x6c, 0xe7, 0x63, 0x1a, 0xce,
+	0x2e, 0xc1, 0x12, 0x07, 0xae, 0x95, 0xed, 0x78, 0xcc, 0x7c, 0x6c, 0xdb, 0xe6, 0x94, 0x7a, 0xcc,
+	0x45, 0xf5, 0x48, 0x6e, 0xc3, 0xb2, 0x66, 0x0f, 0x87, 0x06, 0xca, 0x50, 0x25, 0xbc, 0x7b, 0xb3,
+	0x1c, 0x51, 0x8f, 0x18, 0xc1, 0xb5, 0x29, 0xbd, 0xcd, 0xc1, 0xea, 0x84, 0xf6, 0xc0, 0x25, 0x05,
+	0xce, 0x8f, 0x2c, 0xb5, 0xdf, 0x77, 0x69, 0x9f, 0xf5, 0x03, 0x25, 0x31, 0xec, 0x86, 0x75, 0x2d,
+	0xcd, 0xe8, 0x9d, 0x09, 0x95, 0x72, 0x23, 0xa9, 0x24, 0xc1, 0x60, 0xb3, 0xc1, 0xea, 0x2c, 0xf5,
+	0xd9, 0xb9, 0xd5, 0xaf, 0xcc, 0x50, 0xfe, 0x04, 0x88, 0x18, 0x1d, 0x53, 0x7a, 0x73, 0x73, 0xeb,
+	0x5d, 0xe6, 0xd2, 0xef, 0xfa, 0x7b, 0x8c, 0xa3, 0x89, 0xc6, 0x47, 0x93, 0xb4, 0xde, 0xfc, 0xfc,
+	0xfe, 0xc6, 0x2a, 0x92, 0xca, 0xa5, 0x7d, 0x68, 0x3e, 0xc3, 0xab, 0x1f, 0xdb, 0xa5, 0xed, 0x46,
+	0xd3, 0xb8, 0x17, 0xa6, 0x1a, 0x93, 0x3a, 0x0e, 0xb9, 0x51, 0x52, 0x45, 0xda, 0xeb, 0xe3, 0x58,
+	0x4c, 0x24, 0xd5, 0x80, 0xb5, 0xa9, 0xaa, 0x82, 0xbc, 0x7e, 0x01, 0x60, 0x44, 0xd4, 0x20, 0x91,
+	0xb7, 0x66, 0x9d, 0xc6, 0x49, 0x45, 0x72, 0x42, 0x5a, 0xfa, 0x33, 0x03, 0x64, 0x12, 0xc2, 0x06,
+	0x90, 0x94, 0xbb, 0xf4, 0x55, 0x38, 0xc4, 0x26, 0x9d, 0xa5, 0xaf, 0x58, 0x27, 0xf6, 0x55, 0xb7,
+	0x4f, 0xfd, 0xd4, 0x04, 0x5b, 0x16, 0x34, 0x31, 0x03, 0x61, 0xf3, 0x4f, 0x84, 0x5a, 0x49, 0x34,
+	0xb0, 0x5a, 0x82, 0xce, 0xde, 0x2d, 0xec, 0x14, 0x46, 0xbe, 0x09, 0xa0, 0xe8, 0x69, 0x4b, 0x46,
+	0xf2, 0x79, 0x43, 0xee, 0x00, 0x89, 0x61, 0x3a, 0xce, 0x1b, 0xaa, 0xa5, 0x85, 0xe7, 0x7a, 0x39,
+	0xe2, 0x74, 0x03, 0x06, 0x0e, 0x49, 0xeb, 0xd1, 0x16, 0x93, 0x29, 0x0b, 0x73, 0x33, 0xf7, 0x66,
+	0x59, 0x07, 0x4a, 0xec, 0x52, 0x2c, 0xa4, 0x3e, 0x6c, 0xcc, 0x50, 0x1f, 0xe4, 0xeb, 0x1e, 0x54,
+	0xde, 0xf3, 0xe8, 0xa5, 0xe4, 0x3a, 0xbf, 0x2e, 0xe2, 0x8b, 0x84, 0x0d, 0x17, 0xe4, 0xfb, 0x0c,
+	0x54, 0xef, 0x53, 0x3f, 0xf1, 0x8e, 0x23, 0x33, 0x0b, 0x60, 0xf2, 0xb1, 0xd7, 0xbc, 0x3c, 0xb3,
+	0x75, 0xc7, 0x8f, 0x31, 0xe9, 0xd2, 0x9b, 0x3f, 0xfe, 0xfa, 0x39, 0xbb, 0x46, 0xce, 0xb7, 0x53,
+	0x2f, 0x62, 0xfe, 0xc6, 0x6e, 0xf3, 0xf9, 0x8b, 0xbc, 0x82, 0x22, 0xf3, 0x82, 0x9d, 0x33, 0x72,
+	0xe5, 0xd4, 0x1b, 0xf2, 0xff, 0xb3, 0xcc, 0x4f, 0x35, 0xf9, 0x0e, 0x6a, 0x3d, 0xea, 0x27, 0x5f,
+	0x75, 0xe4, 0xf6, 0x7f, 0x78, 0xfb, 0x35, 0x57, 0x5a, 0xe2, 0x2d, 0xde, 0x0a, 0xdf, 0xe2, 0xad,
+	0x3d, 0xf6, 0x16, 0x97, 0x2e, 0x73, 0xd3, 0x1b, 0xd2, 0xda, 0x34, 0xd3, 0xa6, 0x50, 0x44, 0x7e,
+	0xca, 0xc0, 0x2a, 0xee, 0x7b, 0xda, 0x7b, 0x87, 0xcc, 0x50, 0xdc, 0xfc, 0xf8, 0x7d, 0x5e, 0x4d,
+	0xd2, 0x35, 0xee, 0xce, 0x26, 0xb9, 0x30, 0xcd, 0x9d, 0xb8, 0x0f, 0x11, 0x17, 0x4a, 0x07, 0x58,
+	0xed, 0x6c, 0xd8, 0xf3, 0x66, 0xba, 0x70, 0x6b, 0xee, 0x81, 0xd5, 0x3b, 0x3d, 0x05, 0x0e, 0x37,
+	0xf3, 0x1a, 0x16, 0x59, 0x10, 0xf0, 0x9b, 0x48, 0xa7, 0x0c, 0xf3, 0x61, 0xc4, 0xe7, 0x7f, 0x80,
+	0x48, 0x9b, 0xdc, 0x78, 0x93, 0x34, 0x66, 0x19, 0x27, 0xbf, 0x64, 0xa0, 0x8e, 0xc6, 0x53, 0x3f,
+	0x3d, 0xc8, 0x87, 0xb3, 0x2c, 0x4c, 0xfb, 0xaf, 0xd2, 0xbc, 0x33, 0x27, 0x3a, 0xf0, 0xe9, 0x2a,
+	0xf7, 0xe9, 0x22, 0xd9, 0x98, 0xe6, 0x53, 0xd4, 0x72, 0x8e, 0x16, 0x78, 0xcc, 0x3f, 0xfa, 0x07,
+	0x8f, 0xfe, 0x15, 0x82, 0x9f, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -834,6 +1547,12 @@ type DebugClient interface {
 	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
 	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
 	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	GetBlockTree(ctx context.Context, in *BlockTreeRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	GetNodeHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeHealthResponse, error)
+	GetDiagnosticDump(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DiagnosticDumpResponse, error)
+	GetAttestationPool(ctx context.Context, in *AttestationPoolRequest, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
+	GetValidatorInclusions(ctx context.Context, in *ValidatorInclusionsRequest, opts ...grpc.CallOption) (*ValidatorInclusionsResponse, error)
+	ListValidatorAttestations(ctx context.Context, in *ValidatorAttestationsRequest, opts ...grpc.CallOption) (*ValidatorAttestationsResponse, error)
 }
 
 type debugClient struct {
@@ -907,6 +1626,60 @@ func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotReq
 	return out, nil
 }
 
+func (c *debugClient) GetBlockTree(ctx context.Context, in *BlockTreeRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlockTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetNodeHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeHealthResponse, error) {
+	out := new(NodeHealthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetNodeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetDiagnosticDump(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DiagnosticDumpResponse, error) {
+	out := new(DiagnosticDumpResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetDiagnosticDump", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetAttestationPool(ctx context.Context, in *AttestationPoolRequest, opts ...grpc.CallOption) (*AttestationPoolResponse, error) {
+	out := new(AttestationPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetAttestationPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetValidatorInclusions(ctx context.Context, in *ValidatorInclusionsRequest, opts ...grpc.CallOption) (*ValidatorInclusionsResponse, error) {
+	out := new(ValidatorInclusionsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetValidatorInclusions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListValidatorAttestations(ctx context.Context, in *ValidatorAttestationsRequest, opts ...grpc.CallOption) (*ValidatorAttestationsResponse, error) {
+	out := new(ValidatorAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListValidatorAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
 	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
@@ -916,6 +1689,12 @@ type DebugServer interface {
 	ListPeers(context.Context, *empty.Empty) (*DebugPeerResponses, error)
 	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
 	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	GetBlockTree(context.Context, *BlockTreeRequest) (*BlockTreeResponse, error)
+	GetNodeHealth(context.Context, *empty.Empty) (*NodeHealthResponse, error)
+	GetDiagnosticDump(context.Context, *empty.Empty) (*DiagnosticDumpResponse, error)
+	GetAttestationPool(context.Context, *AttestationPoolRequest) (*AttestationPoolResponse, error)
+	GetValidatorInclusions(context.Context, *ValidatorInclusionsRequest) (*ValidatorInclusionsResponse, error)
+	ListValidatorAttestations(context.Context, *ValidatorAttestationsRequest) (*ValidatorAttestationsResponse, error)
 }
 
 // UnimplementedDebugServer can be embedded to have forward compatible implementations.
@@ -943,6 +1722,24 @@ func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.Peer
 func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
 }
+func (*UnimplementedDebugServer) GetBlockTree(ctx context.Context, req *BlockTreeRequest) (*BlockTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockTree not implemented")
+}
+func (*UnimplementedDebugServer) GetNodeHealth(ctx context.Context, req *empty.Empty) (*NodeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeHealth not implemented")
+}
+func (*UnimplementedDebugServer) GetDiagnosticDump(ctx context.Context, req *empty.Empty) (*DiagnosticDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnosticDump not implemented")
+}
+func (*UnimplementedDebugServer) GetAttestationPool(ctx context.Context, req *AttestationPoolRequest) (*AttestationPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestationPool not implemented")
+}
+func (*UnimplementedDebugServer) GetValidatorInclusions(ctx context.Context, req *ValidatorInclusionsRequest) (*ValidatorInclusionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorInclusions not implemented")
+}
+func (*UnimplementedDebugServer) ListValidatorAttestations(ctx context.Context, req *ValidatorAttestationsRequest) (*ValidatorAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorAttestations not implemented")
+}
 
 func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
 	s.RegisterService(&_Debug_serviceDesc, srv)
@@ -1074,6 +1871,114 @@ func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_GetBlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlockTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlockTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlockTree(ctx, req.(*BlockTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetNodeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetNodeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetNodeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetNodeHealth(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetDiagnosticDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetDiagnosticDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetDiagnosticDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetDiagnosticDump(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetAttestationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetAttestationPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetAttestationPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetAttestationPool(ctx, req.(*AttestationPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetValidatorInclusions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorInclusionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetValidatorInclusions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetValidatorInclusions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetValidatorInclusions(ctx, req.(*ValidatorInclusionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListValidatorAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListValidatorAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListValidatorAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListValidatorAttestations(ctx, req.(*ValidatorAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Debug",
 	HandlerType: (*DebugServer)(nil),
@@ -1106,6 +2011,30 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInclusionSlot",
 			Handler:    _Debug_GetInclusionSlot_Handler,
 		},
+		{
+			MethodName: "GetBlockTree",
+			Handler:    _Debug_GetBlockTree_Handler,
+		},
+		{
+			MethodName: "GetNodeHealth",
+			Handler:    _Debug_GetNodeHealth_Handler,
+		},
+		{
+			MethodName: "GetDiagnosticDump",
+			Handler:    _Debug_GetDiagnosticDump_Handler,
+		},
+		{
+			MethodName: "GetAttestationPool",
+			Handler:    _Debug_GetAttestationPool_Handler,
+		},
+		{
+			MethodName: "GetValidatorInclusions",
+			Handler:    _Debug_GetValidatorInclusions_Handler,
+		},
+		{
+			MethodName: "ListValidatorAttestations",
+			Handler:    _Debug_ListValidatorAttestations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/debug.proto",
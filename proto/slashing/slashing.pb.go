@@ -281,6 +281,163 @@ func (m *AttestationHistory) GetLatestEpochWritten() uint64 {
 	return 0
 }
 
+type HighestAttestationRequest struct {
+	ValidatorIds         []uint64 `protobuf:"varint,1,rep,packed,name=validator_ids,json=validatorIds,proto3" json:"validator_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HighestAttestationRequest) Reset()         { *m = HighestAttestationRequest{} }
+func (m *HighestAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*HighestAttestationRequest) ProtoMessage()    {}
+func (*HighestAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{5}
+}
+func (m *HighestAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HighestAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HighestAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HighestAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighestAttestationRequest.Merge(m, src)
+}
+func (m *HighestAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HighestAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighestAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HighestAttestationRequest proto.InternalMessageInfo
+
+func (m *HighestAttestationRequest) GetValidatorIds() []uint64 {
+	if m != nil {
+		return m.ValidatorIds
+	}
+	return nil
+}
+
+type HighestAttestationResponse struct {
+	Attestations         []*HighestAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *HighestAttestationResponse) Reset()         { *m = HighestAttestationResponse{} }
+func (m *HighestAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*HighestAttestationResponse) ProtoMessage()    {}
+func (*HighestAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{6}
+}
+func (m *HighestAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HighestAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HighestAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HighestAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighestAttestationResponse.Merge(m, src)
+}
+func (m *HighestAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HighestAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighestAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HighestAttestationResponse proto.InternalMessageInfo
+
+func (m *HighestAttestationResponse) GetAttestations() []*HighestAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type HighestAttestation struct {
+	ValidatorId          uint64   `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	HighestSourceEpoch   uint64   `protobuf:"varint,2,opt,name=highest_source_epoch,json=highestSourceEpoch,proto3" json:"highest_source_epoch,omitempty"`
+	HighestTargetEpoch   uint64   `protobuf:"varint,3,opt,name=highest_target_epoch,json=highestTargetEpoch,proto3" json:"highest_target_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HighestAttestation) Reset()         { *m = HighestAttestation{} }
+func (m *HighestAttestation) String() string { return proto.CompactTextString(m) }
+func (*HighestAttestation) ProtoMessage()    {}
+func (*HighestAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{7}
+}
+func (m *HighestAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HighestAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HighestAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HighestAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HighestAttestation.Merge(m, src)
+}
+func (m *HighestAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *HighestAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_HighestAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HighestAttestation proto.InternalMessageInfo
+
+func (m *HighestAttestation) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
+	}
+	return 0
+}
+
+func (m *HighestAttestation) GetHighestSourceEpoch() uint64 {
+	if m != nil {
+		return m.HighestSourceEpoch
+	}
+	return 0
+}
+
+func (m *HighestAttestation) GetHighestTargetEpoch() uint64 {
+	if m != nil {
+		return m.HighestTargetEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
 	proto.RegisterType((*Slashable)(nil), "ethereum.slashing.Slashable")
@@ -288,46 +445,56 @@ func init() {
 	proto.RegisterType((*ProposalHistory)(nil), "ethereum.slashing.ProposalHistory")
 	proto.RegisterType((*AttestationHistory)(nil), "ethereum.slashing.AttestationHistory")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "ethereum.slashing.AttestationHistory.TargetToSourceEntry")
+	proto.RegisterType((*HighestAttestationRequest)(nil), "ethereum.slashing.HighestAttestationRequest")
+	proto.RegisterType((*HighestAttestationResponse)(nil), "ethereum.slashing.HighestAttestationResponse")
+	proto.RegisterType((*HighestAttestation)(nil), "ethereum.slashing.HighestAttestation")
 }
 
 func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
 
 var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x96, 0xdb, 0xf2, 0x93, 0xa5, 0x82, 0x74, 0xa9, 0x50, 0x14, 0x95, 0x34, 0xca, 0x85, 0x54,
-	0xd0, 0x75, 0x5b, 0x2e, 0xc0, 0xad, 0x96, 0x2a, 0xb5, 0x17, 0x40, 0x4e, 0x10, 0x47, 0x6b, 0x6d,
-	0x4f, 0xed, 0x55, 0x1d, 0xef, 0x6a, 0x77, 0x52, 0xc8, 0x7b, 0xf0, 0x36, 0xbc, 0x00, 0x47, 0x9e,
-	0x00, 0xa1, 0x3c, 0x00, 0x0f, 0xc0, 0x09, 0x79, 0x9d, 0xa4, 0x21, 0xb1, 0x11, 0xbd, 0xcd, 0xce,
-	0xec, 0x7e, 0x3f, 0xe3, 0x19, 0x93, 0xa7, 0x4a, 0x4b, 0x94, 0xae, 0xc9, 0xb8, 0x49, 0x45, 0x9e,
-	0x2c, 0x02, 0x66, 0xf3, 0x74, 0x07, 0x30, 0x05, 0x0d, 0xe3, 0x11, 0x9b, 0x17, 0xda, 0xfb, 0x80,
-	0xa9, 0x7b, 0x7d, 0xcc, 0x33, 0x95, 0xf2, 0x63, 0x37, 0x04, 0x1e, 0xc9, 0x3c, 0x08, 0x33, 0x19,
-	0x5d, 0x95, 0x6f, 0xda, 0x87, 0x89, 0xc0, 0x74, 0x1c, 0xb2, 0x48, 0x8e, 0xdc, 0x44, 0x26, 0xd2,
-	0xb5, 0xe9, 0x70, 0x7c, 0x69, 0x4f, 0x25, 0x5f, 0x11, 0x95, 0xd7, 0x7b, 0x8a, 0xb4, 0xde, 0x6b,
-	0xa9, 0xa4, 0x01, 0x3d, 0x98, 0x71, 0xf8, 0x60, 0x94, 0xcc, 0x0d, 0xd0, 0x21, 0xd9, 0x51, 0xb3,
-	0x5a, 0x30, 0x17, 0xd0, 0x72, 0xba, 0x9b, 0xfd, 0x07, 0x27, 0xcf, 0xd8, 0x42, 0x1a, 0x60, 0xca,
-	0xe6, 0x82, 0xd8, 0x1a, 0x56, 0x53, 0xad, 0x64, 0x7a, 0x07, 0xa4, 0x61, 0x63, 0x1e, 0x66, 0x40,
-	0xf7, 0x48, 0xc3, 0xcc, 0x0f, 0x2d, 0xa7, 0xeb, 0xf4, 0xef, 0xfb, 0x37, 0x89, 0x42, 0xdc, 0x29,
-	0x22, 0x18, 0xac, 0x16, 0xc7, 0x67, 0xb5, 0xff, 0x15, 0xb7, 0x86, 0xd5, 0xe4, 0x2b, 0x99, 0xde,
-	0x17, 0x87, 0x3c, 0x2a, 0x3d, 0xf0, 0xec, 0x5c, 0x18, 0x94, 0x7a, 0x42, 0xdf, 0x11, 0x02, 0x4a,
-	0x46, 0x69, 0x10, 0x0a, 0x34, 0x56, 0xe4, 0xb6, 0x77, 0xf4, 0xfb, 0xc7, 0xfe, 0x8b, 0xa5, 0x4e,
-	0x2b, 0x3d, 0x31, 0x23, 0x8e, 0x22, 0xca, 0x78, 0x68, 0xdc, 0x44, 0x1e, 0x86, 0x02, 0x2f, 0x05,
-	0x64, 0x31, 0xf3, 0x04, 0x66, 0xc2, 0xa0, 0xdf, 0xb0, 0x18, 0x9e, 0x40, 0x43, 0x8f, 0xc8, 0x6e,
-	0xc6, 0x0b, 0xe2, 0xa0, 0xc4, 0xfd, 0xa4, 0x05, 0x22, 0xe4, 0xad, 0x8d, 0xae, 0xd3, 0xdf, 0xf2,
-	0x69, 0x59, 0x3b, 0x2b, 0x4a, 0x1f, 0xcb, 0x4a, 0xef, 0x97, 0x43, 0x68, 0xa9, 0x9e, 0xa3, 0x90,
-	0xf9, 0x5c, 0x59, 0x44, 0x9a, 0xc8, 0x75, 0x02, 0x18, 0xa0, 0x0c, 0x8c, 0x1c, 0xeb, 0x08, 0x66,
-	0x2d, 0x78, 0xcd, 0xd6, 0x46, 0x87, 0xad, 0x03, 0xb0, 0xa1, 0x7d, 0x3d, 0x94, 0x03, 0xfb, 0xf6,
-	0x2c, 0x47, 0x3d, 0xf1, 0x1f, 0xe2, 0x5f, 0xc9, 0xdb, 0xab, 0x6d, 0x9f, 0x92, 0xc7, 0x15, 0xc0,
-	0xb4, 0x49, 0x36, 0xaf, 0x60, 0x62, 0x1b, 0xb8, 0xe5, 0x17, 0x21, 0xdd, 0x25, 0x77, 0xae, 0x79,
-	0x36, 0x86, 0x19, 0x56, 0x79, 0x78, 0xb3, 0xf1, 0xca, 0x39, 0xf9, 0xba, 0x49, 0xee, 0xd9, 0x8f,
-	0x02, 0x9a, 0x2a, 0xf2, 0xe4, 0xc2, 0x2c, 0x46, 0x66, 0xc9, 0x05, 0x3d, 0xa8, 0xf9, 0xd0, 0x17,
-	0x79, 0x0c, 0x9f, 0x21, 0x5e, 0xba, 0xda, 0x7e, 0x5e, 0xdb, 0x90, 0x8a, 0xd9, 0x92, 0xa4, 0xb9,
-	0xc4, 0xe8, 0x15, 0xdb, 0x45, 0x59, 0x0d, 0xd7, 0x40, 0x24, 0x39, 0xc4, 0x9e, 0x5d, 0x44, 0x7b,
-	0xf3, 0x1c, 0x78, 0x0c, 0xba, 0x92, 0xb0, 0x76, 0xd3, 0x04, 0xe9, 0x54, 0x5b, 0x7c, 0x2b, 0x3f,
-	0xa8, 0x98, 0x23, 0xdc, 0xc6, 0xea, 0x5e, 0x05, 0xf3, 0xcd, 0xc6, 0x85, 0xa4, 0xb5, 0xea, 0x6d,
-	0x41, 0xd2, 0xaf, 0x21, 0x59, 0x77, 0xf7, 0x4f, 0x0e, 0x6f, 0xfb, 0xdb, 0xb4, 0xe3, 0x7c, 0x9f,
-	0x76, 0x9c, 0x9f, 0xd3, 0x8e, 0x13, 0xde, 0xb5, 0x7f, 0x9a, 0x97, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x2a, 0xff, 0x62, 0x56, 0xed, 0x04, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x55, 0xda, 0xf0, 0xe8, 0x6d, 0x80, 0x74, 0x5a, 0xa1, 0x10, 0x95, 0x16, 0x5c, 0x21, 0x5a,
+	0xd1, 0x3a, 0x6d, 0xd9, 0x00, 0x2b, 0x1a, 0xa9, 0x52, 0xb3, 0x01, 0xe4, 0x14, 0xb1, 0xb4, 0xc6,
+	0xf6, 0xd4, 0x1e, 0xd5, 0xf5, 0x98, 0x99, 0x49, 0x21, 0xff, 0xc1, 0x8e, 0x8f, 0xe0, 0x37, 0x58,
+	0xf2, 0x05, 0x08, 0xf1, 0x01, 0x7c, 0x00, 0x2b, 0xc6, 0x33, 0x4e, 0xea, 0xc4, 0x0e, 0x4a, 0x17,
+	0x96, 0xee, 0xdc, 0xc7, 0xb9, 0xe7, 0x9e, 0x79, 0x18, 0x1e, 0xa6, 0x9c, 0x49, 0xd6, 0x11, 0x31,
+	0x16, 0x11, 0x4d, 0xc2, 0xb1, 0x61, 0x6b, 0x3f, 0x5a, 0x21, 0x32, 0x22, 0x9c, 0x0c, 0x2e, 0xec,
+	0x51, 0xa0, 0xbd, 0xa9, 0x5c, 0x9d, 0xcb, 0x03, 0x1c, 0xa7, 0x11, 0x3e, 0xe8, 0x78, 0x04, 0xfb,
+	0x2c, 0x71, 0xbd, 0x98, 0xf9, 0xe7, 0xa6, 0xa6, 0xbd, 0x17, 0x52, 0x19, 0x0d, 0x3c, 0xdb, 0x67,
+	0x17, 0x9d, 0x90, 0x85, 0xac, 0xa3, 0xdd, 0xde, 0xe0, 0x4c, 0xaf, 0x4c, 0xbf, 0xcc, 0x32, 0xe9,
+	0x56, 0x0a, 0xad, 0x77, 0x9c, 0xa5, 0x4c, 0x10, 0xde, 0xcf, 0x7b, 0x38, 0x44, 0xa4, 0x2c, 0x11,
+	0x04, 0x9d, 0xc2, 0x4a, 0x9a, 0xc7, 0xdc, 0x11, 0x81, 0x56, 0xed, 0xd1, 0xe2, 0xf6, 0xf2, 0xe1,
+	0x53, 0x7b, 0x4c, 0x4d, 0x19, 0xf6, 0x88, 0x90, 0x5d, 0xc2, 0x6a, 0xa6, 0x53, 0x1e, 0x6b, 0x07,
+	0x96, 0xb4, 0x8d, 0xbd, 0x98, 0xa0, 0x75, 0x58, 0x12, 0xa3, 0x85, 0x82, 0xae, 0x6d, 0xdf, 0x76,
+	0xae, 0x1c, 0x19, 0xb9, 0x23, 0x29, 0x89, 0x90, 0xd5, 0xe4, 0x70, 0x1e, 0x9b, 0x97, 0x5c, 0x09,
+	0xab, 0x89, 0xa7, 0x3c, 0xd6, 0x97, 0x1a, 0xdc, 0x33, 0x33, 0xe0, 0xf8, 0x84, 0x0a, 0xc9, 0xf8,
+	0x10, 0xbd, 0x05, 0x20, 0x29, 0xf3, 0x23, 0xd7, 0xa3, 0x52, 0x68, 0x92, 0x8d, 0xee, 0xfe, 0xdf,
+	0x9f, 0x9b, 0xbb, 0x05, 0xa5, 0x53, 0x3e, 0x14, 0x17, 0x58, 0x52, 0x3f, 0xc6, 0x9e, 0x50, 0xfa,
+	0xee, 0xa9, 0xdc, 0x33, 0x4a, 0xe2, 0xc0, 0xee, 0x52, 0x19, 0x2b, 0x20, 0x67, 0x49, 0x63, 0xa8,
+	0x95, 0x40, 0xfb, 0xb0, 0x16, 0xe3, 0xac, 0xb1, 0x6b, 0x70, 0x3f, 0x71, 0xaa, 0x78, 0x24, 0xad,
+	0x05, 0x05, 0x5d, 0x77, 0x90, 0x89, 0x1d, 0x67, 0xa1, 0x0f, 0x26, 0x62, 0xfd, 0xa9, 0x01, 0x32,
+	0xec, 0x55, 0x0f, 0x96, 0x8c, 0x98, 0xf9, 0xd0, 0x94, 0x98, 0x87, 0x44, 0xba, 0x92, 0xb9, 0x82,
+	0x0d, 0xb8, 0x4f, 0x72, 0x09, 0x5e, 0xda, 0xa5, 0xa3, 0x63, 0x97, 0x01, 0xec, 0x53, 0x5d, 0x7d,
+	0xca, 0xfa, 0xba, 0xf6, 0x38, 0x91, 0x7c, 0xe8, 0xdc, 0x95, 0x13, 0xce, 0xeb, 0xb3, 0x6d, 0x1f,
+	0xc1, 0x6a, 0x05, 0x30, 0x6a, 0xc2, 0xe2, 0x39, 0x19, 0x6a, 0x01, 0xeb, 0x4e, 0x66, 0xa2, 0x35,
+	0xb8, 0x71, 0x89, 0xe3, 0x01, 0xc9, 0xb1, 0xcc, 0xe2, 0xd5, 0xc2, 0x8b, 0x9a, 0xf5, 0x1a, 0x1e,
+	0x9c, 0xd0, 0x30, 0x52, 0xc8, 0x05, 0xd6, 0x0e, 0xf9, 0x38, 0x50, 0x36, 0xda, 0x82, 0x3b, 0x2a,
+	0x93, 0x06, 0x58, 0xcd, 0xe0, 0xd2, 0x40, 0xe8, 0x99, 0xeb, 0x4e, 0x63, 0xec, 0xec, 0x05, 0xc2,
+	0x0a, 0xa1, 0x5d, 0x85, 0x90, 0x9f, 0x9e, 0x1e, 0x34, 0xf0, 0x95, 0x5b, 0xe4, 0xaa, 0x3d, 0xa9,
+	0x50, 0xad, 0x02, 0x64, 0xa2, 0xd4, 0xfa, 0xaa, 0xf6, 0xa6, 0x9c, 0x84, 0x1e, 0x43, 0xa3, 0x48,
+	0x32, 0x1f, 0x7b, 0xb9, 0xc0, 0x31, 0x53, 0x36, 0x32, 0x85, 0xf9, 0xe6, 0x19, 0x85, 0x47, 0xca,
+	0xe6, 0xb1, 0x5c, 0xc2, 0x2c, 0x52, 0xac, 0xc8, 0x37, 0xde, 0x54, 0x2c, 0x4e, 0x54, 0x18, 0xf1,
+	0x75, 0xc5, 0xe1, 0xb7, 0x3a, 0xdc, 0xd2, 0xa7, 0x9b, 0x70, 0x94, 0xc2, 0xfd, 0x9e, 0x18, 0xdf,
+	0xbd, 0x22, 0xd9, 0x9d, 0x19, 0x37, 0xa6, 0x97, 0x04, 0xe4, 0x33, 0x09, 0x0a, 0xa9, 0xed, 0x67,
+	0x33, 0x4f, 0x56, 0xc5, 0x25, 0x65, 0xd0, 0x2c, 0x74, 0xec, 0x66, 0xcf, 0x14, 0xb2, 0x67, 0xf4,
+	0xea, 0xd3, 0x30, 0x21, 0x41, 0x57, 0xbf, 0x68, 0x3a, 0xf3, 0x84, 0xe0, 0x80, 0xf0, 0xca, 0x86,
+	0x33, 0x9f, 0x2c, 0x0a, 0x1b, 0xd5, 0x23, 0xbe, 0x61, 0xef, 0x53, 0xa5, 0x3b, 0xb9, 0xce, 0xa8,
+	0xeb, 0x15, 0x9d, 0xaf, 0x9e, 0x2e, 0x0f, 0x5a, 0xd3, 0xb3, 0x8d, 0x9b, 0x6c, 0xcf, 0x68, 0x52,
+	0x9e, 0xee, 0xff, 0x3d, 0x38, 0xac, 0x96, 0x8f, 0x96, 0x40, 0xbb, 0xf3, 0x9d, 0x53, 0x73, 0x5d,
+	0xda, 0x7b, 0x73, 0x66, 0x1b, 0x09, 0xbb, 0x8d, 0xef, 0xbf, 0x37, 0x6a, 0x3f, 0xd4, 0xf7, 0x4b,
+	0x7d, 0xde, 0x4d, 0xfd, 0x9b, 0x78, 0xfe, 0x0f, 0xf4, 0x4f, 0xbc, 0xa2, 0xaa, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -346,6 +513,7 @@ type SlasherClient interface {
 	IsSlashableBlock(ctx context.Context, in *v1alpha1.SignedBeaconBlockHeader, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
 	IsSlashableAttestationNoUpdate(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*Slashable, error)
 	IsSlashableBlockNoUpdate(ctx context.Context, in *v1alpha1.BeaconBlockHeader, opts ...grpc.CallOption) (*Slashable, error)
+	HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error)
 }
 
 type slasherClient struct {
@@ -392,12 +560,22 @@ func (c *slasherClient) IsSlashableBlockNoUpdate(ctx context.Context, in *v1alph
 	return out, nil
 }
 
+func (c *slasherClient) HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error) {
+	out := new(HighestAttestationResponse)
+	err := c.cc.Invoke(ctx, "/Slasher/HighestAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SlasherServer is the server API for Slasher service.
 type SlasherServer interface {
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
 	IsSlashableBlock(context.Context, *v1alpha1.SignedBeaconBlockHeader) (*ProposerSlashingResponse, error)
 	IsSlashableAttestationNoUpdate(context.Context, *v1alpha1.IndexedAttestation) (*Slashable, error)
 	IsSlashableBlockNoUpdate(context.Context, *v1alpha1.BeaconBlockHeader) (*Slashable, error)
+	HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error)
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -416,6 +594,9 @@ func (*UnimplementedSlasherServer) IsSlashableAttestationNoUpdate(ctx context.Co
 func (*UnimplementedSlasherServer) IsSlashableBlockNoUpdate(ctx context.Context, req *v1alpha1.BeaconBlockHeader) (*Slashable, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableBlockNoUpdate not implemented")
 }
+func (*UnimplementedSlasherServer) HighestAttestations(ctx context.Context, req *HighestAttestationRequest) (*HighestAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestations not implemented")
+}
 
 func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
 	s.RegisterService(&_Slasher_serviceDesc, srv)
@@ -493,6 +674,24 @@ func _Slasher_IsSlashableBlockNoUpdate_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Slasher_HighestAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighestAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).HighestAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Slasher/HighestAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).HighestAttestations(ctx, req.(*HighestAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Slasher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.slashing.Slasher",
 	HandlerType: (*SlasherServer)(nil),
@@ -513,6 +712,10 @@ var _Slasher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsSlashableBlockNoUpdate",
 			Handler:    _Slasher_IsSlashableBlockNoUpdate_Handler,
 		},
+		{
+			MethodName: "HighestAttestations",
+			Handler:    _Slasher_HighestAttestations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/slashing/slashing.proto",
@@ -723,6 +926,134 @@ func (m *AttestationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HighestAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidatorIds) > 0 {
+		dAtA2 := make([]byte, len(m.ValidatorIds)*10)
+		var j1 int
+		for _, num := range m.ValidatorIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintSlashing(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighestAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HighestAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighestAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HighestAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HighestTargetEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.HighestTargetEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HighestSourceEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.HighestSourceEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorId != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -827,6 +1158,64 @@ func (m *AttestationHistory) Size() (n int) {
 	return n
 }
 
+func (m *HighestAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorIds) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIds {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HighestAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HighestAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovSlashing(uint64(m.ValidatorId))
+	}
+	if m.HighestSourceEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.HighestSourceEpoch))
+	}
+	if m.HighestTargetEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.HighestTargetEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1362,6 +1751,335 @@ func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIds = append(m.ValidatorIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIds) == 0 {
+					m.ValidatorIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIds = append(m.ValidatorIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighestAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &HighestAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestSourceEpoch", wireType)
+			}
+			m.HighestSourceEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestSourceEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestTargetEpoch", wireType)
+			}
+			m.HighestTargetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestTargetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21,6 +21,41 @@ const (
 // AttestationAggregationStrategy defines attestation aggregation strategy.
 type AttestationAggregationStrategy string
 
+// Aggregator aggregates attestations of the same data, each aggregation strategy implements it.
+type Aggregator interface {
+	Aggregate(atts []*ethpb.Attestation) ([]*ethpb.Attestation, error)
+}
+
+// AggregatorFunc is an adapter to use an ordinary function as an Aggregator.
+type AggregatorFunc func(atts []*ethpb.Attestation) ([]*ethpb.Attestation, error)
+
+// Aggregate calls f(atts).
+func (f AggregatorFunc) Aggregate(atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
+	return f(atts)
+}
+
+// strategies are the aggregators of the known aggregation strategies.
+var strategies = map[AttestationAggregationStrategy]Aggregator{
+	NaiveAggregation:    AggregatorFunc(NaiveAttestationAggregation),
+	MaxCoverAggregation: AggregatorFunc(MaxCoverAttestationAggregation),
+}
+
+// RegisterStrategy makes the aggregator available as the aggregation strategy, replacing the
+// aggregator of a known strategy of the same name. It is not safe for concurrent use with the
+// aggregation, so strategies are expected to be registered on start, such as in an init function.
+func RegisterStrategy(strategy AttestationAggregationStrategy, aggregator Aggregator) {
+	strategies[strategy] = aggregator
+}
+
+// StrategyAggregator returns the aggregator of the aggregation strategy.
+func StrategyAggregator(strategy AttestationAggregationStrategy) (Aggregator, error) {
+	aggregator, ok := strategies[strategy]
+	if !ok {
+		return nil, errors.Wrapf(aggregation.ErrInvalidStrategy, "%q", strategy)
+	}
+	return aggregator, nil
+}
+
 // attList represents list of attestations, defined for easier en masse operations (filtering, sorting).
 type attList []*ethpb.Attestation
 
@@ -39,14 +74,14 @@ var ErrInvalidAttestationCount = errors.New("invalid number of attestations")
 // Aggregate aggregates attestations. The minimal number of attestations is returned.
 func Aggregate(atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
 	strategy := AttestationAggregationStrategy(featureconfig.Get().AttestationAggregationStrategy)
-	switch strategy {
-	case "", NaiveAggregation:
-		return NaiveAttestationAggregation(atts)
-	case MaxCoverAggregation:
-		return MaxCoverAttestationAggregation(atts)
-	default:
-		return nil, errors.Wrapf(aggregation.ErrInvalidStrategy, "%q", strategy)
+	if strategy == "" {
+		strategy = NaiveAggregation
+	}
+	aggregator, err := StrategyAggregator(strategy)
+	if err != nil {
+		return nil, err
 	}
+	return aggregator.Aggregate(atts)
 }
 
 // AggregatePair aggregates pair of attestations a1 and a2 together.
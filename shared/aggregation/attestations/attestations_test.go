@@ -196,6 +196,17 @@ func TestAggregateAttestations_Aggregate(t *testing.T) {
 				{0b00000011, 0b1},
 			},
 		},
+		{
+			name: "attestations fully contained within a later one",
+			inputs: []bitfield.Bitlist{
+				{0b00000011, 0b1},
+				{0b00000110, 0b1},
+				{0b00000111, 0b1},
+			},
+			want: []bitfield.Bitlist{
+				{0b00000111, 0b1},
+			},
+		},
 		{
 			name: "attestations with different bitlist lengths",
 			inputs: []bitfield.Bitlist{
@@ -242,3 +253,23 @@ func TestAggregateAttestations_Aggregate(t *testing.T) {
 		})
 	}
 }
+
+func TestAggregateAttestations_RegisterStrategy(t *testing.T) {
+	const strategy AttestationAggregationStrategy = "first"
+	_, err := StrategyAggregator(strategy)
+	assert.ErrorContains(t, "invalid aggregation strategy", err)
+
+	RegisterStrategy(strategy, AggregatorFunc(func(atts []*ethpb.Attestation) ([]*ethpb.Attestation, error) {
+		return atts[:1], nil
+	}))
+	defer delete(strategies, strategy)
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{
+		AttestationAggregationStrategy: string(strategy),
+	})
+	defer resetCfg()
+
+	atts := aggtesting.MakeAttestationsFromBitlists(t, []bitfield.Bitlist{{0b1001}, {0b1010}})
+	got, err := Aggregate(atts)
+	require.NoError(t, err)
+	assert.DeepEqual(t, atts[:1], got)
+}
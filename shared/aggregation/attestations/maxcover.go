@@ -61,6 +61,30 @@ func MaxCoverAttestationAggregation(atts []*ethpb.Attestation) ([]*ethpb.Attesta
 	return aggregated.merge(unaggregated.filterContained()), nil
 }
 
+// SelectMaxCover splits the attestations of the same data into the ones covering the most unique
+// attesters, as in Maximum Coverage with overlaps allowed, and the redundant ones whose attesters
+// are all covered by the selected ones. Packing the selected attestations first maximizes the
+// attesters included per attestation slot of a block.
+func SelectMaxCover(atts []*ethpb.Attestation) ([]*ethpb.Attestation, []*ethpb.Attestation, error) {
+	if len(atts) < 2 {
+		return atts, nil, nil
+	}
+	maxCover, err := NewMaxCover(atts)
+	if err != nil {
+		if err == aggregation.ErrBitsDifferentLen {
+			return atts, nil, nil
+		}
+		return nil, nil, err
+	}
+	solution, err := maxCover.Cover(len(atts), true /* allowOverlaps */, false /* allowDuplicates */)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]int, len(solution.Keys))
+	copy(keys, solution.Keys)
+	return attList(atts).selectUsingKeys(solution.Keys), attList(atts).selectComplementUsingKeys(keys), nil
+}
+
 // NewMaxCover returns initialized Maximum Coverage problem for attestations aggregation.
 func NewMaxCover(atts []*ethpb.Attestation) (*aggregation.MaxCoverProblem, error) {
 	if len(atts) == 0 {
@@ -167,10 +191,18 @@ func (al attList) filterContained() attList {
 	filtered := make([]*ethpb.Attestation, 0, len(al))
 	filtered = append(filtered, al[0])
 	for i := 1; i < len(al); i++ {
-		if filtered[len(filtered)-1].AggregationBits.Contains(al[i].AggregationBits) {
-			continue
+		// Any attestation containing this one has at least as many bits, so it was filtered or is
+		// contained by a filtered one.
+		contained := false
+		for _, f := range filtered {
+			if f.AggregationBits.Contains(al[i].AggregationBits) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			filtered = append(filtered, al[i])
 		}
-		filtered = append(filtered, al[i])
 	}
 	return filtered
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/shared/aggregation"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 func TestAggregateAttestations_MaxCover_NewMaxCover(t *testing.T) {
@@ -99,3 +100,27 @@ func TestAggregateAttestations_MaxCover_NewMaxCover(t *testing.T) {
 		})
 	}
 }
+
+func TestAggregateAttestations_MaxCover_SelectMaxCover(t *testing.T) {
+	bits := func(indices ...uint64) bitfield.Bitlist {
+		b := bitfield.NewBitlist(6)
+		for _, idx := range indices {
+			b.SetBitAt(idx, true)
+		}
+		return b
+	}
+	att1 := &ethpb.Attestation{AggregationBits: bits(0, 1, 2)}
+	att2 := &ethpb.Attestation{AggregationBits: bits(1, 3)}
+	att3 := &ethpb.Attestation{AggregationBits: bits(2, 3, 4)}
+	att4 := &ethpb.Attestation{AggregationBits: bits(0, 1, 2)}
+
+	selected, redundant, err := SelectMaxCover([]*ethpb.Attestation{att1, att2, att3, att4})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.Attestation{att1, att3}, selected)
+	assert.DeepEqual(t, []*ethpb.Attestation{att2, att4}, redundant)
+
+	selected, redundant, err = SelectMaxCover([]*ethpb.Attestation{att1})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpb.Attestation{att1}, selected)
+	assert.Equal(t, 0, len(redundant))
+}
@@ -30,8 +30,10 @@ func NaiveAttestationAggregation(atts []*ethpb.Attestation) ([]*ethpb.Attestatio
 		}
 	}
 
-	// Naive deduplication of identical aggregations. O(n^2) time.
-	for i, a := range atts {
+	// Naive deduplication of identical aggregations. O(n^2) time. The index is decremented when a
+	// is removed, so the attestation shifted in its place is compared too.
+	for i := 0; i < len(atts); i++ {
+		a := atts[i]
 		for j := i + 1; j < len(atts); j++ {
 			b := atts[j]
 
@@ -0,0 +1,84 @@
+package clockdrift
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"time"
+)
+
+const (
+	ntpPort       = "123"
+	ntpPacketSize = 48
+	// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and the unix epoch (1970).
+	ntpEpochOffset = 2208988800
+	// clientHeader sets a leap indicator of 0, NTP version 3 and the client mode.
+	clientHeader = 0x1B
+	serverMode   = 4
+)
+
+// queryOffset sends a SNTP request to the server and returns the offset of the
+// server clock from the local clock, following RFC 4330.
+func queryOffset(server string, timeout time.Duration) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, ntpPort)
+	}
+	conn, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Debug("Could not close NTP connection")
+		}
+	}()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	req := make([]byte, ntpPacketSize)
+	req[0] = clientHeader
+	sent := time.Now()
+	transmit := toNTPTime(sent)
+	binary.BigEndian.PutUint64(req[40:], transmit)
+	if _, err := conn.Write(req); err != nil {
+		return 0, err
+	}
+	resp := make([]byte, ntpPacketSize)
+	n, err := conn.Read(resp)
+	if err != nil {
+		return 0, err
+	}
+	received := time.Now()
+	if n < ntpPacketSize {
+		return 0, errors.New("NTP response is too short")
+	}
+	if resp[0]&0x7 != serverMode {
+		return 0, errors.New("NTP response is not in server mode")
+	}
+	// A stratum of 0 is a kiss-o'-death packet, asking the client to stop querying the server.
+	if resp[1] == 0 {
+		return 0, errors.New("NTP server sent a kiss-o'-death response")
+	}
+	if binary.BigEndian.Uint64(resp[24:]) != transmit {
+		return 0, errors.New("NTP response does not match the request")
+	}
+	serverReceived := fromNTPTime(binary.BigEndian.Uint64(resp[32:]))
+	serverTransmitted := fromNTPTime(binary.BigEndian.Uint64(resp[40:]))
+	return (serverReceived.Sub(sent) + serverTransmitted.Sub(received)) / 2, nil
+}
+
+// toNTPTime converts the time to the 64 bits NTP timestamp format, where the first 32 bits
+// are the seconds since the NTP epoch and the last 32 bits are the fraction of second.
+func toNTPTime(t time.Time) uint64 {
+	nsec := uint64(t.Sub(time.Unix(-ntpEpochOffset, 0)))
+	sec := nsec / uint64(time.Second)
+	frac := (nsec % uint64(time.Second)) << 32 / uint64(time.Second)
+	return sec<<32 | frac
+}
+
+func fromNTPTime(ts uint64) time.Time {
+	sec := int64(ts >> 32)
+	nsec := int64((ts & 0xffffffff) * uint64(time.Second) >> 32)
+	return time.Unix(sec-ntpEpochOffset, nsec)
+}
@@ -0,0 +1,123 @@
+// Package clockdrift defines a service periodically comparing the local clock with
+// NTP servers, exporting the measured drift as a metric and warning when it exceeds
+// a threshold. Even small clock skews make validators attest and propose early or
+// late, and make the node disagree with its peers on the current slot.
+package clockdrift
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "clockdrift")
+
+const queryTimeout = 5 * time.Second
+
+var (
+	clockDrift = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "clock_drift_seconds",
+		Help: "The offset of the NTP servers time from the local clock, positive if the local clock is behind",
+	})
+	clockDriftExceeded = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "clock_drift_exceeds_threshold",
+		Help: "1 if the last measured clock drift exceeds the threshold, 0 otherwise",
+	})
+	failedQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "clock_drift_failed_ntp_queries_total",
+		Help: "The number of NTP queries that failed, by server",
+	}, []string{"server"})
+)
+
+// Config options for the clock drift service.
+type Config struct {
+	// Servers are the NTP servers the local clock is compared with, as host or host:port.
+	Servers []string
+	// Threshold is the absolute drift above which a warning is logged.
+	Threshold     time.Duration
+	CheckInterval time.Duration
+}
+
+// Service periodically measures the drift of the local clock. The drift is only
+// reported, it never makes the service unhealthy.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+}
+
+// NewService creates a clock drift service querying the configured NTP servers.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start checking the clock drift, once at startup and then periodically.
+func (s *Service) Start() {
+	go func() {
+		s.check()
+		runutil.RunEvery(s.ctx, s.cfg.CheckInterval, s.check)
+	}()
+}
+
+// Stop checking the clock drift.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status always returns nil, a drifting clock is reported by the logs and metrics.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) check() {
+	offsets := make([]time.Duration, 0, len(s.cfg.Servers))
+	for _, server := range s.cfg.Servers {
+		offset, err := queryOffset(server, queryTimeout)
+		if err != nil {
+			log.WithError(err).WithField("server", server).Debug("Could not query NTP server")
+			failedQueries.WithLabelValues(server).Inc()
+			continue
+		}
+		offsets = append(offsets, offset)
+	}
+	if len(offsets) == 0 {
+		log.Warn("Could not query any NTP server, the drift of the local clock is unknown")
+		return
+	}
+	// The median is robust to a single server being far off.
+	sort.Slice(offsets, func(i, j int) bool {
+		return offsets[i] < offsets[j]
+	})
+	drift := offsets[len(offsets)/2]
+
+	clockDrift.Set(drift.Seconds())
+	if abs(drift) > s.cfg.Threshold {
+		clockDriftExceeded.Set(1)
+		log.WithFields(logrus.Fields{
+			"drift":     drift,
+			"threshold": s.cfg.Threshold,
+		}).Warn("The local clock drifts from NTP time, attestations and blocks may be made at the wrong time. " +
+			"Please synchronize the system clock")
+		return
+	}
+	clockDriftExceeded.Set(0)
+	log.WithField("drift", drift).Debug("Checked local clock drift")
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
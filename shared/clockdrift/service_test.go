@@ -0,0 +1,75 @@
+package clockdrift
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// fakeNTPServer answers SNTP requests with its local time shifted by the offset.
+func fakeNTPServer(t *testing.T, offset time.Duration) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+	go func() {
+		req := make([]byte, ntpPacketSize)
+		for {
+			_, addr, err := conn.ReadFrom(req)
+			if err != nil {
+				return
+			}
+			now := toNTPTime(time.Now().Add(offset))
+			resp := make([]byte, ntpPacketSize)
+			resp[0] = 0x1C
+			resp[1] = 1
+			copy(resp[24:32], req[40:48])
+			binary.BigEndian.PutUint64(resp[32:], now)
+			binary.BigEndian.PutUint64(resp[40:], now)
+			if _, err := conn.WriteTo(resp, addr); err != nil {
+				return
+			}
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestNTPTime_RoundTrip(t *testing.T) {
+	now := time.Now()
+	got := fromNTPTime(toNTPTime(now))
+	assert.Equal(t, true, abs(got.Sub(now)) < time.Microsecond, "Unexpected round trip of %v: %v", now, got)
+}
+
+func TestQueryOffset(t *testing.T) {
+	server := fakeNTPServer(t, 3*time.Second)
+	offset, err := queryOffset(server, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, true, abs(offset-3*time.Second) < 100*time.Millisecond, "Unexpected offset %v", offset)
+}
+
+func TestService_WarnsOnDrift(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := NewService(context.Background(), &Config{
+		Servers:   []string{fakeNTPServer(t, -2*time.Second), fakeNTPServer(t, -2*time.Second), fakeNTPServer(t, time.Hour)},
+		Threshold: time.Second,
+	})
+	s.check()
+	require.LogsContain(t, hook, "The local clock drifts from NTP time")
+	assert.NoError(t, s.Status(), "A drifting clock should not make the service unhealthy")
+
+	hook.Reset()
+	s = NewService(context.Background(), &Config{
+		Servers:   []string{fakeNTPServer(t, 0)},
+		Threshold: time.Second,
+	})
+	s.check()
+	require.LogsDoNotContain(t, hook, "The local clock drifts from NTP time")
+	assert.NoError(t, s.Status())
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -82,3 +83,13 @@ func newConfig(ctx *cli.Context) *Flags {
 	}
 	return cfg
 }
+
+// LogRotationConfig returns the log file rotation config set by the log file flags.
+func LogRotationConfig(ctx *cli.Context) *logutil.RotationConfig {
+	return &logutil.RotationConfig{
+		MaxSize:    ctx.Uint64(LogFileMaxSizeFlag.Name) << 20,
+		MaxAge:     ctx.Duration(LogFileRotationIntervalFlag.Name),
+		MaxBackups: ctx.Int(LogFileMaxBackupsFlag.Name),
+		Compress:   ctx.Bool(LogFileCompressFlag.Name),
+	}
+}
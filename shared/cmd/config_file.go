@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"github.com/urfave/cli/v2/altsrc"
+	"gopkg.in/yaml.v2"
+)
+
+// LoadFlagsFromConfig loads the values of the flags not set on the command line or by an
+// environment variable from the config file specified by --config-file, if any. Files with a
+// .toml extension are parsed as TOML, any other file as YAML.
+func LoadFlagsFromConfig(cliCtx *cli.Context, flags []cli.Flag) error {
+	if !cliCtx.IsSet(ConfigFileFlag.Name) {
+		return nil
+	}
+	source := altsrc.NewYamlSourceFromFlagFunc(ConfigFileFlag.Name)
+	if strings.EqualFold(filepath.Ext(cliCtx.String(ConfigFileFlag.Name)), ".toml") {
+		source = altsrc.NewTomlSourceFromFlagFunc(ConfigFileFlag.Name)
+	}
+	return altsrc.InitInputSourceWithContext(flags, source)(cliCtx)
+}
+
+// WithEnvVars sets an environment variable for each of the flags, named after the flag with
+// the prefix, in upper case and with dashes replaced by underscores. For example the datadir
+// flag is set by PRYSM_BEACON_DATADIR with the PRYSM_BEACON prefix. Environment variables
+// take precedence over the config file, flags on the command line over both. It returns an
+// error if a flag is of a type environment variables cannot be set for.
+func WithEnvVars(prefix string, flags []cli.Flag) ([]cli.Flag, error) {
+	for _, f := range flags {
+		envVar := EnvVarName(prefix, f.Names()[0])
+		switch t := f.(type) {
+		case *cli.BoolFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.DurationFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.GenericFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.Float64Flag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.IntFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.IntSliceFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.StringFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.StringSliceFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.Uint64Flag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		case *cli.UintFlag:
+			t.EnvVars = append(t.EnvVars, envVar)
+		default:
+			return nil, fmt.Errorf("cannot set environment variable of flag %s of type %T", f.Names()[0], f)
+		}
+	}
+	return flags, nil
+}
+
+// EnvVarName returns the name of the environment variable setting the flag.
+func EnvVarName(prefix, flagName string) string {
+	return prefix + "_" + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// DumpConfigCommand returns a command printing the effective values of the flags, after
+// loading the config file and environment variables, in the YAML format of --config-file.
+func DumpConfigCommand(flags []cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:  "dump-config",
+		Usage: "Prints the effective values of all the flags as a YAML config file",
+		Action: func(cliCtx *cli.Context) error {
+			// The flags are set on the app context, the parent of the command context.
+			appCtx := cliCtx
+			if lineage := cliCtx.Lineage(); len(lineage) > 1 {
+				appCtx = lineage[1]
+			}
+			enc, err := yaml.Marshal(EffectiveConfig(appCtx, flags))
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprint(cliCtx.App.Writer, string(enc))
+			return err
+		},
+	}
+}
+
+// EffectiveConfig returns the values of the flags by name, in a form loadable from a config file.
+func EffectiveConfig(cliCtx *cli.Context, flags []cli.Flag) map[string]interface{} {
+	config := make(map[string]interface{}, len(flags))
+	for _, f := range flags {
+		name := f.Names()[0]
+		if name == ConfigFileFlag.Name {
+			continue
+		}
+		switch v := cliCtx.Value(name).(type) {
+		case nil:
+		case time.Duration:
+			config[name] = v.String()
+		case *cli.StringSlice:
+			config[name] = v.Value()
+		case *cli.IntSlice:
+			config[name] = v.Value()
+		case bool, int, uint, uint64, float64, string:
+			config[name] = v
+		default:
+			config[name] = fmt.Sprint(v)
+		}
+	}
+	return config
+}
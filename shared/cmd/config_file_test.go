@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+func TestEnvVarName(t *testing.T) {
+	assert.Equal(t, "PRYSM_BEACON_P2P_TCP_PORT", EnvVarName("PRYSM_BEACON", "p2p-tcp-port"))
+}
+
+func TestLoadFlagsFromConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(dir))
+	}()
+	yamlFile := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte("test-name: yaml\ntest-port: 10\ntest-timeout: 5s\n"), 0600))
+	tomlFile := filepath.Join(dir, "config.toml")
+	require.NoError(t, ioutil.WriteFile(tomlFile, []byte("test-name = \"toml\"\ntest-port = 20\n"), 0600))
+
+	tests := []struct {
+		name     string
+		args     []string
+		env      string
+		wantName string
+		wantPort int
+	}{
+		{
+			name:     "yaml",
+			args:     []string{"--config-file=" + yamlFile},
+			wantName: "yaml",
+			wantPort: 10,
+		},
+		{
+			name:     "toml",
+			args:     []string{"--config-file=" + tomlFile},
+			wantName: "toml",
+			wantPort: 20,
+		},
+		{
+			name:     "environment variable overrides file",
+			args:     []string{"--config-file=" + yamlFile},
+			env:      "env",
+			wantName: "env",
+			wantPort: 10,
+		},
+		{
+			name:     "flag overrides environment variable and file",
+			args:     []string{"--config-file=" + yamlFile, "--test-name=flag", "--test-port=30"},
+			env:      "env",
+			wantName: "flag",
+			wantPort: 30,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != "" {
+				require.NoError(t, os.Setenv("PRYSM_TEST_TEST_NAME", tt.env))
+				defer func() {
+					assert.NoError(t, os.Unsetenv("PRYSM_TEST_TEST_NAME"))
+				}()
+			}
+			flags, err := WithEnvVars("PRYSM_TEST", []cli.Flag{
+				&cli.StringFlag{Name: "test-name"},
+				&cli.IntFlag{Name: "test-port"},
+				&cli.DurationFlag{Name: "test-timeout"},
+			})
+			require.NoError(t, err)
+			flags = WrapFlags(append(flags, ConfigFileFlag))
+			var config map[string]interface{}
+			app := &cli.App{
+				Flags: flags,
+				Before: func(cliCtx *cli.Context) error {
+					return LoadFlagsFromConfig(cliCtx, flags)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					config = EffectiveConfig(cliCtx, flags)
+					return nil
+				},
+			}
+			require.NoError(t, app.Run(append([]string{"test"}, tt.args...)))
+			assert.Equal(t, tt.wantName, config["test-name"])
+			assert.Equal(t, tt.wantPort, config["test-port"])
+			if tt.name == "yaml" {
+				assert.Equal(t, (5 * time.Second).String(), config["test-timeout"])
+			}
+			_, ok := config[ConfigFileFlag.Name]
+			assert.Equal(t, false, ok, "Config file flag should not be part of the effective config")
+		})
+	}
+}
+
+func TestWithEnvVars_UnsupportedFlag(t *testing.T) {
+	_, err := WithEnvVars("PRYSM_TEST", []cli.Flag{&cli.Int64Flag{Name: "test-time"}})
+	assert.ErrorContains(t, "cannot set environment variable of flag test-time", err)
+}
+
+func TestDumpConfigCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(dir))
+	}()
+	yamlFile := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte("test-name: yaml\ntest-port: 10\n"), 0600))
+
+	flags, err := WithEnvVars("PRYSM_TEST", []cli.Flag{
+		&cli.StringFlag{Name: "test-name"},
+		&cli.IntFlag{Name: "test-port"},
+		&cli.StringSliceFlag{Name: "test-peers"},
+	})
+	require.NoError(t, err)
+	flags = WrapFlags(append(flags, ConfigFileFlag))
+	out := new(bytes.Buffer)
+	app := &cli.App{
+		Flags:    flags,
+		Commands: []*cli.Command{DumpConfigCommand(flags)},
+		Writer:   out,
+		Before: func(cliCtx *cli.Context) error {
+			return LoadFlagsFromConfig(cliCtx, flags)
+		},
+	}
+	require.NoError(t, app.Run([]string{"test", "--config-file=" + yamlFile, "--test-port=20", "--test-peers=a", "--test-peers=b", "dump-config"}))
+
+	// The dumped config loads back into the same values.
+	var config map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &config))
+	assert.Equal(t, "yaml", config["test-name"])
+	assert.Equal(t, 20, config["test-port"])
+	assert.DeepEqual(t, []interface{}{"a", "b"}, config["test-peers"])
+	_, ok := config[ConfigFileFlag.Name]
+	assert.Equal(t, false, ok, "Config file flag should not be dumped")
+}
@@ -28,6 +28,12 @@ var (
 		Usage: "Logging verbosity (trace, debug, info=default, warn, error, fatal, panic)",
 		Value: "info",
 	}
+	// LogModuleVerbosityFlag defines per module overrides of the logging verbosity.
+	LogModuleVerbosityFlag = &cli.StringSliceFlag{
+		Name: "log-module-verbosity",
+		Usage: "Logging verbosity of specific modules, overriding --verbosity for them. " +
+			"Modules are given as module=level pairs, e.g. sync=debug,p2p=warn",
+	}
 	// DataDirFlag defines a path on disk.
 	DataDirFlag = &cli.StringFlag{
 		Name:  "datadir",
@@ -46,10 +52,17 @@ var (
 	}
 	// TracingEndpointFlag flag defines the http endpoint for serving traces to Jaeger.
 	TracingEndpointFlag = &cli.StringFlag{
-		Name:  "tracing-endpoint",
-		Usage: "Tracing endpoint defines where beacon chain traces are exposed to Jaeger.",
+		Name: "tracing-endpoint",
+		Usage: "Tracing endpoint defines where beacon chain traces are exposed to Jaeger, or to an OpenTelemetry " +
+			"collector such as http://127.0.0.1:4318/v1/traces with --tracing-exporter=otlp.",
 		Value: "http://127.0.0.1:14268/api/traces",
 	}
+	// TracingExporterFlag defines the protocol the traces are exported with.
+	TracingExporterFlag = &cli.StringFlag{
+		Name:  "tracing-exporter",
+		Usage: "The protocol traces are exported to the tracing endpoint with: jaeger, or otlp for the OTLP/HTTP protocol",
+		Value: "jaeger",
+	}
 	// TraceSampleFractionFlag defines a flag to indicate what fraction of p2p
 	// messages are sampled for tracing.
 	TraceSampleFractionFlag = &cli.Float64Flag{
@@ -63,6 +76,16 @@ var (
 		Usage: "Host used for listening and responding metrics for prometheus.",
 		Value: "127.0.0.1",
 	}
+	// MonitoringDebugEndpointsFlag defines a flag to serve the pprof and expvar endpoints on the monitoring port.
+	MonitoringDebugEndpointsFlag = &cli.BoolFlag{
+		Name:  "enable-monitoring-debug-endpoints",
+		Usage: "Serve the pprof and expvar endpoints under /debug on the monitoring port.",
+	}
+	// MonitoringDebugAuthTokenFlag defines the bearer token required by the debug endpoints of the monitoring port.
+	MonitoringDebugAuthTokenFlag = &cli.StringFlag{
+		Name:  "monitoring-debug-auth-token",
+		Usage: "Bearer token required in the Authorization header of the monitoring port debug endpoints. No token is required if empty.",
+	}
 	// DisableMonitoringFlag defines a flag to disable the metrics collection.
 	DisableMonitoringFlag = &cli.BoolFlag{
 		Name:  "disable-monitoring",
@@ -181,6 +204,27 @@ var (
 		Name:  "log-file",
 		Usage: "Specify log file name, relative or absolute",
 	}
+	// LogFileMaxSizeFlag specifies the size after which the log file is rotated.
+	LogFileMaxSizeFlag = &cli.Uint64Flag{
+		Name:  "log-file-max-size-mb",
+		Usage: "Rotate the log file once it reaches this size in megabytes. Disabled if 0",
+	}
+	// LogFileRotationIntervalFlag specifies the duration after which the log file is rotated.
+	LogFileRotationIntervalFlag = &cli.DurationFlag{
+		Name:  "log-file-rotation-interval",
+		Usage: "Rotate the log file once it has been written to for this duration, e.g. 24h. Disabled if 0",
+	}
+	// LogFileMaxBackupsFlag specifies the number of rotated log files kept.
+	LogFileMaxBackupsFlag = &cli.IntFlag{
+		Name:  "log-file-max-backups",
+		Usage: "Maximum number of rotated log files to keep, the oldest ones are deleted first. All of them are kept if 0",
+		Value: 10,
+	}
+	// LogFileCompressFlag specifies whether rotated log files are compressed.
+	LogFileCompressFlag = &cli.BoolFlag{
+		Name:  "log-file-compress",
+		Usage: "Compress rotated log files with gzip",
+	}
 	// EnableUPnPFlag specifies if UPnP should be enabled or not. The default value is false.
 	EnableUPnPFlag = &cli.BoolFlag{
 		Name:  "enable-upnp",
@@ -189,7 +233,7 @@ var (
 	// ConfigFileFlag specifies the filepath to load flag values.
 	ConfigFileFlag = &cli.StringFlag{
 		Name:  "config-file",
-		Usage: "The filepath to a yaml file with flag values",
+		Usage: "The filepath to a YAML or TOML (.toml extension) file with flag values",
 	}
 	// ChainConfigFileFlag specifies the filepath to load flag values.
 	ChainConfigFileFlag = &cli.StringFlag{
@@ -22,6 +22,8 @@ func WrapFlags(flags []cli.Flag) []cli.Flag {
 			f = altsrc.NewFloat64Flag(t)
 		case *cli.IntFlag:
 			f = altsrc.NewIntFlag(t)
+		case *cli.IntSliceFlag:
+			f = altsrc.NewIntSliceFlag(t)
 		case *cli.StringFlag:
 			f = altsrc.NewStringFlag(t)
 		case *cli.StringSliceFlag:
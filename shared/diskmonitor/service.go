@@ -0,0 +1,122 @@
+// Package diskmonitor defines a service periodically sampling the size of a node's
+// data directory and the free space of its disk, exporting them as metrics and
+// warning when the free disk space falls below a threshold.
+package diskmonitor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	sigar "github.com/elastic/gosigar"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "diskmonitor")
+
+// The bolt database statistics, such as its free pages, open transactions and write
+// times, are already exported by the bolt collector of the database.
+var (
+	dataDirSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "data_directory_size_bytes",
+		Help: "The total size of the files in the data directory",
+	})
+	freeDiskSpace = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "data_directory_free_disk_bytes",
+		Help: "The disk space available to the node on the disk of the data directory",
+	})
+)
+
+// Config options for the disk monitor service.
+type Config struct {
+	DataDir string
+	// FreeDiskWarningThreshold is the free disk space, in bytes, below which a warning is logged.
+	FreeDiskWarningThreshold uint64
+	SampleInterval           time.Duration
+}
+
+// Service periodically samples the disk usage of the data directory.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+}
+
+// NewService creates a disk monitor service for the data directory.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start sampling the disk usage.
+func (s *Service) Start() {
+	s.sample()
+	runutil.RunEvery(s.ctx, s.cfg.SampleInterval, s.sample)
+}
+
+// Stop sampling the disk usage.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the disk monitor service.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) sample() {
+	size, err := directorySize(s.cfg.DataDir)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute data directory size")
+	} else {
+		dataDirSize.Set(float64(size))
+	}
+
+	usage := sigar.FileSystemUsage{}
+	if err := usage.Get(s.cfg.DataDir); err != nil {
+		log.WithError(err).Debug("Could not retrieve data directory disk usage")
+		return
+	}
+	avail := usage.Avail
+	freeDiskSpace.Set(float64(avail))
+	if avail < s.cfg.FreeDiskWarningThreshold {
+		log.WithFields(logrus.Fields{
+			"dataDir":       s.cfg.DataDir,
+			"freeDiskMB":    avail / (1 << 20),
+			"thresholdMB":   s.cfg.FreeDiskWarningThreshold / (1 << 20),
+			"dataDirSizeMB": size / (1 << 20),
+		}).Warn("Free disk space of the data directory is low")
+	}
+}
+
+// directorySize returns the total size of the regular files in the directory.
+func directorySize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			// Files may be removed while walking the directory.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not walk directory")
+	}
+	return size, nil
+}
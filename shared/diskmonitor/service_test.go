@@ -0,0 +1,45 @@
+package diskmonitor
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestDirectorySize(t *testing.T) {
+	dir, err := ioutil.TempDir(testutil.TempDir(), "diskmonitor")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a"), make([]byte, 100), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sub", "b"), make([]byte, 50), 0600))
+
+	size, err := directorySize(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(150), size)
+}
+
+func TestService_WarnsOnLowFreeDisk(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := NewService(context.Background(), &Config{
+		DataDir:                  testutil.TempDir(),
+		FreeDiskWarningThreshold: math.MaxUint64,
+	})
+	s.sample()
+	require.LogsContain(t, hook, "Free disk space of the data directory is low")
+
+	hook.Reset()
+	s.cfg.FreeDiskWarningThreshold = 0
+	s.sample()
+	require.LogsDoNotContain(t, hook, "Free disk space of the data directory is low")
+}
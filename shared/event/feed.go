@@ -21,6 +21,7 @@ import (
 	"errors"
 	"reflect"
 	"sync"
+	"time"
 )
 
 var errBadChannel = errors.New("event: Subscribe argument does not have sendable channel type")
@@ -32,8 +33,10 @@ var errBadChannel = errors.New("event: Subscribe argument does not have sendable
 // Subscribe operation. Subsequent calls to these methods panic if the type does not
 // match.
 //
-// The zero value is ready to use.
+// The zero value is ready to use. The name of the feed labels its metrics.
 type Feed struct {
+	Name string
+
 	once      sync.Once        // ensures that init only runs once
 	sendLock  chan struct{}    // sendLock has a one-element buffer and is empty when held.It protects sendCases.
 	removeSub chan interface{} // interrupts Send
@@ -43,6 +46,8 @@ type Feed struct {
 	mu    sync.Mutex
 	inbox caseList
 	etype reflect.Type
+	// dropping holds the channels to which values are dropped instead of blocking Send when they are full.
+	dropping map[interface{}]bool
 }
 
 // This is the index of the first actual subscription channel in sendCases.
@@ -71,6 +76,18 @@ func (f *Feed) init() {
 // The channel should have ample buffer space to avoid blocking other subscribers.
 // Slow subscribers are not dropped.
 func (f *Feed) Subscribe(channel interface{}) Subscription {
+	return f.subscribe(channel, false)
+}
+
+// SubscribeDropping adds a channel to the feed, like Subscribe, but sends never block on
+// the channel: when its buffer is full, the value is dropped for this subscriber and
+// counted in the dropped values metric of the feed. This protects the sender from slow
+// subscribers, such as RPC streams, at the cost of the subscriber missing values.
+func (f *Feed) SubscribeDropping(channel interface{}) Subscription {
+	return f.subscribe(channel, true)
+}
+
+func (f *Feed) subscribe(channel interface{}, dropping bool) Subscription {
 	f.once.Do(f.init)
 
 	chanval := reflect.ValueOf(channel)
@@ -89,9 +106,22 @@ func (f *Feed) Subscribe(channel interface{}) Subscription {
 	// The next Send will add it to f.sendCases.
 	cas := reflect.SelectCase{Dir: reflect.SelectSend, Chan: chanval}
 	f.inbox = append(f.inbox, cas)
+	if dropping {
+		if f.dropping == nil {
+			f.dropping = make(map[interface{}]bool)
+		}
+		f.dropping[channel] = true
+	}
 	return sub
 }
 
+// isDropping returns whether values are dropped instead of blocking when the channel is full.
+func (f *Feed) isDropping(channel reflect.Value) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.dropping[channel.Interface()]
+}
+
 // note: callers must hold f.mu
 func (f *Feed) typecheck(typ reflect.Type) bool {
 	if f.etype == nil {
@@ -106,6 +136,7 @@ func (f *Feed) remove(sub *feedSub) {
 	// that have not been added to f.sendCases yet.
 	ch := sub.channel.Interface()
 	f.mu.Lock()
+	delete(f.dropping, ch)
 	index := f.inbox.find(ch)
 	if index != -1 {
 		f.inbox = f.inbox.delete(index)
@@ -127,6 +158,7 @@ func (f *Feed) remove(sub *feedSub) {
 // Send delivers to all subscribed channels simultaneously.
 // It returns the number of subscribers that the value was sent to.
 func (f *Feed) Send(value interface{}) (nsent int) {
+	start := time.Now()
 	rvalue := reflect.ValueOf(value)
 
 	f.once.Do(f.init)
@@ -144,8 +176,10 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 	f.mu.Unlock()
 
 	// Set the sent value on all channels.
+	m := metricsFor(f.Name)
 	for i := firstSubSendCase; i < len(f.sendCases); i++ {
 		f.sendCases[i].Send = rvalue
+		m.queueDepth.Observe(float64(f.sendCases[i].Chan.Len()))
 	}
 
 	// Send until all channels except removeSub have been chosen. 'cases' tracks a prefix
@@ -161,6 +195,10 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 				nsent++
 				cases = cases.deactivate(i)
 				i--
+			} else if f.isDropping(cases[i].Chan) {
+				m.dropped.Inc()
+				cases = cases.deactivate(i)
+				i--
 			}
 		}
 		if len(cases) == firstSubSendCase {
@@ -186,6 +224,7 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 		f.sendCases[i].Send = reflect.Value{}
 	}
 	f.sendLock <- struct{}{}
+	m.sendLatency.Observe(float64(time.Since(start).Microseconds()) / 1000)
 	return nsent
 }
 
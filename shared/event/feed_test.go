@@ -331,3 +331,43 @@ func BenchmarkFeedSend1000(b *testing.B) {
 	b.StopTimer()
 	done.Wait()
 }
+
+func TestFeedSubscribeDropping(t *testing.T) {
+	var (
+		feed     Feed
+		slow     = make(chan int, 1)
+		blocking = make(chan int, 1)
+	)
+	slowSub := feed.SubscribeDropping(slow)
+	blockingSub := feed.Subscribe(blocking)
+	defer blockingSub.Unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 3; i++ {
+			if <-blocking != i {
+				t.Errorf("Received unexpected value on the blocking channel")
+			}
+		}
+	}()
+	// The second and third values do not fit in the buffer of the dropping subscriber,
+	// but sending them must not block.
+	for i := 0; i < 3; i++ {
+		feed.Send(i)
+	}
+	<-done
+	if v := <-slow; v != 0 {
+		t.Errorf("Received unexpected value %d on the dropping channel", v)
+	}
+	select {
+	case v := <-slow:
+		t.Errorf("Received unexpected value %d on the dropping channel", v)
+	default:
+	}
+
+	slowSub.Unsubscribe()
+	if len(feed.dropping) != 0 {
+		t.Errorf("Dropping channel was not removed on unsubscribe")
+	}
+}
@@ -0,0 +1,56 @@
+package event
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	feedSendLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "feed_send_latency_milliseconds",
+		Help:    "The time spent delivering a value to all the subscribers of a feed, by feed",
+		Buckets: []float64{0.01, 0.1, 1, 10, 100, 1000, 10000},
+	}, []string{"feed"})
+	feedQueueDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "feed_subscriber_queue_depth",
+		Help:    "The number of values buffered in the channel of a subscriber when a value is sent, by feed",
+		Buckets: []float64{0, 1, 2, 4, 8, 16, 32, 64, 128},
+	}, []string{"feed"})
+	feedDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "feed_dropped_values_total",
+		Help: "The number of values dropped for subscribers whose channel was full, by feed",
+	}, []string{"feed"})
+)
+
+// feedMetrics are the metrics of a feed, with their labels resolved once.
+type feedMetrics struct {
+	sendLatency prometheus.Observer
+	queueDepth  prometheus.Observer
+	dropped     prometheus.Counter
+}
+
+var (
+	metricsByFeed     = make(map[string]*feedMetrics)
+	metricsByFeedLock sync.Mutex
+)
+
+// metricsFor returns the metrics of the feed with the given name, unnamed feeds sharing the same metrics.
+func metricsFor(name string) *feedMetrics {
+	if name == "" {
+		name = "unnamed"
+	}
+	metricsByFeedLock.Lock()
+	defer metricsByFeedLock.Unlock()
+	m, ok := metricsByFeed[name]
+	if !ok {
+		m = &feedMetrics{
+			sendLatency: feedSendLatency.WithLabelValues(name),
+			queueDepth:  feedQueueDepth.WithLabelValues(name),
+			dropped:     feedDropped.WithLabelValues(name),
+		}
+		metricsByFeed[name] = m
+	}
+	return m
+}
@@ -20,6 +20,8 @@ The process for implementing new features using this package is as follows:
 package featureconfig
 
 import (
+	"fmt"
+
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -28,6 +30,9 @@ import (
 
 var log = logrus.WithField("prefix", "flags")
 
+// forkChoiceTieBreakers are the rules the fork choice can break ties between branches with.
+var forkChoiceTieBreakers = []string{"highest_root", "lowest_root", "first_seen"}
+
 // Flags is a struct to represent which features the client will perform on runtime.
 type Flags struct {
 	// State locks
@@ -85,6 +90,7 @@ type Flags struct {
 
 	KafkaBootstrapServers          string // KafkaBootstrapServers to find kafka servers to stream blocks, attestations, etc.
 	AttestationAggregationStrategy string // AttestationAggregationStrategy defines aggregation strategy to be used when aggregating.
+	ForkChoiceTieBreaker           string // ForkChoiceTieBreaker defines the rule breaking ties between fork choice branches of equal weight.
 }
 
 var featureConfig *Flags
@@ -113,9 +119,11 @@ func InitWithReset(c *Flags) func() {
 
 // ConfigureBeaconChain sets the global config based
 // on what flags are enabled for the beacon-chain client.
-func ConfigureBeaconChain(ctx *cli.Context) {
+// It returns an error if a flag has an invalid value.
+func ConfigureBeaconChain(ctx *cli.Context) error {
 	// Using Medalla as the default configuration for now.
 	params.UseMedallaConfig()
+	configureNetwork(ctx)
 
 	complainOnDeprecatedFlags(ctx)
 	cfg := &Flags{}
@@ -232,6 +240,16 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 	}
 	cfg.AttestationAggregationStrategy = ctx.String(attestationAggregationStrategy.Name)
 	log.Infof("Using %q strategy on attestation aggregation", cfg.AttestationAggregationStrategy)
+	cfg.ForkChoiceTieBreaker = ctx.String(forkChoiceTieBreaker.Name)
+	if cfg.ForkChoiceTieBreaker == "" {
+		cfg.ForkChoiceTieBreaker = forkChoiceTieBreaker.Value
+	}
+	if !isForkChoiceTieBreaker(cfg.ForkChoiceTieBreaker) {
+		return fmt.Errorf("invalid --%s %q, must be one of %v", forkChoiceTieBreaker.Name, cfg.ForkChoiceTieBreaker, forkChoiceTieBreakers)
+	}
+	if cfg.ForkChoiceTieBreaker != forkChoiceTieBreaker.Value {
+		log.Warnf("Using %q rule to break fork choice ties, this deviates from the spec", cfg.ForkChoiceTieBreaker)
+	}
 
 	cfg.NewBeaconStateLocks = true
 	if ctx.Bool(disableNewBeaconStateLocks.Name) {
@@ -266,6 +284,16 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		cfg.EnableRoughtime = true
 	}
 	Init(cfg)
+	return nil
+}
+
+func isForkChoiceTieBreaker(name string) bool {
+	for _, rule := range forkChoiceTieBreakers {
+		if name == rule {
+			return true
+		}
+	}
+	return false
 }
 
 // ConfigureSlasher sets the global config based
@@ -273,6 +301,7 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 func ConfigureSlasher(ctx *cli.Context) {
 	// Using Medalla as the default configuration for now.
 	params.UseMedallaConfig()
+	configureNetwork(ctx)
 
 	complainOnDeprecatedFlags(ctx)
 	cfg := &Flags{}
@@ -288,6 +317,7 @@ func ConfigureSlasher(ctx *cli.Context) {
 func ConfigureValidator(ctx *cli.Context) {
 	// Using Medalla as the default configuration for now.
 	params.UseMedallaConfig()
+	configureNetwork(ctx)
 
 	complainOnDeprecatedFlags(ctx)
 	cfg := &Flags{}
@@ -345,3 +375,16 @@ func complainOnDeprecatedFlags(ctx *cli.Context) {
 		}
 	}
 }
+
+// configureNetwork sets the chain and network configs to the preset of the network
+// selected with --network, if any.
+func configureNetwork(ctx *cli.Context) {
+	if !ctx.IsSet(NetworkFlag.Name) {
+		return
+	}
+	network := ctx.String(NetworkFlag.Name)
+	if err := params.UseNetworkPreset(network); err != nil {
+		log.WithError(err).Fatal("Could not use network preset")
+	}
+	log.WithField("network", network).Info("Running on network preset")
+}
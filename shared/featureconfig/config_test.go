@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/urfave/cli/v2"
 )
 
@@ -26,7 +27,22 @@ func TestConfigureBeaconConfig(t *testing.T) {
 	set := flag.NewFlagSet("test", 0)
 	set.Bool(skipBLSVerifyFlag.Name, true, "test")
 	context := cli.NewContext(&app, set, nil)
-	ConfigureBeaconChain(context)
+	require.NoError(t, ConfigureBeaconChain(context))
 	c := Get()
 	assert.Equal(t, true, c.SkipBLSVerify)
 }
+
+func TestConfigureBeaconConfig_ForkChoiceTieBreaker(t *testing.T) {
+	for _, rule := range []string{"highest_root", "lowest_root", "first_seen"} {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(forkChoiceTieBreaker.Name, rule, "test")
+		require.NoError(t, ConfigureBeaconChain(cli.NewContext(&app, set, nil)))
+		assert.Equal(t, rule, Get().ForkChoiceTieBreaker)
+	}
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(forkChoiceTieBreaker.Name, "highest-root", "test")
+	assert.ErrorContains(t, "invalid --fork-choice-tie-breaker", ConfigureBeaconChain(cli.NewContext(&app, set, nil)))
+}
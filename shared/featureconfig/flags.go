@@ -1,6 +1,9 @@
 package featureconfig
 
 import (
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
 
@@ -15,6 +18,12 @@ var (
 		Name:  "onyx",
 		Usage: "This defines the flag through which we can run on the Onyx Prysm Testnet",
 	}
+	// NetworkFlag defines the preset of the network to run on.
+	NetworkFlag = &cli.StringFlag{
+		Name: "network",
+		Usage: "Runs on the network with the name, setting its chain config, fork schedule, deposit contract " +
+			"and bootnodes at once. One of " + strings.Join(params.NetworkPresetNames(), ", "),
+	}
 	devModeFlag = &cli.BoolFlag{
 		Name:  "dev",
 		Usage: "Enable experimental features still in development. These features may not be stable.",
@@ -138,6 +147,12 @@ var (
 		Usage: "Which strategy to use when aggregating attestations, one of: naive, max_cover.",
 		Value: "max_cover",
 	}
+	forkChoiceTieBreaker = &cli.StringFlag{
+		Name: "fork-choice-tie-breaker",
+		Usage: "Which rule to use to break ties between fork choice branches of equal weight, one of: " +
+			"highest_root, lowest_root, first_seen. Anything but highest_root deviates from the spec and is only meant for devnets.",
+		Value: "highest_root",
+	}
 	disableNewBeaconStateLocks = &cli.BoolFlag{
 		Name:  "disable-new-beacon-state-locks",
 		Usage: "Disable new beacon state locking",
@@ -615,12 +630,14 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	waitForSyncedFlag,
 	AltonaTestnet,
 	OnyxTestnet,
+	NetworkFlag,
 	disableAccountsV2,
 }...)
 
 // SlasherFlags contains a list of all the feature flags that apply to the slasher client.
 var SlasherFlags = append(deprecatedFlags, []cli.Flag{
 	disableLookbackFlag,
+	NetworkFlag,
 }...)
 
 // E2EValidatorFlags contains a list of the validator feature flags to be tested in E2E.
@@ -655,9 +672,11 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	disableReduceAttesterStateCopy,
 	disableGRPCConnectionLogging,
 	attestationAggregationStrategy,
+	forkChoiceTieBreaker,
 	disableNewBeaconStateLocks,
 	AltonaTestnet,
 	OnyxTestnet,
+	NetworkFlag,
 	batchBlockVerify,
 	initSyncVerbose,
 	enableFinalizedDepositsCache,
@@ -0,0 +1,36 @@
+package grpcutils
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// UnixSocketPrefix prefixes gRPC endpoints served over a unix domain socket, as in
+// unix:///var/run/beacon.sock.
+const UnixSocketPrefix = "unix://"
+
+// UnixSocketPath returns the path of the socket of an endpoint served over a unix domain
+// socket, and false for any other endpoint.
+func UnixSocketPath(endpoint string) (string, bool) {
+	if !strings.HasPrefix(endpoint, UnixSocketPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(endpoint, UnixSocketPrefix), true
+}
+
+// DialTarget returns the target to dial for the endpoint, along with the dial options
+// required to reach it. Unix domain socket endpoints are dialed by socket path.
+func DialTarget(endpoint string) (string, []grpc.DialOption) {
+	path, ok := UnixSocketPath(endpoint)
+	if !ok {
+		return endpoint, nil
+	}
+	dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", addr)
+	}
+	return path, []grpc.DialOption{grpc.WithContextDialer(dialer)}
+}
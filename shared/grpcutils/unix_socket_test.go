@@ -0,0 +1,16 @@
+package grpcutils
+
+import (
+	"testing"
+)
+
+func TestDialTarget(t *testing.T) {
+	target, opts := DialTarget("127.0.0.1:4000")
+	if target != "127.0.0.1:4000" || len(opts) != 0 {
+		t.Errorf("Expected TCP endpoint to be dialed as is, received %s with %d options", target, len(opts))
+	}
+	target, opts = DialTarget("unix:///var/run/beacon.sock")
+	if target != "/var/run/beacon.sock" || len(opts) != 1 {
+		t.Errorf("Expected unix socket path with a dialer, received %s with %d options", target, len(opts))
+	}
+}
@@ -32,10 +32,43 @@ func GenerateGenesisState(genesisTime, numValidators uint64) (*pb.BeaconState, [
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "could not deterministically generate keys for %d validators", numValidators)
 	}
+	return GenerateGenesisStateFromKeys(genesisTime, privKeys, pubKeys)
+}
+
+// GenerateGenesisStateFromMnemonic deterministically generates a genesis state with the given number
+// of validators, whose keys are derived from the mnemonic as done by the genesis tools of other clients.
+// If a genesis time of 0 is supplied it is set to the current time.
+func GenerateGenesisStateFromMnemonic(genesisTime uint64, mnemonic string, numValidators uint64) (*pb.BeaconState, []*ethpb.Deposit, error) {
+	validatingKeys, withdrawalKeys, err := KeysFromMnemonic(mnemonic, 0 /*startIndex*/, numValidators)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not derive keys for %d validators from mnemonic", numValidators)
+	}
+	pubKeys := make([]bls.PublicKey, numValidators)
+	withdrawalPubKeys := make([]bls.PublicKey, numValidators)
+	for i := range validatingKeys {
+		pubKeys[i] = validatingKeys[i].PublicKey()
+		withdrawalPubKeys[i] = withdrawalKeys[i].PublicKey()
+	}
+	depositDataItems, depositDataRoots, err := depositData(validatingKeys, pubKeys, withdrawalPubKeys)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not generate deposit data from keys")
+	}
+	return generateGenesisStateFromDepositData(genesisTime, depositDataItems, depositDataRoots)
+}
+
+// GenerateGenesisStateFromKeys generates a genesis state with a validator for each of the given keys.
+// If a genesis time of 0 is supplied it is set to the current time.
+func GenerateGenesisStateFromKeys(genesisTime uint64, privKeys []bls.SecretKey, pubKeys []bls.PublicKey) (*pb.BeaconState, []*ethpb.Deposit, error) {
 	depositDataItems, depositDataRoots, err := DepositDataFromKeys(privKeys, pubKeys)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not generate deposit data from keys")
 	}
+	return generateGenesisStateFromDepositData(genesisTime, depositDataItems, depositDataRoots)
+}
+
+func generateGenesisStateFromDepositData(
+	genesisTime uint64, depositDataItems []*ethpb.Deposit_Data, depositDataRoots [][]byte,
+) (*pb.BeaconState, []*ethpb.Deposit, error) {
 	trie, err := trieutil.GenerateTrieFromItems(
 		depositDataRoots,
 		int(params.BeaconConfig().DepositContractTreeDepth),
@@ -99,6 +132,11 @@ func generateDepositsFromData(depositDataItems []*ethpb.Deposit_Data, offset int
 
 // DepositDataFromKeys generates a list of deposit data items from a set of BLS validator keys.
 func DepositDataFromKeys(privKeys []bls.SecretKey, pubKeys []bls.PublicKey) ([]*ethpb.Deposit_Data, [][]byte, error) {
+	return depositData(privKeys, pubKeys, pubKeys)
+}
+
+// depositData generates the deposit data items of the validator keys, withdrawing to the withdrawal keys.
+func depositData(privKeys []bls.SecretKey, pubKeys []bls.PublicKey, withdrawalPubKeys []bls.PublicKey) ([]*ethpb.Deposit_Data, [][]byte, error) {
 	type depositData struct {
 		items []*ethpb.Deposit_Data
 		roots [][]byte
@@ -106,7 +144,9 @@ func DepositDataFromKeys(privKeys []bls.SecretKey, pubKeys []bls.PublicKey) ([]*
 	depositDataItems := make([]*ethpb.Deposit_Data, len(privKeys))
 	depositDataRoots := make([][]byte, len(privKeys))
 	results, err := mputil.Scatter(len(privKeys), func(offset int, entries int, _ *sync.RWMutex) (interface{}, error) {
-		items, roots, err := depositDataFromKeys(privKeys[offset:offset+entries], pubKeys[offset:offset+entries])
+		items, roots, err := depositDataFromKeys(
+			privKeys[offset:offset+entries], pubKeys[offset:offset+entries], withdrawalPubKeys[offset:offset+entries],
+		)
 		return &depositData{items: items, roots: roots}, err
 	})
 	if err != nil {
@@ -123,11 +163,11 @@ func DepositDataFromKeys(privKeys []bls.SecretKey, pubKeys []bls.PublicKey) ([]*
 	return depositDataItems, depositDataRoots, nil
 }
 
-func depositDataFromKeys(privKeys []bls.SecretKey, pubKeys []bls.PublicKey) ([]*ethpb.Deposit_Data, [][]byte, error) {
+func depositDataFromKeys(privKeys []bls.SecretKey, pubKeys []bls.PublicKey, withdrawalPubKeys []bls.PublicKey) ([]*ethpb.Deposit_Data, [][]byte, error) {
 	dataRoots := make([][]byte, len(privKeys))
 	depositDataItems := make([]*ethpb.Deposit_Data, len(privKeys))
 	for i := 0; i < len(privKeys); i++ {
-		data, err := createDepositData(privKeys[i], pubKeys[i])
+		data, err := createDepositData(privKeys[i], pubKeys[i], withdrawalPubKeys[i])
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "could not create deposit data for key: %#x", privKeys[i].Marshal())
 		}
@@ -142,10 +182,10 @@ func depositDataFromKeys(privKeys []bls.SecretKey, pubKeys []bls.PublicKey) ([]*
 }
 
 // Generates a deposit data item from BLS keys and signs the hash tree root of the data.
-func createDepositData(privKey bls.SecretKey, pubKey bls.PublicKey) (*ethpb.Deposit_Data, error) {
+func createDepositData(privKey bls.SecretKey, pubKey bls.PublicKey, withdrawalPubKey bls.PublicKey) (*ethpb.Deposit_Data, error) {
 	di := &ethpb.Deposit_Data{
 		PublicKey:             pubKey.Marshal(),
-		WithdrawalCredentials: withdrawalCredentialsHash(pubKey.Marshal()),
+		WithdrawalCredentials: withdrawalCredentialsHash(withdrawalPubKey.Marshal()),
 		Amount:                params.BeaconConfig().MaxEffectiveBalance,
 	}
 	sr, err := ssz.SigningRoot(di)
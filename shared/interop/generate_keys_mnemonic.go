@@ -0,0 +1,72 @@
+package interop
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/mputil"
+	"github.com/tyler-smith/go-bip39"
+	util "github.com/wealdtech/go-eth2-util"
+)
+
+const (
+	// validatingKeyPathTemplate is the EIP-2334 path of the signing key of a validator.
+	validatingKeyPathTemplate = "m/12381/3600/%d/0/0"
+	// withdrawalKeyPathTemplate is the EIP-2334 path of the withdrawal key of a validator.
+	withdrawalKeyPathTemplate = "m/12381/3600/%d/0"
+)
+
+// KeysFromMnemonic derives the validating and withdrawal private keys of the validators at the
+// indices from a BIP-39 mnemonic, along the EIP-2334 paths also used by the deposit tooling and
+// the genesis tools of other clients.
+func KeysFromMnemonic(mnemonic string, startIndex, numKeys uint64) ([]bls.SecretKey, []bls.SecretKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid mnemonic")
+	}
+	validatingKeys := make([]bls.SecretKey, numKeys)
+	withdrawalKeys := make([]bls.SecretKey, numKeys)
+	type keys struct {
+		validating []bls.SecretKey
+		withdrawal []bls.SecretKey
+	}
+	results, err := mputil.Scatter(int(numKeys), func(offset int, entries int, _ *sync.RWMutex) (interface{}, error) {
+		k := &keys{
+			validating: make([]bls.SecretKey, entries),
+			withdrawal: make([]bls.SecretKey, entries),
+		}
+		var err error
+		for i := 0; i < entries; i++ {
+			index := startIndex + uint64(offset+i)
+			if k.validating[i], err = deriveKey(seed, fmt.Sprintf(validatingKeyPathTemplate, index)); err != nil {
+				return nil, err
+			}
+			if k.withdrawal[i], err = deriveKey(seed, fmt.Sprintf(withdrawalKeyPathTemplate, index)); err != nil {
+				return nil, err
+			}
+		}
+		return k, nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to derive keys")
+	}
+	for _, result := range results {
+		if keysExtent, ok := result.Extent.(*keys); ok {
+			copy(validatingKeys[result.Offset:], keysExtent.validating)
+			copy(withdrawalKeys[result.Offset:], keysExtent.withdrawal)
+		} else {
+			return nil, nil, errors.New("extent not of expected type")
+		}
+	}
+	return validatingKeys, withdrawalKeys, nil
+}
+
+func deriveKey(seed []byte, path string) (bls.SecretKey, error) {
+	key, err := util.PrivateKeyFromSeedAndPath(seed, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not derive key at path %s", path)
+	}
+	return bls.SecretKeyFromBytes(key.Marshal())
+}
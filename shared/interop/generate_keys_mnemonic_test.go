@@ -0,0 +1,53 @@
+package interop_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestKeysFromMnemonic(t *testing.T) {
+	validating, withdrawal, err := interop.KeysFromMnemonic(testMnemonic, 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(validating))
+	require.Equal(t, 4, len(withdrawal))
+	seen := make(map[string]bool)
+	for i := range validating {
+		seen[string(validating[i].Marshal())] = true
+		seen[string(withdrawal[i].Marshal())] = true
+	}
+	assert.Equal(t, 8, len(seen), "Expected distinct keys")
+
+	// Keys only depend on the mnemonic and the validator index.
+	offset, _, err := interop.KeysFromMnemonic(testMnemonic, 2, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, validating[2].Marshal(), offset[0].Marshal())
+	assert.DeepEqual(t, validating[3].Marshal(), offset[1].Marshal())
+
+	_, _, err = interop.KeysFromMnemonic("not a mnemonic", 0, 1)
+	assert.ErrorContains(t, "invalid mnemonic", err)
+}
+
+func TestGenerateGenesisStateFromMnemonic(t *testing.T) {
+	genesisState, deposits, err := interop.GenerateGenesisStateFromMnemonic(1600000000, testMnemonic, 8)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(genesisState.Validators))
+	assert.Equal(t, 8, len(deposits))
+	assert.Equal(t, uint64(1600000000), genesisState.GenesisTime)
+
+	validating, withdrawal, err := interop.KeysFromMnemonic(testMnemonic, 0, 8)
+	require.NoError(t, err)
+	for i, v := range genesisState.Validators {
+		assert.DeepEqual(t, validating[i].PublicKey().Marshal(), v.PublicKey)
+		assert.NotEqual(t, string(withdrawal[i].PublicKey().Marshal()), string(v.PublicKey))
+	}
+
+	// The same triple gives the same genesis state.
+	again, _, err := interop.GenerateGenesisStateFromMnemonic(1600000000, testMnemonic, 8)
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesisState, again)
+}
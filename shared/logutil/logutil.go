@@ -6,14 +6,17 @@ import (
 	"io"
 	"os"
 
-	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
 // ConfigurePersistentLogging adds a log-to-file writer. File content is identical to stdout.
-func ConfigurePersistentLogging(logFileName string) error {
+// The log file is rotated according to the rotation config.
+func ConfigurePersistentLogging(logFileName string, rotation *RotationConfig) error {
 	logrus.WithField("logFileName", logFileName).Info("Logs will be made persistent")
-	f, err := os.OpenFile(logFileName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions)
+	if rotation == nil {
+		rotation = &RotationConfig{}
+	}
+	f, err := newRotatingFile(logFileName, rotation)
 	if err != nil {
 		return err
 	}
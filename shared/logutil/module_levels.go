@@ -0,0 +1,73 @@
+package logutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ParseModuleLevels parses a list of module=level pairs, such as sync=debug, into
+// the log level of each module. The module is the log prefix of the package.
+func ParseModuleLevels(pairs []string) (map[string]logrus.Level, error) {
+	levels := make(map[string]logrus.Level, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid module log level %q, expected module=level", pair)
+		}
+		level, err := logrus.ParseLevel(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		levels[parts[0]] = level
+	}
+	return levels, nil
+}
+
+// ConfigureModuleLevels overrides the global log level for the given modules. The global level
+// is lowered to the most verbose module level, and the messages of every other module which are
+// below the default level are dropped by the formatter.
+func ConfigureModuleLevels(defaultLevel logrus.Level, levels map[string]logrus.Level) {
+	if len(levels) == 0 {
+		return
+	}
+	maxLevel := defaultLevel
+	for _, level := range levels {
+		if level > maxLevel {
+			maxLevel = level
+		}
+	}
+	logrus.SetLevel(maxLevel)
+	logrus.SetFormatter(&moduleLevelFormatter{
+		Formatter:    logrus.StandardLogger().Formatter,
+		defaultLevel: defaultLevel,
+		levels:       levels,
+	})
+}
+
+// moduleLevelFormatter wraps a formatter, only formatting the entries enabled for their module.
+type moduleLevelFormatter struct {
+	logrus.Formatter
+	defaultLevel logrus.Level
+	levels       map[string]logrus.Level
+}
+
+// Format the entry with the underlying formatter, or return nothing to write
+// if the entry is below the log level of its module.
+func (f *moduleLevelFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if !f.enabled(entry) {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
+
+func (f *moduleLevelFormatter) enabled(entry *logrus.Entry) bool {
+	level := f.defaultLevel
+	if prefix, ok := entry.Data["prefix"].(string); ok {
+		if l, ok := f.levels[prefix]; ok {
+			level = l
+		}
+	}
+	return entry.Level <= level
+}
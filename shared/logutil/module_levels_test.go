@@ -0,0 +1,54 @@
+package logutil
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseModuleLevels(t *testing.T) {
+	levels, err := ParseModuleLevels([]string{"sync=debug", "p2p=warn"})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]logrus.Level{"sync": logrus.DebugLevel, "p2p": logrus.WarnLevel}, levels)
+
+	_, err = ParseModuleLevels([]string{"sync"})
+	assert.ErrorContains(t, "expected module=level", err)
+	_, err = ParseModuleLevels([]string{"sync=loud"})
+	assert.ErrorContains(t, "not a valid logrus Level", err)
+}
+
+func TestConfigureModuleLevels(t *testing.T) {
+	formatter := logrus.StandardLogger().Formatter
+	level := logrus.GetLevel()
+	var buf bytes.Buffer
+	logrus.SetOutput(&buf)
+	logrus.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	defer func() {
+		logrus.SetOutput(os.Stderr)
+		logrus.SetFormatter(formatter)
+		logrus.SetLevel(level)
+	}()
+
+	ConfigureModuleLevels(logrus.InfoLevel, map[string]logrus.Level{
+		"sync": logrus.DebugLevel,
+		"p2p":  logrus.WarnLevel,
+	})
+	assert.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+
+	logrus.WithField("prefix", "sync").Debug("sync debug")
+	logrus.WithField("prefix", "p2p").Info("p2p info")
+	logrus.WithField("prefix", "p2p").Warn("p2p warn")
+	logrus.WithField("prefix", "node").Debug("node debug")
+	logrus.WithField("prefix", "node").Info("node info")
+
+	out := buf.String()
+	assert.Equal(t, true, bytes.Contains(buf.Bytes(), []byte("sync debug")), out)
+	assert.Equal(t, false, bytes.Contains(buf.Bytes(), []byte("p2p info")), out)
+	assert.Equal(t, true, bytes.Contains(buf.Bytes(), []byte("p2p warn")), out)
+	assert.Equal(t, false, bytes.Contains(buf.Bytes(), []byte("node debug")), out)
+	assert.Equal(t, true, bytes.Contains(buf.Bytes(), []byte("node info")), out)
+}
@@ -0,0 +1,186 @@
+package logutil
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const backupTimeFormat = "20060102-150405.000"
+
+// RotationConfig defines when a persistent log file is rotated, and which of its backups are kept.
+type RotationConfig struct {
+	// MaxSize is the size in bytes after which the log file is rotated. It is never rotated by size if 0.
+	MaxSize uint64
+	// MaxAge is the duration after which the log file is rotated. It is never rotated by age if 0.
+	MaxAge time.Duration
+	// MaxBackups is the number of rotated log files kept. All of them are kept if 0.
+	MaxBackups int
+	// Compress rotated log files with gzip.
+	Compress bool
+}
+
+// rotatingFile is a log file writer which renames the file to a timestamped backup and
+// starts a new file whenever the file reaches its maximum size or age.
+type rotatingFile struct {
+	path     string
+	cfg      *RotationConfig
+	file     *os.File
+	size     uint64
+	openedAt time.Time
+	lock     sync.Mutex
+	// cleanup compresses and prunes the backups in the background, one rotation at a time.
+	cleanup sync.Mutex
+	wg      sync.WaitGroup
+}
+
+func newRotatingFile(path string, cfg *RotationConfig) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, cfg: cfg}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write the bytes to the log file, rotating it first if writing them would exceed its maximum size
+// or if it reached its maximum age.
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.shouldRotate(uint64(len(p))) {
+		if err := r.rotate(); err != nil {
+			fmt.Fprintf(os.Stderr, "Could not rotate log file %s: %v\n", r.path, err)
+		}
+	}
+	if r.file == nil {
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += uint64(n)
+	return n, err
+}
+
+func (r *rotatingFile) shouldRotate(n uint64) bool {
+	if r.size == 0 {
+		return false
+	}
+	if r.cfg.MaxSize != 0 && r.size+n > r.cfg.MaxSize {
+		return true
+	}
+	return r.cfg.MaxAge != 0 && time.Since(r.openedAt) >= r.cfg.MaxAge
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			fmt.Fprintf(os.Stderr, "Could not close log file %s: %v\n", r.path, closeErr)
+		}
+		return err
+	}
+	r.file = f
+	r.size = uint64(info.Size())
+	r.openedAt = time.Now()
+	return nil
+}
+
+// rotate renames the log file to a backup and opens a new log file. The log file is left closed
+// only if it can't be opened again, in which case the next write opens it.
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+	backup := fmt.Sprintf("%s.%s", r.path, time.Now().Format(backupTimeFormat))
+	if err := os.Rename(r.path, backup); err != nil {
+		// Keep writing to the original log file, and retry the rotation once it grows by its
+		// maximum size or reaches its maximum age again rather than on every write.
+		if openErr := r.open(); openErr != nil {
+			return openErr
+		}
+		r.size = 0
+		return err
+	}
+	if err := r.open(); err != nil {
+		return err
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.cleanup.Lock()
+		defer r.cleanup.Unlock()
+		if r.cfg.Compress {
+			if err := compressFile(backup); err != nil {
+				fmt.Fprintf(os.Stderr, "Could not compress rotated log file %s: %v\n", backup, err)
+			}
+		}
+		if err := r.pruneBackups(); err != nil {
+			fmt.Fprintf(os.Stderr, "Could not prune rotated log files: %v\n", err)
+		}
+	}()
+	return nil
+}
+
+// pruneBackups removes the oldest backups beyond the maximum number of backups.
+func (r *rotatingFile) pruneBackups() error {
+	if r.cfg.MaxBackups == 0 {
+		return nil
+	}
+	backups, err := filepath.Glob(r.path + ".*")
+	if err != nil {
+		return err
+	}
+	// The backup timestamps sort in chronological order, with or without the compression extension.
+	sort.Slice(backups, func(i, j int) bool {
+		return strings.TrimSuffix(backups[i], ".gz") < strings.TrimSuffix(backups[j], ".gz")
+	})
+	for len(backups) > r.cfg.MaxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// compressFile replaces the file with its gzip compressed version.
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Could not close log file %s: %v\n", path, err)
+		}
+	}()
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,119 @@
+package logutil
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func logFilePath(t *testing.T) string {
+	dir := filepath.Join(testutil.TempDir(), t.Name())
+	require.NoError(t, os.RemoveAll(dir))
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	return filepath.Join(dir, "beacon.log")
+}
+
+func TestRotatingFile_RotatesBySize(t *testing.T) {
+	path := logFilePath(t)
+	r, err := newRotatingFile(path, &RotationConfig{MaxSize: 10})
+	require.NoError(t, err)
+
+	_, err = r.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("abc"))
+	require.NoError(t, err)
+	r.wg.Wait()
+
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(content))
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(backups))
+	content, err = ioutil.ReadFile(backups[0])
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(content))
+}
+
+func TestRotatingFile_RotatesByAge(t *testing.T) {
+	path := logFilePath(t)
+	r, err := newRotatingFile(path, &RotationConfig{MaxAge: time.Hour})
+	require.NoError(t, err)
+
+	_, err = r.Write([]byte("first"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("second"))
+	require.NoError(t, err)
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(backups))
+
+	r.openedAt = time.Now().Add(-time.Hour)
+	_, err = r.Write([]byte("third"))
+	require.NoError(t, err)
+	r.wg.Wait()
+	backups, err = filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(backups))
+}
+
+func TestRotatingFile_RenameFails(t *testing.T) {
+	path := logFilePath(t)
+	r, err := newRotatingFile(path, &RotationConfig{MaxSize: 10})
+	require.NoError(t, err)
+
+	_, err = r.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	// The log file can't be renamed once it is removed.
+	require.NoError(t, os.Remove(path))
+	_, err = r.Write([]byte("abc"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("def"))
+	require.NoError(t, err)
+	r.wg.Wait()
+
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", string(content))
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(backups))
+}
+
+func TestRotatingFile_PrunesAndCompressesBackups(t *testing.T) {
+	path := logFilePath(t)
+	r, err := newRotatingFile(path, &RotationConfig{MaxSize: 1, MaxBackups: 2, Compress: true})
+	require.NoError(t, err)
+
+	for _, line := range []string{"a", "b", "c", "d"} {
+		_, err = r.Write([]byte(line))
+		require.NoError(t, err)
+		// Backups are named after the rotation time, so make sure they are all distinct.
+		time.Sleep(2 * time.Millisecond)
+	}
+	r.wg.Wait()
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(backups))
+	f, err := os.Open(backups[0])
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(gz)
+	require.NoError(t, err)
+	assert.Equal(t, "b", string(content))
+}
@@ -0,0 +1,60 @@
+package logutil
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogTail is a logrus hook keeping the most recent log lines in memory, so they
+// can be retrieved at runtime, such as for diagnostic dumps.
+type LogTail struct {
+	formatter logrus.Formatter
+	lines     []string
+	next      int
+	full      bool
+	lock      sync.Mutex
+}
+
+// NewLogTail creates a log tail keeping the given number of lines.
+func NewLogTail(size int) *LogTail {
+	return &LogTail{
+		formatter: &logrus.TextFormatter{DisableColors: true, FullTimestamp: true},
+		lines:     make([]string, size),
+	}
+}
+
+// Levels logged by the hook.
+func (t *LogTail) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire formats the entry and keeps it as the most recent line, replacing the oldest one.
+func (t *LogTail) Fire(entry *logrus.Entry) error {
+	b, err := t.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if len(t.lines) == 0 {
+		return nil
+	}
+	t.lines[t.next] = strings.TrimSuffix(string(b), "\n")
+	t.next = (t.next + 1) % len(t.lines)
+	if t.next == 0 {
+		t.full = true
+	}
+	return nil
+}
+
+// Lines returns the kept log lines, from the oldest to the most recent.
+func (t *LogTail) Lines() []string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if !t.full {
+		return append([]string{}, t.lines[:t.next]...)
+	}
+	return append(append([]string{}, t.lines[t.next:]...), t.lines[:t.next]...)
+}
@@ -0,0 +1,33 @@
+package logutil
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLogTail_KeepsMostRecentLines(t *testing.T) {
+	tail := NewLogTail(3)
+	logger := logrus.New()
+	logger.AddHook(tail)
+	logger.SetOutput(ioutil.Discard)
+
+	logger.Info("first")
+	lines := tail.Lines()
+	require.Equal(t, 1, len(lines))
+	assert.Equal(t, true, strings.Contains(lines[0], "msg=first"), "Unexpected line %s", lines[0])
+
+	for i := 0; i < 4; i++ {
+		logger.WithField("index", i).Info("message")
+	}
+	lines = tail.Lines()
+	require.Equal(t, 3, len(lines))
+	for i, line := range lines {
+		assert.Equal(t, true, strings.Contains(line, fmt.Sprintf("index=%d", i+1)), "Unexpected line %s", line)
+	}
+}
@@ -5,61 +5,40 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // CreateForkDigest creates a fork digest from a genesis time and genesis
 // validators root, utilizing the current slot to determine
-// the active fork version in the node.
+// the active fork version of the fork schedule.
 func CreateForkDigest(
+	schedule *forks.Schedule,
 	genesisTime time.Time,
 	genesisValidatorsRoot []byte,
 ) ([4]byte, error) {
+	if schedule == nil {
+		return [4]byte{}, errors.New("fork schedule is not set")
+	}
 	if genesisTime.IsZero() {
 		return [4]byte{}, errors.New("genesis time is not set")
 	}
 	if len(genesisValidatorsRoot) == 0 {
 		return [4]byte{}, errors.New("genesis validators root is not set")
 	}
-	currentSlot := helpers.SlotsSince(genesisTime)
-	currentEpoch := helpers.SlotToEpoch(currentSlot)
-
-	forkData, err := Fork(currentEpoch)
-	if err != nil {
-		return [4]byte{}, err
-	}
-
-	digest, err := helpers.ComputeForkDigest(forkData.CurrentVersion, genesisValidatorsRoot)
-	if err != nil {
-		return [4]byte{}, err
-	}
-	return digest, nil
+	currentEpoch := helpers.SlotToEpoch(helpers.SlotsSince(genesisTime))
+	return schedule.ForkDigest(currentEpoch, genesisValidatorsRoot)
 }
 
 // Fork given a target epoch,
-// returns the active fork version during this epoch.
+// returns the active fork version of the fork schedule during this epoch.
 func Fork(
+	schedule *forks.Schedule,
 	targetEpoch uint64,
 ) (*pb.Fork, error) {
-	// We retrieve a list of scheduled forks by epoch.
-	// We loop through the keys in this map to determine the current
-	// fork version based on the requested epoch.
-	retrievedForkVersion := params.BeaconConfig().GenesisForkVersion
-	previousForkVersion := params.BeaconConfig().GenesisForkVersion
-	scheduledForks := params.BeaconConfig().ForkVersionSchedule
-	forkEpoch := uint64(0)
-	for epoch, forkVersion := range scheduledForks {
-		if epoch <= targetEpoch {
-			previousForkVersion = retrievedForkVersion
-			retrievedForkVersion = forkVersion
-			forkEpoch = epoch
-		}
+	if schedule == nil {
+		return nil, errors.New("fork schedule is not set")
 	}
-	return &pb.Fork{
-		PreviousVersion: previousForkVersion,
-		CurrentVersion:  retrievedForkVersion,
-		Epoch:           forkEpoch,
-	}, nil
+	return schedule.StateFork(targetEpoch), nil
 }
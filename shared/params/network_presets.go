@@ -0,0 +1,165 @@
+package params
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// networkPresets are the YAML presets of the known networks by name, selected with --network.
+var networkPresets = map[string]string{
+	"mainnet": mainnetPreset,
+	"minimal": minimalPreset,
+	"medalla": medallaPreset,
+	"altona":  altonaPreset,
+	"onyx":    onyxPreset,
+}
+
+const mainnetPreset = `
+BASE_CONFIG: mainnet
+`
+
+const minimalPreset = `
+BASE_CONFIG: minimal
+`
+
+// NetworkPreset bundles the chain config, fork schedule, deposit contract and bootnodes of a
+// network. Values left out keep those of the base config and of the mainnet network config.
+type NetworkPreset struct {
+	BaseConfig                     string            `yaml:"BASE_CONFIG"`
+	MinGenesisActiveValidatorCount uint64            `yaml:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"`
+	MinGenesisTime                 uint64            `yaml:"MIN_GENESIS_TIME"`
+	GenesisTime                    uint64            `yaml:"GENESIS_TIME"`
+	GenesisForkVersion             string            `yaml:"GENESIS_FORK_VERSION"`
+	ForkVersionSchedule            map[uint64]string `yaml:"FORK_VERSION_SCHEDULE"`
+	DepositContractAddress         string            `yaml:"DEPOSIT_CONTRACT_ADDRESS"`
+	ContractDeploymentBlock        uint64            `yaml:"DEPOSIT_CONTRACT_DEPLOYMENT_BLOCK"`
+	ChainID                        uint64            `yaml:"DEPOSIT_CHAIN_ID"`
+	NetworkID                      uint64            `yaml:"DEPOSIT_NETWORK_ID"`
+	BootstrapNodes                 []string          `yaml:"BOOTSTRAP_NODES"`
+}
+
+// NetworkPresetNames returns the names of the known networks, sorted.
+func NetworkPresetNames() []string {
+	names := make([]string, 0, len(networkPresets))
+	for name := range networkPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadNetworkPreset parses the preset of the network with the name.
+func LoadNetworkPreset(name string) (*NetworkPreset, error) {
+	enc, ok := networkPresets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %q, known networks are %s", name, strings.Join(NetworkPresetNames(), ", "))
+	}
+	preset := &NetworkPreset{}
+	if err := yaml.UnmarshalStrict([]byte(enc), preset); err != nil {
+		return nil, errors.Wrapf(err, "could not parse preset of network %s", name)
+	}
+	return preset, nil
+}
+
+// UseNetworkPreset sets the beacon chain config and the network config to the ones of the
+// preset of the network with the name.
+func UseNetworkPreset(name string) error {
+	preset, err := LoadNetworkPreset(name)
+	if err != nil {
+		return err
+	}
+	cfg, err := preset.BeaconConfig()
+	if err != nil {
+		return err
+	}
+	OverrideBeaconConfig(cfg)
+	OverrideBeaconNetworkConfig(preset.NetworkConfig(mainnetNetworkConfig))
+	return nil
+}
+
+// BeaconConfig returns the beacon chain config of the preset.
+func (p *NetworkPreset) BeaconConfig() (*BeaconChainConfig, error) {
+	var cfg *BeaconChainConfig
+	switch p.BaseConfig {
+	case "mainnet":
+		cfg = MainnetConfig().Copy()
+	case "minimal":
+		cfg = MinimalSpecConfig()
+	default:
+		return nil, fmt.Errorf("unknown base config %q", p.BaseConfig)
+	}
+	if p.MinGenesisActiveValidatorCount != 0 {
+		cfg.MinGenesisActiveValidatorCount = p.MinGenesisActiveValidatorCount
+	}
+	if p.MinGenesisTime != 0 {
+		cfg.MinGenesisTime = p.MinGenesisTime
+	}
+	if p.GenesisTime != 0 {
+		cfg.GenesisTime = p.GenesisTime
+	}
+	if p.GenesisForkVersion != "" {
+		version, err := decodeForkVersion(p.GenesisForkVersion)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode genesis fork version")
+		}
+		cfg.GenesisForkVersion = version
+	}
+	if len(p.ForkVersionSchedule) > 0 {
+		cfg.ForkVersionSchedule = make(map[uint64][]byte, len(p.ForkVersionSchedule))
+		for epoch, v := range p.ForkVersionSchedule {
+			version, err := decodeForkVersion(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not decode fork version at epoch %d", epoch)
+			}
+			cfg.ForkVersionSchedule[epoch] = version
+		}
+	}
+	return cfg, nil
+}
+
+// NetworkConfig returns a copy of the network config with the values of the preset applied.
+func (p *NetworkPreset) NetworkConfig(base *NetworkConfig) *NetworkConfig {
+	cfg := base.Copy()
+	if p.DepositContractAddress != "" {
+		cfg.DepositContractAddress = p.DepositContractAddress
+	}
+	if p.ContractDeploymentBlock != 0 {
+		cfg.ContractDeploymentBlock = p.ContractDeploymentBlock
+	}
+	if p.ChainID != 0 {
+		cfg.ChainID = p.ChainID
+	}
+	if p.NetworkID != 0 {
+		cfg.NetworkID = p.NetworkID
+	}
+	if len(p.BootstrapNodes) > 0 {
+		cfg.BootstrapNodes = p.BootstrapNodes
+	}
+	return cfg
+}
+
+func decodeForkVersion(s string) ([]byte, error) {
+	version, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(version) != 4 {
+		return nil, fmt.Errorf("fork version must be 4 bytes, received %d", len(version))
+	}
+	return version, nil
+}
+
+// mustLoadNetworkPreset loads the preset of a network known to be valid, for the
+// config functions of the testnets.
+func mustLoadNetworkPreset(name string) *NetworkPreset {
+	preset, err := LoadNetworkPreset(name)
+	if err != nil {
+		panic(err)
+	}
+	return preset
+}
@@ -0,0 +1,129 @@
+package params
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadNetworkPreset_AllPresets(t *testing.T) {
+	for _, name := range NetworkPresetNames() {
+		preset, err := LoadNetworkPreset(name)
+		if err != nil {
+			t.Fatalf("Could not load preset %s: %v", name, err)
+		}
+		if _, err := preset.BeaconConfig(); err != nil {
+			t.Errorf("Could not get beacon config of preset %s: %v", name, err)
+		}
+	}
+}
+
+func TestLoadNetworkPreset_BootstrapNodes(t *testing.T) {
+	// The bootnodes of each network, which were previously set in the Use<Network>NetworkConfig functions.
+	want := map[string][]string{
+		"mainnet": nil,
+		"minimal": nil,
+		"altona": {
+			"enr:-LK4QFtV7Pz4reD5a7cpfi1z6yPrZ2I9eMMU5mGQpFXLnLoKZW8TXvVubShzLLpsEj6aayvVO1vFx-MApijD3HLPhlECh2F0dG5ldHOIAAAAAAAAAACEZXRoMpD6etXjAAABIf__________gmlkgnY0gmlwhDMPYfCJc2VjcDI1NmsxoQIerw_qBc9apYfZqo2awiwS930_vvmGnW2psuHsTzrJ8YN0Y3CCIyiDdWRwgiMo",
+			"enr:-LK4QPVkFd_MKzdW0219doTZryq40tTe8rwWYO75KDmeZM78fBskGsfCuAww9t8y3u0Q0FlhXOhjE1CWpx3SGbUaU80Ch2F0dG5ldHOIAAAAAAAAAACEZXRoMpD6etXjAAABIf__________gmlkgnY0gmlwhDMPRgeJc2VjcDI1NmsxoQNHu-QfNgzl8VxbMiPgv6wgAljojnqAOrN18tzJMuN8oYN0Y3CCIyiDdWRwgiMo",
+			"enr:-LK4QHe52XPPrcv6-MvcmN5GqDe_sgCwo24n_2hedlfwD_oxNt7cXL3tXJ7h9aYv6CTS1C_H2G2_dkeqm_LBO9nrpiYBh2F0dG5ldHOIAAAAAAAAAACEZXRoMpD9yjmwAAABIf__________gmlkgnY0gmlwhANzD9uJc2VjcDI1NmsxoQJX7zMnRU3szfGfS8MAIfPaQKOBpu3sBVTXf4Qq0b_m-4N0Y3CCIyiDdWRwgiMo",
+			"enr:-LK4QLkbbq7xuRa_EnWd_kc0TkQk0pd0B0cZYR5LvBsncFQBDyPbGdy8d24TzRVeK7ZWwM5_2EcSJK223f8TYUOQYfwBh2F0dG5ldHOIAAAAAAAAAACEZXRoMpD9yjmwAAABIf__________gmlkgnY0gmlwhAPsjtOJc2VjcDI1NmsxoQJNw_aZgWXl2SstD--WAjooGudjWLjEbbCIddJuEPxzWYN0Y3CCIyiDdWRwgiMo",
+			"enr:-LK4QHy-glnxN1WTk5f6d7-xXwy_UKJLs5k7p_S4KRY9I925KTzW_kQLjfFriIpH0de7kygBwrSl726ukq9_OG_sgKMCh2F0dG5ldHOIUjEAIQEAFMiEZXRoMpD9yjmwAAABIf__________gmlkgnY0gmlwhBLmhrCJc2VjcDI1NmsxoQNlU7gT0HUvpLA41n-P5GrCgjwMwtG02YsRRO0lAmpmBYN0Y3CCIyiDdWRwgiMo",
+			"enr:-LK4QDz0n0vpyOpuStB8e22h9ayHVcvmN7o0trC7eC0DnZV9GYGzK5uKv7WlzpMQM2nDTG43DWvF_DZYwJOZCbF4iCQBh2F0dG5ldHOI__________-EZXRoMpD9yjmwAAABIf__________gmlkgnY0gmlwhBKN136Jc2VjcDI1NmsxoQP5gcOUcaruHuMuTv8ht7ZEawp3iih7CmeLqcoY1hxOnoN0Y3CCIyiDdWRwgiMo",
+			"enr:-LK4QOScOZ35sOXEH6CEW15lfv7I3DhqQAzCPQ_nRav95otuSh4yi9ol0AruKDiIk9qqGXyD-wQDaBAPLhwl4t-rUSQBh2F0dG5ldHOI__________-EZXRoMpD9yjmwAAABIf__________gmlkgnY0gmlwhCL68KuJc2VjcDI1NmsxoQK5fYR3Ipoc01dz0d2-EcL7m26zKQSkAbf4rwcMMM09CoN0Y3CCIyiDdWRwgiMo",
+			"enr:-Ku4QMqmWPFkgM58F16wxB50cqWDaWaIsyANHL8wUNSB4Cy1TP9__uJQNRODvx_dvO6rY-BT3psrYTMAaxnMGXb6DuoBh2F0dG5ldHOIAAAAAAAAAACEZXRoMpD1pf1CAAAAAP__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQNoed9JnQh7ltcAacHEGOjwocL1BhMQbYTgaPX0kFuXtIN1ZHCCE4g",
+		},
+		"medalla": {
+			"enr:-Ku4QLglCMIYAgHd51uFUqejD9DWGovHOseHQy7Od1SeZnHnQ3fSpE4_nbfVs8lsy8uF07ae7IgrOOUFU0NFvZp5D4wBh2F0dG5ldHOIAAAAAAAAAACEZXRoMpAYrkzLAAAAAf__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQJxCnE6v_x2ekgY_uoE1rtwzvGy40mq9eD66XfHPBWgIIN1ZHCCD6A",
+			"enr:-Ku4QOdk3u7rXI5YvqwmEbApW_OLlRkq_yzmmhdlrJMcfviacLWwSm-tr1BOvamuRQqfc6lnMeec4E4ddOhd3KqCB98Bh2F0dG5ldHOIAAAAAAAAAACEZXRoMpAYrkzLAAAAAf__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQKH3lxnglLqrA7L6sl5r7XFnckr3XCnlZMaBTYSdE8SHIN1ZHCCG1g",
+			"enr:-Ku4QOVrqhlmsh9m2MGSnvVz8XPfjwHWBuOcgVQvWwBhN0-NI0XVhSerujBBwIeLpc-OES0C9iAzJhiCgRZ0xH13DgEBh2F0dG5ldHOIAAAAAAAAAACEZXRoMpAYrkzLAAAAAf__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQLEq16KLm1vPjUKYGkHq296D60i7y209NYPUpwZPXDVgYN1ZHCCF3A",
+			"enr:-Ku4QFVactU18ogiqPPasKs3jhUm5ISszUrUMK2c6SUPbGtANXVJ2wFapsKwVEVnVKxZ7Gsr9yEc4PYF-a14ahPa1q0Bh2F0dG5ldHOIAAAAAAAAAACEZXRoMpAYrkzLAAAAAf__________gmlkgnY0gmlwhGQbAHyJc2VjcDI1NmsxoQILF-Ya2i5yowVkQtlnZLjG0kqC4qtwmSk8ha7tKLuME4N1ZHCCIyg",
+			"enr:-KG4QFuKQ9eeXDTf8J4tBxFvs3QeMrr72mvS7qJgL9ieO6k9Rq5QuGqtGK4VlXMNHfe34Khhw427r7peSoIbGcN91fUDhGV0aDKQD8XYjwAAAAH__________4JpZIJ2NIJpcIQDhMExiXNlY3AyNTZrMaEDESplmV9c2k73v0DjxVXJ6__2bWyP-tK28_80lf7dUhqDdGNwgiMog3VkcIIjKA",
+		},
+		"onyx": {
+			"enr:-Ku4QMKVC_MowDsmEa20d5uGjrChI0h8_KsKXDmgVQbIbngZV0idV6_RL7fEtZGo-kTNZ5o7_EJI_vCPJ6scrhwX0Z4Bh2F0dG5ldHOIAAAAAAAAAACEZXRoMpD1pf1CAAAAAP__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQJxCnE6v_x2ekgY_uoE1rtwzvGy40mq9eD66XfHPBWgIIN1ZHCCD6A",
+		},
+	}
+	for _, name := range NetworkPresetNames() {
+		wanted, ok := want[name]
+		if !ok {
+			t.Errorf("No expected bootnodes for preset %s", name)
+			continue
+		}
+		preset, err := LoadNetworkPreset(name)
+		if err != nil {
+			t.Fatalf("Could not load preset %s: %v", name, err)
+		}
+		if !reflect.DeepEqual(preset.BootstrapNodes, wanted) {
+			t.Errorf("Unexpected bootnodes for preset %s: %v", name, preset.BootstrapNodes)
+		}
+	}
+}
+
+func TestLoadNetworkPreset_Unknown(t *testing.T) {
+	_, err := LoadNetworkPreset("foo")
+	if err == nil || !strings.Contains(err.Error(), "unknown network") {
+		t.Errorf("Expected unknown network error, received %v", err)
+	}
+}
+
+func TestNetworkPreset_BeaconConfig(t *testing.T) {
+	preset := &NetworkPreset{
+		BaseConfig:          "minimal",
+		MinGenesisTime:      100,
+		GenesisForkVersion:  "0x00000001",
+		ForkVersionSchedule: map[uint64]string{10: "0x00000002"},
+	}
+	cfg, err := preset.BeaconConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.SlotsPerEpoch != MinimalSpecConfig().SlotsPerEpoch {
+		t.Errorf("Expected minimal slots per epoch, received %d", cfg.SlotsPerEpoch)
+	}
+	if cfg.MinGenesisTime != 100 {
+		t.Errorf("Expected min genesis time 100, received %d", cfg.MinGenesisTime)
+	}
+	if !bytes.Equal(cfg.GenesisForkVersion, []byte{0, 0, 0, 1}) {
+		t.Errorf("Unexpected genesis fork version %#x", cfg.GenesisForkVersion)
+	}
+	if !bytes.Equal(cfg.ForkVersionSchedule[10], []byte{0, 0, 0, 2}) {
+		t.Errorf("Unexpected fork version at epoch 10 %#x", cfg.ForkVersionSchedule[10])
+	}
+
+	preset.GenesisForkVersion = "0x01"
+	if _, err := preset.BeaconConfig(); err == nil || !strings.Contains(err.Error(), "fork version must be 4 bytes") {
+		t.Errorf("Expected invalid fork version error, received %v", err)
+	}
+}
+
+func TestUseNetworkPreset(t *testing.T) {
+	SetupTestConfigCleanup(t)
+	prevNetworkConfig := BeaconNetworkConfig().Copy()
+	t.Cleanup(func() {
+		OverrideBeaconNetworkConfig(prevNetworkConfig)
+	})
+
+	if err := UseNetworkPreset("altona"); err != nil {
+		t.Fatal(err)
+	}
+	if BeaconConfig().MinGenesisActiveValidatorCount != 640 {
+		t.Errorf("Expected altona min genesis validator count, received %d", BeaconConfig().MinGenesisActiveValidatorCount)
+	}
+	if BeaconNetworkConfig().DepositContractAddress != "0x16e82D77882A663454Ef92806b7DeCa1D394810f" {
+		t.Errorf("Unexpected deposit contract address %s", BeaconNetworkConfig().DepositContractAddress)
+	}
+	if len(BeaconNetworkConfig().BootstrapNodes) == 0 {
+		t.Error("Expected altona bootnodes")
+	}
+
+	// Network config values not in the preset are the mainnet ones.
+	if err := UseNetworkPreset("mainnet"); err != nil {
+		t.Fatal(err)
+	}
+	if BeaconNetworkConfig().ChainID != 1 || len(BeaconNetworkConfig().BootstrapNodes) != 0 {
+		t.Errorf("Expected mainnet network config, received chain ID %d and %d bootnodes",
+			BeaconNetworkConfig().ChainID, len(BeaconNetworkConfig().BootstrapNodes))
+	}
+}
@@ -0,0 +1,40 @@
+package prometheus
+
+import (
+	"crypto/subtle"
+	"expvar"
+	"net/http"
+	httppprof "net/http/pprof"
+)
+
+// DebugHandlers returns the pprof and expvar handlers to serve on the monitoring port.
+// If the auth token is not empty, requests must provide it as a bearer token.
+func DebugHandlers(authToken string) []Handler {
+	handlers := []Handler{
+		{Path: "/debug/pprof/", Handler: httppprof.Index},
+		{Path: "/debug/pprof/cmdline", Handler: httppprof.Cmdline},
+		{Path: "/debug/pprof/profile", Handler: httppprof.Profile},
+		{Path: "/debug/pprof/symbol", Handler: httppprof.Symbol},
+		{Path: "/debug/pprof/trace", Handler: httppprof.Trace},
+		{Path: "/debug/vars", Handler: expvar.Handler().ServeHTTP},
+	}
+	if authToken == "" {
+		return handlers
+	}
+	for i, h := range handlers {
+		handlers[i].Handler = requireAuthToken(authToken, h.Handler)
+	}
+	return handlers
+}
+
+// requireAuthToken wraps the handler, rejecting the requests without the bearer token.
+func requireAuthToken(token string, h func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	want := []byte("Bearer " + token)
+	return func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h(w, r)
+	}
+}
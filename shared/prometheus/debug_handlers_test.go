@@ -0,0 +1,51 @@
+package prometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDebugHandlers_AuthToken(t *testing.T) {
+	mux := http.NewServeMux()
+	for _, h := range DebugHandlers("secret") {
+		mux.HandleFunc(h.Path, h.Handler)
+	}
+
+	tests := []struct {
+		name   string
+		path   string
+		header string
+		want   int
+	}{
+		{name: "no token", path: "/debug/vars", want: http.StatusUnauthorized},
+		{name: "wrong token", path: "/debug/pprof/", header: "Bearer wrong", want: http.StatusUnauthorized},
+		{name: "expvar", path: "/debug/vars", header: "Bearer secret", want: http.StatusOK},
+		{name: "heap profile", path: "/debug/pprof/heap", header: "Bearer secret", want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+			if rr.Code != tt.want {
+				t.Errorf("Unexpected status code for %s, got %d, wanted %d", tt.path, rr.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestDebugHandlers_NoAuthToken(t *testing.T) {
+	mux := http.NewServeMux()
+	for _, h := range DebugHandlers("") {
+		mux.HandleFunc(h.Path, h.Handler)
+	}
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest("GET", "/debug/pprof/goroutine", nil))
+	if rr.Code != http.StatusOK {
+		t.Errorf("Unexpected status code, got %d, wanted %d", rr.Code, http.StatusOK)
+	}
+}
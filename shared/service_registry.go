@@ -5,6 +5,8 @@ package shared
 import (
 	"fmt"
 	"reflect"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -29,6 +31,25 @@ type Service interface {
 type ServiceRegistry struct {
 	services     map[reflect.Type]Service // map of types to services.
 	serviceTypes []reflect.Type           // keep an ordered slice of registered service types.
+	startTime    time.Time                // time at which the services were started.
+	lastErrors   map[reflect.Type]*ServiceError
+	lock         sync.Mutex
+}
+
+// ServiceError is an error reported by the status of a service, and the time it was reported at.
+type ServiceError struct {
+	Err  error
+	Time time.Time
+}
+
+// ServiceHealth is the health of a registered service.
+type ServiceHealth struct {
+	Type reflect.Type
+	// Err is the current status of the service.
+	Err error
+	// LastErr is the last error reported by the status of the service, even if it is healthy again.
+	LastErr *ServiceError
+	Uptime  time.Duration
 }
 
 // NewServiceRegistry starts a registry instance for convenience
@@ -41,6 +62,9 @@ func NewServiceRegistry() *ServiceRegistry {
 // StartAll initialized each service in order of registration.
 func (s *ServiceRegistry) StartAll() {
 	log.Debugf("Starting %d services: %v", len(s.serviceTypes), s.serviceTypes)
+	s.lock.Lock()
+	s.startTime = time.Now()
+	s.lock.Unlock()
 	for _, kind := range s.serviceTypes {
 		log.Debugf("Starting service type %v", kind)
 		go s.services[kind].Start()
@@ -64,11 +88,47 @@ func (s *ServiceRegistry) StopAll() {
 func (s *ServiceRegistry) Statuses() map[reflect.Type]error {
 	m := make(map[reflect.Type]error, len(s.serviceTypes))
 	for _, kind := range s.serviceTypes {
-		m[kind] = s.services[kind].Status()
+		m[kind] = s.status(kind)
 	}
 	return m
 }
 
+// Health returns the health of every service in order of registration, with their
+// current status, the last error they reported and how long they have been running.
+func (s *ServiceRegistry) Health() []*ServiceHealth {
+	health := make([]*ServiceHealth, 0, len(s.serviceTypes))
+	for _, kind := range s.serviceTypes {
+		err := s.status(kind)
+		s.lock.Lock()
+		h := &ServiceHealth{
+			Type:    kind,
+			Err:     err,
+			LastErr: s.lastErrors[kind],
+		}
+		if !s.startTime.IsZero() {
+			h.Uptime = time.Since(s.startTime)
+		}
+		s.lock.Unlock()
+		health = append(health, h)
+	}
+	return health
+}
+
+// status of the service, recording it as the last error of the service if it is not healthy.
+func (s *ServiceRegistry) status(kind reflect.Type) error {
+	err := s.services[kind].Status()
+	if err == nil {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.lastErrors == nil {
+		s.lastErrors = make(map[reflect.Type]*ServiceError)
+	}
+	s.lastErrors[kind] = &ServiceError{Err: err, Time: time.Now()}
+	return err
+}
+
 // RegisterService appends a service constructor function to the service
 // registry.
 func (s *ServiceRegistry) RegisterService(service Service) error {
@@ -142,3 +142,35 @@ func TestServiceStatus_OK(t *testing.T) {
 		t.Errorf("Received unexpected status for %T = %v", s, sStatus)
 	}
 }
+
+func TestServiceHealth_RecordsLastError(t *testing.T) {
+	registry := NewServiceRegistry()
+	m := &mockService{}
+	if err := registry.RegisterService(m); err != nil {
+		t.Fatalf("failed to register service")
+	}
+
+	health := registry.Health()
+	if len(health) != 1 || health[0].Err != nil || health[0].LastErr != nil || health[0].Uptime != 0 {
+		t.Fatalf("Received unexpected health %+v", health)
+	}
+
+	registry.StartAll()
+	m.status = errors.New("something bad has happened")
+	health = registry.Health()
+	if health[0].Err == nil || health[0].LastErr == nil || health[0].LastErr.Err != m.status {
+		t.Errorf("Received unexpected health %+v", health[0])
+	}
+
+	m.status = nil
+	health = registry.Health()
+	if health[0].Err != nil {
+		t.Errorf("Received unexpected status %v", health[0].Err)
+	}
+	if health[0].LastErr == nil || health[0].LastErr.Err.Error() != "something bad has happened" {
+		t.Errorf("Received unexpected last error %+v", health[0].LastErr)
+	}
+	if health[0].Uptime <= 0 {
+		t.Errorf("Received unexpected uptime %v", health[0].Uptime)
+	}
+}
@@ -60,6 +60,22 @@ func NewBeaconBlock() *ethpb.SignedBeaconBlock {
 	}
 }
 
+// NewAttestation creates an attestation with minimum marshalable fields for the
+// slot and committee index, with the given aggregation bits.
+func NewAttestation(slot uint64, committeeIndex uint64, bits bitfield.Bitlist) *ethpb.Attestation {
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            slot,
+			CommitteeIndex:  committeeIndex,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
 // GenerateFullBlock generates a fully valid block with the requested parameters.
 // Use BlockGenConfig to declare the conditions you would like the block generated under.
 func GenerateFullBlock(
@@ -2,9 +2,11 @@ package testutil
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -29,6 +31,12 @@ var json = jsoniter.Config{
 	TagKey:                 "spec-name",
 }.Froze()
 
+// SpecTestsDirEnv is the environment variable pointing to a directory with the extracted
+// eth2-spec-tests archives, to run the spec tests against a local copy of the test vectors
+// instead of the ones fetched by bazel. The directory is expected to contain the tests folder
+// of the archives, such as tests/mainnet/phase0.
+const SpecTestsDirEnv = "ETH2_SPEC_TESTS_DIR"
+
 // UnmarshalYaml using a customized json encoder that supports "spec-name"
 // override tag.
 func UnmarshalYaml(y []byte, dest interface{}) error {
@@ -43,11 +51,15 @@ func UnmarshalYaml(y []byte, dest interface{}) error {
 // on the passed in eth2-spec-tests directory along with its path.
 func TestFolders(t *testing.T, config string, folderPath string) ([]os.FileInfo, string) {
 	testsFolderPath := path.Join("tests", config, "phase0", folderPath)
-	filepath, err := bazel.Runfile(testsFolderPath)
+	// TEST_SRCDIR is set by bazel when running tests.
+	if os.Getenv(SpecTestsDirEnv) == "" && os.Getenv("TEST_SRCDIR") == "" {
+		t.Skipf("Spec tests are only available with bazel or with %s set", SpecTestsDirEnv)
+	}
+	fullPath, err := SpecTestFilePath(testsFolderPath)
 	if err != nil {
 		t.Fatal(err)
 	}
-	testFolders, err := ioutil.ReadDir(filepath)
+	testFolders, err := ioutil.ReadDir(fullPath)
 	if err != nil {
 		t.Fatalf("Failed to read file: %v", err)
 	}
@@ -55,6 +67,24 @@ func TestFolders(t *testing.T, config string, folderPath string) ([]os.FileInfo,
 	return testFolders, testsFolderPath
 }
 
+// SpecTestFilePath returns the full path of a spec test file or directory, from the
+// directory set in ETH2_SPEC_TESTS_DIR if any or from the bazel runfiles otherwise.
+func SpecTestFilePath(filePath string) (string, error) {
+	dir := os.Getenv(SpecTestsDirEnv)
+	if dir == "" {
+		return bazel.Runfile(filePath)
+	}
+	fullPath := filepath.Join(dir, filePath)
+	if _, err := os.Stat(fullPath); err != nil {
+		if os.IsNotExist(err) {
+			// Same error as bazel.Runfile, callers check for it to know if a file is optional.
+			return "", fmt.Errorf("spec test file %s: could not locate file", filePath)
+		}
+		return "", err
+	}
+	return fullPath, nil
+}
+
 // BazelDirectoryNonEmpty returns true if directory exists and is not empty.
 func BazelDirectoryNonEmpty(filePath string) (bool, error) {
 	p, err := bazel.Runfile(filePath)
@@ -70,11 +100,11 @@ func BazelDirectoryNonEmpty(filePath string) (bool, error) {
 
 // BazelFileBytes returns the byte array of the bazel file path given.
 func BazelFileBytes(filePaths ...string) ([]byte, error) {
-	filepath, err := bazel.Runfile(path.Join(filePaths...))
+	fullPath, err := SpecTestFilePath(path.Join(filePaths...))
 	if err != nil {
 		return nil, err
 	}
-	fileBytes, err := ioutil.ReadFile(filepath)
+	fileBytes, err := ioutil.ReadFile(fullPath)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +133,7 @@ func RunBlockOperationTest(
 	}
 
 	// If the post.ssz is not present, it means the test should fail on our end.
-	postSSZFilepath, err := bazel.Runfile(path.Join(folderPath, "post.ssz"))
+	postSSZFilepath, err := SpecTestFilePath(path.Join(folderPath, "post.ssz"))
 	postSSZExists := true
 	if err != nil && strings.Contains(err.Error(), "could not locate file") {
 		postSSZExists = false
@@ -165,7 +195,7 @@ func RunEpochOperationTest(
 	}
 
 	// If the post.ssz is not present, it means the test should fail on our end.
-	postSSZFilepath, err := bazel.Runfile(path.Join(testFolderPath, "post.ssz"))
+	postSSZFilepath, err := SpecTestFilePath(path.Join(testFolderPath, "post.ssz"))
 	postSSZExists := true
 	if err != nil && strings.Contains(err.Error(), "could not locate file") {
 		postSSZExists = false
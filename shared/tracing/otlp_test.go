@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"go.opencensus.io/trace"
+)
+
+func TestOTLPExporter_Export(t *testing.T) {
+	var received map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	e := &otlpExporter{endpoint: srv.URL, client: srv.Client()}
+	start := time.Unix(0, 1000)
+	span := &trace.SpanData{
+		SpanContext: trace.SpanContext{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{2},
+		},
+		ParentSpanID: trace.SpanID{3},
+		SpanKind:     trace.SpanKindServer,
+		Name:         "sync.validateBeaconBlockPubSub",
+		StartTime:    start,
+		EndTime:      start.Add(time.Microsecond),
+		Attributes:   map[string]interface{}{"slot": int64(5)},
+		Status:       trace.Status{Code: trace.StatusCodeInternal, Message: "bad block"},
+	}
+	require.NoError(t, e.export([]*trace.SpanData{span}))
+
+	resourceSpans := received["resourceSpans"].([]interface{})
+	scopeSpans := resourceSpans[0].(map[string]interface{})["scopeSpans"].([]interface{})
+	spans := scopeSpans[0].(map[string]interface{})["spans"].([]interface{})
+	require.Equal(t, 1, len(spans))
+	got := spans[0].(map[string]interface{})
+	assert.Equal(t, "01000000000000000000000000000000", got["traceId"])
+	assert.Equal(t, "0200000000000000", got["spanId"])
+	assert.Equal(t, "0300000000000000", got["parentSpanId"])
+	assert.Equal(t, float64(otlpSpanKindServer), got["kind"])
+	assert.Equal(t, "1000", got["startTimeUnixNano"])
+	assert.Equal(t, "2000", got["endTimeUnixNano"])
+	attribute := got["attributes"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "slot", attribute["key"])
+	assert.Equal(t, "5", attribute["value"].(map[string]interface{})["intValue"])
+	status := got["status"].(map[string]interface{})
+	assert.Equal(t, float64(otlpStatusCodeError), status["code"])
+	assert.Equal(t, "bad block", status["message"])
+}
+
+func TestOTLPExporter_ExportFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	e := &otlpExporter{endpoint: srv.URL, client: srv.Client()}
+	err := e.export([]*trace.SpanData{{Name: "span"}})
+	assert.ErrorContains(t, "400 Bad Request", err)
+}
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	err := Setup("beacon-chain", "", "http://127.0.0.1:4318/v1/traces", "zipkin", 1, true)
+	assert.ErrorContains(t, "unknown tracing exporter", err)
+}
@@ -1,9 +1,10 @@
-// Package tracing sets up jaeger as an opentracing tool
+// Package tracing sets up jaeger or an OTLP collector as an opentracing tool
 // for services in Prysm.
 package tracing
 
 import (
 	"errors"
+	"fmt"
 
 	"contrib.go.opencensus.io/exporter/jaeger"
 	"github.com/prysmaticlabs/prysm/shared/version"
@@ -13,8 +14,15 @@ import (
 
 var log = logrus.WithField("prefix", "tracing")
 
-// Setup creates and initializes a new tracing configuration..
-func Setup(serviceName, processName, endpoint string, sampleFraction float64, enable bool) error {
+// Exporters of the collected traces.
+const (
+	JaegerExporter = "jaeger"
+	OTLPExporter   = "otlp"
+)
+
+// Setup creates and initializes a new tracing configuration, exporting the traces to the endpoint
+// with the jaeger or otlp exporter.
+func Setup(serviceName, processName, endpoint, exporter string, sampleFraction float64, enable bool) error {
 	if !enable {
 		trace.ApplyConfig(trace.Config{DefaultSampler: trace.NeverSample()})
 		return nil
@@ -24,11 +32,21 @@ func Setup(serviceName, processName, endpoint string, sampleFraction float64, en
 		return errors.New("tracing service name cannot be empty")
 	}
 
+	if exporter != JaegerExporter && exporter != OTLPExporter {
+		return fmt.Errorf("unknown tracing exporter %q, must be %s or %s", exporter, JaegerExporter, OTLPExporter)
+	}
+
 	trace.ApplyConfig(trace.Config{
 		DefaultSampler:          trace.ProbabilitySampler(sampleFraction),
 		MaxMessageEventsPerSpan: 500,
 	})
 
+	if exporter == OTLPExporter {
+		log.Infof("Starting OTLP exporter endpoint at address = %s", endpoint)
+		trace.RegisterExporter(newOTLPExporter(endpoint, serviceName, processName))
+		return nil
+	}
+
 	log.Infof("Starting Jaeger exporter endpoint at address = %s", endpoint)
 	exporter, err := jaeger.NewExporter(jaeger.Options{
 		CollectorEndpoint: endpoint,
@@ -10,4 +10,8 @@ var (
 		Name: "slasher_attestations_received_total",
 		Help: "The # of attestations received by slasher",
 	})
+	slasherSlashingSubmitFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "slasher_slashing_submit_failures_total",
+		Help: "The # of slashings the slasher could not submit to the beacon node",
+	}, []string{"type"})
 )
@@ -193,4 +193,7 @@ func (bs *Service) Start() {
 	go bs.subscribeDetectedProposerSlashings(bs.ctx, bs.proposerSlashingsChan)
 	go bs.subscribeDetectedAttesterSlashings(bs.ctx, bs.attesterSlashingsChan)
 
+	// Any slashings which were detected but could not be submitted in a
+	// previous session are forwarded to the beacon node as well.
+	go bs.submitPendingSlashings(bs.ctx)
 }
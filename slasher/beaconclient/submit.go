@@ -4,9 +4,11 @@ import (
 	"context"
 	"strings"
 
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -23,9 +25,8 @@ func (bs *Service) subscribeDetectedProposerSlashings(ctx context.Context, ch ch
 	for {
 		select {
 		case slashing := <-ch:
-			if _, err := bs.beaconClient.SubmitProposerSlashing(ctx, slashing); err != nil {
-				log.Error(err)
-			}
+			// Failures are logged, the slashing stays active in the DB and is submitted again on the next start.
+			_ = bs.submitProposerSlashing(ctx, slashing)
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
@@ -48,21 +49,8 @@ func (bs *Service) subscribeDetectedAttesterSlashings(ctx context.Context, ch ch
 	for {
 		select {
 		case slashing := <-ch:
-			if slashing != nil && slashing.Attestation_1 != nil && slashing.Attestation_2 != nil {
-				slashableIndices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
-				_, err := bs.beaconClient.SubmitAttesterSlashing(ctx, slashing)
-				if err == nil {
-					log.WithFields(logrus.Fields{
-						"sourceEpoch": slashing.Attestation_1.Data.Source.Epoch,
-						"targetEpoch": slashing.Attestation_1.Data.Target.Epoch,
-						"indices":     slashableIndices,
-					}).Info("Found a valid attester slashing! Submitting to beacon node")
-				} else if strings.Contains(err.Error(), helpers.ErrSigFailedToVerify.Error()) {
-					log.WithError(err).Errorf("Could not submit attester slashing with indices %v", slashableIndices)
-				} else if !strings.Contains(err.Error(), "could not slash") {
-					log.WithError(err).Errorf("Could not slash validators with indices %v", slashableIndices)
-				}
-			}
+			// Failures are logged, the slashing stays active in the DB and is submitted again on the next start.
+			_ = bs.submitAttesterSlashing(ctx, slashing)
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
@@ -72,3 +60,94 @@ func (bs *Service) subscribeDetectedAttesterSlashings(ctx context.Context, ch ch
 		}
 	}
 }
+
+// submitPendingSlashings submits every active slashing persisted in the slasher DB
+// to the connected beacon node, which inserts them into its operations pool and
+// broadcasts them to the network. This ensures evidence detected while the
+// beacon node was unreachable, or before a restart, is not lost. Submitted slashings
+// are marked as such in the DB, the others are submitted again on the next start.
+func (bs *Service) submitPendingSlashings(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.submitPendingSlashings")
+	defer span.End()
+	var submitted, failed int
+	attesterSlashings, err := bs.slasherDB.AttesterSlashings(ctx, status.Active)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve pending attester slashings from DB")
+	}
+	for _, slashing := range attesterSlashings {
+		if err := bs.submitAttesterSlashing(ctx, slashing); err != nil {
+			failed++
+			continue
+		}
+		submitted++
+	}
+	proposerSlashings, err := bs.slasherDB.ProposalSlashingsByStatus(ctx, status.Active)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve pending proposer slashings from DB")
+	}
+	for _, slashing := range proposerSlashings {
+		if err := bs.submitProposerSlashing(ctx, slashing); err != nil {
+			failed++
+			continue
+		}
+		submitted++
+	}
+	if submitted > 0 {
+		log.WithField("slashings", submitted).Info("Submitted pending slashings to beacon node")
+	}
+	if failed > 0 {
+		log.WithField("slashings", failed).Warn("Could not submit pending slashings to beacon node, retrying on next start")
+	}
+}
+
+// submitProposerSlashing submits the slashing to the beacon node and marks it as submitted
+// in the slasher DB.
+func (bs *Service) submitProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	if slashing == nil || slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+		return errors.New("nil proposer slashing")
+	}
+	if _, err := bs.beaconClient.SubmitProposerSlashing(ctx, slashing); err != nil {
+		slasherSlashingSubmitFailures.WithLabelValues("proposer").Inc()
+		log.WithError(err).Errorf(
+			"Could not submit proposer slashing for proposer index %d",
+			slashing.Header_1.Header.ProposerIndex,
+		)
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"slot":          slashing.Header_1.Header.Slot,
+		"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+	}).Info("Submitted proposer slashing to beacon node")
+	if err := bs.slasherDB.SaveProposerSlashing(ctx, status.Submitted, slashing); err != nil {
+		log.WithError(err).Error("Could not mark proposer slashing as submitted in DB")
+	}
+	return nil
+}
+
+// submitAttesterSlashing submits the slashing to the beacon node and marks it as submitted
+// in the slasher DB.
+func (bs *Service) submitAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+		return errors.New("nil attester slashing")
+	}
+	slashableIndices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	_, err := bs.beaconClient.SubmitAttesterSlashing(ctx, slashing)
+	if err != nil {
+		slasherSlashingSubmitFailures.WithLabelValues("attester").Inc()
+		if strings.Contains(err.Error(), helpers.ErrSigFailedToVerify.Error()) {
+			log.WithError(err).Errorf("Could not submit attester slashing with indices %v", slashableIndices)
+		} else if !strings.Contains(err.Error(), "could not slash") {
+			log.WithError(err).Errorf("Could not slash validators with indices %v", slashableIndices)
+		}
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"sourceEpoch": slashing.Attestation_1.Data.Source.Epoch,
+		"targetEpoch": slashing.Attestation_1.Data.Target.Epoch,
+		"indices":     slashableIndices,
+	}).Info("Found a valid attester slashing! Submitting to beacon node")
+	if err := bs.slasherDB.SaveAttesterSlashing(ctx, status.Submitted, slashing); err != nil {
+		log.WithError(err).Error("Could not mark attester slashing as submitted in DB")
+	}
+	return nil
+}
@@ -2,13 +2,17 @@ package beaconclient
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -20,6 +24,7 @@ func TestService_SubscribeDetectedProposerSlashings(t *testing.T) {
 
 	bs := Service{
 		beaconClient:          client,
+		slasherDB:             testDB.SetupSlasherDB(t, false),
 		proposerSlashingsFeed: new(event.Feed),
 	}
 
@@ -62,6 +67,7 @@ func TestService_SubscribeDetectedAttesterSlashings(t *testing.T) {
 
 	bs := Service{
 		beaconClient:          client,
+		slasherDB:             testDB.SetupSlasherDB(t, false),
 		attesterSlashingsFeed: new(event.Feed),
 	}
 
@@ -96,3 +102,97 @@ func TestService_SubscribeDetectedAttesterSlashings(t *testing.T) {
 	exitRoutine <- true
 	require.LogsContain(t, hook, "Context canceled")
 }
+
+func TestService_SubmitPendingSlashings(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    db,
+	}
+
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2, 3},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 3},
+				Target: &ethpb.Checkpoint{Epoch: 4},
+			},
+			Signature: []byte{1, 2},
+		},
+		Attestation_2: &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{3, 4, 5},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 2},
+				Target: &ethpb.Checkpoint{Epoch: 4},
+			},
+			Signature: []byte{3, 4},
+		},
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{ProposerIndex: 5, Slot: 5},
+			Signature: []byte{1, 2},
+		},
+		Header_2: &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{ProposerIndex: 5, Slot: 5},
+			Signature: []byte{3, 4},
+		},
+	}
+	require.NoError(t, db.SaveAttesterSlashing(ctx, status.Active, attesterSlashing))
+	require.NoError(t, db.SaveProposerSlashing(ctx, status.Active, proposerSlashing))
+
+	client.EXPECT().SubmitAttesterSlashing(gomock.Any(), gomock.Any()).Return(&ethpb.SubmitSlashingResponse{}, nil)
+	client.EXPECT().SubmitProposerSlashing(gomock.Any(), gomock.Any()).Return(&ethpb.SubmitSlashingResponse{}, nil)
+	bs.submitPendingSlashings(ctx)
+	require.LogsContain(t, hook, "Submitted pending slashings to beacon node")
+	require.LogsDoNotContain(t, hook, "Could not submit pending slashings")
+
+	// Submitted slashings are not submitted again.
+	attesterSlashings, err := db.AttesterSlashings(ctx, status.Active)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(attesterSlashings))
+	proposerSlashings, err := db.ProposalSlashingsByStatus(ctx, status.Submitted)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(proposerSlashings))
+}
+
+func TestService_SubmitPendingSlashings_Failures(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    db,
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{ProposerIndex: 5, Slot: 5},
+			Signature: []byte{1, 2},
+		},
+		Header_2: &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{ProposerIndex: 5, Slot: 5},
+			Signature: []byte{3, 4},
+		},
+	}
+	require.NoError(t, db.SaveProposerSlashing(ctx, status.Active, proposerSlashing))
+
+	client.EXPECT().SubmitProposerSlashing(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+	bs.submitPendingSlashings(ctx)
+	require.LogsContain(t, hook, "Could not submit pending slashings")
+	require.LogsDoNotContain(t, hook, "Submitted pending slashings to beacon node")
+
+	// The slashing is submitted again on the next start.
+	proposerSlashings, err := db.ProposalSlashingsByStatus(ctx, status.Active)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(proposerSlashings))
+}
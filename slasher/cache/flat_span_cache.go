@@ -55,6 +55,18 @@ func (c *EpochFlatSpansCache) PruneOldest() uint64 {
 	return 0
 }
 
+// Epochs returns the epoch keys currently held in the cache, from oldest to newest.
+func (c *EpochFlatSpansCache) Epochs() []uint64 {
+	keys := c.cache.Keys()
+	epochs := make([]uint64, 0, len(keys))
+	for _, k := range keys {
+		if epoch, ok := k.(uint64); ok {
+			epochs = append(epochs, epoch)
+		}
+	}
+	return epochs
+}
+
 // Has returns true if the key exists in the cache.
 func (c *EpochFlatSpansCache) Has(epoch uint64) bool {
 	return c.cache.Contains(epoch)
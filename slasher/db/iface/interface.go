@@ -8,6 +8,7 @@ import (
 	"io"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	detectionTypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 )
@@ -46,6 +47,9 @@ type ReadOnlyDatabase interface {
 	// Chain data related methods.
 	ChainHead(ctx context.Context) (*ethpb.ChainHead, error)
 
+	// Highest attestation related methods.
+	HighestAttestation(ctx context.Context, validatorID uint64) (*slashpb.HighestAttestation, error)
+
 	// Cache management methods.
 	RemoveOldestFromCache(ctx context.Context) uint64
 }
@@ -70,12 +74,14 @@ type WriteAccessDatabase interface {
 
 	// MinMaxSpan related methods.
 	SaveEpochSpans(ctx context.Context, epoch uint64, spans *detectionTypes.EpochStore, toCache bool) error
+	SaveEpochsSpans(ctx context.Context, epochsSpans map[uint64]*detectionTypes.EpochStore) error
 	SaveEpochSpansMap(ctx context.Context, epoch uint64, spanMap map[uint64]detectionTypes.Span) error
 	SaveValidatorEpochSpan(ctx context.Context, validatorIdx uint64, epoch uint64, spans detectionTypes.Span) error
 	SaveCachedSpansMaps(ctx context.Context) error
 	SaveEpochsSpanByValidatorsIndices(ctx context.Context, epochsSpans map[uint64]map[uint64]detectionTypes.Span) error
 	DeleteEpochSpans(ctx context.Context, validatorIdx uint64) error
 	DeleteValidatorSpanByEpoch(ctx context.Context, validatorIdx uint64, epoch uint64) error
+	PruneSpans(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error
 
 	// ProposerSlashing related methods.
 	DeleteProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
@@ -88,6 +94,10 @@ type WriteAccessDatabase interface {
 
 	// Chain data related methods.
 	SaveChainHead(ctx context.Context, head *ethpb.ChainHead) error
+
+	// Highest attestation related methods.
+	SaveHighestAttestation(ctx context.Context, highest *slashpb.HighestAttestation) error
+	UpdateHighestAttestations(ctx context.Context, validatorIDs []uint64, source uint64, target uint64) error
 }
 
 // FullAccessDatabase represents a full access database with only DB interaction functions.
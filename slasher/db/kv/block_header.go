@@ -7,8 +7,6 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	bolt "go.etcd.io/bbolt"
@@ -72,7 +70,6 @@ func (db *Store) HasBlockHeader(ctx context.Context, slot uint64, validatorID ui
 func (db *Store) SaveBlockHeader(ctx context.Context, blockHeader *ethpb.SignedBeaconBlockHeader) error {
 	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveBlockHeader")
 	defer span.End()
-	epoch := helpers.SlotToEpoch(blockHeader.Header.Slot)
 	key := encodeSlotValidatorIDSig(blockHeader.Header.Slot, blockHeader.Header.ProposerIndex, blockHeader.Signature)
 	enc, err := proto.Marshal(blockHeader)
 	if err != nil {
@@ -87,15 +84,7 @@ func (db *Store) SaveBlockHeader(ctx context.Context, blockHeader *ethpb.SignedB
 
 		return err
 	})
-	if err != nil {
-		return err
-	}
-
-	// Prune block header history every 10th epoch.
-	if epoch%params.BeaconConfig().PruneSlasherStoragePeriod == 0 {
-		return db.PruneBlockHistory(ctx, epoch, params.BeaconConfig().WeakSubjectivityPeriod)
-	}
-	return nil
+	return err
 }
 
 // DeleteBlockHeader deletes a block header using the slot and validator id.
@@ -123,8 +112,11 @@ func (db *Store) PruneBlockHistory(ctx context.Context, currentEpoch uint64, pru
 	pruneTillSlot := uint64(pruneTill) * params.BeaconConfig().SlotsPerEpoch
 	return db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicBlockHeadersBucket)
-		c := tx.Bucket(historicBlockHeadersBucket).Cursor()
-		for k, _ := c.First(); k != nil && bytesutil.FromBytes8(k[:8]) <= pruneTillSlot; k, _ = c.Next() {
+		keys, err := keysWithEpochPrefixAtMost(bucket, pruneTillSlot)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
 			if err := bucket.Delete(k); err != nil {
 				return errors.Wrap(err, "failed to delete the block header from historical bucket")
 			}
@@ -0,0 +1,83 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// HighestAttestation retrieves the highest source and target epochs the slasher has
+// observed for the given validator id. Returns nil if no attestation was recorded yet.
+func (db *Store) HighestAttestation(ctx context.Context, validatorID uint64) (*slashpb.HighestAttestation, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.HighestAttestation")
+	defer span.End()
+	var highest *slashpb.HighestAttestation
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(highestAttestationBucket).Get(bytesutil.Bytes8(validatorID))
+		if enc == nil {
+			return nil
+		}
+		highest = &slashpb.HighestAttestation{}
+		return proto.Unmarshal(enc, highest)
+	})
+	return highest, err
+}
+
+// SaveHighestAttestation persists the highest source and target epochs of a validator.
+func (db *Store) SaveHighestAttestation(ctx context.Context, highest *slashpb.HighestAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveHighestAttestation")
+	defer span.End()
+	enc, err := proto.Marshal(highest)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode highest attestation")
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(highestAttestationBucket)
+		if err := bucket.Put(bytesutil.Bytes8(highest.ValidatorId), enc); err != nil {
+			return errors.Wrap(err, "failed to save highest attestation to db")
+		}
+		return nil
+	})
+}
+
+// UpdateHighestAttestations raises the highest source and target epochs of the validators to the
+// source and target epochs of their attestation. The highest attestations are read, compared and
+// written in a single transaction, so concurrent updates never lower them.
+func (db *Store) UpdateHighestAttestations(ctx context.Context, validatorIDs []uint64, source uint64, target uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.UpdateHighestAttestations")
+	defer span.End()
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(highestAttestationBucket)
+		for _, idx := range validatorIDs {
+			key := bytesutil.Bytes8(idx)
+			highest := &slashpb.HighestAttestation{ValidatorId: idx}
+			if enc := bucket.Get(key); enc != nil {
+				if err := proto.Unmarshal(enc, highest); err != nil {
+					return errors.Wrapf(err, "failed to decode highest attestation of validator %d", idx)
+				}
+				if source <= highest.HighestSourceEpoch && target <= highest.HighestTargetEpoch {
+					continue
+				}
+			}
+			if source > highest.HighestSourceEpoch {
+				highest.HighestSourceEpoch = source
+			}
+			if target > highest.HighestTargetEpoch {
+				highest.HighestTargetEpoch = target
+			}
+			enc, err := proto.Marshal(highest)
+			if err != nil {
+				return errors.Wrap(err, "failed to encode highest attestation")
+			}
+			if err := bucket.Put(key, enc); err != nil {
+				return errors.Wrap(err, "failed to save highest attestation to db")
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package kv
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestStore_HighestAttestation_NotFound(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	ctx := context.Background()
+
+	highest, err := db.HighestAttestation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, (*slashpb.HighestAttestation)(nil), highest)
+}
+
+func TestStore_SaveHighestAttestation(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	ctx := context.Background()
+
+	tests := []*slashpb.HighestAttestation{
+		{ValidatorId: 1, HighestSourceEpoch: 2, HighestTargetEpoch: 3},
+		{ValidatorId: 2, HighestSourceEpoch: 0, HighestTargetEpoch: 1},
+		{ValidatorId: 1, HighestSourceEpoch: 5, HighestTargetEpoch: 6},
+	}
+	for _, tt := range tests {
+		require.NoError(t, db.SaveHighestAttestation(ctx, tt))
+		highest, err := db.HighestAttestation(ctx, tt.ValidatorId)
+		require.NoError(t, err)
+		require.DeepEqual(t, tt, highest)
+	}
+}
+
+func TestStore_UpdateHighestAttestations(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	ctx := context.Background()
+
+	require.NoError(t, db.UpdateHighestAttestations(ctx, []uint64{1, 2}, 2, 3))
+	// Lower epochs never lower the highest attestations.
+	require.NoError(t, db.UpdateHighestAttestations(ctx, []uint64{1}, 1, 5))
+	require.NoError(t, db.UpdateHighestAttestations(ctx, []uint64{2}, 1, 2))
+
+	want := []*slashpb.HighestAttestation{
+		{ValidatorId: 1, HighestSourceEpoch: 2, HighestTargetEpoch: 5},
+		{ValidatorId: 2, HighestSourceEpoch: 2, HighestTargetEpoch: 3},
+	}
+	for _, tt := range want {
+		highest, err := db.HighestAttestation(ctx, tt.ValidatorId)
+		require.NoError(t, err)
+		require.DeepEqual(t, tt, highest)
+	}
+}
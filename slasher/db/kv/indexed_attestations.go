@@ -172,9 +172,13 @@ func (db *Store) PruneAttHistory(ctx context.Context, currentEpoch uint64, pruni
 
 	return db.update(func(tx *bolt.Tx) error {
 		attBucket := tx.Bucket(historicIndexedAttestationsBucket)
-		c := tx.Bucket(historicIndexedAttestationsBucket).Cursor()
-		max := bytesutil.Bytes8(uint64(pruneFromEpoch))
-		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], max) <= 0; k, _ = c.Next() {
+		// Keys are prefixed by little endian target epochs, so they are not sorted
+		// by epoch and we have to check every key in the bucket.
+		keys, err := keysWithEpochPrefixAtMost(attBucket, uint64(pruneFromEpoch))
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
 			if err := attBucket.Delete(k); err != nil {
 				return errors.Wrap(err, "failed to delete indexed attestation from historical bucket")
 			}
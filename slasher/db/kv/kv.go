@@ -8,6 +8,8 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/slasher/cache"
 	bolt "go.etcd.io/bbolt"
@@ -16,11 +18,17 @@ import (
 
 var databaseFileName = "slasher.db"
 
+var slasherDBSize = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "slasher_db_size_bytes",
+	Help: "The size of the slasher database in bytes",
+})
+
 // Store defines an implementation of the slasher Database interface
 // using BoltDB as the underlying persistent kv-store for eth2.
 type Store struct {
 	db               *bolt.DB
 	databasePath     string
+	shared           bool
 	spanCache        *cache.EpochSpansCache
 	flatSpanCache    *cache.EpochFlatSpansCache
 	spanCacheEnabled bool
@@ -32,9 +40,13 @@ type Config struct {
 	SpanCacheSize int
 }
 
-// Close closes the underlying boltdb database.
+// Close closes the underlying boltdb database. A database shared with another store is left
+// open for its owner to close.
 func (db *Store) Close() error {
 	db.flatSpanCache.Purge()
+	if db.shared {
+		return nil
+	}
 	return db.db.Close()
 }
 
@@ -52,7 +64,13 @@ func (db *Store) ClearSpanCache() {
 }
 
 func (db *Store) update(fn func(*bolt.Tx) error) error {
-	return db.db.Update(fn)
+	return db.db.Update(func(tx *bolt.Tx) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		slasherDBSize.Set(float64(tx.Size()))
+		return nil
+	})
 }
 func (db *Store) view(fn func(*bolt.Tx) error) error {
 	return db.db.View(fn)
@@ -60,6 +78,9 @@ func (db *Store) view(fn func(*bolt.Tx) error) error {
 
 // ClearDB removes any previously stored data at the configured data directory.
 func (db *Store) ClearDB() error {
+	if db.shared {
+		return errors.New("cannot clear a database shared with another store")
+	}
 	if _, err := os.Stat(db.databasePath); os.IsNotExist(err) {
 		return nil
 	}
@@ -95,7 +116,17 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 		}
 		return nil, err
 	}
-	kv := &Store{db: boltDB, databasePath: datafile}
+	return newStore(&Store{db: boltDB, databasePath: datafile}, cfg)
+}
+
+// NewKVStoreWithDB initializes the slasher kv-buckets in a boltDB database already opened by
+// another store, like the beacon node database, so both keep their data in the same file.
+// Closing the returned store leaves the database open.
+func NewKVStoreWithDB(boltDB *bolt.DB, cfg *Config) (*Store, error) {
+	return newStore(&Store{db: boltDB, databasePath: boltDB.Path(), shared: true}, cfg)
+}
+
+func newStore(kv *Store, cfg *Config) (*Store, error) {
 	kv.EnableSpanCache(true)
 	spanCache, err := cache.NewEpochSpansCache(cfg.SpanCacheSize, persistSpanMapsOnEviction(kv))
 	if err != nil {
@@ -117,6 +148,7 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 			historicBlockHeadersBucket,
 			compressedIdxAttsBucket,
 			validatorsPublicKeysBucket,
+			highestAttestationBucket,
 			validatorsMinMaxSpanBucket,
 			validatorsMinMaxSpanBucketNew,
 			slashingBucket,
@@ -3,6 +3,7 @@ package kv
 import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	bolt "go.etcd.io/bbolt"
 )
 
 const (
@@ -20,6 +21,7 @@ var (
 	chainDataBucket                   = []byte("chain-data-bucket")
 	compressedIdxAttsBucket           = []byte("compressed-idx-atts-bucket")
 	validatorsPublicKeysBucket        = []byte("validators-public-keys-bucket")
+	highestAttestationBucket          = []byte("highest-attestation-bucket")
 	// In order to quickly detect surround and surrounded attestations we need to store
 	// the min and max span for each validator for each epoch.
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
@@ -44,3 +46,20 @@ func encodeType(st types.SlashingType) []byte {
 func encodeTypeRoot(st types.SlashingType, root [32]byte) []byte {
 	return append([]byte{byte(st)}, root[:]...)
 }
+
+// keysWithEpochPrefixAtMost returns all keys in the bucket whose 8 byte little endian
+// prefix, an epoch or a slot, is lower or equal to the given value. Keys are copied so
+// they can be safely deleted from the bucket afterwards.
+func keysWithEpochPrefixAtMost(bucket *bolt.Bucket, max uint64) ([][]byte, error) {
+	var keys [][]byte
+	err := bucket.ForEach(func(k, _ []byte) error {
+		if len(k) < 8 || bytesutil.FromBytes8(k[:8]) > max {
+			return nil
+		}
+		key := make([]byte, len(k))
+		copy(key, k)
+		keys = append(keys, key)
+		return nil
+	})
+	return keys, err
+}
@@ -95,6 +95,66 @@ func (db *Store) SaveEpochSpans(ctx context.Context, epoch uint64, es *types.Epo
 	})
 }
 
+// SaveEpochsSpans accepts a map of epoch to span byte arrays and writes all of them
+// to disk in a single transaction, updating the cached entries so cache and DB never conflict.
+func (db *Store) SaveEpochsSpans(ctx context.Context, epochsSpans map[uint64]*types.EpochStore) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveEpochsSpans")
+	defer span.End()
+	for _, es := range epochsSpans {
+		if len(es.Bytes())%int(types.SpannerEncodedLength) != 0 {
+			return types.ErrWrongSize
+		}
+	}
+	for epoch, es := range epochsSpans {
+		if db.flatSpanCache.Has(epoch) {
+			db.flatSpanCache.Set(epoch, es)
+		}
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(validatorsMinMaxSpanBucketNew)
+		if err != nil {
+			return err
+		}
+		for epoch, es := range epochsSpans {
+			if err := b.Put(bytesutil.Bytes8(epoch), es.Bytes()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PruneSpans removes the span maps of every epoch older than the pruning epoch age
+// from both the cache and the DB.
+func (db *Store) PruneSpans(ctx context.Context, currentEpoch uint64, pruningEpochAge uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PruneSpans")
+	defer span.End()
+	pruneTill := int64(currentEpoch) - int64(pruningEpochAge)
+	if pruneTill <= 0 {
+		return nil
+	}
+	// Evicting from the cache persists the span maps to disk, so this needs to
+	// happen before clearing them from the bucket.
+	for _, epoch := range db.flatSpanCache.Epochs() {
+		if epoch <= uint64(pruneTill) {
+			db.flatSpanCache.Delete(epoch)
+		}
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(validatorsMinMaxSpanBucketNew)
+		keys, err := keysWithEpochPrefixAtMost(bucket, uint64(pruneTill))
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return errors.Wrap(err, "failed to delete epoch spans")
+			}
+		}
+		return nil
+	})
+}
+
 // CacheLength returns the number of cached items.
 func (db *Store) CacheLength(ctx context.Context) int {
 	ctx, span := trace.StartSpan(ctx, "slasherDB.CacheLength")
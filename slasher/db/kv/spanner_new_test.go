@@ -157,3 +157,53 @@ func TestStore_SaveEpochSpans_ToDB(t *testing.T) {
 	require.NoError(t, err)
 	require.DeepEqual(t, epochStore.Bytes(), esFromDB.Bytes())
 }
+
+func TestStore_PruneSpans(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	ctx := context.Background()
+
+	epochStore, err := types.EpochStoreFromMap(map[uint64]types.Span{
+		1: {MinSpan: 5, MaxSpan: 69, SigBytes: [2]byte{40, 219}, HasAttested: true},
+	})
+	require.NoError(t, err)
+	// Epochs are stored as little endian keys, so epoch 256 is sorted before epochs 10 and 260.
+	require.NoError(t, db.SaveEpochSpans(ctx, 10, epochStore, dbTypes.UseCache))
+	require.NoError(t, db.SaveEpochSpans(ctx, 256, epochStore, dbTypes.UseDB))
+	require.NoError(t, db.SaveEpochSpans(ctx, 260, epochStore, dbTypes.UseDB))
+
+	require.NoError(t, db.PruneSpans(ctx, 300, 42))
+
+	for _, epoch := range []uint64{10, 256} {
+		es, err := db.EpochSpans(ctx, epoch, dbTypes.UseDB)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(es.Bytes()), "Expected spans for epoch %d to be pruned", epoch)
+	}
+	es, err := db.EpochSpans(ctx, 260, dbTypes.UseDB)
+	require.NoError(t, err)
+	require.DeepEqual(t, epochStore.Bytes(), es.Bytes())
+}
+
+func TestStore_SaveEpochsSpans(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	db := setupDB(t, cli.NewContext(&app, set, nil))
+	ctx := context.Background()
+
+	epochsSpans := make(map[uint64]*types.EpochStore)
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		es, err := types.EpochStoreFromMap(map[uint64]types.Span{
+			epoch: {MinSpan: uint16(epoch), MaxSpan: 2, SigBytes: [2]byte{1, byte(epoch)}, HasAttested: true},
+		})
+		require.NoError(t, err)
+		epochsSpans[epoch] = es
+	}
+	require.NoError(t, db.SaveEpochsSpans(ctx, epochsSpans))
+
+	for epoch, want := range epochsSpans {
+		es, err := db.EpochSpans(ctx, epoch, dbTypes.UseDB)
+		require.NoError(t, err)
+		require.DeepEqual(t, want.Bytes(), es.Bytes(), "Unexpected spans for epoch %d", epoch)
+	}
+}
@@ -14,6 +14,8 @@ const (
 	Included
 	// Reverted slashing proof that has been reverted and therefore is relevant again.
 	Reverted //relevant again
+	// Submitted slashing proof that has been submitted to the beacon node.
+	Submitted
 )
 
 const (
@@ -28,9 +30,10 @@ func (status SlashingStatus) String() string {
 		"Unknown",
 		"Active",
 		"Included",
-		"Reverted"}
+		"Reverted",
+		"Submitted"}
 
-	if status < Active || status > Reverted {
+	if status < Active || status > Submitted {
 		return "Unknown"
 	}
 	// return the name of a SlashingStatus
@@ -0,0 +1,187 @@
+package attestations
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	dbTypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"go.opencensus.io/trace"
+)
+
+var _ = iface.SpanBatch(&SpanBatch{})
+
+// SpanBatch is a span detector which buffers the epoch spans it reads and writes
+// in memory, so detection for an attestation in the batch takes into account the
+// span updates of the attestations before it, and all updates are persisted at once
+// when the batch is flushed.
+type SpanBatch struct {
+	*SpanDetector
+	parent *SpanDetector
+	spans  *bufferedSpans
+}
+
+// NewBatch creates a span batch on top of the span detector database. A batch which
+// is not flushed is simply discarded, without any of its span updates being persisted.
+func (s *SpanDetector) NewBatch() iface.SpanBatch {
+	spans := &bufferedSpans{
+		Database: s.slasherDB,
+		read:     make(map[uint64]*types.EpochStore),
+		spans:    make(map[uint64]*types.EpochStore),
+		writes:   make(map[uint64]bool),
+	}
+	return &SpanBatch{
+		SpanDetector: NewSpanDetector(spans),
+		parent:       s,
+		spans:        spans,
+	}
+}
+
+// Flush persists every epoch span updated in the batch. Spans marked to be saved to
+// the cache are saved there, while all the others are written in a single DB transaction.
+// The validator spans updated by the batch are merged into the epoch spans of the span
+// detector, so the span updates made since the batch read them are not overwritten.
+func (b *SpanBatch) Flush(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "spanner.Flush")
+	defer span.End()
+	b.parent.lock.Lock()
+	defer b.parent.lock.Unlock()
+	return b.spans.flush(ctx)
+}
+
+// bufferedSpans wraps a slasher database, keeping every epoch span read
+// or saved through it in memory until flushed.
+type bufferedSpans struct {
+	db.Database
+	// read keeps the epoch spans as they were read from the underlying database,
+	// to find the validator spans updated by the batch when flushing.
+	read  map[uint64]*types.EpochStore
+	spans map[uint64]*types.EpochStore
+	// writes tracks the epochs saved since the last flush,
+	// and whether all of their saves were requested to the cache.
+	writes map[uint64]bool
+}
+
+// EpochSpans returns the buffered spans for the epoch, reading them
+// from the underlying database the first time they are requested.
+func (b *bufferedSpans) EpochSpans(ctx context.Context, epoch uint64, fromCache bool) (*types.EpochStore, error) {
+	if es, ok := b.spans[epoch]; ok {
+		return es, nil
+	}
+	es, err := b.Database.EpochSpans(ctx, epoch, fromCache)
+	if err != nil {
+		return nil, err
+	}
+	// The spans read from the cache are shared, so the batch updates a copy of them.
+	read, err := copyEpochStore(es)
+	if err != nil {
+		return nil, err
+	}
+	buffered, err := copyEpochStore(es)
+	if err != nil {
+		return nil, err
+	}
+	b.read[epoch] = read
+	b.spans[epoch] = buffered
+	return buffered, nil
+}
+
+// SaveEpochSpans buffers the spans for the epoch until the next flush.
+func (b *bufferedSpans) SaveEpochSpans(ctx context.Context, epoch uint64, es *types.EpochStore, toCache bool) error {
+	if len(es.Bytes())%int(types.SpannerEncodedLength) != 0 {
+		return types.ErrWrongSize
+	}
+	b.spans[epoch] = es
+	if allToCache, ok := b.writes[epoch]; ok {
+		toCache = allToCache && toCache
+	}
+	b.writes[epoch] = toCache
+	return nil
+}
+
+func (b *bufferedSpans) flush(ctx context.Context) error {
+	toDB := make(map[uint64]*types.EpochStore)
+	for epoch, toCache := range b.writes {
+		current, err := b.Database.EpochSpans(ctx, epoch, dbTypes.UseCache)
+		if err != nil {
+			return errors.Wrapf(err, "could not read spans for epoch %d", epoch)
+		}
+		merged, err := b.merge(epoch, current)
+		if err != nil {
+			return errors.Wrapf(err, "could not merge spans for epoch %d", epoch)
+		}
+		if !toCache {
+			toDB[epoch] = merged
+			continue
+		}
+		if err := b.Database.SaveEpochSpans(ctx, epoch, merged, dbTypes.UseCache); err != nil {
+			return errors.Wrapf(err, "could not save spans for epoch %d", epoch)
+		}
+	}
+	if len(toDB) > 0 {
+		if err := b.Database.SaveEpochsSpans(ctx, toDB); err != nil {
+			return errors.Wrap(err, "could not save epoch spans")
+		}
+	}
+	b.read = make(map[uint64]*types.EpochStore)
+	b.spans = make(map[uint64]*types.EpochStore)
+	b.writes = make(map[uint64]bool)
+	return nil
+}
+
+// merge applies the validator spans of the epoch updated in the batch to the current epoch
+// spans of the underlying database, keeping the lowest min span, the highest max span and
+// the first signature bytes saved for each validator.
+func (b *bufferedSpans) merge(epoch uint64, current *types.EpochStore) (*types.EpochStore, error) {
+	buffered := b.spans[epoch].Bytes()
+	var read []byte
+	if es, ok := b.read[epoch]; ok {
+		read = es.Bytes()
+	}
+	if bytes.Equal(buffered, read) {
+		return current, nil
+	}
+	empty := make([]byte, types.SpannerEncodedLength)
+	for cursor := uint64(0); cursor < uint64(len(buffered)); cursor += types.SpannerEncodedLength {
+		enc := buffered[cursor : cursor+types.SpannerEncodedLength]
+		readEnc := empty
+		if cursor < uint64(len(read)) {
+			readEnc = read[cursor : cursor+types.SpannerEncodedLength]
+		}
+		if bytes.Equal(enc, readEnc) {
+			continue
+		}
+		span, err := types.UnmarshalSpan(enc)
+		if err != nil {
+			return nil, err
+		}
+		idx := cursor / types.SpannerEncodedLength
+		currentSpan, err := current.GetValidatorSpan(idx)
+		if err != nil {
+			return nil, err
+		}
+		if currentSpan.MinSpan > 0 && (span.MinSpan == 0 || currentSpan.MinSpan < span.MinSpan) {
+			span.MinSpan = currentSpan.MinSpan
+		}
+		if currentSpan.MaxSpan > span.MaxSpan {
+			span.MaxSpan = currentSpan.MaxSpan
+		}
+		if currentSpan.HasAttested {
+			span.HasAttested = true
+			span.SigBytes = currentSpan.SigBytes
+		}
+		if current, err = current.SetValidatorSpan(idx, span); err != nil {
+			return nil, err
+		}
+	}
+	return current, nil
+}
+
+func copyEpochStore(es *types.EpochStore) (*types.EpochStore, error) {
+	spans := make([]byte, len(es.Bytes()))
+	copy(spans, es.Bytes())
+	return types.NewEpochStore(spans)
+}
@@ -0,0 +1,108 @@
+package attestations
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	dbTypes "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestSpanBatch_Flush_MatchesUpdateSpans(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSpanDetector(testDB.SetupSlasherDB(t, false))
+	batchDetector := NewSpanDetector(testDB.SetupSlasherDB(t, false))
+
+	// Attestations far enough from genesis for part of the min span updates
+	// to go beyond the lookback and be saved to the DB.
+	atts := []*ethpb.IndexedAttestation{
+		indexedAttestation(3, 4, []uint64{0}),
+		indexedAttestation(5, 7, []uint64{0, 1}),
+		indexedAttestation(150, 151, []uint64{1, 2}),
+		indexedAttestation(140, 152, []uint64{3}),
+	}
+	batch := batchDetector.NewBatch()
+	for _, att := range atts {
+		require.NoError(t, sd.UpdateSpans(ctx, att))
+		require.NoError(t, batch.UpdateSpans(ctx, att))
+	}
+	require.NoError(t, batch.Flush(ctx))
+
+	for epoch := uint64(0); epoch <= 152; epoch++ {
+		want, err := sd.slasherDB.EpochSpans(ctx, epoch, dbTypes.UseDB)
+		require.NoError(t, err)
+		got, err := batchDetector.slasherDB.EpochSpans(ctx, epoch, dbTypes.UseDB)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want.Bytes(), got.Bytes(), "Unexpected spans for epoch %d", epoch)
+	}
+}
+
+func TestSpanBatch_DetectsSlashingsWithinBatch(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSpanDetector(testDB.SetupSlasherDB(t, false))
+	batch := sd.NewBatch()
+
+	require.NoError(t, batch.UpdateSpans(ctx, indexedAttestation(3, 4, []uint64{0})))
+	doubleVote := indexedAttestation(2, 4, []uint64{0})
+	doubleVote.Signature = []byte{3, 4}
+	want := []*types.DetectionResult{
+		{
+			ValidatorIndex: 0,
+			Kind:           types.DoubleVote,
+			SlashableEpoch: 4,
+			SigBytes:       [2]byte{1, 2},
+		},
+	}
+	res, err := batch.DetectSlashingsForAttestation(ctx, doubleVote)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res)
+
+	require.NoError(t, batch.Flush(ctx))
+	res, err = sd.DetectSlashingsForAttestation(ctx, doubleVote)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res)
+}
+
+func TestSpanBatch_Flush_KeepsConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSpanDetector(testDB.SetupSlasherDB(t, false))
+	batch := sd.NewBatch()
+	require.NoError(t, batch.UpdateSpans(ctx, indexedAttestation(3, 4, []uint64{0})))
+
+	// Span updates are not blocked by a buffered batch, and are not overwritten by its flush.
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(3, 4, []uint64{1})))
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(1, 4, []uint64{0})))
+	require.NoError(t, batch.Flush(ctx))
+
+	spans, err := sd.slasherDB.EpochSpans(ctx, 2, dbTypes.UseCache)
+	require.NoError(t, err)
+	want := []types.Span{
+		{MinSpan: 2, MaxSpan: 2},
+		{MinSpan: 2},
+	}
+	for idx, wantSpan := range want {
+		span, err := spans.GetValidatorSpan(uint64(idx))
+		require.NoError(t, err)
+		assert.Equal(t, wantSpan.MinSpan, span.MinSpan, "Unexpected min span for validator %d", idx)
+		assert.Equal(t, wantSpan.MaxSpan, span.MaxSpan, "Unexpected max span for validator %d", idx)
+	}
+}
+
+func TestSpanBatch_NotFlushed(t *testing.T) {
+	ctx := context.Background()
+	sd := NewSpanDetector(testDB.SetupSlasherDB(t, false))
+	batch := sd.NewBatch()
+	require.NoError(t, batch.UpdateSpans(ctx, indexedAttestation(3, 4, []uint64{0})))
+
+	// A discarded batch neither blocks span updates nor changes the spans of the detector.
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(3, 4, []uint64{1})))
+	spans, err := sd.slasherDB.EpochSpans(ctx, 2, dbTypes.UseCache)
+	require.NoError(t, err)
+	span, err := spans.GetValidatorSpan(0)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(0), span.MinSpan, "Unexpected min span for validator 0")
+}
@@ -18,4 +18,13 @@ type SpanDetector interface {
 
 	// Write functions.
 	UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error
+	NewBatch() SpanBatch
+}
+
+// SpanBatch defines a span detector which keeps its span updates in memory
+// until they are flushed to the database at once. A batch which is not flushed
+// is discarded without persisting its span updates.
+type SpanBatch interface {
+	SpanDetector
+	Flush(ctx context.Context) error
 }
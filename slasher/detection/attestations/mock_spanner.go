@@ -76,3 +76,17 @@ func (s *MockSpanDetector) DeleteValidatorSpansByEpoch(ctx context.Context, vali
 func (s *MockSpanDetector) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
 	return nil
 }
+
+// NewBatch returns a mocked span batch which runs detection and updates on the mock itself.
+func (s *MockSpanDetector) NewBatch() iface.SpanBatch {
+	return &mockSpanBatch{MockSpanDetector: s}
+}
+
+type mockSpanBatch struct {
+	*MockSpanDetector
+}
+
+// Flush is a mock for flushing a span batch.
+func (b *mockSpanBatch) Flush(ctx context.Context) error {
+	return nil
+}
@@ -5,6 +5,7 @@ package attestations
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -42,6 +43,9 @@ var _ = iface.SpanDetector(&SpanDetector{})
 // spans from validators and attestation data roots.
 type SpanDetector struct {
 	slasherDB db.Database
+	// lock is held while spans are updated and while span batches are flushed, so the
+	// span updates of a batch are merged into up to date epoch spans.
+	lock sync.Mutex
 }
 
 // NewSpanDetector creates a new instance of a struct tracking
@@ -154,6 +158,8 @@ func (s *SpanDetector) DetectSlashingsForAttestation(
 func (s *SpanDetector) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
 	ctx, span := trace.StartSpan(ctx, "spanner.UpdateSpans")
 	defer span.End()
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	// Save the signature for the received attestation so we can have more detail to find it in the DB.
 	if err := s.saveSigBytes(ctx, att); err != nil {
 		return err
@@ -191,7 +197,7 @@ func (s *SpanDetector) saveSigBytes(ctx context.Context, att *ethpb.IndexedAttes
 		// If the validator has already attested for this target epoch,
 		// then we do not need to update the values of the span sig bytes.
 		if span.HasAttested {
-			return nil
+			continue
 		}
 
 		sigBytes := [2]byte{0, 0}
@@ -272,6 +278,9 @@ func (s *SpanDetector) updateMinSpan(ctx context.Context, att *ethpb.IndexedAtte
 				indices = append(indices, idx)
 			}
 		}
+		// Min spans are monotonic going back in time, so once a validator's span
+		// was not lowered at this epoch it will not be lowered at any earlier one.
+		valIndices = indices
 		if epoch <= lookbackEpoch && dbOrCache == dbTypes.UseCache {
 			dbOrCache = dbTypes.UseDB
 		}
@@ -330,6 +339,8 @@ func (s *SpanDetector) updateMaxSpan(ctx context.Context, att *ethpb.IndexedAtte
 		if err := s.slasherDB.SaveEpochSpans(ctx, epoch, spanMap, dbTypes.UseCache); err != nil {
 			return err
 		}
+		// Likewise, max spans only shrink moving towards the target epoch.
+		valIndices = indices
 		if len(indices) == 0 {
 			break
 		}
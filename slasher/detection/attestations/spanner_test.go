@@ -842,3 +842,91 @@ func TestSpanDetector_UpdateMinSpansCheckCacheSize(t *testing.T) {
 	require.NoError(t, sd.updateMinSpan(ctx, att))
 	require.Equal(t, epochLookback, db.CacheLength(ctx), "Unexpected cache length")
 }
+
+func TestSpanDetector_UpdateSpans_StopsPerValidator(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	sd := &SpanDetector{
+		slasherDB: db,
+	}
+
+	// Validator 0 attests first for source 3 target 4.
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(3, 4, []uint64{0})))
+	// Then validators 0 and 1 attest together for source 5 target 7.
+	att := indexedAttestation(5, 7, []uint64{0, 1})
+	att.Signature = []byte{3, 4}
+	require.NoError(t, sd.UpdateSpans(ctx, att))
+
+	want := []map[uint64]types.Span{
+		// Epoch 0.
+		{
+			0: {MinSpan: 4},
+			1: {MinSpan: 7},
+		},
+		// Epoch 1.
+		{
+			0: {MinSpan: 3},
+			1: {MinSpan: 6},
+		},
+		// Epoch 2.
+		{
+			0: {MinSpan: 2},
+			1: {MinSpan: 5},
+		},
+		// Epoch 3.
+		{
+			0: {MinSpan: 4},
+			1: {MinSpan: 4},
+		},
+		// Epoch 4.
+		{
+			0: {MinSpan: 3, SigBytes: [2]byte{1, 2}, HasAttested: true},
+			1: {MinSpan: 3},
+		},
+		// Epoch 5.
+		{},
+		// Epoch 6.
+		{
+			0: {MaxSpan: 1},
+			1: {MaxSpan: 1},
+		},
+		// Epoch 7.
+		{
+			0: {SigBytes: [2]byte{3, 4}, HasAttested: true},
+			1: {SigBytes: [2]byte{3, 4}, HasAttested: true},
+		},
+	}
+	for epoch := range want {
+		sm, err := sd.slasherDB.EpochSpans(ctx, uint64(epoch), dbTypes.UseDB)
+		require.NoError(t, err, "Failed to read from slasherDB")
+		resMap, err := sm.ToMap()
+		require.NoError(t, err)
+		assert.DeepEqual(t, want[epoch], resMap, "Unexpected spans for epoch %d", epoch)
+	}
+
+}
+
+func TestSpanDetector_UpdateSpans_SaveSigBytesPartiallyAttested(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	sd := &SpanDetector{
+		slasherDB: db,
+	}
+
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(0, 1, []uint64{0})))
+	att := indexedAttestation(0, 1, []uint64{0, 1})
+	att.Signature = []byte{3, 4}
+	require.NoError(t, sd.UpdateSpans(ctx, att))
+
+	sm, err := sd.slasherDB.EpochSpans(ctx, 1, dbTypes.UseDB)
+	require.NoError(t, err)
+	resMap, err := sm.ToMap()
+	require.NoError(t, err)
+	// Validator 0 keeps its original signature bytes while validator 1,
+	// which had not attested yet, must be marked as attested.
+	want := map[uint64]types.Span{
+		0: {SigBytes: [2]byte{1, 2}, HasAttested: true},
+		1: {SigBytes: [2]byte{3, 4}, HasAttested: true},
+	}
+	assert.DeepEqual(t, want, resMap)
+}
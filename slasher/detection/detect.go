@@ -3,6 +3,7 @@ package detection
 import (
 	"bytes"
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -11,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 	"go.opencensus.io/trace"
 )
@@ -22,7 +24,97 @@ func (ds *Service) DetectAttesterSlashings(
 ) ([]*ethpb.AttesterSlashing, error) {
 	ctx, span := trace.StartSpan(ctx, "detection.DetectAttesterSlashings")
 	defer span.End()
-	results, err := ds.minMaxSpanDetector.DetectSlashingsForAttestation(ctx, att)
+	return ds.detectAttesterSlashings(ctx, ds.minMaxSpanDetector, att)
+}
+
+// DetectAttesterSlashingsBatch runs detection on a batch of attestations, updating the spans
+// and highest attestations of the ones which are not slashable, and returns the slashings found.
+// The attestations are grouped by validator chunk. Span updates are kept in memory while the
+// attestations of a chunk are processed and are saved with a single DB transaction per chunk.
+func (ds *Service) DetectAttesterSlashingsBatch(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.DetectAttesterSlashingsBatch")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("batchSize", int64(len(atts))))
+	var found []*ethpb.AttesterSlashing
+	for _, chunk := range groupByValidatorChunk(atts) {
+		slashings, err := ds.detectAttesterSlashingsChunk(ctx, chunk)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, slashings...)
+	}
+	return found, nil
+}
+
+// detectAttesterSlashingsChunk runs detection on the attestations of a validator chunk,
+// through a span batch flushed once all of them are processed.
+func (ds *Service) detectAttesterSlashingsChunk(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	batch := ds.minMaxSpanDetector.NewBatch()
+	var found []*ethpb.AttesterSlashing
+	for _, att := range atts {
+		if ctx.Err() != nil {
+			break
+		}
+		attestationsProcessed.Inc()
+		slashings, err := ds.detectAttesterSlashings(ctx, batch, att)
+		if err != nil {
+			log.WithError(err).Error("Could not detect attester slashings")
+			continue
+		}
+		if len(slashings) < 1 {
+			if err := ds.updateSpans(ctx, batch, att); err != nil {
+				log.WithError(err).Error("Could not update spans")
+			}
+			if err := ds.UpdateHighestAttestation(ctx, att); err != nil {
+				log.WithError(err).Error("Could not update highest attestation")
+			}
+		}
+		ds.submitAttesterSlashings(ctx, slashings)
+		found = append(found, slashings...)
+	}
+	if err := batch.Flush(ctx); err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return found, nil
+}
+
+// groupByValidatorChunk groups the attestations by the validator chunk of their first attesting
+// index. Chunks are ordered by their first attestation, and attestations keep their order within
+// their chunk.
+func groupByValidatorChunk(atts []*ethpb.IndexedAttestation) [][]*ethpb.IndexedAttestation {
+	var chunks [][]*ethpb.IndexedAttestation
+	positions := make(map[uint64]int)
+	for _, att := range atts {
+		var chunk uint64
+		if len(att.AttestingIndices) > 0 {
+			chunk = att.AttestingIndices[0] / validatorChunkSize
+		}
+		i, ok := positions[chunk]
+		if !ok {
+			i = len(chunks)
+			positions[chunk] = i
+			chunks = append(chunks, nil)
+		}
+		chunks[i] = append(chunks[i], att)
+	}
+	return chunks
+}
+
+func (ds *Service) detectAttesterSlashings(
+	ctx context.Context,
+	spanner iface.SpanDetector,
+	att *ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	results, err := spanner.DetectSlashingsForAttestation(ctx, att)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +172,26 @@ func (ds *Service) DetectAttesterSlashings(
 
 // UpdateSpans passthrough function that updates span maps given an indexed attestation.
 func (ds *Service) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	return ds.minMaxSpanDetector.UpdateSpans(ctx, att)
+	return ds.updateSpans(ctx, ds.minMaxSpanDetector, att)
+}
+
+func (ds *Service) updateSpans(ctx context.Context, spanner iface.SpanDetector, att *ethpb.IndexedAttestation) error {
+	start := time.Now()
+	defer func() {
+		spanUpdateLatency.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+	return spanner.UpdateSpans(ctx, att)
+}
+
+// UpdateHighestAttestation records the attestation source and target epochs for each of its
+// attesting validators whenever they are higher than the ones previously observed.
+func (ds *Service) UpdateHighestAttestation(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "detection.UpdateHighestAttestation")
+	defer span.End()
+	if err := ds.slasherDB.UpdateHighestAttestations(ctx, att.AttestingIndices, att.Data.Source.Epoch, att.Data.Target.Epoch); err != nil {
+		return errors.Wrap(err, "could not update highest attestations")
+	}
+	return nil
 }
 
 // detectDoubleVote cross references the passed in attestation with the bloom filter maintained
@@ -167,7 +278,14 @@ func (ds *Service) detectSurroundVotes(
 
 // DetectDoubleProposals checks if the given signed beacon block is a slashable offense and returns the slashing.
 func (ds *Service) DetectDoubleProposals(ctx context.Context, incomingBlock *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error) {
-	return ds.proposalsDetector.DetectDoublePropose(ctx, incomingBlock)
+	slashing, err := ds.proposalsDetector.DetectDoublePropose(ctx, incomingBlock)
+	if err != nil {
+		return nil, err
+	}
+	if slashing != nil {
+		doubleProposalsDetected.Inc()
+	}
+	return slashing, nil
 }
 
 // DetectDoubleProposeNoUpdate checks if the given beacon block header is a slashable offense.
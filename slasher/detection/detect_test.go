@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -557,3 +558,67 @@ func createIndexedAttForResult(result *types.DetectionResult) *ethpb.IndexedAtte
 		Signature: append(result.SigBytes[:], []byte{uint8(result.ValidatorIndex), 4, 5, 6, 7, 8}...),
 	}
 }
+
+func TestDetect_UpdateHighestAttestation(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	ds := Service{
+		ctx:       ctx,
+		slasherDB: db,
+	}
+	atts := []*ethpb.IndexedAttestation{
+		{
+			AttestingIndices: []uint64{1, 2},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 2},
+				Target: &ethpb.Checkpoint{Epoch: 3},
+			},
+		},
+		// Lower epochs should not override the highest ones.
+		{
+			AttestingIndices: []uint64{1},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 0},
+				Target: &ethpb.Checkpoint{Epoch: 1},
+			},
+		},
+		// Source and target are tracked independently.
+		{
+			AttestingIndices: []uint64{2},
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 1},
+				Target: &ethpb.Checkpoint{Epoch: 5},
+			},
+		},
+	}
+	for _, att := range atts {
+		require.NoError(t, ds.UpdateHighestAttestation(ctx, att))
+	}
+
+	want := map[uint64]*slashpb.HighestAttestation{
+		1: {ValidatorId: 1, HighestSourceEpoch: 2, HighestTargetEpoch: 3},
+		2: {ValidatorId: 2, HighestSourceEpoch: 2, HighestTargetEpoch: 5},
+	}
+	for idx, wanted := range want {
+		highest, err := db.HighestAttestation(ctx, idx)
+		require.NoError(t, err)
+		require.DeepEqual(t, wanted, highest)
+	}
+}
+
+func TestDetect_groupByValidatorChunk(t *testing.T) {
+	att := func(indices ...uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{AttestingIndices: indices}
+	}
+	atts := []*ethpb.IndexedAttestation{
+		att(validatorChunkSize+1, 3),
+		att(2, validatorChunkSize),
+		att(),
+		att(2*validatorChunkSize - 1),
+		att(validatorChunkSize - 1),
+	}
+	chunks := groupByValidatorChunk(atts)
+	require.Equal(t, 2, len(chunks))
+	assert.DeepEqual(t, []*ethpb.IndexedAttestation{atts[0], atts[3]}, chunks[0])
+	assert.DeepEqual(t, []*ethpb.IndexedAttestation{atts[1], atts[2], atts[4]}, chunks[1])
+}
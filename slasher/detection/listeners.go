@@ -31,7 +31,8 @@ func (ds *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Sign
 				log.WithError(err).Error("Could not get block header from block")
 				continue
 			}
-			slashing, err := ds.proposalsDetector.DetectDoublePropose(ctx, signedBlkHdr)
+			blocksProcessed.Inc()
+			slashing, err := ds.DetectDoubleProposals(ctx, signedBlkHdr)
 			if err != nil {
 				log.WithError(err).Error("Could not perform detection on block header")
 				continue
@@ -49,8 +50,9 @@ func (ds *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Sign
 
 // detectIncomingAttestations subscribes to an event feed for
 // attestation objects from a notifier interface. Upon receiving
-// an attestation from the feed, we run surround vote and double vote
-// detection on the attestation.
+// an attestation from the feed, we take every other attestation already
+// waiting in the channel and run surround vote and double vote
+// detection on all of them as a single batch.
 func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethpb.IndexedAttestation) {
 	ctx, span := trace.StartSpan(ctx, "detection.detectIncomingAttestations")
 	defer span.End()
@@ -59,17 +61,10 @@ func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethp
 	for {
 		select {
 		case indexedAtt := <-ch:
-			slashings, err := ds.DetectAttesterSlashings(ctx, indexedAtt)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
-				continue
+			atts := drainAttestations(ch, []*ethpb.IndexedAttestation{indexedAtt})
+			if _, err := ds.DetectAttesterSlashingsBatch(ctx, atts); err != nil {
+				log.WithError(err).Error("Could not run detection on attestations batch")
 			}
-			if len(slashings) < 1 {
-				if err := ds.minMaxSpanDetector.UpdateSpans(ctx, indexedAtt); err != nil {
-					log.WithError(err).Error("Could not update spans")
-				}
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
@@ -79,3 +74,17 @@ func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethp
 		}
 	}
 }
+
+// drainAttestations appends the attestations available in the channel
+// without blocking, up to the attestation batch size.
+func drainAttestations(ch chan *ethpb.IndexedAttestation, atts []*ethpb.IndexedAttestation) []*ethpb.IndexedAttestation {
+	for len(atts) < attestationBatchSize {
+		select {
+		case att := <-ch:
+			atts = append(atts, att)
+		default:
+			return atts
+		}
+	}
+	return atts
+}
@@ -18,4 +18,23 @@ var (
 		Name: "surrounded_votes_detected_total",
 		Help: "The # of surrounded slashable events detected",
 	})
+	doubleProposalsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "double_proposals_detected_total",
+		Help: "The # of double proposal slashable events detected",
+	})
+	attestationsProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_processed_total",
+		Help: "The # of attestations run through slashing detection",
+	})
+	blocksProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_blocks_processed_total",
+		Help: "The # of block headers run through slashing detection",
+	})
+	spanUpdateLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "slasher_span_update_latency_milliseconds",
+			Help:    "Captures the time taken to update min-max spans for an attestation in milliseconds",
+			Buckets: []float64{1, 5, 10, 50, 100, 500, 1000},
+		},
+	)
 )
@@ -36,20 +36,26 @@ func (dd *ProposeDetector) DetectDoublePropose(
 	if err != nil {
 		return nil, err
 	}
+	var ps *ethpb.ProposerSlashing
 	for _, blockHeader := range headersFromIdx {
-		if bytes.Equal(blockHeader.Signature, incomingBlk.Signature) {
-			continue
-		}
-		ps := &ethpb.ProposerSlashing{Header_1: incomingBlk, Header_2: blockHeader}
-		if err := dd.slasherDB.SaveProposerSlashing(ctx, status.Active, ps); err != nil {
-			return nil, err
+		if !bytes.Equal(blockHeader.Signature, incomingBlk.Signature) {
+			ps = &ethpb.ProposerSlashing{Header_1: incomingBlk, Header_2: blockHeader}
+			break
 		}
-		return ps, nil
 	}
+	// The incoming header is only stored once it was compared with the previous ones. It is stored
+	// even when it conflicts with one of them, so every signed header seen for a (proposer, slot)
+	// pair remains available as evidence.
 	if err := dd.slasherDB.SaveBlockHeader(ctx, incomingBlk); err != nil {
 		return nil, err
 	}
-	return nil, nil
+	if ps == nil {
+		return nil, nil
+	}
+	if err := dd.slasherDB.SaveProposerSlashing(ctx, status.Active, ps); err != nil {
+		return nil, err
+	}
+	return ps, nil
 }
 
 // DetectDoubleProposeNoUpdate detects double proposals for a given block header by db search
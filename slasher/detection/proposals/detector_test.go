@@ -73,3 +73,26 @@ func TestProposalsDetector_DetectSlashingsForBlockHeaders(t *testing.T) {
 		})
 	}
 }
+
+func TestProposalsDetector_DetectDoublePropose_StoresConflictingHeaders(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	sd := &ProposeDetector{
+		slasherDB: db,
+	}
+	blk1, err := testDetect.SignedBlockHeader(testDetect.StartSlot(0), 0)
+	require.NoError(t, err)
+	blk2, err := testDetect.SignedBlockHeader(testDetect.StartSlot(0), 0)
+	require.NoError(t, err)
+
+	res, err := sd.DetectDoublePropose(ctx, blk1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, (*ethpb.ProposerSlashing)(nil), res)
+	res, err = sd.DetectDoublePropose(ctx, blk2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.ProposerSlashing{Header_1: blk2, Header_2: blk1}, res)
+
+	headers, err := db.BlockHeaders(ctx, blk1.Header.Slot, blk1.Header.ProposerIndex)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(headers), "Expected both conflicting headers to be stored")
+}
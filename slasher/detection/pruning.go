@@ -0,0 +1,54 @@
+package detection
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// pruneHistory periodically removes indexed attestations, spans and block headers
+// older than the configured pruning epoch age, relative to the beacon node's
+// current head epoch.
+func (ds *Service) pruneHistory(ctx context.Context) {
+	interval := params.BeaconConfig().PruneSlasherStoragePeriod *
+		params.BeaconConfig().SlotsPerEpoch *
+		params.BeaconConfig().SecondsPerSlot
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			head, err := ds.chainFetcher.ChainHead(ctx)
+			if err != nil {
+				log.WithError(err).Error("Cannot retrieve chain head from beacon node")
+				continue
+			}
+			if err := ds.pruneHistoryForEpoch(ctx, head.HeadEpoch); err != nil {
+				log.WithError(err).Error("Could not prune slasher history")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// pruneHistoryForEpoch removes all slasher records older than the pruning epoch age
+// before the given epoch.
+func (ds *Service) pruneHistoryForEpoch(ctx context.Context, currentEpoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "detection.pruneHistoryForEpoch")
+	defer span.End()
+	if err := ds.slasherDB.PruneAttHistory(ctx, currentEpoch, ds.pruningEpochAge); err != nil {
+		return errors.Wrap(err, "could not prune indexed attestations")
+	}
+	if err := ds.slasherDB.PruneSpans(ctx, currentEpoch, ds.pruningEpochAge); err != nil {
+		return errors.Wrap(err, "could not prune spans")
+	}
+	if err := ds.slasherDB.PruneBlockHistory(ctx, currentEpoch, ds.pruningEpochAge); err != nil {
+		return errors.Wrap(err, "could not prune block headers")
+	}
+	log.WithField("epoch", currentEpoch).Debug("Pruned slasher history")
+	return nil
+}
@@ -0,0 +1,59 @@
+package detection
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+)
+
+func TestService_PruneHistoryForEpoch(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	ds := Service{
+		slasherDB:       db,
+		pruningEpochAge: 10,
+	}
+
+	oldAtt := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 1},
+			Target: &ethpb.Checkpoint{Epoch: 2},
+		},
+		Signature: []byte{1, 2},
+	}
+	recentAtt := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 14},
+			Target: &ethpb.Checkpoint{Epoch: 15},
+		},
+		Signature: []byte{3, 4},
+	}
+	require.NoError(t, db.SaveIndexedAttestations(ctx, []*ethpb.IndexedAttestation{oldAtt, recentAtt}))
+	oldHeader := &ethpb.SignedBeaconBlockHeader{
+		Header:    &ethpb.BeaconBlockHeader{Slot: 1, ProposerIndex: 1},
+		Signature: []byte{1, 2},
+	}
+	recentHeader := &ethpb.SignedBeaconBlockHeader{
+		Header:    &ethpb.BeaconBlockHeader{Slot: 15 * params.BeaconConfig().SlotsPerEpoch, ProposerIndex: 1},
+		Signature: []byte{3, 4},
+	}
+	require.NoError(t, db.SaveBlockHeader(ctx, oldHeader))
+	require.NoError(t, db.SaveBlockHeader(ctx, recentHeader))
+
+	require.NoError(t, ds.pruneHistoryForEpoch(ctx, 20))
+
+	exists, err := db.HasIndexedAttestation(ctx, oldAtt)
+	require.NoError(t, err)
+	require.Equal(t, false, exists, "Expected old attestation to be pruned")
+	exists, err = db.HasIndexedAttestation(ctx, recentAtt)
+	require.NoError(t, err)
+	require.Equal(t, true, exists, "Expected recent attestation to be kept")
+	require.Equal(t, false, db.HasBlockHeader(ctx, oldHeader.Header.Slot, 1), "Expected old block header to be pruned")
+	require.Equal(t, true, db.HasBlockHeader(ctx, recentHeader.Header.Slot, 1), "Expected recent block header to be kept")
+}
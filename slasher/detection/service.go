@@ -6,6 +6,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
@@ -18,6 +19,14 @@ import (
 
 var log = logrus.WithField("prefix", "detection")
 
+// attestationBatchSize is the maximum number of incoming attestations
+// processed together, with a span flush per validator chunk.
+const attestationBatchSize = 1024
+
+// validatorChunkSize is the number of validators whose attestations are
+// grouped together in a batch, their span updates being flushed per chunk.
+const validatorChunkSize = 256
+
 // Status detection statuses type.
 type Status int
 
@@ -62,6 +71,9 @@ type Service struct {
 	minMaxSpanDetector    iface.SpanDetector
 	proposalsDetector     proposerIface.ProposalsDetector
 	historicalDetection   bool
+	historicalStartEpoch  uint64
+	historicalEndEpoch    uint64
+	pruningEpochAge       uint64
 	status                Status
 }
 
@@ -74,11 +86,18 @@ type Config struct {
 	AttesterSlashingsFeed *event.Feed
 	ProposerSlashingsFeed *event.Feed
 	HistoricalDetection   bool
+	HistoricalStartEpoch  uint64
+	HistoricalEndEpoch    uint64
+	PruningEpochAge       uint64
 }
 
 // NewDetectionService instantiation.
 func NewDetectionService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
+	pruningEpochAge := cfg.PruningEpochAge
+	if pruningEpochAge == 0 {
+		pruningEpochAge = params.BeaconConfig().WeakSubjectivityPeriod
+	}
 	return &Service{
 		ctx:                   ctx,
 		cancel:                cancel,
@@ -87,12 +106,15 @@ func NewDetectionService(ctx context.Context, cfg *Config) *Service {
 		slasherDB:             cfg.SlasherDB,
 		beaconClient:          cfg.BeaconClient,
 		blocksChan:            make(chan *ethpb.SignedBeaconBlock, 1),
-		attsChan:              make(chan *ethpb.IndexedAttestation, 1),
+		attsChan:              make(chan *ethpb.IndexedAttestation, attestationBatchSize),
 		attesterSlashingsFeed: cfg.AttesterSlashingsFeed,
 		proposerSlashingsFeed: cfg.ProposerSlashingsFeed,
 		minMaxSpanDetector:    attestations.NewSpanDetector(cfg.SlasherDB),
 		proposalsDetector:     proposals.NewProposeDetector(cfg.SlasherDB),
 		historicalDetection:   cfg.HistoricalDetection,
+		historicalStartEpoch:  cfg.HistoricalStartEpoch,
+		historicalEndEpoch:    cfg.HistoricalEndEpoch,
+		pruningEpochAge:       pruningEpochAge,
 		status:                None,
 	}
 }
@@ -124,8 +146,8 @@ func (ds *Service) Start() {
 	sub.Unsubscribe()
 
 	if ds.historicalDetection {
-		// The detection service runs detection on all historical
-		// chain data since genesis.
+		// The detection service runs detection on historical chain data
+		// from the configured start epoch, or the oldest epoch we keep.
 		ds.status = HistoricalDetection
 		ds.detectHistoricalChainData(ds.ctx)
 	}
@@ -137,6 +159,15 @@ func (ds *Service) Start() {
 	// our gRPC client to keep detecting slashable offenses.
 	go ds.detectIncomingBlocks(ds.ctx, ds.blocksChan)
 	go ds.detectIncomingAttestations(ds.ctx, ds.attsChan)
+	// We prune records older than the pruning epoch age so the slasher
+	// database does not grow without bound.
+	go ds.pruneHistory(ds.ctx)
+}
+
+// StartPruning only starts pruning the slasher history, for slashers which receive blocks and
+// attestations by other means than the beacon client, such as the one embedded in the beacon node.
+func (ds *Service) StartPruning() {
+	go ds.pruneHistory(ds.ctx)
 }
 
 func (ds *Service) detectHistoricalChainData(ctx context.Context) {
@@ -154,18 +185,16 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 		log.WithError(err).Error("Cannot retrieve chain head from beacon node")
 		return
 	}
-	var latestStoredEpoch uint64
-	if latestStoredHead != nil {
-		latestStoredEpoch = latestStoredHead.HeadEpoch
-	}
-	log.Infof("Performing historical detection from epoch %d to %d", latestStoredEpoch, currentChainHead.HeadEpoch)
+	startEpoch := ds.historicalDetectionStartEpoch(latestStoredHead, currentChainHead)
+	log.Infof("Performing historical detection from epoch %d to %d", startEpoch, ds.historicalDetectionEndEpoch(currentChainHead))
 
 	// We retrieve historical chain data from the last persisted chain head in the
-	// slasher DB up to the current beacon node's head epoch we retrieved via gRPC.
+	// slasher DB up to the current beacon node's head epoch we retrieved via gRPC,
+	// or up to the configured end epoch.
 	// If no data was persisted from previous sessions, we request data starting from
-	// the genesis epoch.
+	// the configured start epoch, or the oldest epoch within the pruning epoch age.
 	var storedEpoch uint64
-	for epoch := latestStoredEpoch; epoch < currentChainHead.HeadEpoch; epoch++ {
+	for epoch := startEpoch; epoch < ds.historicalDetectionEndEpoch(currentChainHead); epoch++ {
 		if ctx.Err() != nil {
 			log.WithError(err).Errorf("Could not fetch attestations for epoch: %d", epoch)
 			return
@@ -180,22 +209,9 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 			return
 		}
 
-		for _, att := range indexedAtts {
-			if ctx.Err() == context.Canceled {
-				log.WithError(ctx.Err()).Error("context has been canceled, ending detection")
-				return
-			}
-			slashings, err := ds.DetectAttesterSlashings(ctx, att)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
-				continue
-			}
-			if len(slashings) < 1 {
-				if err := ds.minMaxSpanDetector.UpdateSpans(ctx, att); err != nil {
-					log.WithError(err).Error("Could not update spans")
-				}
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
+		if _, err := ds.DetectAttesterSlashingsBatch(ctx, indexedAtts); err != nil {
+			log.WithError(err).Errorf("Could not run detection on attestations for epoch: %d", epoch)
+			return
 		}
 		latestStoredHead = &ethpb.ChainHead{HeadEpoch: epoch}
 		if err := ds.slasherDB.SaveChainHead(ctx, latestStoredHead); err != nil {
@@ -203,7 +219,7 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 		}
 		storedEpoch = epoch
 		ds.slasherDB.RemoveOldestFromCache(ctx)
-		if epoch == currentChainHead.HeadEpoch-1 {
+		if ds.historicalEndEpoch == 0 && epoch == currentChainHead.HeadEpoch-1 {
 			currentChainHead, err = ds.chainFetcher.ChainHead(ctx)
 			if err != nil {
 				log.WithError(err).Error("Cannot retrieve chain head from beacon node")
@@ -217,6 +233,29 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 	log.Infof("Completed slashing detection on historical chain data up to epoch %d", storedEpoch)
 }
 
+// historicalDetectionStartEpoch returns the epoch from which historical detection should
+// run. Epochs already processed in a previous session are never replayed, and epochs
+// older than the pruning epoch age are skipped unless a start epoch was configured.
+func (ds *Service) historicalDetectionStartEpoch(latestStoredHead *ethpb.ChainHead, currentChainHead *ethpb.ChainHead) uint64 {
+	startEpoch := ds.historicalStartEpoch
+	if startEpoch == 0 && currentChainHead.HeadEpoch > ds.pruningEpochAge {
+		startEpoch = currentChainHead.HeadEpoch - ds.pruningEpochAge
+	}
+	if latestStoredHead != nil && latestStoredHead.HeadEpoch > startEpoch {
+		startEpoch = latestStoredHead.HeadEpoch
+	}
+	return startEpoch
+}
+
+// historicalDetectionEndEpoch returns the epoch before which historical detection stops. It is
+// the current head epoch, unless an earlier end epoch was configured.
+func (ds *Service) historicalDetectionEndEpoch(currentChainHead *ethpb.ChainHead) uint64 {
+	if ds.historicalEndEpoch != 0 && ds.historicalEndEpoch+1 < currentChainHead.HeadEpoch {
+		return ds.historicalEndEpoch + 1
+	}
+	return currentChainHead.HeadEpoch
+}
+
 func (ds *Service) submitAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
 	ctx, span := trace.StartSpan(ctx, "detection.submitAttesterSlashings")
 	defer span.End()
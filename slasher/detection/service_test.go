@@ -0,0 +1,96 @@
+package detection
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestService_HistoricalDetectionStartEpoch(t *testing.T) {
+	tests := []struct {
+		name                 string
+		historicalStartEpoch uint64
+		pruningEpochAge      uint64
+		storedHead           *ethpb.ChainHead
+		currentHead          *ethpb.ChainHead
+		want                 uint64
+	}{
+		{
+			name:            "fresh slasher starts at genesis when the chain is younger than the pruning epoch age",
+			pruningEpochAge: 100,
+			currentHead:     &ethpb.ChainHead{HeadEpoch: 50},
+			want:            0,
+		},
+		{
+			name:            "fresh slasher starts within the pruning epoch age",
+			pruningEpochAge: 100,
+			currentHead:     &ethpb.ChainHead{HeadEpoch: 150},
+			want:            50,
+		},
+		{
+			name:                 "configured start epoch is used by a fresh slasher",
+			historicalStartEpoch: 10,
+			pruningEpochAge:      100,
+			currentHead:          &ethpb.ChainHead{HeadEpoch: 150},
+			want:                 10,
+		},
+		{
+			name:                 "previously processed epochs are not replayed",
+			historicalStartEpoch: 10,
+			pruningEpochAge:      100,
+			storedHead:           &ethpb.ChainHead{HeadEpoch: 120},
+			currentHead:          &ethpb.ChainHead{HeadEpoch: 150},
+			want:                 120,
+		},
+		{
+			name:            "stale progress older than the pruning epoch age is skipped",
+			pruningEpochAge: 100,
+			storedHead:      &ethpb.ChainHead{HeadEpoch: 20},
+			currentHead:     &ethpb.ChainHead{HeadEpoch: 150},
+			want:            50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &Service{
+				historicalStartEpoch: tt.historicalStartEpoch,
+				pruningEpochAge:      tt.pruningEpochAge,
+			}
+			assert.Equal(t, tt.want, ds.historicalDetectionStartEpoch(tt.storedHead, tt.currentHead))
+		})
+	}
+}
+
+func TestService_HistoricalDetectionEndEpoch(t *testing.T) {
+	tests := []struct {
+		name               string
+		historicalEndEpoch uint64
+		currentHead        *ethpb.ChainHead
+		want               uint64
+	}{
+		{
+			name:        "detection runs up to the head epoch by default",
+			currentHead: &ethpb.ChainHead{HeadEpoch: 150},
+			want:        150,
+		},
+		{
+			name:               "detection stops after the configured end epoch",
+			historicalEndEpoch: 100,
+			currentHead:        &ethpb.ChainHead{HeadEpoch: 150},
+			want:               101,
+		},
+		{
+			name:               "end epoch after the head epoch is bound by the head epoch",
+			historicalEndEpoch: 200,
+			currentHead:        &ethpb.ChainHead{HeadEpoch: 150},
+			want:               150,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &Service{historicalEndEpoch: tt.historicalEndEpoch}
+			assert.Equal(t, tt.want, ds.historicalDetectionEndEpoch(tt.currentHead))
+		})
+	}
+}
@@ -51,10 +51,28 @@ var (
 		Name:  "enable-historical-detection",
 		Usage: "Enables historical attestation detection for the slasher. Requires --historical-slasher-node on the beacon node.",
 	}
+	// HistoricalDetectionStartEpochFlag defines the epoch from which historical detection starts.
+	HistoricalDetectionStartEpochFlag = &cli.Uint64Flag{
+		Name: "historical-detection-start-epoch",
+		Usage: "Epoch from which historical detection ingests indexed attestations from the beacon node. " +
+			"Defaults to the oldest epoch within the pruning epoch age. Ignored for epochs already processed.",
+	}
+	// HistoricalDetectionEndEpochFlag defines the last epoch ingested by historical detection.
+	HistoricalDetectionEndEpochFlag = &cli.Uint64Flag{
+		Name: "historical-detection-end-epoch",
+		Usage: "Last epoch for which historical detection ingests indexed attestations from the beacon node. " +
+			"Defaults to the beacon node's head epoch.",
+	}
 	// SpanCacheSize is a flag that sets the size of span cache.
 	SpanCacheSize = &cli.IntFlag{
 		Name:  "spans-cache-size",
 		Usage: "Sets the span cache size.",
 		Value: 1500,
 	}
+	// PruningEpochAgeFlag defines how many epochs of history the slasher keeps in its database.
+	PruningEpochAgeFlag = &cli.Uint64Flag{
+		Name: "pruning-epoch-age",
+		Usage: "Number of epochs of indexed attestations, spans and block headers to keep in the slasher database. " +
+			"Older records are pruned. Defaults to the weak subjectivity period.",
+	}
 )
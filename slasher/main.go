@@ -18,7 +18,6 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/node"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
-	"github.com/urfave/cli/v2/altsrc"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 )
 
@@ -48,10 +47,15 @@ var appFlags = []cli.Flag{
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
+	cmd.TracingExporterFlag,
 	cmd.TraceSampleFractionFlag,
 	cmd.MonitoringHostFlag,
 	flags.MonitoringPortFlag,
 	cmd.LogFileName,
+	cmd.LogFileMaxSizeFlag,
+	cmd.LogFileRotationIntervalFlag,
+	cmd.LogFileMaxBackupsFlag,
+	cmd.LogFileCompressFlag,
 	cmd.LogFormat,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
@@ -69,11 +73,18 @@ var appFlags = []cli.Flag{
 	flags.BeaconCertFlag,
 	flags.BeaconRPCProviderFlag,
 	flags.EnableHistoricalDetectionFlag,
+	flags.HistoricalDetectionStartEpochFlag,
+	flags.HistoricalDetectionEndEpochFlag,
 	flags.SpanCacheSize,
+	flags.PruningEpochAgeFlag,
 }
 
 func init() {
-	appFlags = cmd.WrapFlags(append(appFlags, featureconfig.SlasherFlags...))
+	envFlags, err := cmd.WithEnvVars("PRYSM_SLASHER", append(appFlags, featureconfig.SlasherFlags...))
+	if err != nil {
+		logrus.WithError(err).Fatal("Could not set the environment variables of the flags")
+	}
+	appFlags = cmd.WrapFlags(envFlags)
 }
 
 func main() {
@@ -82,16 +93,12 @@ func main() {
 	app.Usage = `launches an Ethereum Serenity slasher server that interacts with a beacon chain.`
 	app.Version = version.GetVersion()
 	app.Flags = appFlags
+	app.Commands = []*cli.Command{cmd.DumpConfigCommand(appFlags)}
 	app.Action = startSlasher
 	app.Before = func(ctx *cli.Context) error {
 		// Load any flags from file, if specified.
-		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
-			if err := altsrc.InitInputSourceWithContext(
-				appFlags,
-				altsrc.NewYamlSourceFromFlagFunc(
-					cmd.ConfigFileFlag.Name))(ctx); err != nil {
-				return err
-			}
+		if err := cmd.LoadFlagsFromConfig(ctx, appFlags); err != nil {
+			return err
 		}
 
 		format := ctx.String(cmd.LogFormat.Name)
@@ -114,7 +121,7 @@ func main() {
 
 		logFileName := ctx.String(cmd.LogFileName.Name)
 		if logFileName != "" {
-			if err := logutil.ConfigurePersistentLogging(logFileName); err != nil {
+			if err := logutil.ConfigurePersistentLogging(logFileName, cmd.LogRotationConfig(ctx)); err != nil {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
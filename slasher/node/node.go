@@ -58,6 +58,7 @@ func NewSlasherNode(cliCtx *cli.Context) (*SlasherNode, error) {
 		"slasher", // Service name.
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
+		cliCtx.String(cmd.TracingExporterFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
 		cliCtx.Bool(cmd.EnableTracingFlag.Name),
 	); err != nil {
@@ -225,6 +226,11 @@ func (s *SlasherNode) registerDetectionService() error {
 	if err := s.services.FetchService(&bs); err != nil {
 		panic(err)
 	}
+	startEpoch := s.cliCtx.Uint64(flags.HistoricalDetectionStartEpochFlag.Name)
+	endEpoch := s.cliCtx.Uint64(flags.HistoricalDetectionEndEpochFlag.Name)
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return fmt.Errorf("historical detection end epoch %d is before its start epoch %d", endEpoch, startEpoch)
+	}
 	ds := detection.NewDetectionService(s.ctx, &detection.Config{
 		Notifier:              bs,
 		SlasherDB:             s.db,
@@ -233,6 +239,9 @@ func (s *SlasherNode) registerDetectionService() error {
 		AttesterSlashingsFeed: s.attesterSlashingsFeed,
 		ProposerSlashingsFeed: s.proposerSlashingsFeed,
 		HistoricalDetection:   s.cliCtx.Bool(flags.EnableHistoricalDetectionFlag.Name),
+		HistoricalStartEpoch:  startEpoch,
+		HistoricalEndEpoch:    endEpoch,
+		PruningEpochAge:       s.cliCtx.Uint64(flags.PruningEpochAgeFlag.Name),
 	})
 	return s.services.RegisterService(ds)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
@@ -30,6 +31,7 @@ type Server struct {
 	beaconClient    *beaconclient.Service
 	attestationLock sync.Mutex
 	proposeLock     sync.Mutex
+	forkSchedule    *forks.Schedule
 }
 
 // IsSlashableAttestation returns an attester slashing if the attestation submitted
@@ -38,10 +40,6 @@ func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.Indexed
 	ctx, span := trace.StartSpan(ctx, "detection.IsSlashableAttestation")
 	defer span.End()
 
-	log.WithFields(logrus.Fields{
-		"slot":    req.Data.Slot,
-		"indices": req.AttestingIndices,
-	}).Debug("Received attestation via RPC")
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "nil request provided")
 	}
@@ -57,6 +55,10 @@ func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.Indexed
 	if req.Signature == nil {
 		return nil, status.Error(codes.InvalidArgument, "nil signature provided")
 	}
+	log.WithFields(logrus.Fields{
+		"slot":    req.Data.Slot,
+		"indices": req.AttestingIndices,
+	}).Debug("Received attestation via RPC")
 
 	err := attestationutil.IsValidAttestationIndices(ctx, req)
 	if err != nil {
@@ -66,7 +68,7 @@ func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.Indexed
 	if err != nil {
 		return nil, err
 	}
-	fork, err := p2putils.Fork(req.Data.Target.Epoch)
+	fork, err := p2putils.Fork(ss.forkSchedule, req.Data.Target.Epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +111,9 @@ func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.Indexed
 		if err := ss.detector.UpdateSpans(ctx, req); err != nil {
 			log.WithError(err).Error("could not update spans")
 		}
+		if err := ss.detector.UpdateHighestAttestation(ctx, req); err != nil {
+			log.WithError(err).Error("could not update highest attestation")
+		}
 	}
 	return &slashpb.AttesterSlashingResponse{
 		AttesterSlashing: slashings,
@@ -121,10 +126,6 @@ func (ss *Server) IsSlashableBlock(ctx context.Context, req *ethpb.SignedBeaconB
 	ctx, span := trace.StartSpan(ctx, "detection.IsSlashableBlock")
 	defer span.End()
 
-	log.WithFields(logrus.Fields{
-		"slot":           req.Header.Slot,
-		"proposer_index": req.Header.ProposerIndex,
-	}).Info("Received block via RPC")
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "nil request provided")
 	}
@@ -135,12 +136,16 @@ func (ss *Server) IsSlashableBlock(ctx context.Context, req *ethpb.SignedBeaconB
 	if req.Signature == nil {
 		return nil, status.Error(codes.InvalidArgument, "nil signature provided")
 	}
+	log.WithFields(logrus.Fields{
+		"slot":           req.Header.Slot,
+		"proposer_index": req.Header.ProposerIndex,
+	}).Info("Received block via RPC")
 	gvr, err := ss.beaconClient.GenesisValidatorsRoot(ctx)
 	if err != nil {
 		return nil, err
 	}
 	blockEpoch := helpers.SlotToEpoch(req.Header.Slot)
-	fork, err := p2putils.Fork(blockEpoch)
+	fork, err := p2putils.Fork(ss.forkSchedule, blockEpoch)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +179,23 @@ func (ss *Server) IsSlashableBlock(ctx context.Context, req *ethpb.SignedBeaconB
 // IsSlashableAttestationNoUpdate returns true if the attestation submitted
 // is a slashable vote (no db update is being done).
 func (ss *Server) IsSlashableAttestationNoUpdate(ctx context.Context, req *ethpb.IndexedAttestation) (*slashpb.Slashable, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.IsSlashableAttestationNoUpdate")
+	defer span.End()
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request provided")
+	}
+	if req.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request data provided")
+	}
+	if req.Data.Target == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request data target provided")
+	}
+	if req.Data.Source == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request data source provided")
+	}
+	if err := attestationutil.IsValidAttestationIndices(ctx, req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attesting indices: %v", err)
+	}
 	sl := &slashpb.Slashable{}
 	slashings, err := ss.detector.DetectAttesterSlashings(ctx, req)
 	if err != nil {
@@ -189,6 +211,11 @@ func (ss *Server) IsSlashableAttestationNoUpdate(ctx context.Context, req *ethpb
 // IsSlashableBlockNoUpdate returns true if the block submitted
 // is slashable (no db update is being done).
 func (ss *Server) IsSlashableBlockNoUpdate(ctx context.Context, req *ethpb.BeaconBlockHeader) (*slashpb.Slashable, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.IsSlashableBlockNoUpdate")
+	defer span.End()
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request provided")
+	}
 	sl := &slashpb.Slashable{}
 	slash, err := ss.detector.DetectDoubleProposeNoUpdate(ctx, req)
 	if err != nil {
@@ -197,3 +224,28 @@ func (ss *Server) IsSlashableBlockNoUpdate(ctx context.Context, req *ethpb.Beaco
 	sl.Slashable = slash
 	return sl, nil
 }
+
+// HighestAttestations returns the highest source and target epochs observed in the
+// attestations of each of the requested validators. Validators with no recorded
+// attestation are left out of the response.
+func (ss *Server) HighestAttestations(ctx context.Context, req *slashpb.HighestAttestationRequest) (*slashpb.HighestAttestationResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.HighestAttestations")
+	defer span.End()
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request provided")
+	}
+	atts := make([]*slashpb.HighestAttestation, 0, len(req.ValidatorIds))
+	for _, idx := range req.ValidatorIds {
+		highest, err := ss.slasherDB.HighestAttestation(ctx, idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not get highest attestation for validator %d: %v", idx, err)
+		}
+		if highest == nil {
+			continue
+		}
+		atts = append(atts, highest)
+	}
+	return &slashpb.HighestAttestationResponse{
+		Attestations: atts,
+	}, nil
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
@@ -57,13 +59,15 @@ func TestServer_IsSlashableAttestation(t *testing.T) {
 	cfg := &detection.Config{
 		SlasherDB: db,
 	}
-	fork, err := p2putils.Fork(savedAttestation.Data.Target.Epoch)
+	schedule, err := forks.NewBeaconSchedule(nil)
+	require.NoError(t, err)
+	fork, err := p2putils.Fork(schedule, savedAttestation.Data.Target.Epoch)
 	require.NoError(t, err)
 
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewBeaconClientService(ctx, bcCfg)
 	ds := detection.NewDetectionService(ctx, cfg)
-	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
+	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs, forkSchedule: schedule}
 	nClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).Return(wantedGenesis, nil).AnyTimes()
 	bClient.EXPECT().ListValidators(
 		gomock.Any(),
@@ -142,7 +146,9 @@ func TestServer_IsSlashableAttestationNoUpdate(t *testing.T) {
 	cfg := &detection.Config{
 		SlasherDB: db,
 	}
-	fork, err := p2putils.Fork(savedAttestation.Data.Target.Epoch)
+	schedule, err := forks.NewBeaconSchedule(nil)
+	require.NoError(t, err)
+	fork, err := p2putils.Fork(schedule, savedAttestation.Data.Target.Epoch)
 	require.NoError(t, err)
 	domain, err := helpers.Domain(fork, savedAttestation.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester, wantedGenesis.GenesisValidatorsRoot)
 	require.NoError(t, err)
@@ -161,7 +167,7 @@ func TestServer_IsSlashableAttestationNoUpdate(t *testing.T) {
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewBeaconClientService(ctx, bcCfg)
 	ds := detection.NewDetectionService(ctx, cfg)
-	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
+	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs, forkSchedule: schedule}
 	slashings, err := server.IsSlashableAttestation(ctx, savedAttestation)
 	require.NoError(t, err, "Got error while trying to detect slashing")
 	require.Equal(t, 0, len(slashings.AttesterSlashing), "Found slashings while no slashing should have been found on first attestation")
@@ -208,7 +214,9 @@ func TestServer_IsSlashableBlock(t *testing.T) {
 		SlasherDB: db,
 	}
 	savedBlockEpoch := helpers.SlotToEpoch(savedBlock.Header.Slot)
-	fork, err := p2putils.Fork(savedBlockEpoch)
+	schedule, err := forks.NewBeaconSchedule(nil)
+	require.NoError(t, err)
+	fork, err := p2putils.Fork(schedule, savedBlockEpoch)
 	require.NoError(t, err)
 	domain, err := helpers.Domain(fork, savedBlockEpoch, params.BeaconConfig().DomainBeaconProposer, wantedGenesis.GenesisValidatorsRoot)
 	require.NoError(t, err)
@@ -216,7 +224,7 @@ func TestServer_IsSlashableBlock(t *testing.T) {
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewBeaconClientService(ctx, bcCfg)
 	ds := detection.NewDetectionService(ctx, cfg)
-	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
+	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs, forkSchedule: schedule}
 
 	wg := sync.WaitGroup{}
 	wg.Add(100)
@@ -285,7 +293,9 @@ func TestServer_IsSlashableBlockNoUpdate(t *testing.T) {
 		SlasherDB: db,
 	}
 	savedBlockEpoch := helpers.SlotToEpoch(savedBlock.Header.Slot)
-	fork, err := p2putils.Fork(savedBlockEpoch)
+	schedule, err := forks.NewBeaconSchedule(nil)
+	require.NoError(t, err)
+	fork, err := p2putils.Fork(schedule, savedBlockEpoch)
 	require.NoError(t, err)
 	domain, err := helpers.Domain(fork, savedBlockEpoch, params.BeaconConfig().DomainBeaconProposer, wantedGenesis.GenesisValidatorsRoot)
 	require.NoError(t, err)
@@ -299,7 +309,7 @@ func TestServer_IsSlashableBlockNoUpdate(t *testing.T) {
 	bcCfg := &beaconclient.Config{BeaconClient: bClient, NodeClient: nClient, SlasherDB: db}
 	bs, err := beaconclient.NewBeaconClientService(ctx, bcCfg)
 	ds := detection.NewDetectionService(ctx, cfg)
-	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs}
+	server := Server{ctx: ctx, detector: ds, slasherDB: db, beaconClient: bs, forkSchedule: schedule}
 	slashings, err := server.IsSlashableBlock(ctx, savedBlock)
 	require.NoError(t, err, "Got error while trying to detect slashing")
 	require.Equal(t, 0, len(slashings.ProposerSlashing), "Found slashings while no slashing should have been found on first block")
@@ -307,3 +317,78 @@ func TestServer_IsSlashableBlockNoUpdate(t *testing.T) {
 	require.NoError(t, err, "Got error while trying to detect slashing")
 	require.Equal(t, true, sl.Slashable, "Block should be found to be slashable")
 }
+
+func TestServer_HighestAttestations(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	ds := detection.NewDetectionService(ctx, &detection.Config{SlasherDB: db})
+	server := Server{ctx: ctx, detector: ds, slasherDB: db}
+
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 3},
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 4},
+			Target: &ethpb.Checkpoint{Epoch: 5},
+		},
+	}
+	require.NoError(t, ds.UpdateHighestAttestation(ctx, att))
+
+	res, err := server.HighestAttestations(ctx, &slashpb.HighestAttestationRequest{
+		ValidatorIds: []uint64{1, 2, 3},
+	})
+	require.NoError(t, err)
+	want := []*slashpb.HighestAttestation{
+		{ValidatorId: 1, HighestSourceEpoch: 4, HighestTargetEpoch: 5},
+		{ValidatorId: 3, HighestSourceEpoch: 4, HighestTargetEpoch: 5},
+	}
+	require.DeepEqual(t, want, res.Attestations)
+}
+
+func TestServer_IsSlashableAttestationNoUpdate_InvalidRequest(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	ds := detection.NewDetectionService(ctx, &detection.Config{SlasherDB: db})
+	server := Server{ctx: ctx, detector: ds, slasherDB: db}
+
+	tests := []struct {
+		name    string
+		req     *ethpb.IndexedAttestation
+		wantErr string
+	}{
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: "nil request provided",
+		},
+		{
+			name:    "nil data",
+			req:     &ethpb.IndexedAttestation{AttestingIndices: []uint64{1}},
+			wantErr: "nil request data provided",
+		},
+		{
+			name: "nil source",
+			req: &ethpb.IndexedAttestation{
+				AttestingIndices: []uint64{1},
+				Data:             &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 1}},
+			},
+			wantErr: "nil request data source provided",
+		},
+		{
+			name: "unsorted indices",
+			req: &ethpb.IndexedAttestation{
+				AttestingIndices: []uint64{3, 1},
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: 0},
+					Target: &ethpb.Checkpoint{Epoch: 1},
+				},
+			},
+			wantErr: "invalid attesting indices",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.IsSlashableAttestationNoUpdate(ctx, tt.req)
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/forks"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
@@ -115,11 +116,16 @@ func (s *Service) Start() {
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
+	forkSchedule, err := forks.NewBeaconSchedule(nil)
+	if err != nil {
+		log.Errorf("Could not create fork schedule: %v", err)
+	}
 	slasherServer := &Server{
 		ctx:          s.ctx,
 		detector:     s.detector,
 		slasherDB:    s.slasherDB,
 		beaconClient: s.beaconclient,
+		forkSchedule: forkSchedule,
 	}
 	slashpb.RegisterSlasherServer(s.grpcServer, slasherServer)
 
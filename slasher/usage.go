@@ -52,11 +52,16 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingExporterFlag,
 			cmd.TraceSampleFractionFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogFileMaxSizeFlag,
+			cmd.LogFileRotationIntervalFlag,
+			cmd.LogFileMaxBackupsFlag,
+			cmd.LogFileCompressFlag,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
@@ -83,7 +88,10 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RPCHost,
 			flags.BeaconRPCProviderFlag,
 			flags.EnableHistoricalDetectionFlag,
+			flags.HistoricalDetectionStartEpochFlag,
+			flags.HistoricalDetectionEndEpochFlag,
 			flags.SpanCacheSize,
+			flags.PruningEpochAgeFlag,
 		},
 	},
 	{
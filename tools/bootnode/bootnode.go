@@ -69,7 +69,7 @@ func main() {
 	flag.Parse()
 
 	if *logFileName != "" {
-		if err := logutil.ConfigurePersistentLogging(*logFileName); err != nil {
+		if err := logutil.ConfigurePersistentLogging(*logFileName, nil); err != nil {
 			log.WithError(err).Error("Failed to configuring logging to disk.")
 		}
 	}
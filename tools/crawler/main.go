@@ -0,0 +1,341 @@
+/**
+ * Network crawler
+ *
+ * Crawls the discv5 DHT from the given bootnodes for a while and writes a census of the
+ * nodes found: their ENRs, fork digests and attestation subnets, and optionally the agent
+ * strings the nodes report over libp2p identify when dialed. A summary of the client
+ * diversity, fork digests and subnet coverage is logged at the end, which helps monitoring
+ * the health of a testnet.
+ *
+ * Usage: Run crawler --help for flag options.
+ */
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/go-bitfield"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	bootnodes   = flag.String("bootnodes", "", "Comma separated ENRs of the bootnodes to start crawling from, defaults to the bootnodes of the network config")
+	discv5Port  = flag.Int("discv5-port", 9000, "Local UDP port to use for discv5")
+	duration    = flag.Duration("duration", 5*time.Minute, "Time to crawl the DHT for")
+	dialPeers   = flag.Bool("dial-peers", false, "Dial the nodes found with libp2p to collect their agent strings")
+	dialTimeout = flag.Duration("dial-timeout", 10*time.Second, "Timeout of each libp2p dial")
+	concurrency = flag.Int("concurrency", 16, "Number of nodes dialed concurrently")
+	outputPath  = flag.String("output", "census.json", "Path to write the census report to, as JSON")
+)
+
+var log = logrus.WithField("prefix", "crawler")
+
+// nodeInfo is the census entry of a node found in the DHT.
+type nodeInfo struct {
+	ID              string   `json:"id"`
+	ENR             string   `json:"enr"`
+	IP              string   `json:"ip"`
+	TCPPort         int      `json:"tcp_port"`
+	UDPPort         int      `json:"udp_port"`
+	PeerID          string   `json:"peer_id,omitempty"`
+	ForkDigest      string   `json:"fork_digest,omitempty"`
+	NextForkVersion string   `json:"next_fork_version,omitempty"`
+	NextForkEpoch   uint64   `json:"next_fork_epoch,omitempty"`
+	AttSubnets      []uint64 `json:"attestation_subnets"`
+	AgentVersion    string   `json:"agent_version,omitempty"`
+	DialError       string   `json:"dial_error,omitempty"`
+}
+
+// census is the report written by the crawler.
+type census struct {
+	Time        time.Time      `json:"time"`
+	Nodes       []*nodeInfo    `json:"nodes"`
+	ForkDigests map[string]int `json:"fork_digests"`
+	Clients     map[string]int `json:"clients,omitempty"`
+	// Number of nodes advertising each attestation subnet.
+	AttSubnets map[uint64]int `json:"attestation_subnets"`
+}
+
+func main() {
+	flag.Parse()
+
+	var bootnodeENRs []string
+	if *bootnodes != "" {
+		bootnodeENRs = strings.Split(*bootnodes, ",")
+	} else {
+		bootnodeENRs = params.BeaconNetworkConfig().BootstrapNodes
+	}
+	var bootNodes []*enode.Node
+	for _, addr := range bootnodeENRs {
+		node, err := enode.Parse(enode.ValidSchemes, addr)
+		if err != nil {
+			log.Fatalf("Could not parse bootnode ENR %s: %v", addr, err)
+		}
+		bootNodes = append(bootNodes, node)
+	}
+	if len(bootNodes) == 0 {
+		log.Fatal("No bootnodes to crawl from, please specify --bootnodes")
+	}
+
+	privKey, err := ecdsa.GenerateKey(btcec.S256(), rand.Reader)
+	if err != nil {
+		log.Fatalf("Could not generate private key: %v", err)
+	}
+	listener, err := startListener(privKey, bootNodes)
+	if err != nil {
+		log.Fatalf("Could not start discv5 listener: %v", err)
+	}
+	defer listener.Close()
+
+	log.Infof("Crawling the DHT for %s", *duration)
+	nodes := crawl(listener, *duration)
+	log.Infof("Found %d nodes", len(nodes))
+
+	infos := make([]*nodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		infos = append(infos, newNodeInfo(node))
+	}
+	if *dialPeers {
+		h, err := libp2p.New(context.Background(), libp2p.Identity(convertToInterfacePrivkey(privKey)), libp2p.NoListenAddrs)
+		if err != nil {
+			log.Fatalf("Could not create libp2p host: %v", err)
+		}
+		collectAgentVersions(h, nodes, infos)
+		if err := h.Close(); err != nil {
+			log.WithError(err).Error("Could not close libp2p host")
+		}
+	}
+
+	report := newCensus(infos)
+	enc, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatalf("Could not marshal census: %v", err)
+	}
+	if err := ioutil.WriteFile(*outputPath, enc, 0644); err != nil {
+		log.Fatalf("Could not write census: %v", err)
+	}
+	logSummary(report)
+	log.Infof("Wrote census of %d nodes to %s", len(infos), *outputPath)
+}
+
+func startListener(privKey *ecdsa.PrivateKey, bootNodes []*enode.Node) (*discover.UDPv5, error) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4zero, Port: *discv5Port})
+	if err != nil {
+		return nil, err
+	}
+	db, err := enode.OpenDB("")
+	if err != nil {
+		return nil, err
+	}
+	localNode := enode.NewLocalNode(db, privKey)
+	return discover.ListenV5(conn, localNode, discover.Config{
+		PrivateKey: privKey,
+		Bootnodes:  bootNodes,
+	})
+}
+
+// crawl walks random nodes of the DHT until the duration elapsed, keeping the latest
+// record of each node found.
+func crawl(listener *discover.UDPv5, d time.Duration) []*enode.Node {
+	iterator := listener.RandomNodes()
+	timer := time.AfterFunc(d, iterator.Close)
+	defer timer.Stop()
+
+	found := make(map[enode.ID]*enode.Node)
+	for iterator.Next() {
+		node := iterator.Node()
+		if existing, ok := found[node.ID()]; ok && existing.Seq() >= node.Seq() {
+			continue
+		}
+		_, seen := found[node.ID()]
+		found[node.ID()] = node
+		if !seen && len(found)%100 == 0 {
+			log.Infof("Found %d nodes so far", len(found))
+		}
+	}
+	nodes := make([]*enode.Node, 0, len(found))
+	for _, node := range found {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID().String() < nodes[j].ID().String()
+	})
+	return nodes
+}
+
+func newNodeInfo(node *enode.Node) *nodeInfo {
+	info := &nodeInfo{
+		ID:         node.ID().String(),
+		ENR:        node.String(),
+		TCPPort:    node.TCP(),
+		UDPPort:    node.UDP(),
+		AttSubnets: []uint64{},
+	}
+	if node.IP() != nil {
+		info.IP = node.IP().String()
+	}
+	if id, err := peer.IDFromPublicKey(convertToInterfacePubkey(node.Pubkey())); err == nil {
+		info.PeerID = id.String()
+	}
+
+	sszEncodedForkEntry := make([]byte, 16)
+	if err := node.Record().Load(enr.WithEntry(params.BeaconNetworkConfig().ETH2Key, &sszEncodedForkEntry)); err == nil {
+		forkEntry := &pb.ENRForkID{}
+		if err := forkEntry.UnmarshalSSZ(sszEncodedForkEntry); err == nil {
+			info.ForkDigest = hex.EncodeToString(forkEntry.CurrentForkDigest)
+			info.NextForkVersion = hex.EncodeToString(forkEntry.NextForkVersion)
+			info.NextForkEpoch = forkEntry.NextForkEpoch
+		}
+	}
+
+	bitV := bitfield.NewBitvector64()
+	if err := node.Record().Load(enr.WithEntry(params.BeaconNetworkConfig().AttSubnetKey, &bitV)); err == nil && len(bitV) == 8 {
+		for i := uint64(0); i < bitV.Len(); i++ {
+			if bitV.BitAt(i) {
+				info.AttSubnets = append(info.AttSubnets, i)
+			}
+		}
+	}
+	return info
+}
+
+// collectAgentVersions dials the nodes with a TCP port, reading the agent version they
+// report over identify on connection.
+func collectAgentVersions(h host.Host, nodes []*enode.Node, infos []*nodeInfo) {
+	log.Infof("Dialing %d nodes to collect their agent versions", len(nodes))
+	sem := make(chan struct{}, *concurrency)
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		if node.TCP() == 0 || node.IP() == nil || node.IP().To4() == nil {
+			infos[i].DialError = "no ipv4 tcp address"
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(node *enode.Node, info *nodeInfo) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", node.IP().To4(), node.TCP(), info.PeerID))
+			if err != nil {
+				info.DialError = err.Error()
+				return
+			}
+			addrInfo, err := peer.AddrInfoFromP2pAddr(addr)
+			if err != nil {
+				info.DialError = err.Error()
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), *dialTimeout)
+			defer cancel()
+			// Connecting waits for the identify exchange to complete.
+			if err := h.Connect(ctx, *addrInfo); err != nil {
+				info.DialError = err.Error()
+				return
+			}
+			if agent, err := h.Peerstore().Get(addrInfo.ID, "AgentVersion"); err == nil {
+				info.AgentVersion, _ = agent.(string)
+			}
+			if err := h.Network().ClosePeer(addrInfo.ID); err != nil {
+				log.WithError(err).Debug("Could not disconnect from peer")
+			}
+		}(node, infos[i])
+	}
+	wg.Wait()
+}
+
+func newCensus(infos []*nodeInfo) *census {
+	report := &census{
+		Time:        time.Now(),
+		Nodes:       infos,
+		ForkDigests: make(map[string]int),
+		AttSubnets:  make(map[uint64]int),
+	}
+	if *dialPeers {
+		report.Clients = make(map[string]int)
+	}
+	for i := uint64(0); i < params.BeaconNetworkConfig().AttestationSubnetCount; i++ {
+		report.AttSubnets[i] = 0
+	}
+	for _, info := range infos {
+		digest := info.ForkDigest
+		if digest == "" {
+			digest = "none"
+		}
+		report.ForkDigests[digest]++
+		for _, subnet := range info.AttSubnets {
+			report.AttSubnets[subnet]++
+		}
+		if *dialPeers {
+			report.Clients[clientName(info)]++
+		}
+	}
+	return report
+}
+
+// clientName returns the client implementation from the agent version, which starts with
+// the client name in the form name/version.
+func clientName(info *nodeInfo) string {
+	if info.AgentVersion == "" {
+		if info.DialError != "" {
+			return "unreachable"
+		}
+		return "unknown"
+	}
+	return strings.ToLower(strings.SplitN(info.AgentVersion, "/", 2)[0])
+}
+
+func logSummary(report *census) {
+	log.Infof("Census of %d nodes", len(report.Nodes))
+	for digest, count := range report.ForkDigests {
+		log.WithField("forkDigest", digest).Infof("%d nodes", count)
+	}
+	for client, count := range report.Clients {
+		log.WithField("client", client).Infof("%d nodes", count)
+	}
+	var uncovered []uint64
+	for subnet, count := range report.AttSubnets {
+		if count == 0 {
+			uncovered = append(uncovered, subnet)
+		}
+	}
+	if len(uncovered) > 0 {
+		sort.Slice(uncovered, func(i, j int) bool {
+			return uncovered[i] < uncovered[j]
+		})
+		log.Warnf("No node advertises the attestation subnets %v", uncovered)
+	}
+}
+
+func convertToInterfacePubkey(pubkey *ecdsa.PublicKey) crypto.PubKey {
+	return (*crypto.Secp256k1PublicKey)((*btcec.PublicKey)(pubkey))
+}
+
+func convertToInterfacePrivkey(privkey *ecdsa.PrivateKey) crypto.PrivKey {
+	return (*crypto.Secp256k1PrivateKey)((*btcec.PrivateKey)(privkey))
+}
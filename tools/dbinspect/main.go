@@ -0,0 +1,362 @@
+/**
+ * Beacon DB inspection tool
+ *
+ * Opens a beacon node database in read-only mode to query blocks by slot or root,
+ * print state summaries, list bucket statistics and dump the values stored under
+ * specific keys, without needing to write a one-off program for it. The beacon node
+ * must not be running while the database is inspected, as it holds the database lock.
+ */
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	dbPath string
+	root   string
+	slot   uint64
+	bucket string
+	key    string
+	format string
+	out    string
+)
+
+var (
+	rootFlag = &cli.StringFlag{
+		Name:        "root",
+		Usage:       "Hex encoded block root, defaults to the head block root",
+		Destination: &root,
+	}
+	formatFlag = &cli.StringFlag{
+		Name:        "format",
+		Usage:       "Output format: json|ssz, SSZ is printed hex encoded unless --out is set",
+		Value:       "json",
+		Destination: &format,
+	}
+	outFlag = &cli.StringFlag{
+		Name:        "out",
+		Usage:       "Path to write the output to instead of stdout",
+		Destination: &out,
+	}
+)
+
+func main() {
+	app := cli.App{}
+	app.Name = "dbinspect"
+	app.Usage = "Inspect the contents of a beacon node database"
+	app.Version = version.GetVersion()
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:        "db-path",
+			Usage:       "Path to the directory containing beaconchain.db",
+			Required:    true,
+			Destination: &dbPath,
+		},
+	}
+	app.Commands = []*cli.Command{
+		{
+			Name:   "buckets",
+			Usage:  "List the number of keys and data size of each bucket",
+			Action: withDB(printBuckets),
+		},
+		{
+			Name:  "block",
+			Usage: "Print the blocks at a slot or the block with a root",
+			Flags: []cli.Flag{
+				&cli.Uint64Flag{
+					Name:        "slot",
+					Usage:       "Slot of the blocks to print",
+					Destination: &slot,
+				},
+				rootFlag,
+				formatFlag,
+				outFlag,
+			},
+			Action: withDB(printBlocks),
+		},
+		{
+			Name:   "state",
+			Usage:  "Print a summary of the state saved for a block root",
+			Flags:  []cli.Flag{rootFlag},
+			Action: withDB(printStateSummary),
+		},
+		{
+			Name:  "key",
+			Usage: "Dump the value stored under a key of a bucket",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "bucket",
+					Usage:       "Name of the bucket, as listed by the buckets command",
+					Required:    true,
+					Destination: &bucket,
+				},
+				&cli.StringFlag{
+					Name:        "key",
+					Usage:       "Key to dump, hex encoded if prefixed by 0x",
+					Required:    true,
+					Destination: &key,
+				},
+				&cli.StringFlag{
+					Name: "format",
+					Usage: "Output format: raw|json|ssz, raw prints the persisted bytes hex encoded " +
+						"and json|ssz decode the values of the blocks and state buckets",
+					Value:       "raw",
+					Destination: &format,
+				},
+				outFlag,
+			},
+			Action: withDB(dumpKey),
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func withDB(action func(c *cli.Context, d db.InspectableDatabase, w io.Writer) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		d, err := db.NewDBReadOnly(dbPath)
+		if err != nil {
+			return errors.Wrap(err, "could not open database")
+		}
+		defer func() {
+			if err := d.Close(); err != nil {
+				log.WithError(err).Error("Could not close database")
+			}
+		}()
+		w := io.Writer(os.Stdout)
+		if out != "" {
+			f, err := os.Create(out)
+			if err != nil {
+				return errors.Wrap(err, "could not create output file")
+			}
+			defer func() {
+				if err := f.Close(); err != nil {
+					log.WithError(err).Error("Could not close output file")
+				}
+			}()
+			w = f
+		}
+		return action(c, d, w)
+	}
+}
+
+func printBuckets(c *cli.Context, d db.InspectableDatabase, w io.Writer) error {
+	stats, err := d.BucketStats(c.Context)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "BUCKET\tKEYS\tSIZE (BYTES)")
+	for _, s := range stats {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", s.Name, s.Keys, s.Size)
+	}
+	return tw.Flush()
+}
+
+func printBlocks(c *cli.Context, d db.InspectableDatabase, w io.Writer) error {
+	var blks []*ethpb.SignedBeaconBlock
+	switch {
+	case root != "":
+		r, err := decodeRoot(root)
+		if err != nil {
+			return err
+		}
+		blk, err := d.Block(c.Context, r)
+		if err != nil {
+			return err
+		}
+		if blk == nil {
+			return fmt.Errorf("no block found with root %#x", r)
+		}
+		blks = append(blks, blk)
+	case c.IsSet("slot"):
+		var err error
+		blks, err = d.Blocks(c.Context, filters.NewFilter().SetStartSlot(slot).SetEndSlot(slot))
+		if err != nil {
+			return err
+		}
+		if len(blks) == 0 {
+			return fmt.Errorf("no block found at slot %d", slot)
+		}
+	default:
+		blk, err := d.HeadBlock(c.Context)
+		if err != nil {
+			return err
+		}
+		if blk == nil {
+			return errors.New("no head block found")
+		}
+		blks = append(blks, blk)
+	}
+	for _, blk := range blks {
+		if err := writeMessage(w, blk, blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printStateSummary(c *cli.Context, d db.InspectableDatabase, w io.Writer) error {
+	var st *state.BeaconState
+	var err error
+	if root != "" {
+		r, err := decodeRoot(root)
+		if err != nil {
+			return err
+		}
+		st, err = d.State(c.Context, r)
+		if err != nil {
+			return err
+		}
+	} else {
+		st, err = d.HeadState(c.Context)
+		if err != nil {
+			return err
+		}
+	}
+	if st == nil {
+		return errors.New("no state found")
+	}
+	stateRoot, err := st.HashTreeRoot(c.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not compute state root")
+	}
+	headerRoot, err := stateutil.BlockHeaderRoot(st.LatestBlockHeader())
+	if err != nil {
+		return errors.Wrap(err, "could not compute latest block header root")
+	}
+	activeCount, err := helpers.ActiveValidatorCount(st, helpers.CurrentEpoch(st))
+	if err != nil {
+		return errors.Wrap(err, "could not count active validators")
+	}
+	var totalBalance uint64
+	for _, b := range st.Balances() {
+		totalBalance += b
+	}
+	justified := st.CurrentJustifiedCheckpoint()
+	finalized := st.FinalizedCheckpoint()
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Slot\t%d\n", st.Slot())
+	fmt.Fprintf(tw, "Epoch\t%d\n", helpers.CurrentEpoch(st))
+	fmt.Fprintf(tw, "State root\t%#x\n", stateRoot)
+	fmt.Fprintf(tw, "Latest block header root\t%#x\n", headerRoot)
+	fmt.Fprintf(tw, "Genesis time\t%d\n", st.GenesisTime())
+	fmt.Fprintf(tw, "Fork version\t%#x\n", st.Fork().CurrentVersion)
+	fmt.Fprintf(tw, "Validators\t%d\n", st.NumValidators())
+	fmt.Fprintf(tw, "Active validators\t%d\n", activeCount)
+	fmt.Fprintf(tw, "Total balance (Gwei)\t%d\n", totalBalance)
+	fmt.Fprintf(tw, "Justified checkpoint\tepoch %d, root %#x\n", justified.Epoch, justified.Root)
+	fmt.Fprintf(tw, "Finalized checkpoint\tepoch %d, root %#x\n", finalized.Epoch, finalized.Root)
+	return tw.Flush()
+}
+
+func dumpKey(c *cli.Context, d db.InspectableDatabase, w io.Writer) error {
+	k := []byte(key)
+	if strings.HasPrefix(key, "0x") {
+		var err error
+		k, err = hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			return errors.Wrap(err, "could not decode key")
+		}
+	}
+	if format == "raw" {
+		value, err := d.RawValue(c.Context, []byte(bucket), k)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			return fmt.Errorf("no value found for key %#x", k)
+		}
+		_, err = fmt.Fprintf(w, "%#x\n", value)
+		return err
+	}
+
+	r := bytesutil.ToBytes32(k)
+	if len(k) != 32 {
+		return fmt.Errorf("can only decode values keyed by a 32 byte root, got a %d byte key", len(k))
+	}
+	switch bucket {
+	case "blocks":
+		blk, err := d.Block(c.Context, r)
+		if err != nil {
+			return err
+		}
+		if blk == nil {
+			return fmt.Errorf("no block found with root %#x", r)
+		}
+		return writeMessage(w, blk, blk)
+	case "state":
+		st, err := d.State(c.Context, r)
+		if err != nil {
+			return err
+		}
+		if st == nil {
+			return fmt.Errorf("no state found for root %#x", r)
+		}
+		return writeMessage(w, st.InnerStateUnsafe(), st.InnerStateUnsafe())
+	default:
+		return fmt.Errorf("cannot decode values of bucket %q, use the raw format", bucket)
+	}
+}
+
+func writeMessage(w io.Writer, msg proto.Message, sszMsg fastssz.Marshaler) error {
+	switch format {
+	case "json":
+		marshaler := &jsonpb.Marshaler{Indent: "  "}
+		enc, err := marshaler.MarshalToString(msg)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal to JSON")
+		}
+		_, err = fmt.Fprintln(w, enc)
+		return err
+	case "ssz":
+		enc, err := sszMsg.MarshalSSZ()
+		if err != nil {
+			return errors.Wrap(err, "could not marshal to SSZ")
+		}
+		// Raw SSZ is only written to files, it is hex encoded on stdout.
+		if out == "" {
+			_, err = fmt.Fprintf(w, "%#x\n", enc)
+			return err
+		}
+		_, err = w.Write(enc)
+		return err
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func decodeRoot(s string) ([32]byte, error) {
+	r, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not decode root")
+	}
+	if len(r) != 32 {
+		return [32]byte{}, fmt.Errorf("expected a 32 byte root, got %d bytes", len(r))
+	}
+	return bytesutil.ToBytes32(r), nil
+}
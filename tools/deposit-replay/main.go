@@ -0,0 +1,208 @@
+/**
+ * Deposit log replay tool
+ *
+ * Fetches all the deposit logs of the deposit contract from an eth1 endpoint, rebuilds the
+ * deposit trie from them and compares its root with the eth1 data of a beacon state, as well
+ * as with the root reported by the deposit contract at the eth1 block voted in the state. Gaps
+ * or duplicates in the merkle tree indices of the logs are reported along the way. This helps
+ * diagnosing nodes which disagree on the deposits to include in blocks.
+ */
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+var (
+	httpProvider    = flag.String("http-web3provider", "http://localhost:8545", "An eth1 web3 provider url")
+	contractAddress = flag.String("deposit-contract", "", "Address of the deposit contract")
+	fromBlock       = flag.Uint64("from-block", 0, "Eth1 block to start fetching the deposit logs from, usually the deployment block of the deposit contract")
+	toBlock         = flag.Uint64("to-block", 0, "Last eth1 block to fetch the deposit logs of, defaults to the block of the eth1 data of the state, or the latest block without a state")
+	blocksPerQuery  = flag.Uint64("blocks-per-query", 1000, "Number of eth1 blocks to fetch the logs of in each request")
+	statePath       = flag.String("state-path", "", "Path to a SSZ encoded beacon state to compare the deposit root of its eth1 data with")
+)
+
+var depositEventSignature = hashutil.HashKeccak256([]byte("DepositEvent(bytes,bytes,bytes,bytes,bytes)"))
+
+func main() {
+	flag.Parse()
+	if *contractAddress == "" {
+		log.Fatal("Please specify the address of the deposit contract with --deposit-contract")
+	}
+	if *blocksPerQuery == 0 {
+		log.Fatal("--blocks-per-query must be greater than 0")
+	}
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *httpProvider)
+	if err != nil {
+		log.Fatalf("Could not connect to eth1 endpoint: %v", err)
+	}
+	address := common.HexToAddress(*contractAddress)
+
+	var st *pb.BeaconState
+	if *statePath != "" {
+		enc, err := ioutil.ReadFile(*statePath)
+		if err != nil {
+			log.Fatalf("Could not read state file: %v", err)
+		}
+		st = &pb.BeaconState{}
+		if err := st.UnmarshalSSZ(enc); err != nil {
+			log.Fatalf("Could not unmarshal state: %v", err)
+		}
+	}
+
+	lastBlock := *toBlock
+	if lastBlock == 0 {
+		if st != nil && st.Eth1Data != nil {
+			header, err := client.HeaderByHash(ctx, common.BytesToHash(st.Eth1Data.BlockHash))
+			if err != nil {
+				log.Fatalf("Could not get eth1 block %#x of the state eth1 data: %v", st.Eth1Data.BlockHash, err)
+			}
+			lastBlock = header.Number.Uint64()
+		} else {
+			header, err := client.HeaderByNumber(ctx, nil)
+			if err != nil {
+				log.Fatalf("Could not get latest eth1 block: %v", err)
+			}
+			lastBlock = header.Number.Uint64()
+		}
+	}
+	if lastBlock < *fromBlock {
+		log.Fatalf("Last block %d is before the first block %d", lastBlock, *fromBlock)
+	}
+
+	log.Printf("Fetching deposit logs from block %d to %d", *fromBlock, lastBlock)
+	depositRoots, err := replayDeposits(ctx, client, address, *fromBlock, lastBlock)
+	if err != nil {
+		log.Fatalf("Could not replay deposit logs: %v", err)
+	}
+	replayedRoot, err := depositTrieRoot(depositRoots)
+	if err != nil {
+		log.Fatalf("Could not generate deposit trie: %v", err)
+	}
+	log.Printf("Replayed %d deposits up to block %d, deposit root is %#x", len(depositRoots), lastBlock, replayedRoot)
+
+	caller, err := contracts.NewDepositContractCaller(address, client)
+	if err != nil {
+		log.Fatalf("Could not create deposit contract caller: %v", err)
+	}
+	contractRoot, err := caller.GetDepositRoot(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(lastBlock)})
+	if err != nil {
+		log.Fatalf("Could not get deposit root from the deposit contract: %v", err)
+	}
+	if contractRoot != replayedRoot {
+		log.Printf("MISMATCH: deposit contract reports root %#x at block %d", contractRoot, lastBlock)
+	} else {
+		log.Printf("Deposit contract root at block %d matches", lastBlock)
+	}
+
+	if st == nil {
+		return
+	}
+	if st.Eth1Data == nil {
+		log.Fatal("State has no eth1 data")
+	}
+	count := st.Eth1Data.DepositCount
+	log.Printf("State at slot %d has eth1 data with %d deposits, deposit root %#x, of which %d are processed",
+		st.Slot, count, st.Eth1Data.DepositRoot, st.Eth1DepositIndex)
+	if count > uint64(len(depositRoots)) {
+		log.Fatalf("MISMATCH: state eth1 data has %d deposits, only %d were found in the logs", count, len(depositRoots))
+	}
+	expectedRoot, err := depositTrieRoot(depositRoots[:count])
+	if err != nil {
+		log.Fatalf("Could not generate deposit trie: %v", err)
+	}
+	if !bytes.Equal(expectedRoot[:], st.Eth1Data.DepositRoot) {
+		log.Fatalf("MISMATCH: deposit root of the first %d replayed deposits is %#x, state eth1 data has %#x",
+			count, expectedRoot, st.Eth1Data.DepositRoot)
+	}
+	log.Printf("Deposit root of the state eth1 data matches the first %d replayed deposits", count)
+}
+
+// replayDeposits fetches the deposit logs between the given blocks and returns the hash tree
+// roots of their deposit data, ordered by merkle tree index. It fails when the logs do not cover
+// every index from zero, and logs about duplicated indices.
+func replayDeposits(ctx context.Context, client *ethclient.Client, address common.Address, start, end uint64) ([][]byte, error) {
+	var roots [][]byte
+	for from := start; from <= end; from += *blocksPerQuery {
+		to := from + *blocksPerQuery - 1
+		if to > end {
+			to = end
+		}
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{address},
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, depositLog := range logs {
+			if len(depositLog.Topics) == 0 || depositLog.Topics[0] != depositEventSignature {
+				continue
+			}
+			pubkey, withdrawalCredentials, amount, signature, merkleTreeIndex, err := contracts.UnpackDepositLogData(depositLog.Data)
+			if err != nil {
+				return nil, fmt.Errorf("could not unpack log in block %d: %v", depositLog.BlockNumber, err)
+			}
+			index := binary.LittleEndian.Uint64(merkleTreeIndex)
+			if index < uint64(len(roots)) {
+				log.Printf("Duplicate deposit log with index %d in block %d", index, depositLog.BlockNumber)
+				continue
+			}
+			if index > uint64(len(roots)) {
+				return nil, fmt.Errorf("missing deposit logs with indices %d to %d before block %d", len(roots), index-1, depositLog.BlockNumber)
+			}
+			depositData := &ethpb.Deposit_Data{
+				PublicKey:             pubkey,
+				WithdrawalCredentials: withdrawalCredentials,
+				Amount:                bytesutil.FromBytes8(amount),
+				Signature:             signature,
+			}
+			root, err := ssz.HashTreeRoot(depositData)
+			if err != nil {
+				return nil, err
+			}
+			roots = append(roots, root[:])
+		}
+	}
+	return roots, nil
+}
+
+// depositTrieRoot returns the deposit root of the deposits, as in the eth1 data and the
+// deposit contract.
+func depositTrieRoot(depositRoots [][]byte) ([32]byte, error) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	var depositTrie *trieutil.SparseMerkleTrie
+	var err error
+	if len(depositRoots) == 0 {
+		depositTrie, err = trieutil.NewTrie(depth)
+	} else {
+		depositTrie, err = trieutil.GenerateTrieFromItems(depositRoots, depth)
+	}
+	if err != nil {
+		return [32]byte{}, err
+	}
+	// HashTreeRoot mixes in a length of 0 for the empty trie, which holds a single zero leaf.
+	return depositTrie.HashTreeRoot(), nil
+}
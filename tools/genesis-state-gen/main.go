@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/ghodss/yaml"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/interop"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
@@ -15,6 +16,7 @@ var (
 	numValidators    = flag.Int("num-validators", 0, "Number of validators to deterministically include in the generated genesis state")
 	useMainnetConfig = flag.Bool("mainnet-config", false, "Select whether genesis state should be generated with mainnet or minimal (default) params")
 	genesisTime      = flag.Uint64("genesis-time", 0, "Unix timestamp used as the genesis time in the generated genesis state (defaults to now)")
+	mnemonic         = flag.String("mnemonic", "", "Mnemonic to derive the validator keys from along the EIP-2334 paths, as the genesis tools of other clients do (defaults to the deterministic interop keys)")
 	sszOutputFile    = flag.String("output-ssz", "", "Output filename of the SSZ marshaling of the generated genesis state")
 	yamlOutputFile   = flag.String("output-yaml", "", "Output filename of the YAML marshaling of the generated genesis state")
 	jsonOutputFile   = flag.String("output-json", "", "Output filename of the JSON marshaling of the generated genesis state")
@@ -35,7 +37,13 @@ func main() {
 		params.OverrideBeaconConfig(params.MinimalSpecConfig())
 	}
 
-	genesisState, _, err := interop.GenerateGenesisState(*genesisTime, uint64(*numValidators))
+	var genesisState *pb.BeaconState
+	var err error
+	if *mnemonic != "" {
+		genesisState, _, err = interop.GenerateGenesisStateFromMnemonic(*genesisTime, *mnemonic, uint64(*numValidators))
+	} else {
+		genesisState, _, err = interop.GenerateGenesisState(*genesisTime, uint64(*numValidators))
+	}
 	if err != nil {
 		log.Fatalf("Could not generate genesis beacon state: %v", err)
 	}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/mputil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/urfave/cli/v2"
+)
+
+const depositDataFileName = "deposit_data.json"
+
+var (
+	numKeysFlag = &cli.Uint64Flag{
+		Name:     "num-keys",
+		Usage:    "Number of validator keystores to generate",
+		Required: true,
+	}
+	startIndexFlag = &cli.Uint64Flag{
+		Name:  "start-index",
+		Usage: "Validator index of the first key to generate, which numbers the keystore files and selects the interop deterministic keys",
+	}
+	randomFlag = &cli.BoolFlag{
+		Name:  "random",
+		Usage: "Generate random keys instead of the interop deterministic keys",
+	}
+	outputDirFlag = &cli.StringFlag{
+		Name:     "output-dir",
+		Usage:    "Output directory to write the keystore files and their deposit data to",
+		Required: true,
+	}
+	genesisStatePathFlag = &cli.StringFlag{
+		Name: "genesis-state-path",
+		Usage: "If set, path to write a genesis state(ssz) with a validator for each generated key to, " +
+			"preceded by the interop deterministic keys of the validator indices below --start-index",
+	}
+	genesisTimeFlag = &cli.Uint64Flag{
+		Name:  "genesis-time",
+		Usage: "Unix timestamp of the genesis state, defaults to the current time",
+	}
+)
+
+// depositDataJSON is the deposit data of a validator, as written by the eth2.0-deposit-cli.
+type depositDataJSON struct {
+	PubKey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+// generate generates the requested number of validator keys, either deterministically following
+// the interop specification or randomly, and writes them as EIP-2335 keystore files along with
+// the deposit data of the validators. Optionally, an interop genesis state with the validators
+// already registered is written as well, so a testnet does not need to process their deposits.
+func generate(cliCtx *cli.Context) error {
+	numKeys := cliCtx.Uint64(numKeysFlag.Name)
+	if numKeys == 0 {
+		return errors.New("--num-keys must be greater than 0")
+	}
+	outputDir, err := fileutil.ExpandPath(cliCtx.String(outputDirFlag.Name))
+	if err != nil {
+		return errors.Wrapf(err, "could not expand path: %s", cliCtx.String(outputDirFlag.Name))
+	}
+	if err := os.MkdirAll(outputDir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return errors.Wrapf(err, "could not create directory: %s", outputDir)
+	}
+	password := cliCtx.String(passwordFlag.Name)
+	if !cliCtx.IsSet(passwordFlag.Name) {
+		password, err = promptutil.PasswordPrompt("Input the keystore(s) password", func(s string) error {
+			// Any password is valid.
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	startIndex := cliCtx.Uint64(startIndexFlag.Name)
+	genesisStatePath := cliCtx.String(genesisStatePathFlag.Name)
+	if genesisStatePath != "" && startIndex > 0 && cliCtx.Bool(randomFlag.Name) {
+		return errors.New("--genesis-state-path requires --start-index 0 with --random keys, " +
+			"so the validator index of each key matches its keystore file")
+	}
+	var privKeys []bls.SecretKey
+	var pubKeys []bls.PublicKey
+	if cliCtx.Bool(randomFlag.Name) {
+		for i := uint64(0); i < numKeys; i++ {
+			privKey := bls.RandKey()
+			privKeys = append(privKeys, privKey)
+			pubKeys = append(pubKeys, privKey.PublicKey())
+		}
+	} else {
+		privKeys, pubKeys, err = interop.DeterministicallyGenerateKeys(startIndex, numKeys)
+		if err != nil {
+			return errors.Wrap(err, "could not generate interop keys")
+		}
+	}
+
+	// Encrypting keystores is purposefully slow, so it is spread across all cores.
+	// Keystores are numbered by the validator index of their key.
+	if _, err := mputil.Scatter(len(privKeys), func(offset int, entries int, _ *sync.RWMutex) (interface{}, error) {
+		for i := offset; i < offset+entries; i++ {
+			keystorePath := filepath.Join(outputDir, fmt.Sprintf("keystore-%d.json", startIndex+uint64(i)))
+			if err := writeKeystore(keystorePath, privKeys[i], password); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}); err != nil {
+		return errors.Wrap(err, "could not write keystores")
+	}
+
+	depositData, depositDataRoots, err := interop.DepositDataFromKeys(privKeys, pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not generate deposit data")
+	}
+	forkVersion := hex.EncodeToString(params.BeaconConfig().GenesisForkVersion)
+	depositDataItems := make([]*depositDataJSON, len(depositData))
+	for i, data := range depositData {
+		// The deposit message is the deposit data without its signature.
+		messageRoot, err := ssz.SigningRoot(data)
+		if err != nil {
+			return errors.Wrap(err, "could not compute deposit message root")
+		}
+		depositDataItems[i] = &depositDataJSON{
+			PubKey:                hex.EncodeToString(data.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(data.WithdrawalCredentials),
+			Amount:                data.Amount,
+			Signature:             hex.EncodeToString(data.Signature),
+			DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+			DepositDataRoot:       hex.EncodeToString(depositDataRoots[i]),
+			ForkVersion:           forkVersion,
+		}
+	}
+	encodedDepositData, err := json.MarshalIndent(depositDataItems, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not json marshal deposit data")
+	}
+	depositDataPath := filepath.Join(outputDir, depositDataFileName)
+	if err := ioutil.WriteFile(depositDataPath, encodedDepositData, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return errors.Wrapf(err, "could not write file at path: %s", depositDataPath)
+	}
+	fmt.Printf("\nWrote %d keystore files and their deposit data to %s\n", len(privKeys), au.BrightMagenta(outputDir))
+
+	if genesisStatePath == "" {
+		return nil
+	}
+	// The validators of the genesis state are indexed by their position, so the validator
+	// indices below the start index are filled with their interop deterministic keys.
+	genesisPrivKeys, genesisPubKeys := privKeys, pubKeys
+	if startIndex > 0 {
+		genesisPrivKeys, genesisPubKeys, err = interop.DeterministicallyGenerateKeys(0, startIndex+numKeys)
+		if err != nil {
+			return errors.Wrap(err, "could not generate interop keys")
+		}
+	}
+	genesisState, _, err := interop.GenerateGenesisStateFromKeys(cliCtx.Uint64(genesisTimeFlag.Name), genesisPrivKeys, genesisPubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not generate genesis state")
+	}
+	encodedState, err := ssz.Marshal(genesisState)
+	if err != nil {
+		return errors.Wrap(err, "could not ssz marshal genesis state")
+	}
+	if err := ioutil.WriteFile(genesisStatePath, encodedState, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return errors.Wrapf(err, "could not write file at path: %s", genesisStatePath)
+	}
+	fmt.Printf("Wrote genesis state with %d validators to %s\n", len(genesisPubKeys), au.BrightMagenta(genesisStatePath))
+	return nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/urfave/cli/v2"
+)
+
+func TestGenerate(t *testing.T) {
+	outputDir := setupRandomDir(t)
+	genesisStatePath := filepath.Join(outputDir, "genesis.ssz")
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(passwordFlag.Name, "", "")
+	set.Uint64(numKeysFlag.Name, 0, "")
+	set.Uint64(startIndexFlag.Name, 0, "")
+	set.String(outputDirFlag.Name, "", "")
+	set.String(genesisStatePathFlag.Name, "", "")
+	require.NoError(t, set.Set(passwordFlag.Name, "secretPassw0rd$1999"))
+	require.NoError(t, set.Set(numKeysFlag.Name, "2"))
+	require.NoError(t, set.Set(startIndexFlag.Name, "3"))
+	require.NoError(t, set.Set(outputDirFlag.Name, outputDir))
+	require.NoError(t, set.Set(genesisStatePathFlag.Name, genesisStatePath))
+	require.NoError(t, generate(cli.NewContext(&app, set, nil)))
+
+	_, pubKeys, err := interop.DeterministicallyGenerateKeys(3, 2)
+	require.NoError(t, err)
+	for i, pubKey := range pubKeys {
+		encoded, err := ioutil.ReadFile(filepath.Join(outputDir, fmt.Sprintf("keystore-%d.json", 3+i)))
+		require.NoError(t, err)
+		keystore := &v2keymanager.Keystore{}
+		require.NoError(t, json.Unmarshal(encoded, keystore))
+		assert.Equal(t, hex.EncodeToString(pubKey.Marshal()), keystore.Pubkey)
+	}
+
+	encoded, err := ioutil.ReadFile(filepath.Join(outputDir, depositDataFileName))
+	require.NoError(t, err)
+	var depositData []*depositDataJSON
+	require.NoError(t, json.Unmarshal(encoded, &depositData))
+	require.Equal(t, 2, len(depositData))
+	for i, pubKey := range pubKeys {
+		assert.Equal(t, hex.EncodeToString(pubKey.Marshal()), depositData[i].PubKey)
+		assert.Equal(t, hex.EncodeToString(params.BeaconConfig().GenesisForkVersion), depositData[i].ForkVersion)
+		assert.NotEqual(t, "", depositData[i].DepositMessageRoot)
+	}
+
+	encoded, err = ioutil.ReadFile(genesisStatePath)
+	require.NoError(t, err)
+	genesisState := &pb.BeaconState{}
+	require.NoError(t, ssz.Unmarshal(encoded, genesisState))
+	// The keys are at their validator index, after the interop keys of the indices below the start index.
+	require.Equal(t, 5, len(genesisState.Validators))
+	for i, pubKey := range pubKeys {
+		assert.DeepEqual(t, pubKey.Marshal(), genesisState.Validators[3+i].PublicKey)
+	}
+}
@@ -68,6 +68,20 @@ func main() {
 				},
 				Action: decrypt,
 			},
+			{
+				Name:  "generate",
+				Usage: "generate keystore files and their deposit data for a number of validators, for testnets",
+				Flags: []cli.Flag{
+					passwordFlag,
+					numKeysFlag,
+					startIndexFlag,
+					randomFlag,
+					outputDirFlag,
+					genesisStatePathFlag,
+					genesisTimeFlag,
+				},
+				Action: generate,
+			},
 			{
 				Name:  "encrypt",
 				Usage: "encrypt a specified hex value of a BLS12-381 private key into a keystore file",
@@ -182,13 +196,29 @@ func encrypt(cliCtx *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "not a valid BLS12-381 private key")
 	}
+	if err := writeKeystore(fullPath, privKey, password); err != nil {
+		return err
+	}
+	fmt.Printf(
+		"\nWrote encrypted keystore file at path %s\n",
+		au.BrightMagenta(fullPath),
+	)
+	fmt.Printf("Pubkey: %s\n", au.BrightGreen(
+		fmt.Sprintf("%#x", privKey.PublicKey().Marshal()),
+	))
+	return nil
+}
+
+// Encrypts the private key into the EIP-2335 keystore.json format
+// and writes the keystore to the provided path.
+func writeKeystore(fullPath string, privKey bls.SecretKey, password string) error {
 	pubKey := fmt.Sprintf("%x", privKey.PublicKey().Marshal())
 	encryptor := keystorev4.New()
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return errors.Wrap(err, "could not generate new random uuid")
 	}
-	cryptoFields, err := encryptor.Encrypt(bytesValue, password)
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
 	if err != nil {
 		return errors.Wrap(err, "could not encrypt into new keystore")
 	}
@@ -206,13 +236,6 @@ func encrypt(cliCtx *cli.Context) error {
 	if err := ioutil.WriteFile(fullPath, encodedFile, params.BeaconIoConfig().ReadWritePermissions); err != nil {
 		return errors.Wrapf(err, "could not write file at path: %s", fullPath)
 	}
-	fmt.Printf(
-		"\nWrote encrypted keystore file at path %s\n",
-		au.BrightMagenta(fullPath),
-	)
-	fmt.Printf("Pubkey: %s\n", au.BrightGreen(
-		fmt.Sprintf("%#x", privKey.PublicKey().Marshal()),
-	))
 	return nil
 }
 
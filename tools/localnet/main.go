@@ -0,0 +1,263 @@
+/**
+ * Local testnet launcher
+ *
+ * Spins up a number of beacon nodes and validator clients on the local machine from a shared
+ * interop genesis state. Each beacon node gets its own data directory and ports, and the nodes
+ * are wired to each other as static peers, so no bootnode or eth1 chain is needed. The logs of
+ * all the processes are streamed to stdout, prefixed by the name of the process. This allows for
+ * end-to-end testing of a small network without kubernetes. Stop the testnet with Ctrl-C.
+ */
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+)
+
+var (
+	numBeaconNodes  = flag.Int("beacon-nodes", 2, "Number of beacon nodes to run")
+	numValidators   = flag.Uint64("validators", 64, "Number of validators in the genesis state, split evenly across the validator clients")
+	outputDir       = flag.String("output-dir", "", "Directory to store the genesis state and the data directories of all the processes in")
+	beaconBinary    = flag.String("beacon-binary", "beacon-chain", "Path to the beacon-chain binary")
+	validatorBinary = flag.String("validator-binary", "validator", "Path to the validator binary")
+	basePort        = flag.Int("base-port", 4000, "First port of the range of ports assigned to the beacon nodes, each node uses 10 ports")
+	genesisDelay    = flag.Duration("genesis-delay", 30*time.Second, "Time from now to the genesis of the testnet, giving the nodes time to start")
+)
+
+// Offsets of the ports of a beacon node from the first port assigned to it.
+const (
+	rpcPortOffset = iota
+	grpcGatewayPortOffset
+	tcpPortOffset
+	udpPortOffset
+	monitoringPortOffset
+	portsPerNode = 10
+)
+
+type beaconNode struct {
+	index     int
+	dataDir   string
+	keyPath   string
+	firstPort int
+	multiAddr string
+}
+
+func main() {
+	flag.Parse()
+	if *outputDir == "" {
+		log.Fatal("Please specify --output-dir to store the testnet data in")
+	}
+	if *numBeaconNodes <= 0 || *numValidators == 0 {
+		log.Fatal("At least one beacon node and one validator are required")
+	}
+	if err := os.MkdirAll(*outputDir, os.ModePerm); err != nil {
+		log.Fatal(err)
+	}
+
+	genesisTime := uint64(time.Now().Add(*genesisDelay).Unix())
+	genesisPath := filepath.Join(*outputDir, "genesis.ssz")
+	log.Printf("Generating interop genesis state with %d validators", *numValidators)
+	if err := writeGenesisState(genesisPath, genesisTime); err != nil {
+		log.Fatalf("Could not generate genesis state: %v", err)
+	}
+
+	nodes := make([]*beaconNode, *numBeaconNodes)
+	for i := range nodes {
+		node, err := newBeaconNode(i)
+		if err != nil {
+			log.Fatalf("Could not set up beacon node %d: %v", i, err)
+		}
+		nodes[i] = node
+	}
+
+	logs := &logWriter{out: os.Stdout}
+	var processes []*exec.Cmd
+	defer func() {
+		for _, p := range processes {
+			if p.Process != nil {
+				if err := p.Process.Signal(syscall.SIGTERM); err != nil {
+					log.Printf("Could not stop process %d: %v", p.Process.Pid, err)
+				}
+			}
+		}
+		for _, p := range processes {
+			if err := p.Wait(); err != nil {
+				log.Printf("Process exited: %v", err)
+			}
+		}
+	}()
+
+	for _, node := range nodes {
+		args := []string{
+			"--datadir=" + node.dataDir,
+			"--interop-genesis-state=" + genesisPath,
+			"--interop-eth1data-votes",
+			"--p2p-priv-key=" + node.keyPath,
+			"--p2p-host-ip=127.0.0.1",
+			fmt.Sprintf("--rpc-port=%d", node.firstPort+rpcPortOffset),
+			fmt.Sprintf("--grpc-gateway-port=%d", node.firstPort+grpcGatewayPortOffset),
+			fmt.Sprintf("--p2p-tcp-port=%d", node.firstPort+tcpPortOffset),
+			fmt.Sprintf("--p2p-udp-port=%d", node.firstPort+udpPortOffset),
+			fmt.Sprintf("--monitoring-port=%d", node.firstPort+monitoringPortOffset),
+			fmt.Sprintf("--min-sync-peers=%d", len(nodes)-1),
+			"--no-discovery",
+			"--force-clear-db",
+		}
+		for _, peerNode := range nodes {
+			if peerNode != node {
+				args = append(args, "--peer="+peerNode.multiAddr)
+			}
+		}
+		cmd, err := startProcess(logs, fmt.Sprintf("beacon-%d", node.index), *beaconBinary, args)
+		if err != nil {
+			log.Printf("Could not start beacon node %d: %v", node.index, err)
+			return
+		}
+		processes = append(processes, cmd)
+	}
+
+	// Each beacon node gets a validator client, running its share of the interop validator keys.
+	perClient := *numValidators / uint64(len(nodes))
+	for i, node := range nodes {
+		startIndex := uint64(i) * perClient
+		count := perClient
+		if i == len(nodes)-1 {
+			count = *numValidators - startIndex
+		}
+		if count == 0 {
+			continue
+		}
+		args := []string{
+			"--datadir=" + filepath.Join(*outputDir, fmt.Sprintf("validator-%d", i)),
+			fmt.Sprintf("--interop-start-index=%d", startIndex),
+			fmt.Sprintf("--interop-num-validators=%d", count),
+			fmt.Sprintf("--beacon-rpc-provider=127.0.0.1:%d", node.firstPort+rpcPortOffset),
+			// Validator client ports follow the port ranges of the beacon nodes.
+			fmt.Sprintf("--monitoring-port=%d", *basePort+len(nodes)*portsPerNode+2*i),
+			fmt.Sprintf("--grpc-gateway-port=%d", *basePort+len(nodes)*portsPerNode+2*i+1),
+			"--disable-accounts-v2",
+			"--force-clear-db",
+		}
+		cmd, err := startProcess(logs, fmt.Sprintf("validator-%d", i), *validatorBinary, args)
+		if err != nil {
+			log.Printf("Could not start validator client %d: %v", i, err)
+			return
+		}
+		processes = append(processes, cmd)
+	}
+	log.Printf("Started %d beacon nodes and their validator clients, genesis is at %s", len(nodes), time.Unix(int64(genesisTime), 0))
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	<-sigc
+	log.Println("Stopping the testnet")
+}
+
+func writeGenesisState(genesisPath string, genesisTime uint64) error {
+	genesisState, _, err := interop.GenerateGenesisState(genesisTime, *numValidators)
+	if err != nil {
+		return err
+	}
+	enc, err := ssz.Marshal(genesisState)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(genesisPath, enc, 0644)
+}
+
+// newBeaconNode assigns the ports of a beacon node and generates its network key,
+// so its address is known by the other nodes before it is started.
+func newBeaconNode(index int) (*beaconNode, error) {
+	dataDir := filepath.Join(*outputDir, fmt.Sprintf("beacon-%d", index))
+	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := priv.Raw()
+	if err != nil {
+		return nil, err
+	}
+	keyPath := filepath.Join(dataDir, "network-key")
+	if err := ioutil.WriteFile(keyPath, []byte(hex.EncodeToString(raw)), 0600); err != nil {
+		return nil, err
+	}
+	id, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	firstPort := *basePort + index*portsPerNode
+	return &beaconNode{
+		index:     index,
+		dataDir:   dataDir,
+		keyPath:   keyPath,
+		firstPort: firstPort,
+		multiAddr: fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", firstPort+tcpPortOffset, id.Pretty()),
+	}, nil
+}
+
+func startProcess(logs *logWriter, name, binary string, args []string) (*exec.Cmd, error) {
+	cmd := exec.Command(binary, args...)
+	cmd.Stdout = logs.forProcess(name)
+	cmd.Stderr = logs.forProcess(name)
+	log.Printf("Starting %s: %s %s", name, binary, strings.Join(args, " "))
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+// logWriter combines the output of several processes, one line at a time.
+type logWriter struct {
+	out  io.Writer
+	lock sync.Mutex
+}
+
+func (w *logWriter) forProcess(name string) io.Writer {
+	return &processWriter{logs: w, prefix: []byte("[" + name + "] ")}
+}
+
+// processWriter writes the complete lines of output of a process, prefixed by its name.
+type processWriter struct {
+	logs   *logWriter
+	prefix []byte
+	buf    []byte
+}
+
+func (w *processWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		w.logs.lock.Lock()
+		_, err := w.logs.out.Write(append(append([]byte{}, w.prefix...), w.buf[:i+1]...))
+		w.logs.lock.Unlock()
+		if err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[i+1:]
+	}
+}
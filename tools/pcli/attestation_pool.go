@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+var (
+	poolEndpoint         string
+	poolOutputPath       string
+	poolOutputFormat     string
+	poolSlots            = cli.NewInt64Slice()
+	poolCommitteeIndices = cli.NewInt64Slice()
+)
+
+var attestationPoolCommand = &cli.Command{
+	Name:  "attestation-pool",
+	Usage: "Subcommands to inspect the attestation pool of a beacon node",
+	Subcommands: []*cli.Command{
+		{
+			Name: "dump",
+			Usage: "Dumps the attestation pool of a beacon node to a file, from the debug RPC endpoints " +
+				"enabled by --enable-debug-rpc-endpoints, so it can be replayed in benchmarks and tests",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "grpc-endpoint",
+					Usage:       "gRPC endpoint of the beacon node",
+					Value:       "127.0.0.1:4000",
+					Destination: &poolEndpoint,
+				},
+				&cli.StringFlag{
+					Name:        "output-path",
+					Usage:       "Path to write the attestation pool dump to",
+					Required:    true,
+					Destination: &poolOutputPath,
+				},
+				&cli.StringFlag{
+					Name:        "output-format",
+					Usage:       "Encoding of the dump: proto|json, only protobuf dumps can be loaded back into a pool",
+					Value:       "proto",
+					Destination: &poolOutputFormat,
+				},
+				&cli.Int64SliceFlag{
+					Name:        "slot",
+					Usage:       "Only dump the attestations of this slot, may be repeated",
+					Destination: poolSlots,
+				},
+				&cli.Int64SliceFlag{
+					Name:        "committee-index",
+					Usage:       "Only dump the attestations of this committee index, may be repeated",
+					Destination: poolCommitteeIndices,
+				},
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+			},
+			Action: func(c *cli.Context) error {
+				// The dump of a full pool can exceed the default gRPC message size limit, which
+				// --grpc-max-msg-size raises like for the validator client.
+				conn, err := grpc.Dial(
+					poolEndpoint,
+					grpc.WithInsecure(),
+					grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(c.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name))),
+				)
+				if err != nil {
+					return errors.Wrap(err, "could not dial beacon node")
+				}
+				defer func() {
+					if err := conn.Close(); err != nil {
+						log.WithError(err).Error("Could not close connection to beacon node")
+					}
+				}()
+				dump, err := pbrpc.NewDebugClient(conn).GetAttestationPool(context.Background(), &pbrpc.AttestationPoolRequest{
+					Slots:            toUint64s(poolSlots.Value()),
+					CommitteeIndices: toUint64s(poolCommitteeIndices.Value()),
+				})
+				if err != nil {
+					return errors.Wrap(err, "could not get attestation pool")
+				}
+
+				var enc []byte
+				switch poolOutputFormat {
+				case "proto":
+					enc, err = proto.Marshal(dump)
+				case "json":
+					enc, err = json.MarshalIndent(dump, "", "  ")
+				default:
+					return fmt.Errorf("unknown format %q", poolOutputFormat)
+				}
+				if err != nil {
+					return errors.Wrapf(err, "could not encode to %s", poolOutputFormat)
+				}
+				if err := ioutil.WriteFile(poolOutputPath, enc, 0644); err != nil {
+					return err
+				}
+				log.WithFields(log.Fields{
+					"unaggregated": len(dump.UnaggregatedAttestations),
+					"aggregated":   len(dump.AggregatedAttestations),
+					"block":        len(dump.BlockAttestations),
+					"forkchoice":   len(dump.ForkchoiceAttestations),
+				}).Infof("Wrote attestation pool dump to %s", poolOutputPath)
+				return nil
+			},
+		},
+	},
+}
+
+func toUint64s(values []int64) []uint64 {
+	u := make([]uint64, len(values))
+	for i, v := range values {
+		u[i] = uint64(v)
+	}
+	return u
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	benchDBPath         string
+	benchStartSlot      uint64
+	benchEndSlot        uint64
+	benchPreStatePath   string
+	benchBlockPaths     = cli.NewStringSlice()
+	benchNoVerifySigs   bool
+	benchOutputPath     string
+	benchComparisonPath string
+)
+
+var benchmarkCommand = &cli.Command{
+	Name:     "benchmark",
+	Category: "state-transition",
+	Usage: "Replays blocks on top of a state, loaded from a beacon DB or from SSZ files, " +
+		"and reports the time spent in each phase of the state transition of each block",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "db-path",
+			Usage:       "Path to the directory containing the beaconchain.db to load the state and blocks from",
+			Destination: &benchDBPath,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "Slot of the state to replay the blocks on, when loading from a DB",
+			Destination: &benchStartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "Slot of the last block to replay, when loading from a DB",
+			Destination: &benchEndSlot,
+		},
+		&cli.StringFlag{
+			Name:        "pre-state-path",
+			Usage:       "Path to the state file(ssz) to replay the blocks on, when loading from files",
+			Destination: &benchPreStatePath,
+		},
+		&cli.StringSliceFlag{
+			Name:        "block-path",
+			Usage:       "Path to a block file(ssz) to replay, may be repeated to replay several blocks in order",
+			Destination: benchBlockPaths,
+		},
+		&cli.BoolFlag{
+			Name:        "no-verify-signatures",
+			Usage:       "Process the blocks without verifying their signatures",
+			Destination: &benchNoVerifySigs,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "Path to write the timings to as JSON, to compare them with the ones of another build",
+			Destination: &benchOutputPath,
+		},
+		&cli.StringFlag{
+			Name:        "compare",
+			Usage:       "Path to the JSON timings of a previous run to compare this run with",
+			Destination: &benchComparisonPath,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		ctx := context.Background()
+		var preState *stateTrie.BeaconState
+		var blks []*ethpb.SignedBeaconBlock
+		var err error
+		if benchDBPath != "" {
+			preState, blks, err = loadFromDB(ctx)
+		} else {
+			preState, blks, err = loadFromFiles()
+		}
+		if err != nil {
+			return err
+		}
+		if len(blks) == 0 {
+			return errors.New("no blocks to replay")
+		}
+		timings, err := replayBlocks(ctx, preState, blks)
+		if err != nil {
+			return err
+		}
+		var previous []*blockTiming
+		if benchComparisonPath != "" {
+			enc, err := ioutil.ReadFile(benchComparisonPath)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(enc, &previous); err != nil {
+				return errors.Wrap(err, "could not decode timings to compare with")
+			}
+		}
+		if err := printTimings(timings, previous); err != nil {
+			return err
+		}
+		if benchOutputPath != "" {
+			enc, err := json.MarshalIndent(timings, "", "  ")
+			if err != nil {
+				return err
+			}
+			return ioutil.WriteFile(benchOutputPath, enc, 0600)
+		}
+		return nil
+	},
+}
+
+// blockTiming is the time spent in each phase of the state transition of a block. The epoch
+// processing time is the time spent in the slots ending an epoch, slot processing included.
+type blockTiming struct {
+	Slot            uint64        `json:"slot"`
+	SlotProcessing  time.Duration `json:"slot_processing"`
+	EpochProcessing time.Duration `json:"epoch_processing"`
+	BlockProcessing time.Duration `json:"block_processing"`
+	StateRoot       time.Duration `json:"state_root"`
+}
+
+func (t *blockTiming) total() time.Duration {
+	return t.SlotProcessing + t.EpochProcessing + t.BlockProcessing + t.StateRoot
+}
+
+func loadFromDB(ctx context.Context) (*stateTrie.BeaconState, []*ethpb.SignedBeaconBlock, error) {
+	if benchEndSlot <= benchStartSlot {
+		return nil, nil, errors.New("end slot must be higher than the start slot")
+	}
+	d, err := db.NewDBReadOnly(benchDBPath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+	states, err := d.HighestSlotStatesBelow(ctx, benchStartSlot+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(states) == 0 {
+		return nil, nil, fmt.Errorf("no state saved at or below slot %d", benchStartSlot)
+	}
+	preState := states[0]
+	if preState.Slot() != benchStartSlot {
+		log.Warnf("No state saved at slot %d, replaying from the state at slot %d", benchStartSlot, preState.Slot())
+	}
+	blks, err := d.Blocks(ctx, filters.NewFilter().SetStartSlot(preState.Slot()+1).SetEndSlot(benchEndSlot))
+	if err != nil {
+		return nil, nil, err
+	}
+	return preState, canonicalBlocks(blks, preState.Slot()), nil
+}
+
+// canonicalBlocks returns the chain of blocks ending at the highest block,
+// skipping the blocks of the forks in the range, sorted by slot.
+func canonicalBlocks(blks []*ethpb.SignedBeaconBlock, fromSlot uint64) []*ethpb.SignedBeaconBlock {
+	if len(blks) == 0 {
+		return nil
+	}
+	byRoot := make(map[[32]byte]*ethpb.SignedBeaconBlock, len(blks))
+	highest := blks[0]
+	for _, b := range blks {
+		r, err := stateutil.BlockRoot(b.Block)
+		if err != nil {
+			log.WithError(err).Error("Could not compute block root")
+			continue
+		}
+		byRoot[r] = b
+		if b.Block.Slot > highest.Block.Slot {
+			highest = b
+		}
+	}
+	chain := []*ethpb.SignedBeaconBlock{highest}
+	for b := highest; b.Block.Slot > fromSlot; {
+		parent, ok := byRoot[bytesutil.ToBytes32(b.Block.ParentRoot)]
+		if !ok {
+			break
+		}
+		chain = append(chain, parent)
+		b = parent
+	}
+	sort.Slice(chain, func(i, j int) bool {
+		return chain[i].Block.Slot < chain[j].Block.Slot
+	})
+	return chain
+}
+
+func loadFromFiles() (*stateTrie.BeaconState, []*ethpb.SignedBeaconBlock, error) {
+	if benchPreStatePath == "" {
+		return nil, nil, errors.New("either a DB path or a pre state path must be provided")
+	}
+	pbState := &pb.BeaconState{}
+	if err := dataFetcher(benchPreStatePath, pbState); err != nil {
+		return nil, nil, errors.Wrap(err, "could not load pre state")
+	}
+	preState, err := stateTrie.InitializeFromProto(pbState)
+	if err != nil {
+		return nil, nil, err
+	}
+	var blks []*ethpb.SignedBeaconBlock
+	for _, p := range benchBlockPaths.Value() {
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := dataFetcher(p, blk); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not load block %s", p)
+		}
+		blks = append(blks, blk)
+	}
+	return preState, blks, nil
+}
+
+// replayBlocks runs the state transition of each block, following the steps of
+// state.ExecuteStateTransition so each of them can be timed separately. The skip slot
+// cache is disabled, so every slot is processed and the cache copies aren't timed.
+func replayBlocks(ctx context.Context, st *stateTrie.BeaconState, blks []*ethpb.SignedBeaconBlock) ([]*blockTiming, error) {
+	state.SkipSlotCache.Disable()
+	defer state.SkipSlotCache.Enable()
+	processBlock := state.ProcessBlock
+	if benchNoVerifySigs {
+		processBlock = func(ctx context.Context, st *stateTrie.BeaconState, blk *ethpb.SignedBeaconBlock) (*stateTrie.BeaconState, error) {
+			_, st, err := state.ProcessBlockNoVerifyAnySig(ctx, st, blk)
+			return st, err
+		}
+	}
+	timings := make([]*blockTiming, 0, len(blks))
+	var err error
+	for _, blk := range blks {
+		timing := &blockTiming{Slot: blk.Block.Slot}
+		// The slots are processed one at a time, to time the ones processing an epoch apart.
+		for st.Slot() < blk.Block.Slot {
+			slot := st.Slot()
+			epochBoundary := state.CanProcessEpoch(st)
+			start := time.Now()
+			st, err = state.ProcessSlots(ctx, st, slot+1)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not process slot %d", slot)
+			}
+			if epochBoundary {
+				timing.EpochProcessing += time.Since(start)
+			} else {
+				timing.SlotProcessing += time.Since(start)
+			}
+		}
+
+		start := time.Now()
+		st, err = processBlock(ctx, st, blk)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process block at slot %d", blk.Block.Slot)
+		}
+		timing.BlockProcessing = time.Since(start)
+
+		start = time.Now()
+		postRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		timing.StateRoot = time.Since(start)
+		if !bytes.Equal(postRoot[:], blk.Block.StateRoot) {
+			return nil, fmt.Errorf("state root of block at slot %d does not match, wanted %#x, received %#x",
+				blk.Block.Slot, blk.Block.StateRoot, postRoot)
+		}
+		timings = append(timings, timing)
+	}
+	return timings, nil
+}
+
+func printTimings(timings, previous []*blockTiming) error {
+	previousBySlot := make(map[uint64]*blockTiming, len(previous))
+	for _, t := range previous {
+		previousBySlot[t.Slot] = t
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	header := "SLOT\tSLOTS\tEPOCH\tBLOCK\tSTATE ROOT\tTOTAL"
+	if len(previous) > 0 {
+		header += "\tPREVIOUS TOTAL\tCHANGE"
+	}
+	fmt.Fprintln(w, header)
+	sum := &blockTiming{}
+	previousSum := &blockTiming{}
+	for _, t := range timings {
+		fmt.Fprintf(w, "%d\t%v\t%v\t%v\t%v\t%v", t.Slot, t.SlotProcessing, t.EpochProcessing, t.BlockProcessing, t.StateRoot, t.total())
+		sum.SlotProcessing += t.SlotProcessing
+		sum.EpochProcessing += t.EpochProcessing
+		sum.BlockProcessing += t.BlockProcessing
+		sum.StateRoot += t.StateRoot
+		if p, ok := previousBySlot[t.Slot]; ok {
+			fmt.Fprintf(w, "\t%v\t%s", p.total(), change(p.total(), t.total()))
+			previousSum.SlotProcessing += p.SlotProcessing
+			previousSum.EpochProcessing += p.EpochProcessing
+			previousSum.BlockProcessing += p.BlockProcessing
+			previousSum.StateRoot += p.StateRoot
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "TOTAL\t%v\t%v\t%v\t%v\t%v", sum.SlotProcessing, sum.EpochProcessing, sum.BlockProcessing, sum.StateRoot, sum.total())
+	if len(previous) > 0 {
+		fmt.Fprintf(w, "\t%v\t%s", previousSum.total(), change(previousSum.total(), sum.total()))
+	}
+	fmt.Fprintln(w)
+	return w.Flush()
+}
+
+// change formats the relative change from a previous duration to the current one.
+func change(previous, current time.Duration) string {
+	if previous == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%+.1f%%", 100*(float64(current)-float64(previous))/float64(previous))
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	convertInputPath    string
+	convertDataType     string
+	convertInputFormat  string
+	convertOutputFormat string
+	convertOutputPath   string
+	convertExpectedRoot string
+)
+
+var convertCommand = &cli.Command{
+	Name:  "convert",
+	Usage: "Converts data between the SSZ, JSON and YAML encodings, reporting its hash tree root",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "input-path",
+			Usage:       "Path to the file to convert",
+			Required:    true,
+			Destination: &convertInputPath,
+		},
+		&cli.StringFlag{
+			Name:        "data-type",
+			Usage:       "Data type of the file: " + dataTypes,
+			Required:    true,
+			Destination: &convertDataType,
+		},
+		&cli.StringFlag{
+			Name:        "input-format",
+			Usage:       "Encoding of the file: ssz|json|yaml",
+			Value:       "ssz",
+			Destination: &convertInputFormat,
+		},
+		&cli.StringFlag{
+			Name:        "output-format",
+			Usage:       "Encoding to convert the file to: ssz|json|yaml",
+			Value:       "yaml",
+			Destination: &convertOutputFormat,
+		},
+		&cli.StringFlag{
+			Name:        "output-path",
+			Usage:       "Path to write the converted data to, it is printed if not set, hex encoded for SSZ",
+			Destination: &convertOutputPath,
+		},
+		&cli.StringFlag{
+			Name:        "expected-root",
+			Usage:       "Hex encoded hash tree root the data is expected to have",
+			Destination: &convertExpectedRoot,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		data := sszObject(convertDataType)
+		if data == nil {
+			return fmt.Errorf("invalid data type %q", convertDataType)
+		}
+		input, err := ioutil.ReadFile(convertInputPath)
+		if err != nil {
+			return err
+		}
+		if err := decodeAs(convertInputFormat, input, data); err != nil {
+			return errors.Wrapf(err, "could not decode %s file", convertInputFormat)
+		}
+
+		root, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			return errors.Wrap(err, "could not compute hash tree root")
+		}
+		log.Infof("Hash tree root of the %s is %#x", convertDataType, root)
+		if convertExpectedRoot != "" {
+			want, err := hex.DecodeString(strings.TrimPrefix(convertExpectedRoot, "0x"))
+			if err != nil {
+				return errors.Wrap(err, "could not decode expected root")
+			}
+			if !bytes.Equal(want, root[:]) {
+				return fmt.Errorf("hash tree root %#x does not match the expected root %#x", root, want)
+			}
+		}
+
+		output, err := encodeAs(convertOutputFormat, data)
+		if err != nil {
+			return errors.Wrapf(err, "could not encode to %s", convertOutputFormat)
+		}
+		if convertOutputPath != "" {
+			return ioutil.WriteFile(convertOutputPath, output, 0644)
+		}
+		if convertOutputFormat == "ssz" {
+			output = []byte(fmt.Sprintf("%#x\n", output))
+		}
+		_, err = os.Stdout.Write(output)
+		return err
+	},
+}
+
+func decodeAs(format string, input []byte, data interface{}) error {
+	switch format {
+	case "ssz":
+		return ssz.Unmarshal(input, data)
+	case "json":
+		return json.Unmarshal(input, data)
+	case "yaml":
+		return yaml.Unmarshal(input, data)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func encodeAs(format string, data interface{}) ([]byte, error) {
+	switch format {
+	case "ssz":
+		return ssz.Marshal(data)
+	case "json":
+		return json.MarshalIndent(data, "", "  ")
+	case "yaml":
+		return yaml.Marshal(data)
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
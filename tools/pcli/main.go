@@ -51,46 +51,15 @@ func main() {
 					Destination: &sszPath,
 				},
 				&cli.StringFlag{
-					Name: "data-type",
-					Usage: "ssz file data type: " +
-						"block|" +
-						"signed_block|" +
-						"attestation|" +
-						"block_header|" +
-						"deposit|" +
-						"proposer_slashing|" +
-						"signed_block_header|" +
-						"signed_voluntary_exit|" +
-						"voluntary_exit|" +
-						"state",
+					Name:        "data-type",
+					Usage:       "ssz file data type: " + dataTypes,
 					Required:    true,
 					Destination: &sszType,
 				},
 			},
 			Action: func(c *cli.Context) error {
-				var data interface{}
-				switch sszType {
-				case "block":
-					data = &ethpb.BeaconBlock{}
-				case "signed_block":
-					data = &ethpb.SignedBeaconBlock{}
-				case "attestation":
-					data = &ethpb.Attestation{}
-				case "block_header":
-					data = &ethpb.BeaconBlockHeader{}
-				case "deposit":
-					data = &ethpb.Deposit{}
-				case "proposer_slashing":
-					data = &ethpb.ProposerSlashing{}
-				case "signed_block_header":
-					data = &ethpb.SignedBeaconBlockHeader{}
-				case "signed_voluntary_exit":
-					data = &ethpb.SignedVoluntaryExit{}
-				case "voluntary_exit":
-					data = &ethpb.VoluntaryExit{}
-				case "state":
-					data = &pb.BeaconState{}
-				default:
+				data := sszObject(sszType)
+				if data == nil {
 					log.Fatal("Invalid type")
 				}
 				prettyPrint(sszPath, data)
@@ -194,6 +163,9 @@ func main() {
 				return nil
 			},
 		},
+		convertCommand,
+		benchmarkCommand,
+		attestationPoolCommand,
 	}
 	if err := app.Run(os.Args); err != nil {
 		log.Error(err.Error())
@@ -201,6 +173,61 @@ func main() {
 	}
 }
 
+// dataTypes lists the data types accepted by sszObject, for flag usages.
+var dataTypes = "block|" +
+	"signed_block|" +
+	"attestation|" +
+	"attestation_data|" +
+	"indexed_attestation|" +
+	"aggregate_attestation_and_proof|" +
+	"signed_aggregate_attestation_and_proof|" +
+	"attester_slashing|" +
+	"block_header|" +
+	"deposit|" +
+	"proposer_slashing|" +
+	"signed_block_header|" +
+	"signed_voluntary_exit|" +
+	"voluntary_exit|" +
+	"state"
+
+// sszObject returns an empty object of the data type to decode data into, or nil if the type is unknown.
+func sszObject(dataType string) interface{} {
+	switch dataType {
+	case "block":
+		return &ethpb.BeaconBlock{}
+	case "signed_block":
+		return &ethpb.SignedBeaconBlock{}
+	case "attestation":
+		return &ethpb.Attestation{}
+	case "attestation_data":
+		return &ethpb.AttestationData{}
+	case "indexed_attestation":
+		return &ethpb.IndexedAttestation{}
+	case "aggregate_attestation_and_proof":
+		return &ethpb.AggregateAttestationAndProof{}
+	case "signed_aggregate_attestation_and_proof":
+		return &ethpb.SignedAggregateAttestationAndProof{}
+	case "attester_slashing":
+		return &ethpb.AttesterSlashing{}
+	case "block_header":
+		return &ethpb.BeaconBlockHeader{}
+	case "deposit":
+		return &ethpb.Deposit{}
+	case "proposer_slashing":
+		return &ethpb.ProposerSlashing{}
+	case "signed_block_header":
+		return &ethpb.SignedBeaconBlockHeader{}
+	case "signed_voluntary_exit":
+		return &ethpb.SignedVoluntaryExit{}
+	case "voluntary_exit":
+		return &ethpb.VoluntaryExit{}
+	case "state":
+		return &pb.BeaconState{}
+	default:
+		return nil
+	}
+}
+
 // dataFetcher fetches and unmarshals data from file to provided data structure.
 func dataFetcher(fPath string, data interface{}) error {
 	rawFile, err := ioutil.ReadFile(fPath)
@@ -14,6 +14,7 @@ import (
 var failedPreAttSignLocalErr = "attempted to make slashable attestation, rejected by local slashing protection"
 var failedPreAttSignExternalErr = "attempted to make slashable attestation, rejected by external slasher service"
 var failedPostAttSignExternalErr = "external slasher service detected a submitted slashable attestation"
+var failedPreAttSignWatermarkErr = "attempted to sign an attestation below the epochs of the imported slashing protection history"
 
 func (v *validator) preAttSignValidations(ctx context.Context, indexedAtt *ethpb.IndexedAttestation, pubKey [48]byte) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
@@ -29,6 +30,16 @@ func (v *validator) preAttSignValidations(ctx context.Context, indexedAtt *ethpb
 		} else if !ok {
 			log.WithField("publicKey", fmtKey).Debug("Could not get local slashing protection data for validator")
 		}
+		belowWatermarks, err := v.isBelowLowestSignedEpochs(ctx, pubKey, indexedAtt.Data.Source.Epoch, indexedAtt.Data.Target.Epoch)
+		if err != nil {
+			return err
+		}
+		if belowWatermarks {
+			if v.emitAccountMetrics {
+				ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.New(failedPreAttSignWatermarkErr)
+		}
 	}
 
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
@@ -107,6 +118,25 @@ func isNewAttSlashable(history *slashpb.AttestationHistory, sourceEpoch uint64,
 	return false
 }
 
+// isBelowLowestSignedEpochs returns true if an attestation of sourceEpoch and targetEpoch must not be
+// signed, as EIP-3076 requires after importing a slashing protection history: its source epoch is lower
+// than the lowest imported source epoch, or its target epoch is not higher than the lowest imported
+// target epoch. The imported history doesn't cover the attestations made below these watermarks.
+func (v *validator) isBelowLowestSignedEpochs(ctx context.Context, pubKey [48]byte, sourceEpoch uint64, targetEpoch uint64) (bool, error) {
+	lowestSource, exists, err := v.db.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return false, fmt.Errorf("could not get lowest signed source epoch: %v", err)
+	}
+	if exists && sourceEpoch < lowestSource {
+		return true, nil
+	}
+	lowestTarget, exists, err := v.db.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return false, fmt.Errorf("could not get lowest signed target epoch: %v", err)
+	}
+	return exists && targetEpoch <= lowestTarget, nil
+}
+
 // markAttestationForTargetEpoch returns the modified attestation history with the passed-in epochs marked
 // as attested for. This is done to prevent the validator client from signing any slashable attestations.
 func markAttestationForTargetEpoch(history *slashpb.AttestationHistory, sourceEpoch uint64, targetEpoch uint64) *slashpb.AttestationHistory {
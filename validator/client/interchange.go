@@ -0,0 +1,337 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// interchangeFormatVersion is the version of the EIP-3076 slashing protection interchange format
+// imported and exported.
+const interchangeFormatVersion = "5"
+
+// The EIP-3076 slashing protection interchange format, encoding the integers as decimal strings
+// and the bytes as 0x prefixed hex strings. The signing roots aren't kept in the slashing
+// protection history, they are omitted on export and ignored on import.
+type interchangeJSON struct {
+	Metadata *interchangeMetadataJSON `json:"metadata"`
+	Data     []*interchangeDataJSON   `json:"data"`
+}
+
+type interchangeMetadataJSON struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+type interchangeDataJSON struct {
+	Pubkey             string                        `json:"pubkey"`
+	SignedBlocks       []*interchangeBlockJSON       `json:"signed_blocks"`
+	SignedAttestations []*interchangeAttestationJSON `json:"signed_attestations"`
+}
+
+type interchangeBlockJSON struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+type interchangeAttestationJSON struct {
+	SourceEpoch string `json:"source_epoch"`
+	TargetEpoch string `json:"target_epoch"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// ExportSlashingProtectionJSON writes the slashing protection history of the validator DB in the
+// EIP-3076 interchange format, for the chain of the genesis validators root.
+func ExportSlashingProtectionJSON(ctx context.Context, valDB db.Database, genesisValidatorsRoot [32]byte, w io.Writer) error {
+	attested, err := valDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested public keys")
+	}
+	proposed, err := valDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposed public keys")
+	}
+	pubKeys := make(map[[48]byte]bool, len(attested)+len(proposed))
+	for _, pubKey := range append(attested, proposed...) {
+		pubKeys[pubKey] = true
+	}
+	sortedPubKeys := make([][48]byte, 0, len(pubKeys))
+	for pubKey := range pubKeys {
+		sortedPubKeys = append(sortedPubKeys, pubKey)
+	}
+	sort.Slice(sortedPubKeys, func(i, j int) bool {
+		return bytes.Compare(sortedPubKeys[i][:], sortedPubKeys[j][:]) < 0
+	})
+	histories, err := valDB.AttestationHistoryForPubKeys(ctx, sortedPubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestation histories")
+	}
+
+	interchange := &interchangeJSON{
+		Metadata: &interchangeMetadataJSON{
+			InterchangeFormatVersion: interchangeFormatVersion,
+			GenesisValidatorsRoot:    fmt.Sprintf("%#x", genesisValidatorsRoot),
+		},
+		Data: make([]*interchangeDataJSON, 0, len(sortedPubKeys)),
+	}
+	for _, pubKey := range sortedPubKeys {
+		data := &interchangeDataJSON{
+			Pubkey:             fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       make([]*interchangeBlockJSON, 0),
+			SignedAttestations: make([]*interchangeAttestationJSON, 0),
+		}
+		slots, err := valDB.ProposalHistoryForPubKey(ctx, pubKey[:])
+		if err != nil {
+			return errors.Wrapf(err, "could not get proposal history for public key %#x", pubKey)
+		}
+		for _, slot := range slots {
+			data.SignedBlocks = append(data.SignedBlocks, &interchangeBlockJSON{Slot: strconv.FormatUint(slot, 10)})
+		}
+		for _, target := range attestedTargetEpochs(histories[pubKey]) {
+			data.SignedAttestations = append(data.SignedAttestations, &interchangeAttestationJSON{
+				SourceEpoch: strconv.FormatUint(safeTargetToSource(histories[pubKey], target), 10),
+				TargetEpoch: strconv.FormatUint(target, 10),
+			})
+		}
+		if len(data.SignedBlocks) == 0 && len(data.SignedAttestations) == 0 {
+			continue
+		}
+		interchange.Data = append(interchange.Data, data)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(interchange)
+}
+
+// ImportSlashingProtectionJSON merges the slashing protection history in the EIP-3076 interchange
+// format into the validator DB, so that no signature conflicting with the imported history is
+// made. The lowest block slot and attestation epochs imported by public key are saved as well,
+// and no block or attestation below them is signed afterwards. The import is rejected before
+// writing to the DB if the history is for another chain than the one of the genesis validators
+// root, or if it contains attestations slashable with the history of the DB. The attestation
+// histories, proposals and lowest signed epochs are then saved in separate transactions, so an
+// import failing while saving them may be left partially applied.
+func ImportSlashingProtectionJSON(ctx context.Context, valDB db.Database, genesisValidatorsRoot [32]byte, r io.Reader) error {
+	interchange := &interchangeJSON{}
+	if err := json.NewDecoder(r).Decode(interchange); err != nil {
+		return errors.Wrap(err, "could not decode slashing protection interchange")
+	}
+	if interchange.Metadata == nil || interchange.Metadata.InterchangeFormatVersion != interchangeFormatVersion {
+		return fmt.Errorf("unsupported slashing protection interchange format, expected version %s", interchangeFormatVersion)
+	}
+	root, err := decodeHex(interchange.Metadata.GenesisValidatorsRoot, 32)
+	if err != nil {
+		return errors.Wrap(err, "invalid genesis validators root")
+	}
+	if !bytes.Equal(root, genesisValidatorsRoot[:]) {
+		return fmt.Errorf("slashing protection history is for genesis validators root %#x, expected %#x", root, genesisValidatorsRoot)
+	}
+
+	proposals := make(map[[48]byte][]uint64)
+	attestations := make(map[[48]byte][][2]uint64)
+	pubKeys := make([][48]byte, 0, len(interchange.Data))
+	for _, data := range interchange.Data {
+		enc, err := decodeHex(data.Pubkey, 48)
+		if err != nil {
+			return errors.Wrap(err, "invalid public key")
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], enc)
+		if _, ok := attestations[pubKey]; !ok {
+			pubKeys = append(pubKeys, pubKey)
+		}
+		for _, b := range data.SignedBlocks {
+			slot, err := strconv.ParseUint(b.Slot, 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid block slot for public key %#x", pubKey)
+			}
+			proposals[pubKey] = append(proposals[pubKey], slot)
+		}
+		atts := attestations[pubKey]
+		for _, a := range data.SignedAttestations {
+			source, err := strconv.ParseUint(a.SourceEpoch, 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid attestation source epoch for public key %#x", pubKey)
+			}
+			target, err := strconv.ParseUint(a.TargetEpoch, 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid attestation target epoch for public key %#x", pubKey)
+			}
+			if source > target {
+				return fmt.Errorf("attestation source epoch %d is after target epoch %d for public key %#x", source, target, pubKey)
+			}
+			atts = append(atts, [2]uint64{source, target})
+		}
+		attestations[pubKey] = atts
+	}
+
+	histories, err := valDB.AttestationHistoryForPubKeys(ctx, pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not get attestation histories")
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	for pubKey, atts := range attestations {
+		history := histories[pubKey]
+		// The attestations are marked by increasing target epoch, as the history only covers the
+		// weak subjectivity period before the latest target epoch.
+		sort.Slice(atts, func(i, j int) bool {
+			return atts[i][1] < atts[j][1]
+		})
+		for _, att := range atts {
+			source, target := att[0], att[1]
+			if int(target) <= int(history.LatestEpochWritten)-int(wsPeriod) {
+				continue
+			}
+			if safeTargetToSource(history, target) == source {
+				continue
+			}
+			if isNewAttSlashable(history, source, target) {
+				return fmt.Errorf(
+					"attestation with source epoch %d and target epoch %d is slashable with the history of public key %#x",
+					source, target, pubKey,
+				)
+			}
+			history = markAttestationForTargetEpoch(history, source, target)
+		}
+		histories[pubKey] = history
+	}
+
+	if err := valDB.SaveAttestationHistoryForPubKeys(ctx, histories); err != nil {
+		return errors.Wrap(err, "could not save attestation histories")
+	}
+	for pubKey, slots := range proposals {
+		if len(slots) == 0 {
+			continue
+		}
+		lowestSlot := slots[0]
+		for _, slot := range slots {
+			if err := valDB.SaveProposalHistoryForSlot(ctx, pubKey[:], slot); err != nil {
+				return errors.Wrapf(err, "could not save proposal history for public key %#x", pubKey)
+			}
+			if slot < lowestSlot {
+				lowestSlot = slot
+			}
+		}
+		if err := valDB.SaveLowestSignedProposal(ctx, pubKey, lowestSlot); err != nil {
+			return errors.Wrapf(err, "could not save lowest signed proposal for public key %#x", pubKey)
+		}
+	}
+	for pubKey, atts := range attestations {
+		if len(atts) == 0 {
+			continue
+		}
+		lowestSource, lowestTarget := atts[0][0], atts[0][1]
+		for _, att := range atts {
+			if att[0] < lowestSource {
+				lowestSource = att[0]
+			}
+			if att[1] < lowestTarget {
+				lowestTarget = att[1]
+			}
+		}
+		if err := valDB.SaveLowestSignedSourceEpoch(ctx, pubKey, lowestSource); err != nil {
+			return errors.Wrapf(err, "could not save lowest signed source epoch for public key %#x", pubKey)
+		}
+		if err := valDB.SaveLowestSignedTargetEpoch(ctx, pubKey, lowestTarget); err != nil {
+			return errors.Wrapf(err, "could not save lowest signed target epoch for public key %#x", pubKey)
+		}
+	}
+	return nil
+}
+
+// ExportSlashingProtection writes the slashing protection history of the validator database in
+// dataDir to the interchange file at filePath.
+func ExportSlashingProtection(ctx context.Context, dataDir string, filePath string, genesisValidatorsRoot [32]byte) (err error) {
+	store, err := kv.GetKVStore(dataDir)
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare the validator database for exporting")
+	}
+	if store == nil {
+		return fmt.Errorf("no validator database found in %s", dataDir)
+	}
+	defer func() {
+		if deferErr := store.Close(); deferErr != nil && err == nil {
+			err = errors.Wrap(deferErr, "failed to close the validator database")
+		}
+	}()
+
+	f, err := os.Create(filePath)
+	if err != nil {
+		return errors.Wrap(err, "could not create slashing protection interchange file")
+	}
+	defer func() {
+		if deferErr := f.Close(); deferErr != nil && err == nil {
+			err = errors.Wrap(deferErr, "could not close slashing protection interchange file")
+		}
+	}()
+	return ExportSlashingProtectionJSON(ctx, store, genesisValidatorsRoot, f)
+}
+
+// ImportSlashingProtection merges the slashing protection history of the interchange file at
+// filePath into the validator database in dataDir, which is created if it doesn't exist.
+func ImportSlashingProtection(ctx context.Context, dataDir string, filePath string, genesisValidatorsRoot [32]byte) (err error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return errors.Wrap(err, "could not open slashing protection interchange file")
+	}
+	defer func() {
+		if deferErr := f.Close(); deferErr != nil && err == nil {
+			err = errors.Wrap(deferErr, "could not close slashing protection interchange file")
+		}
+	}()
+
+	store, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare the validator database for importing")
+	}
+	defer func() {
+		if deferErr := store.Close(); deferErr != nil && err == nil {
+			err = errors.Wrap(deferErr, "failed to close the validator database")
+		}
+	}()
+	return ImportSlashingProtectionJSON(ctx, store, genesisValidatorsRoot, f)
+}
+
+// attestedTargetEpochs returns the target epochs of the attestations in the history, in increasing order.
+func attestedTargetEpochs(history *slashpb.AttestationHistory) []uint64 {
+	if history == nil {
+		return nil
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	start := uint64(0)
+	if history.LatestEpochWritten >= wsPeriod {
+		start = history.LatestEpochWritten - wsPeriod + 1
+	}
+	var targets []uint64
+	for target := start; target <= history.LatestEpochWritten; target++ {
+		if safeTargetToSource(history, target) != params.BeaconConfig().FarFutureEpoch {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func decodeHex(s string, length int) ([]byte, error) {
+	enc, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(enc) != length {
+		return nil, fmt.Errorf("expected %d bytes, received %d", length, len(enc))
+	}
+	return enc, nil
+}
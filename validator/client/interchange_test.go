@@ -0,0 +1,135 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestSlashingProtectionJSON_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	root := [32]byte{'a'}
+	pubKeys := [][48]byte{{1}, {2}}
+	sourceDB := dbTest.SetupDB(t, pubKeys)
+
+	histories, err := sourceDB.AttestationHistoryForPubKeys(ctx, pubKeys)
+	require.NoError(t, err)
+	histories[pubKeys[0]] = markAttestationForTargetEpoch(histories[pubKeys[0]], 0, 1)
+	histories[pubKeys[0]] = markAttestationForTargetEpoch(histories[pubKeys[0]], 1, 3)
+	require.NoError(t, sourceDB.SaveAttestationHistoryForPubKeys(ctx, histories))
+	require.NoError(t, sourceDB.SaveProposalHistoryForSlot(ctx, pubKeys[1][:], 5))
+	require.NoError(t, sourceDB.SaveProposalHistoryForSlot(ctx, pubKeys[1][:], params.BeaconConfig().SlotsPerEpoch+2))
+
+	exported := &bytes.Buffer{}
+	require.NoError(t, ExportSlashingProtectionJSON(ctx, sourceDB, root, exported))
+
+	targetDB := dbTest.SetupDB(t, nil)
+	require.NoError(t, ImportSlashingProtectionJSON(ctx, targetDB, root, bytes.NewReader(exported.Bytes())))
+
+	imported, err := targetDB.AttestationHistoryForPubKeys(ctx, pubKeys[:1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), imported[pubKeys[0]].LatestEpochWritten)
+	require.Equal(t, uint64(0), safeTargetToSource(imported[pubKeys[0]], 1))
+	require.Equal(t, uint64(1), safeTargetToSource(imported[pubKeys[0]], 3))
+	require.Equal(t, params.BeaconConfig().FarFutureEpoch, safeTargetToSource(imported[pubKeys[0]], 2))
+	slots, err := targetDB.ProposalHistoryForPubKey(ctx, pubKeys[1][:])
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{5, params.BeaconConfig().SlotsPerEpoch + 2}, slots)
+
+	reexported := &bytes.Buffer{}
+	require.NoError(t, ExportSlashingProtectionJSON(ctx, targetDB, root, reexported))
+	require.Equal(t, exported.String(), reexported.String())
+}
+
+func TestImportSlashingProtectionJSON_SlashableAttestation(t *testing.T) {
+	ctx := context.Background()
+	root := [32]byte{'a'}
+	pubKey := [48]byte{1}
+	valDB := dbTest.SetupDB(t, [][48]byte{pubKey})
+
+	histories, err := valDB.AttestationHistoryForPubKeys(ctx, [][48]byte{pubKey})
+	require.NoError(t, err)
+	histories[pubKey] = markAttestationForTargetEpoch(histories[pubKey], 2, 5)
+	require.NoError(t, valDB.SaveAttestationHistoryForPubKeys(ctx, histories))
+
+	// The attestation of source epoch 3 and target epoch 4 is surrounded by the one in the DB.
+	interchange := `{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "0x6100000000000000000000000000000000000000000000000000000000000000"
+  },
+  "data": [
+    {
+      "pubkey": "0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+      "signed_blocks": [{"slot": "7"}],
+      "signed_attestations": [{"source_epoch": "3", "target_epoch": "4"}]
+    }
+  ]
+}`
+	err = ImportSlashingProtectionJSON(ctx, valDB, root, strings.NewReader(interchange))
+	require.ErrorContains(t, "is slashable", err)
+
+	slots, err := valDB.ProposalHistoryForPubKey(ctx, pubKey[:])
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slots), "Expected no proposal imported")
+}
+
+func TestImportSlashingProtectionJSON_WrongGenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	valDB := dbTest.SetupDB(t, nil)
+
+	exported := &bytes.Buffer{}
+	require.NoError(t, ExportSlashingProtectionJSON(ctx, valDB, [32]byte{'a'}, exported))
+	err := ImportSlashingProtectionJSON(ctx, valDB, [32]byte{'b'}, exported)
+	require.ErrorContains(t, "slashing protection history is for genesis validators root", err)
+}
+
+func TestImportSlashingProtectionJSON_LowestSignedWatermarks(t *testing.T) {
+	reset := featureconfig.InitWithReset(&featureconfig.Flags{LocalProtection: true})
+	defer reset()
+	ctx := context.Background()
+	root := [32]byte{'a'}
+	validator, _, finish := setup(t)
+	defer finish()
+
+	interchange := fmt.Sprintf(`{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "%#x"
+  },
+  "data": [
+    {
+      "pubkey": "%#x",
+      "signed_blocks": [{"slot": "12"}, {"slot": "10"}],
+      "signed_attestations": [{"source_epoch": "4", "target_epoch": "6"}, {"source_epoch": "3", "target_epoch": "5"}]
+    }
+  ]
+}`, root, validatorPubKey)
+	require.NoError(t, ImportSlashingProtectionJSON(ctx, validator.db, root, strings.NewReader(interchange)))
+
+	err := validator.preBlockSignValidations(ctx, validatorPubKey, &ethpb.BeaconBlock{Slot: 8})
+	require.ErrorContains(t, failedPreBlockSignWatermarkErr, err)
+	require.NoError(t, validator.preBlockSignValidations(ctx, validatorPubKey, &ethpb.BeaconBlock{Slot: 13}))
+
+	attestation := func(source, target uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: source},
+				Target: &ethpb.Checkpoint{Epoch: target},
+			},
+		}
+	}
+	err = validator.preAttSignValidations(ctx, attestation(2, 7), validatorPubKey)
+	require.ErrorContains(t, failedPreAttSignWatermarkErr, err, "Expected lower source epoch to be rejected")
+	err = validator.preAttSignValidations(ctx, attestation(3, 5), validatorPubKey)
+	require.ErrorContains(t, failedPreAttSignWatermarkErr, err, "Expected lowest target epoch to be rejected")
+	require.NoError(t, validator.preAttSignValidations(ctx, attestation(4, 7), validatorPubKey))
+}
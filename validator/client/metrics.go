@@ -126,6 +126,29 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorBeaconNodeHealthyVec used to track the health of the beacon node endpoints.
+	ValidatorBeaconNodeHealthyVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "beacon node endpoint health: 1 healthy, 0 skipped after consecutive failed calls",
+		},
+		[]string{
+			// Beacon node endpoint.
+			"endpoint",
+		},
+	)
+	// ValidatorBeaconNodeFailuresVec used to count the failed calls to the beacon node endpoints.
+	ValidatorBeaconNodeFailuresVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failed_calls",
+		},
+		[]string{
+			// Beacon node endpoint.
+			"endpoint",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
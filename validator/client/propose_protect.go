@@ -15,6 +15,7 @@ import (
 var failedPreBlockSignLocalErr = "attempted to sign a double proposal, block rejected by local protection"
 var failedPreBlockSignExternalErr = "attempted a double proposal, block rejected by remote slashing protection"
 var failedPostBlockSignErr = "made a double proposal, considered slashable by remote slashing protection"
+var failedPreBlockSignWatermarkErr = "attempted to sign a block at or below the slots of the imported slashing protection history"
 
 func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
@@ -35,6 +36,19 @@ func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte
 			}
 			return errors.New(failedPreBlockSignLocalErr)
 		}
+
+		// As EIP-3076 requires, no block is signed at or below the lowest slot of an imported
+		// slashing protection history, which doesn't cover the blocks proposed before it.
+		lowestSlot, exists, err := v.db.LowestSignedProposal(ctx, pubKey)
+		if err != nil {
+			return errors.Wrap(err, "failed to get lowest signed proposal")
+		}
+		if exists && block.Slot <= lowestSlot {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.New(failedPreBlockSignWatermarkErr)
+		}
 	}
 
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
@@ -0,0 +1,299 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+)
+
+// endpointMaxFailures is the number of consecutive failed calls after which a beacon node
+// endpoint is considered unhealthy, and skipped until its backoff expires.
+const endpointMaxFailures = 3
+
+// redundantValidatorClient sends the attestations and aggregates to several beacon nodes
+// concurrently, so that a stalled beacon node doesn't cause missed attestations. The attestation
+// data, aggregates, duties, domains, validator indices and subnet subscriptions are requested from
+// the healthy beacon nodes in turn until one succeeds. Every other call goes to the primary beacon
+// node.
+type redundantValidatorClient struct {
+	ethpb.BeaconNodeValidatorClient
+	endpoints []*beaconEndpoint
+}
+
+// beaconEndpoint tracks the health of a beacon node endpoint from the results of the calls.
+type beaconEndpoint struct {
+	name   string
+	client ethpb.BeaconNodeValidatorClient
+
+	lock           sync.Mutex
+	failures       int
+	unhealthyUntil time.Time
+}
+
+// newRedundantValidatorClient returns a client sending the calls to the clients of the endpoints,
+// the first one is the primary beacon node.
+func newRedundantValidatorClient(names []string, clients []ethpb.BeaconNodeValidatorClient) *redundantValidatorClient {
+	c := &redundantValidatorClient{
+		BeaconNodeValidatorClient: clients[0],
+		endpoints:                 make([]*beaconEndpoint, len(clients)),
+	}
+	for i, client := range clients {
+		c.endpoints[i] = &beaconEndpoint{name: names[i], client: client}
+		ValidatorBeaconNodeHealthyVec.WithLabelValues(names[i]).Set(1)
+	}
+	return c
+}
+
+// GetAttestationData requests the attestation data from the healthy beacon nodes in turn.
+func (c *redundantValidatorClient) GetAttestationData(
+	ctx context.Context, in *ethpb.AttestationDataRequest, opts ...grpc.CallOption,
+) (*ethpb.AttestationData, error) {
+	res, err := c.failover(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.GetAttestationData(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestationData), nil
+}
+
+// SubmitAggregateSelectionProof requests the aggregate from the healthy beacon nodes in turn.
+func (c *redundantValidatorClient) SubmitAggregateSelectionProof(
+	ctx context.Context, in *ethpb.AggregateSelectionRequest, opts ...grpc.CallOption,
+) (*ethpb.AggregateSelectionResponse, error) {
+	res, err := c.failover(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.SubmitAggregateSelectionProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AggregateSelectionResponse), nil
+}
+
+// DomainData requests the signing domain from the healthy beacon nodes in turn.
+func (c *redundantValidatorClient) DomainData(
+	ctx context.Context, in *ethpb.DomainRequest, opts ...grpc.CallOption,
+) (*ethpb.DomainResponse, error) {
+	res, err := c.failover(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.DomainData(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.DomainResponse), nil
+}
+
+// GetDuties requests the duties from the healthy beacon nodes in turn.
+func (c *redundantValidatorClient) GetDuties(
+	ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption,
+) (*ethpb.DutiesResponse, error) {
+	res, err := c.failover(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.GetDuties(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.DutiesResponse), nil
+}
+
+// StreamDuties opens the duties stream on the healthy beacon nodes in turn. The stream isn't
+// bounded by a timeout, it lasts as long as the context.
+func (c *redundantValidatorClient) StreamDuties(
+	ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption,
+) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	var err error
+	for _, e := range c.healthyEndpoints() {
+		var stream ethpb.BeaconNodeValidator_StreamDutiesClient
+		stream, err = e.client.StreamDuties(ctx, in, opts...)
+		if err == nil {
+			e.report(nil)
+			return stream, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		e.report(err)
+		log.WithError(err).WithField("endpoint", e.name).Debug("Beacon node call failed, trying the next one")
+	}
+	return nil, err
+}
+
+// SubscribeCommitteeSubnets subscribes to the committee subnets through the healthy beacon nodes in turn.
+func (c *redundantValidatorClient) SubscribeCommitteeSubnets(
+	ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption,
+) (*ptypes.Empty, error) {
+	res, err := c.failover(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.SubscribeCommitteeSubnets(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ptypes.Empty), nil
+}
+
+// ValidatorIndex requests the index of the validator from the healthy beacon nodes in turn.
+func (c *redundantValidatorClient) ValidatorIndex(
+	ctx context.Context, in *ethpb.ValidatorIndexRequest, opts ...grpc.CallOption,
+) (*ethpb.ValidatorIndexResponse, error) {
+	res, err := c.failover(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.ValidatorIndex(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorIndexResponse), nil
+}
+
+// ProposeAttestation submits the attestation to all the healthy beacon nodes concurrently.
+func (c *redundantValidatorClient) ProposeAttestation(
+	ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption,
+) (*ethpb.AttestResponse, error) {
+	res, err := c.broadcast(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.ProposeAttestation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestResponse), nil
+}
+
+// SubmitSignedAggregateSelectionProof submits the signed aggregate to all the healthy beacon nodes concurrently.
+func (c *redundantValidatorClient) SubmitSignedAggregateSelectionProof(
+	ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, opts ...grpc.CallOption,
+) (*ethpb.SignedAggregateSubmitResponse, error) {
+	res, err := c.broadcast(ctx, func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error) {
+		return client.SubmitSignedAggregateSelectionProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.SignedAggregateSubmitResponse), nil
+}
+
+type endpointCall func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (proto.Message, error)
+
+// failover makes the call to the healthy endpoints in turn, each with a third of a slot to respond,
+// and returns the first successful response. A call failing because the context is done isn't
+// held against the endpoint.
+func (c *redundantValidatorClient) failover(ctx context.Context, call endpointCall) (proto.Message, error) {
+	timeout := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 3
+	var err error
+	for _, e := range c.healthyEndpoints() {
+		callCtx, cancel := context.WithTimeout(ctx, timeout)
+		var res proto.Message
+		res, err = call(callCtx, e.client)
+		cancel()
+		if err == nil {
+			e.report(nil)
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		e.report(err)
+		log.WithError(err).WithField("endpoint", e.name).Debug("Beacon node call failed, trying the next one")
+	}
+	return nil, err
+}
+
+// broadcast makes the call to all the healthy endpoints concurrently, and returns the first
+// successful response. The calls still running finish in the background, within the deadline of
+// the context, so that a stalled beacon node doesn't delay the others.
+func (c *redundantValidatorClient) broadcast(ctx context.Context, call endpointCall) (proto.Message, error) {
+	endpoints := c.healthyEndpoints()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	}
+	callCtx, cancel := context.WithDeadline(context.Background(), deadline)
+
+	type result struct {
+		res proto.Message
+		err error
+	}
+	results := make(chan result, len(endpoints))
+	var wg sync.WaitGroup
+	for _, e := range endpoints {
+		wg.Add(1)
+		go func(e *beaconEndpoint) {
+			defer wg.Done()
+			res, err := call(callCtx, e.client)
+			e.report(err)
+			if err != nil {
+				log.WithError(err).WithField("endpoint", e.name).Debug("Beacon node submission failed")
+			}
+			results <- result{res: res, err: err}
+		}(e)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	var err error
+	for range endpoints {
+		select {
+		case r := <-results:
+			if r.err == nil {
+				return r.res, nil
+			}
+			err = r.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return nil, errors.Wrap(err, "all beacon nodes failed")
+}
+
+// healthyEndpoints returns the endpoints which are healthy or whose backoff expired, or all the
+// endpoints if none is healthy.
+func (c *redundantValidatorClient) healthyEndpoints() []*beaconEndpoint {
+	healthy := make([]*beaconEndpoint, 0, len(c.endpoints))
+	for _, e := range c.endpoints {
+		if e.healthy() {
+			healthy = append(healthy, e)
+		}
+	}
+	if len(healthy) == 0 {
+		return c.endpoints
+	}
+	return healthy
+}
+
+func (e *beaconEndpoint) healthy() bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.failures < endpointMaxFailures || time.Now().After(e.unhealthyUntil)
+}
+
+// report records the result of a call, an endpoint failing too many consecutive calls is skipped
+// for an epoch before it is tried again.
+func (e *beaconEndpoint) report(err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if err == nil {
+		if e.failures >= endpointMaxFailures {
+			log.WithField("endpoint", e.name).Info("Beacon node is healthy again")
+		}
+		e.failures = 0
+		ValidatorBeaconNodeHealthyVec.WithLabelValues(e.name).Set(1)
+		return
+	}
+	ValidatorBeaconNodeFailuresVec.WithLabelValues(e.name).Inc()
+	e.failures++
+	if e.failures >= endpointMaxFailures {
+		if e.failures == endpointMaxFailures {
+			log.WithError(err).WithField("endpoint", e.name).Warn("Beacon node is unhealthy, skipping it for an epoch")
+		}
+		epoch := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second
+		e.unhealthyUntil = time.Now().Add(epoch)
+		ValidatorBeaconNodeHealthyVec.WithLabelValues(e.name).Set(0)
+	}
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRedundantValidatorClient_ProposeAttestation_StalledNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	stalled := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	healthy := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newRedundantValidatorClient([]string{"stalled", "healthy"}, []ethpb.BeaconNodeValidatorClient{stalled, healthy})
+
+	stalled.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	want := &ethpb.AttestResponse{AttestationDataRoot: []byte{'a'}}
+	healthy.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).Return(want, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	res, err := c.ProposeAttestation(ctx, &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res)
+	assert.Equal(t, true, time.Since(start) < time.Second, "Stalled beacon node delayed the submission")
+}
+
+func TestRedundantValidatorClient_SubmitSignedAggregateSelectionProof_AllFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	first := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	second := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newRedundantValidatorClient([]string{"first", "second"}, []ethpb.BeaconNodeValidatorClient{first, second})
+
+	first.EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), gomock.Any()).Return(nil, errors.New("bad"))
+	second.EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), gomock.Any()).Return(nil, errors.New("bad"))
+
+	_, err := c.SubmitSignedAggregateSelectionProof(context.Background(), &ethpb.SignedAggregateSubmitRequest{})
+	assert.ErrorContains(t, "all beacon nodes failed", err)
+}
+
+func TestRedundantValidatorClient_GetAttestationData_Failover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	failing := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	healthy := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newRedundantValidatorClient([]string{"failing", "healthy"}, []ethpb.BeaconNodeValidatorClient{failing, healthy})
+
+	// The failing beacon node is skipped after too many consecutive failures.
+	failing.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("bad")).Times(endpointMaxFailures)
+	want := &ethpb.AttestationData{Slot: 5}
+	healthy.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(want, nil).Times(endpointMaxFailures + 1)
+
+	for i := 0; i < endpointMaxFailures+1; i++ {
+		res, err := c.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{})
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, res)
+	}
+	assert.Equal(t, false, c.endpoints[0].healthy())
+	assert.Equal(t, true, c.endpoints[1].healthy())
+}
+
+func TestRedundantValidatorClient_AllUnhealthy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newRedundantValidatorClient([]string{"only"}, []ethpb.BeaconNodeValidatorClient{client})
+	for i := 0; i < endpointMaxFailures; i++ {
+		c.endpoints[0].report(errors.New("bad"))
+	}
+	require.Equal(t, false, c.endpoints[0].healthy())
+
+	// An unhealthy beacon node is still used when there is no other one.
+	client.EXPECT().SubmitAggregateSelectionProof(gomock.Any(), gomock.Any()).Return(&ethpb.AggregateSelectionResponse{}, nil)
+	_, err := c.SubmitAggregateSelectionProof(context.Background(), &ethpb.AggregateSelectionRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, true, c.endpoints[0].healthy(), "Beacon node is still unhealthy after a successful call")
+}
+
+func TestRedundantValidatorClient_GetDuties_Failover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	failing := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	healthy := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newRedundantValidatorClient([]string{"failing", "healthy"}, []ethpb.BeaconNodeValidatorClient{failing, healthy})
+
+	failing.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(nil, errors.New("bad"))
+	want := &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 1}}}
+	healthy.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(want, nil)
+
+	res, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res)
+}
+
+func TestRedundantValidatorClient_Failover_CanceledContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newRedundantValidatorClient([]string{"only"}, []ethpb.BeaconNodeValidatorClient{client})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(nil, context.Canceled).Times(endpointMaxFailures)
+	for i := 0; i < endpointMaxFailures; i++ {
+		_, err := c.DomainData(ctx, &ethpb.DomainRequest{})
+		assert.ErrorContains(t, context.Canceled.Error(), err)
+	}
+	assert.Equal(t, true, c.endpoints[0].healthy(), "Canceled calls are held against the beacon node")
+}
@@ -43,6 +43,8 @@ type ValidatorService struct {
 	graffiti             []byte
 	conn                 *grpc.ClientConn
 	endpoint             string
+	redundantEndpoints   []string
+	redundantConns       []*grpc.ClientConn
 	withCert             string
 	dataDir              string
 	keyManager           keymanager.KeyManager
@@ -60,6 +62,7 @@ type ValidatorService struct {
 // Config for the validator service.
 type Config struct {
 	Endpoint                   string
+	RedundantEndpoints         []string
 	DataDir                    string
 	CertFlag                   string
 	GraffitiFlag               string
@@ -85,6 +88,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		ctx:                  ctx,
 		cancel:               cancel,
 		endpoint:             cfg.Endpoint,
+		redundantEndpoints:   cfg.RedundantEndpoints,
 		withCert:             cfg.CertFlag,
 		dataDir:              cfg.DataDir,
 		graffiti:             []byte(cfg.GraffitiFlag),
@@ -122,7 +126,8 @@ func (v *ValidatorService) Start() {
 	if dialOpts == nil {
 		return
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+	target, targetOpts := grpcutils.DialTarget(v.endpoint)
+	conn, err := grpc.DialContext(v.ctx, target, append(dialOpts, targetOpts...)...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
 		return
@@ -132,6 +137,25 @@ func (v *ValidatorService) Start() {
 	}
 
 	v.conn = conn
+
+	// The attestations and aggregates are also sent to the redundant beacon nodes.
+	var validatorClient ethpb.BeaconNodeValidatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+	if len(v.redundantEndpoints) > 0 {
+		names := []string{v.endpoint}
+		clients := []ethpb.BeaconNodeValidatorClient{validatorClient}
+		for _, endpoint := range v.redundantEndpoints {
+			target, targetOpts := grpcutils.DialTarget(endpoint)
+			conn, err := grpc.DialContext(v.ctx, target, append(dialOpts, targetOpts...)...)
+			if err != nil {
+				log.Errorf("Could not dial redundant endpoint: %s, %v", endpoint, err)
+				continue
+			}
+			v.redundantConns = append(v.redundantConns, conn)
+			names = append(names, endpoint)
+			clients = append(clients, ethpb.NewBeaconNodeValidatorClient(conn))
+		}
+		validatorClient = newRedundantValidatorClient(names, clients)
+	}
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -149,7 +173,7 @@ func (v *ValidatorService) Start() {
 
 	v.validator = &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
+		validatorClient:                validatorClient,
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
 		node:                           ethpb.NewNodeClient(v.conn),
 		keyManager:                     v.keyManager,
@@ -175,6 +199,11 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	for _, conn := range v.redundantConns {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close redundant connection")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
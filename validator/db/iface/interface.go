@@ -17,9 +17,20 @@ type ValidatorDB interface {
 	// Proposer protection related methods.
 	ProposalHistoryForEpoch(ctx context.Context, publicKey []byte, epoch uint64) (bitfield.Bitlist, error)
 	SaveProposalHistoryForEpoch(ctx context.Context, publicKey []byte, epoch uint64, history bitfield.Bitlist) error
+	SaveProposalHistoryForSlot(ctx context.Context, publicKey []byte, slot uint64) error
+	ProposalHistoryForPubKey(ctx context.Context, publicKey []byte) ([]uint64, error)
+	ProposedPublicKeys(ctx context.Context) ([][48]byte, error)
 	// Attester protection related methods.
 	AttestationHistoryForPubKeys(ctx context.Context, publicKeys [][48]byte) (map[[48]byte]*slashpb.AttestationHistory, error)
 	SaveAttestationHistoryForPubKeys(ctx context.Context, historyByPubKey map[[48]byte]*slashpb.AttestationHistory) error
+	AttestedPublicKeys(ctx context.Context) ([][48]byte, error)
+	// Watermarks of the imported slashing protection histories.
+	LowestSignedProposal(ctx context.Context, publicKey [48]byte) (uint64, bool, error)
+	SaveLowestSignedProposal(ctx context.Context, publicKey [48]byte, slot uint64) error
+	LowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error)
+	SaveLowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error
+	LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error)
+	SaveLowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error
 	// Validator RPC authentication methods.
 	SaveHashedPasswordForAPI(ctx context.Context, hashedPassword []byte) error
 	HashedPasswordForAPI(ctx context.Context) ([]byte, error)
@@ -81,3 +81,20 @@ func (store *Store) SaveAttestationHistoryForPubKeys(ctx context.Context, histor
 	})
 	return err
 }
+
+// AttestedPublicKeys returns the validator public keys having an attestation history in the DB.
+func (store *Store) AttestedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestedPublicKeys")
+	defer span.End()
+
+	var pubKeys [][48]byte
+	err := store.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(historicAttestationsBucket).ForEach(func(k, _ []byte) error {
+			var pubKey [48]byte
+			copy(pubKey[:], k)
+			pubKeys = append(pubKeys, pubKey)
+			return nil
+		})
+	})
+	return pubKeys, err
+}
@@ -171,3 +171,26 @@ func TestSaveAttestationHistory_Overwrites(t *testing.T) {
 		}
 	}
 }
+
+func TestAttestedPublicKeys(t *testing.T) {
+	pubkeys := [][48]byte{{30}, {25}}
+	db := setupDB(t, pubkeys)
+
+	history := &slashpb.AttestationHistory{
+		TargetToSource:     map[uint64]uint64{0: params.BeaconConfig().FarFutureEpoch},
+		LatestEpochWritten: 0,
+	}
+	if err := db.SaveAttestationHistoryForPubKeys(context.Background(), map[[48]byte]*slashpb.AttestationHistory{
+		pubkeys[0]: history,
+	}); err != nil {
+		t.Fatalf("Saving attestation history failed: %v", err)
+	}
+
+	attested, err := db.AttestedPublicKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([][48]byte{pubkeys[0]}, attested) {
+		t.Fatalf("Expected attested public keys %v, received %v", pubkeys[:1], attested)
+	}
+}
@@ -76,6 +76,9 @@ func NewKVStore(dirPath string, pubKeys [][48]byte) (*Store, error) {
 			tx,
 			historicProposalsBucket,
 			historicAttestationsBucket,
+			lowestSignedProposalsBucket,
+			lowestSignedSourceBucket,
+			lowestSignedTargetBucket,
 			validatorAPIBucket,
 		)
 	}); err != nil {
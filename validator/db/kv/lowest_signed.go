@@ -0,0 +1,84 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/wealdtech/go-bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// LowestSignedProposal returns the lowest block slot of the slashing protection histories imported for
+// the validator public key, and false if no history with blocks was imported for it.
+func (store *Store) LowestSignedProposal(ctx context.Context, publicKey [48]byte) (uint64, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedProposal")
+	defer span.End()
+	return store.lowestSigned(lowestSignedProposalsBucket, publicKey)
+}
+
+// SaveLowestSignedProposal saves the lowest block slot of a slashing protection history imported for the
+// validator public key. As no block at or below the lowest slot of any imported history may be signed,
+// the highest of the saved slots is kept.
+func (store *Store) SaveLowestSignedProposal(ctx context.Context, publicKey [48]byte, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveLowestSignedProposal")
+	defer span.End()
+	return store.saveLowestSigned(lowestSignedProposalsBucket, publicKey, slot)
+}
+
+// LowestSignedSourceEpoch returns the lowest attestation source epoch of the slashing protection histories
+// imported for the validator public key, and false if no history with attestations was imported for it.
+func (store *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedSourceEpoch")
+	defer span.End()
+	return store.lowestSigned(lowestSignedSourceBucket, publicKey)
+}
+
+// SaveLowestSignedSourceEpoch saves the lowest attestation source epoch of a slashing protection history
+// imported for the validator public key, keeping the highest of the saved epochs.
+func (store *Store) SaveLowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveLowestSignedSourceEpoch")
+	defer span.End()
+	return store.saveLowestSigned(lowestSignedSourceBucket, publicKey, epoch)
+}
+
+// LowestSignedTargetEpoch returns the lowest attestation target epoch of the slashing protection histories
+// imported for the validator public key, and false if no history with attestations was imported for it.
+func (store *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedTargetEpoch")
+	defer span.End()
+	return store.lowestSigned(lowestSignedTargetBucket, publicKey)
+}
+
+// SaveLowestSignedTargetEpoch saves the lowest attestation target epoch of a slashing protection history
+// imported for the validator public key, keeping the highest of the saved epochs.
+func (store *Store) SaveLowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveLowestSignedTargetEpoch")
+	defer span.End()
+	return store.saveLowestSigned(lowestSignedTargetBucket, publicKey, epoch)
+}
+
+func (store *Store) lowestSigned(bucketName []byte, publicKey [48]byte) (uint64, bool, error) {
+	var lowest uint64
+	var exists bool
+	err := store.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(bucketName).Get(publicKey[:])
+		if len(enc) != 8 {
+			return nil
+		}
+		lowest = binary.LittleEndian.Uint64(enc)
+		exists = true
+		return nil
+	})
+	return lowest, exists, err
+}
+
+func (store *Store) saveLowestSigned(bucketName []byte, publicKey [48]byte, lowest uint64) error {
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		if enc := bucket.Get(publicKey[:]); len(enc) == 8 && binary.LittleEndian.Uint64(enc) >= lowest {
+			return nil
+		}
+		return bucket.Put(publicKey[:], bytesutil.Bytes8(lowest))
+	})
+}
@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_LowestSignedProposal(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, nil)
+
+	_, exists, err := db.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	require.NoError(t, db.SaveLowestSignedProposal(ctx, pubKey, 10))
+	slot, exists, err := db.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, uint64(10), slot)
+
+	// The highest watermark of the imported histories is kept.
+	require.NoError(t, db.SaveLowestSignedProposal(ctx, pubKey, 5))
+	slot, _, err = db.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), slot)
+	require.NoError(t, db.SaveLowestSignedProposal(ctx, pubKey, 20))
+	slot, _, err = db.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), slot)
+}
+
+func TestStore_LowestSignedSourceAndTargetEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, nil)
+
+	require.NoError(t, db.SaveLowestSignedSourceEpoch(ctx, pubKey, 3))
+	require.NoError(t, db.SaveLowestSignedTargetEpoch(ctx, pubKey, 4))
+	source, exists, err := db.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, uint64(3), source)
+	target, exists, err := db.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, uint64(4), target)
+
+	_, exists, err = db.LowestSignedTargetEpoch(ctx, [48]byte{2})
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+}
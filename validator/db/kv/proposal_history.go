@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
@@ -61,6 +62,81 @@ func (store *Store) SaveProposalHistoryForEpoch(ctx context.Context, pubKey []by
 	return err
 }
 
+// SaveProposalHistoryForSlot marks the slot as proposed in the proposal history of the validator public key,
+// the proposal history of a public key unknown to the DB is created.
+func (store *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey []byte, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveProposalHistoryForSlot")
+	defer span.End()
+
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(historicProposalsBucket)
+		valBucket, err := bucket.CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return errors.Wrap(err, "failed to create proposal history bucket")
+		}
+		slotBits := bitfield.NewBitlist(params.BeaconConfig().SlotsPerEpoch)
+		if enc := valBucket.Get(bytesutil.Bytes8(epoch)); len(enc) != 0 {
+			copy(slotBits, enc)
+		}
+		slotBits.SetBitAt(slot%params.BeaconConfig().SlotsPerEpoch, true)
+		if err := valBucket.Put(bytesutil.Bytes8(epoch), slotBits); err != nil {
+			return err
+		}
+		return pruneProposalHistory(valBucket, epoch)
+	})
+}
+
+// ProposalHistoryForPubKey returns the proposed slots in the proposal history of the validator public key,
+// in increasing order. Returns nil if there is no proposal history for the validator.
+func (store *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey []byte) ([]uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForPubKey")
+	defer span.End()
+
+	var slots []uint64
+	err := store.view(func(tx *bolt.Tx) error {
+		valBucket := tx.Bucket(historicProposalsBucket).Bucket(publicKey)
+		if valBucket == nil {
+			return nil
+		}
+		// The epochs are little endian encoded and can't be iterated in order.
+		if err := valBucket.ForEach(func(k, v []byte) error {
+			epoch := binary.LittleEndian.Uint64(k)
+			slotBits := bitfield.Bitlist(v)
+			for i := uint64(0); i < slotBits.Len(); i++ {
+				if slotBits.BitAt(i) {
+					slots = append(slots, epoch*params.BeaconConfig().SlotsPerEpoch+i)
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		sort.Slice(slots, func(i, j int) bool {
+			return slots[i] < slots[j]
+		})
+		return nil
+	})
+	return slots, err
+}
+
+// ProposedPublicKeys returns the validator public keys having a proposal history in the DB.
+func (store *Store) ProposedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposedPublicKeys")
+	defer span.End()
+
+	var pubKeys [][48]byte
+	err := store.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(historicProposalsBucket).ForEach(func(k, _ []byte) error {
+			var pubKey [48]byte
+			copy(pubKey[:], k)
+			pubKeys = append(pubKeys, pubKey)
+			return nil
+		})
+	})
+	return pubKeys, err
+}
+
 func pruneProposalHistory(valBucket *bolt.Bucket, newestEpoch uint64) error {
 	c := valBucket.Cursor()
 	for k, _ := c.First(); k != nil; k, _ = c.First() {
@@ -194,3 +194,29 @@ func TestPruneProposalHistory_OK(t *testing.T) {
 		}
 	}
 }
+
+func TestSaveProposalHistoryForSlot_OK(t *testing.T) {
+	pubkey := [48]byte{3}
+	db := setupDB(t, [][48]byte{})
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	slots := []uint64{1, 3, slotsPerEpoch + 2, 5 * slotsPerEpoch}
+	for _, slot := range []uint64{slots[3], slots[0], slots[2], slots[1]} {
+		require.NoError(t, db.SaveProposalHistoryForSlot(context.Background(), pubkey[:], slot), "Saving proposal history failed")
+	}
+
+	saved, err := db.ProposalHistoryForPubKey(context.Background(), pubkey[:])
+	require.NoError(t, err)
+	require.DeepEqual(t, slots, saved, "Unexpected proposed slots")
+	slotBits, err := db.ProposalHistoryForEpoch(context.Background(), pubkey[:], 1)
+	require.NoError(t, err)
+	require.Equal(t, true, slotBits.BitAt(2), "Expected slot to be marked as proposed")
+
+	pubKeys, err := db.ProposedPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{pubkey}, pubKeys)
+
+	saved, err = db.ProposalHistoryForPubKey(context.Background(), []byte{4})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(saved), "Expected no proposals for unknown public key")
+}
@@ -5,6 +5,11 @@ var (
 	historicProposalsBucket = []byte("proposal-history-bucket")
 	// Validator slashing protection from slashable attestations.
 	historicAttestationsBucket = []byte("attestation-history-bucket")
+	// Lowest signed block slot and attestation source and target epochs of the slashing protection
+	// histories imported by public key, under which the validator must not sign (EIP-3076).
+	lowestSignedProposalsBucket = []byte("lowest-signed-proposals-bucket")
+	lowestSignedSourceBucket    = []byte("lowest-signed-source-bucket")
+	lowestSignedTargetBucket    = []byte("lowest-signed-target-bucket")
 	// Bucket for storing important information regarding the validator API
 	// such as a password hash for API authentication.
 	validatorAPIBucket = []byte("validator-api-bucket")
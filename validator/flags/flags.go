@@ -30,9 +30,18 @@ var (
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Beacon node RPC provider endpoint, either host:port or unix:///path/to/socket for a beacon node serving RPC over a unix domain socket",
 		Value: "127.0.0.1:4000",
 	}
+	// BeaconRPCRedundantProvidersFlag defines the beacon node RPC endpoints the attestations are also sent to,
+	// and the validator calls fail over to.
+	BeaconRPCRedundantProvidersFlag = &cli.StringSliceFlag{
+		Name: "beacon-rpc-redundant-providers",
+		Usage: "Comma separated list of additional beacon node RPC endpoints the attestations and aggregates " +
+			"are submitted to concurrently. The attestation data, aggregates, duties, domains, validator indices " +
+			"and subnet subscriptions are requested from them when the beacon-rpc-provider fails. The endpoints " +
+			"failing consecutive calls are skipped for an epoch",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -144,6 +153,17 @@ var (
 		Name:  "target-dir",
 		Usage: "The directory of the target validator database",
 	}
+	// SlashingProtectionJSONFileFlag defines the path of the EIP-3076 slashing protection interchange file
+	// imported or exported.
+	SlashingProtectionJSONFileFlag = &cli.StringFlag{
+		Name:  "slashing-protection-json-file",
+		Usage: "The path of the slashing protection interchange JSON file to import or export",
+	}
+	// GenesisValidatorsRootFlag defines the genesis validators root of the chain of the slashing protection history.
+	GenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "genesis-validators-root",
+		Usage: "The hex encoded genesis validators root of the chain of the slashing protection history",
+	}
 	// UnencryptedKeysFlag specifies a file path of a JSON file of unencrypted validator keys as an
 	// alternative from launching the validator client from decrypting a keystore directory.
 	UnencryptedKeysFlag = &cli.StringFlag{
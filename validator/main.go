@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"runtime"
@@ -18,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -29,7 +31,6 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/node"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
-	"github.com/urfave/cli/v2/altsrc"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 	"google.golang.org/grpc"
 )
@@ -48,14 +49,29 @@ func startNode(ctx *cli.Context) error {
 	return nil
 }
 
+// genesisValidatorsRoot returns the genesis validators root of the hex encoded flag value.
+func genesisValidatorsRoot(cliCtx *cli.Context) ([32]byte, error) {
+	enc, err := hex.DecodeString(strings.TrimPrefix(cliCtx.String(flags.GenesisValidatorsRootFlag.Name), "0x"))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(enc) != 32 {
+		return [32]byte{}, fmt.Errorf("expected 32 bytes, received %d", len(enc))
+	}
+	return bytesutil.ToBytes32(enc), nil
+}
+
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
+	flags.BeaconRPCRedundantProvidersFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.KeystorePathFlag,
 	flags.SourceDirectories,
 	flags.SourceDirectory,
 	flags.TargetDirectory,
+	flags.SlashingProtectionJSONFileFlag,
+	flags.GenesisValidatorsRootFlag,
 	flags.PasswordFlag,
 	flags.DisablePenaltyRewardLogFlag,
 	flags.UnencryptedKeysFlag,
@@ -87,9 +103,14 @@ var appFlags = []cli.Flag{
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
+	cmd.TracingExporterFlag,
 	cmd.TraceSampleFractionFlag,
 	cmd.LogFormat,
 	cmd.LogFileName,
+	cmd.LogFileMaxSizeFlag,
+	cmd.LogFileRotationIntervalFlag,
+	cmd.LogFileMaxBackupsFlag,
+	cmd.LogFileCompressFlag,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
@@ -102,7 +123,11 @@ var appFlags = []cli.Flag{
 }
 
 func init() {
-	appFlags = cmd.WrapFlags(append(appFlags, featureconfig.ValidatorFlags...))
+	envFlags, err := cmd.WithEnvVars("PRYSM_VALIDATOR", append(appFlags, featureconfig.ValidatorFlags...))
+	if err != nil {
+		logrus.WithError(err).Fatal("Could not set the environment variables of the flags")
+	}
+	appFlags = cmd.WrapFlags(envFlags)
 }
 
 func main() {
@@ -115,6 +140,7 @@ func main() {
 	app.Commands = []*cli.Command{
 		v2.WalletCommands,
 		v2.AccountCommands,
+		cmd.DumpConfigCommand(appFlags),
 		{
 			Name:     "accounts",
 			Category: "accounts",
@@ -211,7 +237,8 @@ contract in order to activate the validator client`,
 							cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
 							grpc.WithBlock())
 						endpoint := cliCtx.String(flags.BeaconRPCProviderFlag.Name)
-						conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+						target, targetOpts := grpcutils.DialTarget(endpoint)
+						conn, err := grpc.DialContext(ctx, target, append(dialOpts, targetOpts...)...)
 						if err != nil {
 							log.WithError(err).Errorf("Failed to dial beacon node endpoint at %s", endpoint)
 							return err
@@ -295,15 +322,71 @@ contract in order to activate the validator client`,
 				},
 			},
 		},
+		{
+			Name:     "slashing-protection",
+			Category: "slashing-protection",
+			Usage:    "defines commands for moving the slashing protection history of validators between clients",
+			Subcommands: []*cli.Command{
+				{
+					Name:        "export",
+					Description: "exports the slashing protection history of the validator database in the EIP-3076 interchange format",
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.SlashingProtectionJSONFileFlag,
+						flags.GenesisValidatorsRootFlag,
+					},
+					Action: func(cliCtx *cli.Context) error {
+						dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+						filePath := cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name)
+						root, err := genesisValidatorsRoot(cliCtx)
+						if err != nil {
+							log.WithError(err).Error("Could not parse genesis validators root")
+							return err
+						}
+
+						if err := client.ExportSlashingProtection(context.Background(), dataDir, filePath, root); err != nil {
+							log.WithError(err).Error("Exporting slashing protection history failed")
+							return err
+						}
+						log.WithField("file", filePath).Info("Export completed successfully")
+						return nil
+					},
+				},
+				{
+					Name:        "import",
+					Description: "imports a slashing protection history in the EIP-3076 interchange format into the validator database",
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.SlashingProtectionJSONFileFlag,
+						flags.GenesisValidatorsRootFlag,
+					},
+					Action: func(cliCtx *cli.Context) error {
+						dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+						filePath := cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name)
+						root, err := genesisValidatorsRoot(cliCtx)
+						if err != nil {
+							log.WithError(err).Error("Could not parse genesis validators root")
+							return err
+						}
+
+						if err := client.ImportSlashingProtection(context.Background(), dataDir, filePath, root); err != nil {
+							log.WithError(err).Error("Importing slashing protection history failed")
+							return err
+						}
+						log.Info("Import completed successfully")
+						return nil
+					},
+				},
+			},
+		},
 	}
 
 	app.Flags = appFlags
 
 	app.Before = func(ctx *cli.Context) error {
-		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
-			if err := altsrc.InitInputSourceWithContext(appFlags, altsrc.NewYamlSourceFromFlagFunc(cmd.ConfigFileFlag.Name))(ctx); err != nil {
-				return err
-			}
+		// Load any flags from file, if specified.
+		if err := cmd.LoadFlagsFromConfig(ctx, appFlags); err != nil {
+			return err
 		}
 		flags.ComplainOnDeprecatedFlags(ctx)
 
@@ -331,7 +414,7 @@ contract in order to activate the validator client`,
 
 		logFileName := ctx.String(cmd.LogFileName.Name)
 		if logFileName != "" {
-			if err := logutil.ConfigurePersistentLogging(logFileName); err != nil {
+			if err := logutil.ConfigurePersistentLogging(logFileName, cmd.LogRotationConfig(ctx)); err != nil {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	accountsv2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
@@ -55,6 +56,7 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		"validator", // service name
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
+		cliCtx.String(cmd.TracingExporterFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
 		cliCtx.Bool(cmd.EnableTracingFlag.Name),
 	); err != nil {
@@ -233,6 +235,7 @@ func (s *ValidatorClient) registerClientService(
 	validatingPubKeys [][48]byte,
 ) error {
 	endpoint := s.cliCtx.String(flags.BeaconRPCProviderFlag.Name)
+	redundantEndpoints := sliceutil.SplitCommaSeparated(s.cliCtx.StringSlice(flags.BeaconRPCRedundantProvidersFlag.Name))
 	dataDir := s.cliCtx.String(cmd.DataDirFlag.Name)
 	logValidatorBalances := !s.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name)
 	emitAccountMetrics := !s.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name)
@@ -248,6 +251,7 @@ func (s *ValidatorClient) registerClientService(
 	}
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
 		Endpoint:                   endpoint,
+		RedundantEndpoints:         redundantEndpoints,
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		KeyManagerV2:               keyManagerV2,
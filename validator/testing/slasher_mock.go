@@ -18,6 +18,7 @@ type MockSlasher struct {
 	IsSlashableAttestationNoUpdateCalled bool
 	IsSlashableBlockCalled               bool
 	IsSlashableBlockNoUpdateCalled       bool
+	HighestAtts                          []*slashpb.HighestAttestation
 }
 
 // IsSlashableAttestation returns slashbale attestation if slash attestation is set to true.
@@ -79,3 +80,10 @@ func (ms MockSlasher) IsSlashableBlockNoUpdate(ctx context.Context, in *eth.Beac
 		Slashable: ms.SlashBlock,
 	}, nil
 }
+
+// HighestAttestations returns the highest attestations configured in the mock.
+func (ms MockSlasher) HighestAttestations(ctx context.Context, in *slashpb.HighestAttestationRequest, opts ...grpc.CallOption) (*slashpb.HighestAttestationResponse, error) {
+	return &slashpb.HighestAttestationResponse{
+		Attestations: ms.HighestAtts,
+	}, nil
+}
@@ -53,11 +53,16 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingExporterFlag,
 			cmd.TraceSampleFractionFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogFileMaxSizeFlag,
+			cmd.LogFileRotationIntervalFlag,
+			cmd.LogFileMaxBackupsFlag,
+			cmd.LogFileCompressFlag,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
@@ -78,6 +83,7 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "validator",
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
+			flags.BeaconRPCRedundantProvidersFlag,
 			flags.CertFlag,
 			flags.KeyManager,
 			flags.KeyManagerOpts,
@@ -98,6 +104,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.SourceDirectories,
 			flags.SourceDirectory,
 			flags.TargetDirectory,
+			flags.SlashingProtectionJSONFileFlag,
+			flags.GenesisValidatorsRootFlag,
 			flags.DisableAccountMetricsFlag,
 			flags.WalletDirFlag,
 			flags.DeprecatedPasswordsDirFlag,